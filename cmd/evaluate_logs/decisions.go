@@ -0,0 +1,137 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+// decision is one DecisionAnalysis event (the advice shown on a user-controlled turn and
+// the move taken) joined with what the player banked in that round.
+type decision struct {
+	round     string
+	player    string
+	bustRate  float64
+	handScore int     // Points banked by staying
+	evIfHit   float64 // Expected hand score after one more card
+	suggested string
+	chosen    string
+	banked    int // Points the player banked in the round (0 after a bust)
+	busted    bool
+}
+
+// diverged reports whether the player did not follow the suggestion.
+func (d decision) diverged() bool {
+	return d.chosen != d.suggested
+}
+
+// evCost estimates what a divergence cost: the expected value of the suggested move
+// (the hand score for stay, evIfHit for hit) minus what the round actually banked.
+// It is negative when the round turned out better than the suggestion's expectation.
+func (d decision) evCost() float64 {
+	suggested := float64(d.handScore)
+	if d.suggested == "hit" {
+		suggested = d.evIfHit
+	}
+	return suggested - float64(d.banked)
+}
+
+func (d decision) result() string {
+	if d.busted {
+		return "bust"
+	}
+	return fmt.Sprintf("banked %d", d.banked)
+}
+
+// decisions joins every DecisionAnalysis event of the game to the outcome of its round,
+// in round order. A player with no Stay, Frozen or Flip7 event in the round banked nothing
+// (a bust, or another player's Flip 7 ended the round).
+func (g *gameTranscript) decisions() []decision {
+	var result []decision
+	for _, roundID := range g.roundOrder {
+		records := g.rounds[roundID]
+
+		banked := make(map[string]int)
+		busted := make(map[string]bool)
+		for _, r := range records {
+			switch r.EventType {
+			case "Stay", "Frozen", "Flip7":
+				banked[r.PlayerID] = detailInt(r.Details, "banked_score")
+			case "Bust":
+				busted[r.PlayerID] = true
+			}
+		}
+
+		for _, r := range records {
+			if r.EventType != "DecisionAnalysis" {
+				continue
+			}
+			bustRate, _ := r.Details["bust_rate"].(float64)
+			evIfHit, _ := r.Details["ev_hit"].(float64)
+			result = append(result, decision{
+				round:     roundID,
+				player:    g.name(r.PlayerID),
+				bustRate:  bustRate,
+				handScore: detailInt(r.Details, "hand_score"),
+				evIfHit:   evIfHit,
+				suggested: detailString(r.Details, "suggested"),
+				chosen:    detailString(r.Details, "chosen"),
+				banked:    banked[r.PlayerID],
+				busted:    busted[r.PlayerID],
+			})
+		}
+	}
+	return result
+}
+
+// renderDecisionReview lists the turns where a user-controlled player did not follow the
+// suggested move, then their agreement rate and the estimated EV cost of those turns.
+func (g *gameTranscript) renderDecisionReview(w io.Writer) {
+	decisions := g.decisions()
+	if len(decisions) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w, "\n## Decision Review")
+	fmt.Fprintln(w)
+
+	type summary struct {
+		turns, followed int
+		cost            float64
+	}
+	summaries := make(map[string]*summary)
+	var players []string
+	var divergences []decision
+	for _, d := range decisions {
+		s, ok := summaries[d.player]
+		if !ok {
+			s = &summary{}
+			summaries[d.player] = s
+			players = append(players, d.player)
+		}
+		s.turns++
+		if d.diverged() {
+			s.cost += d.evCost()
+			divergences = append(divergences, d)
+		} else {
+			s.followed++
+		}
+	}
+
+	if len(divergences) == 0 {
+		fmt.Fprintln(w, "Every decision followed the suggestion.")
+	} else {
+		fmt.Fprintln(w, "| Round | Player | Hand | Bust | EV if hit | Suggested | Chosen | Round result | EV cost |")
+		fmt.Fprintln(w, "| ---: | :--- | ---: | ---: | ---: | :--- | :--- | :--- | ---: |")
+		for _, d := range divergences {
+			fmt.Fprintf(w, "| %s | %s | %d | %.1f%% | %.1f | %s | %s | %s | %.1f |\n",
+				d.round, d.player, d.handScore, d.bustRate*100, d.evIfHit, d.suggested, d.chosen, d.result(), d.evCost())
+		}
+	}
+
+	fmt.Fprintln(w)
+	for _, player := range players {
+		s := summaries[player]
+		fmt.Fprintf(w, "- %s followed %d of %d suggestions (%.1f%%); estimated EV cost of the rest: %.1f\n",
+			player, s.followed, s.turns, percent(s.followed, s.turns), s.cost)
+	}
+}
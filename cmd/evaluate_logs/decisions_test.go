@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func decisionRecord(game, round, suggested, chosen string, handScore int, evHit float64) LogRecord {
+	return LogRecord{GameID: game, RoundID: round, PlayerID: "id-me", EventType: "DecisionAnalysis", Details: map[string]interface{}{
+		"bust_rate":  0.25,
+		"hand_score": float64(handScore),
+		"ev_hit":     evHit,
+		"suggested":  suggested,
+		"chosen":     chosen,
+	}}
+}
+
+func TestRenderReport_DecisionReviewJoinsRoundOutcome(t *testing.T) {
+	records := []LogRecord{
+		{GameID: "g1", RoundID: "0", PlayerID: "system", EventType: "GameStart", Details: map[string]interface{}{
+			"players":    []interface{}{"Me", "Bob"},
+			"player_ids": []interface{}{"id-me", "id-bob"},
+		}},
+		// Round 1: Me follows once, then hits against a stay suggestion and busts
+		decisionRecord("g1", "1", "hit", "hit", 10, 14.5),
+		decisionRecord("g1", "1", "stay", "hit", 20, 12.0),
+		{GameID: "g1", RoundID: "1", PlayerID: "id-me", EventType: "Bust"},
+		{GameID: "g1", RoundID: "1", PlayerID: "id-bob", EventType: "Stay", Details: map[string]interface{}{"banked_score": 9.0, "total_score": 9.0}},
+		// Round 2: Me stays against a hit suggestion and banks 12
+		decisionRecord("g1", "2", "hit", "stay", 12, 16.5),
+		{GameID: "g1", RoundID: "2", PlayerID: "id-me", EventType: "Stay", Details: map[string]interface{}{"banked_score": 12.0, "total_score": 12.0}},
+		// Another game reusing round 2 must not be joined to g1
+		{GameID: "g2", RoundID: "2", PlayerID: "id-me", EventType: "Stay", Details: map[string]interface{}{"banked_score": 40.0, "total_score": 40.0}},
+	}
+
+	var buf bytes.Buffer
+	renderReport(&buf, records)
+	output := buf.String()
+
+	for _, want := range []string{
+		"## Decision Review",
+		"| 1 | Me | 20 | 25.0% | 12.0 | stay | hit | bust | 20.0 |",
+		"| 2 | Me | 12 | 25.0% | 16.5 | hit | stay | banked 12 | 4.5 |",
+		"- Me followed 1 of 3 suggestions (33.3%); estimated EV cost of the rest: 24.5",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "| 1 | Me | 10 |") {
+		t.Errorf("Expected the followed suggestion to be left out of the table, got:\n%s", output)
+	}
+	if strings.Count(output, "## Decision Review") != 1 {
+		t.Errorf("Expected a review only for the game with decisions, got:\n%s", output)
+	}
+}
@@ -1,29 +1,33 @@
 package main
 
 import (
-	"encoding/csv"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"strings"
+
+	"flip7_strategy/internal/infrastructure/logging"
 )
 
-type LogRecord struct {
-	Timestamp string
-	GameID    string
-	RoundID   string
-	PlayerID  string
-	EventType string
-	Details   map[string]interface{}
-}
+// LogRecord is one row of the game log, as read by the logging package.
+type LogRecord = logging.LogRecord
 
 func main() {
-	if len(os.Args) < 2 {
-		fmt.Println("Usage: evaluate_logs <log_file>")
+	args := os.Args[1:]
+	report := false
+	if len(args) > 0 && args[0] == "-report" {
+		// Render a readable transcript instead of aggregate statistics
+		report = true
+		args = args[1:]
+	}
+
+	if len(args) < 1 {
+		fmt.Println("Usage: evaluate_logs [-report] <log_file>")
 		return
 	}
 
-	filePath := os.Args[1]
+	filePath := args[0]
 	file, err := os.Open(filePath)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to open file: %v\n", err)
@@ -31,76 +35,91 @@ func main() {
 	}
 	defer file.Close()
 
-	reader := csv.NewReader(file)
-
-	// Read header
-	_, err = reader.Read()
+	records, err := logging.ReadLogRecords(file, os.Stderr)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to read header: %v\n", err)
 		return
 	}
 
-	var records []LogRecord
-	for {
-		row, err := reader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error reading row: %v\n", err)
-			continue
-		}
-
-		// Validate row has at least 5 fields before accessing
-		if len(row) < 5 {
-			fmt.Fprintf(os.Stderr, "Skipping malformed row (expected at least 5 fields, got %d): %v\n", len(row), row)
-			continue
-		}
-
-		var details map[string]interface{}
-		if len(row) > 5 {
-			if err := json.Unmarshal([]byte(row[5]), &details); err != nil {
-				fmt.Fprintf(os.Stderr, "Error unmarshalling details for row: %v\n\tJSON: %s\n\tError: %v\n", row, row[5], err)
-				details = make(map[string]interface{})
-			}
-		}
-
-		records = append(records, LogRecord{
-			Timestamp: row[0],
-			GameID:    row[1],
-			RoundID:   row[2],
-			PlayerID:  row[3],
-			EventType: row[4],
-			Details:   details,
-		})
+	if report {
+		renderReport(os.Stdout, records)
+		return
 	}
-
-	analyze(records)
+	analyze(os.Stdout, records)
 }
 
-func analyze(records []LogRecord) {
-	fmt.Printf("Total Records: %d\n", len(records))
+// analyze writes the statistics of records to w.
+func analyze(w io.Writer, records []LogRecord) {
+	fmt.Fprintf(w, "Total Records: %d\n", len(records))
+
+	// AI games name each seat's strategy in GameStart; they are summarized per strategy
+	// and the rest per player name.
+	strategies := indexStrategies(records)
 
 	games := make(map[string]bool)
 	playerWins := make(map[string]int)
 	busts := 0
 	flips := 0
+	// Manual Mode logs the risk shown before the draw that busted, and the hand score it cost
+	bustRisk, riskedBusts := 0.0, 0
+	scoreLost, scoredBusts := 0, 0
+
+	// Player IDs are resolved to names through GameStart (per game, IDs are unique), telling
+	// players who share a name in a game apart by their seat
+	names := make(map[string]string)
+	namesakes := make(map[string]map[string][]string) // Game ID -> name -> IDs of the players with it
+	totals := make(map[string]float64)                // Player ID -> last total score logged
+	turnTotals := make(map[string]int64)
+	turnMax := make(map[string]int64)
+	turnCounts := make(map[string]int)
 
 	for _, r := range records {
+		if isMatchEvent(r.EventType) {
+			continue
+		}
 		games[r.GameID] = true
 
-		if r.EventType == "GameEnd" {
-			if winners, ok := r.Details["winners"].([]interface{}); ok {
-				for _, w := range winners {
-					if name, ok := w.(string); ok {
-						playerWins[name]++
-					}
+		if r.EventType == "GameStart" {
+			players := detailStrings(r.Details, "players")
+			labels := seatLabels(players)
+			namesakes[r.GameID] = make(map[string][]string)
+			for i, id := range detailStrings(r.Details, "player_ids") {
+				if i < len(players) {
+					names[id] = labels[i]
+					namesakes[r.GameID][players[i]] = append(namesakes[r.GameID][players[i]], id)
+				}
+			}
+		}
+		if total, ok := r.Details["total_score"].(float64); ok {
+			totals[r.PlayerID] = total
+		}
+
+		if r.EventType == "TurnEnd" {
+			if ms, ok := r.Details["duration_ms"].(float64); ok {
+				turnTotals[r.PlayerID] += int64(ms)
+				turnCounts[r.PlayerID]++
+				if int64(ms) > turnMax[r.PlayerID] {
+					turnMax[r.PlayerID] = int64(ms)
 				}
 			}
 		}
 
+		if _, ok := strategies[r.GameID]; !ok && r.EventType == "GameEnd" {
+			for _, winner := range winnerLabels(r, names, namesakes[r.GameID], totals) {
+				playerWins[winner]++
+			}
+		}
+
 		if r.EventType == "Bust" {
 			busts++
+			if risk, ok := r.Details["pre_hit_bust_rate"].(float64); ok {
+				bustRisk += risk
+				riskedBusts++
+			}
+			if lost, ok := r.Details["hand_score_lost"].(float64); ok {
+				scoreLost += int(lost)
+				scoredBusts++
+			}
 		}
 
 		if r.EventType == "Flip7" {
@@ -108,12 +127,106 @@ func analyze(records []LogRecord) {
 		}
 	}
 
-	fmt.Printf("Total Games: %d\n", len(games))
-	fmt.Printf("Total Busts: %d\n", busts)
-	fmt.Printf("Total Flip7s: %d\n", flips)
+	fmt.Fprintf(w, "Total Games: %d\n", len(games))
+	fmt.Fprintf(w, "Total Busts: %d\n", busts)
+	if riskedBusts > 0 {
+		fmt.Fprintf(w, "Average Risk Taken on Busts: %.1f%% (%d busts)\n", bustRisk/float64(riskedBusts)*100, riskedBusts)
+	}
+	if scoredBusts > 0 {
+		fmt.Fprintf(w, "Average Hand Score Lost to Busts: %.1f\n", float64(scoreLost)/float64(scoredBusts))
+	}
+	fmt.Fprintf(w, "Total Flip7s: %d\n", flips)
+
+	fmt.Fprintln(w, "\nWins by Player:")
+	for p, wins := range playerWins {
+		fmt.Fprintf(w, "- %s: %d\n", p, wins)
+	}
+
+	if matches := collectMatches(records); len(matches) > 0 {
+		writeMatches(w, matches)
+	}
 
-	fmt.Println("\nWins by Player:")
-	for p, w := range playerWins {
-		fmt.Printf("- %s: %d\n", p, w)
+	if len(strategies) > 0 {
+		writeStrategySummary(w, summarizeStrategies(records, strategies))
+	}
+
+	if len(turnCounts) > 0 {
+		type turnStats struct {
+			name  string
+			avg   float64
+			max   int64
+			count int
+		}
+		var stats []turnStats
+		for id, count := range turnCounts {
+			name := names[id]
+			if name == "" {
+				name = id
+			}
+			stats = append(stats, turnStats{
+				name:  name,
+				avg:   float64(turnTotals[id]) / float64(count),
+				max:   turnMax[id],
+				count: count,
+			})
+		}
+		sort.Slice(stats, func(i, j int) bool { return stats[i].name < stats[j].name })
+
+		fmt.Fprintln(w, "\nTurn Durations by Player:")
+		for _, st := range stats {
+			fmt.Fprintf(w, "- %s: avg %.2fs, max %.2fs (%d turns)\n", st.name, st.avg/1000, float64(st.max)/1000, st.count)
+		}
+	}
+}
+
+// seatLabels names the players of a game in the order of its GameStart: by their name, or
+// by their name and seat (e.g. "Tom #2") when another player of the game has the same name,
+// ignoring case, as in logs from before Manual Mode made names unique.
+func seatLabels(players []string) []string {
+	count := make(map[string]int)
+	for _, name := range players {
+		count[strings.ToLower(name)]++
+	}
+	labels := make([]string, len(players))
+	for i, name := range players {
+		labels[i] = name
+		if count[strings.ToLower(name)] > 1 {
+			labels[i] = fmt.Sprintf("%s #%d", name, i+1)
+		}
+	}
+	return labels
+}
+
+// winnerLabels returns the labels (see seatLabels) of the winners of the GameEnd record r.
+// Winners are read by player ID when the log has them. Older logs only name the winners; a
+// name that several players share is resolved to the one with the highest total logged
+// (totals), the winner's when the players did not tie.
+func winnerLabels(r LogRecord, names map[string]string, namesakes map[string][]string, totals map[string]float64) []string {
+	var labels []string
+	if ids := detailStrings(r.Details, "winner_ids"); len(ids) > 0 {
+		winners := detailStrings(r.Details, "winners")
+		for i, id := range ids {
+			label := names[id]
+			if label == "" && i < len(winners) {
+				label = winners[i]
+			}
+			labels = append(labels, label)
+		}
+		return labels
+	}
+	for _, name := range detailStrings(r.Details, "winners") {
+		ids := namesakes[name]
+		if len(ids) == 0 {
+			labels = append(labels, name)
+			continue
+		}
+		best := ids[0]
+		for _, id := range ids[1:] {
+			if totals[id] > totals[best] {
+				best = id
+			}
+		}
+		labels = append(labels, names[best])
 	}
+	return labels
 }
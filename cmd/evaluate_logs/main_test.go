@@ -10,18 +10,7 @@ import (
 
 func TestAnalyze_EmptyRecords(t *testing.T) {
 	var buf bytes.Buffer
-	// Redirect stdout to buffer
-	oldStdout := os.Stdout
-	r, w, _ := os.Pipe()
-	os.Stdout = w
-
-	analyze([]LogRecord{})
-
-	w.Close()
-	os.Stdout = oldStdout
-	if _, err := buf.ReadFrom(r); err != nil {
-		t.Fatalf("Failed to read captured stdout: %v", err)
-	}
+	analyze(&buf, []LogRecord{})
 
 	output := buf.String()
 	if !strings.Contains(output, "Total Records: 0") {
@@ -64,17 +53,7 @@ func TestAnalyze_MultipleRecords(t *testing.T) {
 	}
 
 	var buf bytes.Buffer
-	oldStdout := os.Stdout
-	r, w, _ := os.Pipe()
-	os.Stdout = w
-
-	analyze(records)
-
-	w.Close()
-	os.Stdout = oldStdout
-	if _, err := buf.ReadFrom(r); err != nil {
-		t.Fatalf("Failed to read captured stdout: %v", err)
-	}
+	analyze(&buf, records)
 
 	output := buf.String()
 
@@ -101,6 +80,107 @@ func TestAnalyze_MultipleRecords(t *testing.T) {
 	}
 }
 
+func TestAnalyze_TurnDurations(t *testing.T) {
+	records := []LogRecord{
+		{
+			GameID:    "game1",
+			EventType: "GameStart",
+			Details: map[string]interface{}{
+				"players":    []interface{}{"Alice", "Bob"},
+				"player_ids": []interface{}{"id-a", "id-b"},
+			},
+		},
+		{GameID: "game1", PlayerID: "id-a", EventType: "TurnEnd", Details: map[string]interface{}{"duration_ms": 1000.0}},
+		{GameID: "game1", PlayerID: "id-b", EventType: "TurnEnd", Details: map[string]interface{}{"duration_ms": 4000.0}},
+		{GameID: "game1", PlayerID: "id-a", EventType: "TurnEnd", Details: map[string]interface{}{"duration_ms": 3000.0}},
+	}
+
+	var buf bytes.Buffer
+	analyze(&buf, records)
+
+	output := buf.String()
+	if !strings.Contains(output, "- Alice: avg 2.00s, max 3.00s (2 turns)") {
+		t.Errorf("Expected Alice's turn stats, got: %s", output)
+	}
+	if !strings.Contains(output, "- Bob: avg 4.00s, max 4.00s (1 turns)") {
+		t.Errorf("Expected Bob's turn stats, got: %s", output)
+	}
+}
+
+func TestAnalyze_DuplicateNames(t *testing.T) {
+	// Two players named Tom in each game. The first game's log predates winner IDs, so its
+	// winner is the Tom with the higher total; the second names its winner by ID.
+	start := func(game string) LogRecord {
+		return LogRecord{GameID: game, EventType: "GameStart", Details: map[string]interface{}{
+			"players":    []interface{}{"Me", "Tom", "Tom"},
+			"player_ids": []interface{}{game + "-me", game + "-tom1", game + "-tom2"},
+		}}
+	}
+	records := []LogRecord{
+		start("game1"),
+		{GameID: "game1", PlayerID: "game1-tom1", EventType: "Stay", Details: map[string]interface{}{"total_score": 150.0}},
+		{GameID: "game1", PlayerID: "game1-tom2", EventType: "Stay", Details: map[string]interface{}{"total_score": 210.0}},
+		{GameID: "game1", PlayerID: "game1-tom1", EventType: "TurnEnd", Details: map[string]interface{}{"duration_ms": 1000.0}},
+		{GameID: "game1", PlayerID: "game1-tom2", EventType: "TurnEnd", Details: map[string]interface{}{"duration_ms": 3000.0}},
+		{GameID: "game1", EventType: "GameEnd", Details: map[string]interface{}{"winners": []interface{}{"Tom"}}},
+		start("game2"),
+		{GameID: "game2", EventType: "GameEnd", Details: map[string]interface{}{
+			"winners":    []interface{}{"Tom"},
+			"winner_ids": []interface{}{"game2-tom2"},
+		}},
+	}
+
+	var buf bytes.Buffer
+	analyze(&buf, records)
+
+	output := buf.String()
+	for _, want := range []string{
+		"- Tom #3: 2\n",
+		"- Tom #2: avg 1.00s, max 1.00s (1 turns)",
+		"- Tom #3: avg 3.00s, max 3.00s (1 turns)",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q, got: %s", want, output)
+		}
+	}
+	if strings.Contains(output, "- Tom: ") || strings.Contains(output, "- Tom #2: 1") {
+		t.Errorf("Expected the two Toms not to be merged, got: %s", output)
+	}
+}
+
+func TestAnalyze_Matches(t *testing.T) {
+	inMatch := func(game string, number int) LogRecord {
+		return LogRecord{GameID: game, EventType: "GameInMatch", Details: map[string]interface{}{"match_id": "match1", "game": float64(number)}}
+	}
+	records := []LogRecord{
+		{GameID: "match1", EventType: "MatchStart", Details: map[string]interface{}{"best_of": 3.0}},
+		{GameID: "match1_game1", EventType: "GameStart"},
+		inMatch("match1_game1", 1),
+		{GameID: "match1_game2", EventType: "GameStart"},
+		inMatch("match1_game2", 2),
+		{GameID: "match1", EventType: "MatchEnd", Details: map[string]interface{}{
+			"winners": []interface{}{"Bob"},
+			"wins":    map[string]interface{}{"Bob": 2.0, "Me": 0.0},
+		}},
+		{GameID: "match2", EventType: "MatchStart", Details: map[string]interface{}{"best_of": 5.0}},
+		{GameID: "match2_game1", EventType: "GameStart"},
+	}
+
+	var buf bytes.Buffer
+	analyze(&buf, records)
+
+	output := buf.String()
+	for _, want := range []string{
+		"Total Games: 3\n",
+		"- match1 (best of 3): 2 game(s) [match1_game1, match1_game2], won by Bob (2 wins)\n",
+		"- match2 (best of 5): 0 game(s) [], not finished\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q, got: %s", want, output)
+		}
+	}
+}
+
 func TestMain_NoArguments(t *testing.T) {
 	// Save original args
 	oldArgs := os.Args
@@ -123,7 +203,7 @@ func TestMain_NoArguments(t *testing.T) {
 	}
 
 	output := buf.String()
-	if !strings.Contains(output, "Usage: evaluate_logs <log_file>") {
+	if !strings.Contains(output, "Usage: evaluate_logs [-report] <log_file>") {
 		t.Errorf("Expected usage message, got: %s", output)
 	}
 }
@@ -366,3 +446,25 @@ func TestMain_EmptyFile(t *testing.T) {
 		t.Errorf("Expected 'Failed to read header' error, got: %s", output)
 	}
 }
+
+func TestAnalyze_BustRisk(t *testing.T) {
+	records := []LogRecord{
+		{GameID: "game1", EventType: "Bust", Details: map[string]interface{}{"pre_hit_bust_rate": 0.2, "hand_score_lost": 20.0}},
+		{GameID: "game1", EventType: "Bust", Details: map[string]interface{}{"pre_hit_bust_rate": 0.4, "hand_score_lost": 5.0}},
+		{GameID: "game1", EventType: "Bust", Details: map[string]interface{}{"hand_score_lost": 11.0}}, // Busted during a Flip Three
+	}
+
+	var buf bytes.Buffer
+	analyze(&buf, records)
+
+	output := buf.String()
+	for _, want := range []string{
+		"Total Busts: 3",
+		"Average Risk Taken on Busts: 30.0% (2 busts)",
+		"Average Hand Score Lost to Busts: 12.0",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q, got: %s", want, output)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// matchSeries is a best-of-N series of games found in a log. Its MatchStart and MatchEnd
+// events are logged under the match ID, and every game finished in it logs a GameInMatch
+// event naming the match.
+type matchSeries struct {
+	id      string
+	bestOf  int
+	games   []string       // IDs of the games finished in the series, in order
+	ended   bool           // Whether the MatchEnd event was logged
+	winners []string       // Series winners, from MatchEnd
+	wins    map[string]int // Player name -> games won, from MatchEnd
+}
+
+// isMatchEvent reports whether eventType is logged under a match ID rather than a game ID.
+func isMatchEvent(eventType string) bool {
+	return eventType == "MatchStart" || eventType == "MatchEnd"
+}
+
+// collectMatches groups the games of records into the series they belong to, in the order
+// the series were first logged.
+func collectMatches(records []LogRecord) []*matchSeries {
+	var matches []*matchSeries
+	byID := make(map[string]*matchSeries)
+	series := func(id string) *matchSeries {
+		m, ok := byID[id]
+		if !ok {
+			m = &matchSeries{id: id}
+			byID[id] = m
+			matches = append(matches, m)
+		}
+		return m
+	}
+
+	for _, r := range records {
+		switch r.EventType {
+		case "MatchStart":
+			series(r.GameID).bestOf = detailInt(r.Details, "best_of")
+		case "GameInMatch":
+			if id := detailString(r.Details, "match_id"); id != "" {
+				m := series(id)
+				m.games = append(m.games, r.GameID)
+			}
+		case "MatchEnd":
+			m := series(r.GameID)
+			m.ended = true
+			m.winners = detailStrings(r.Details, "winners")
+			m.wins = make(map[string]int)
+			if wins, ok := r.Details["wins"].(map[string]interface{}); ok {
+				for name, v := range wins {
+					if f, ok := v.(float64); ok {
+						m.wins[name] = int(f)
+					}
+				}
+			}
+		}
+	}
+	return matches
+}
+
+// writeMatches lists every series with its games and, once it ended, its winners.
+func writeMatches(w io.Writer, matches []*matchSeries) {
+	fmt.Fprintln(w, "\nMatches:")
+	for _, m := range matches {
+		fmt.Fprintf(w, "- %s (best of %d): %d game(s) [%s]", m.id, m.bestOf, len(m.games), strings.Join(m.games, ", "))
+		if !m.ended {
+			fmt.Fprintln(w, ", not finished")
+			continue
+		}
+		winners := make([]string, len(m.winners))
+		for i, name := range m.winners {
+			winners[i] = fmt.Sprintf("%s (%d wins)", name, m.wins[name])
+		}
+		fmt.Fprintf(w, ", won by %s\n", strings.Join(winners, ", "))
+	}
+}
@@ -0,0 +1,240 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// gameTranscript collects the records of one game, grouped by round.
+type gameTranscript struct {
+	id         string
+	players    []string          // Seat order from GameStart
+	names      map[string]string // Player ID -> name
+	rounds     map[string][]LogRecord
+	roundOrder []string
+	end        *LogRecord
+	match      *LogRecord // GameInMatch, for a game of a series
+}
+
+// renderReport writes a human-readable markdown transcript of every game in records.
+// Games appear in the order they were first logged. Rounds are grouped per game, because
+// RoundID is only the round count and repeats across games in the same log file.
+func renderReport(w io.Writer, records []LogRecord) {
+	var games []*gameTranscript
+	byID := make(map[string]*gameTranscript)
+
+	for i := range records {
+		r := records[i]
+		if isMatchEvent(r.EventType) {
+			continue // Logged under the match, not a game
+		}
+		g, ok := byID[r.GameID]
+		if !ok {
+			g = &gameTranscript{
+				id:     r.GameID,
+				names:  make(map[string]string),
+				rounds: make(map[string][]LogRecord),
+			}
+			byID[r.GameID] = g
+			games = append(games, g)
+		}
+
+		switch r.EventType {
+		case "GameStart":
+			g.players = detailStrings(r.Details, "players")
+			for i, id := range detailStrings(r.Details, "player_ids") {
+				if i < len(g.players) {
+					g.names[id] = g.players[i]
+				}
+			}
+		case "GameEnd":
+			g.end = &records[i]
+		case "GameInMatch":
+			g.match = &records[i]
+		default:
+			if _, seen := g.rounds[r.RoundID]; !seen {
+				g.roundOrder = append(g.roundOrder, r.RoundID)
+			}
+			g.rounds[r.RoundID] = append(g.rounds[r.RoundID], r)
+		}
+	}
+
+	for i, g := range games {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		g.render(w)
+	}
+}
+
+func (g *gameTranscript) name(playerID string) string {
+	if name, ok := g.names[playerID]; ok {
+		return name
+	}
+	return playerID
+}
+
+func (g *gameTranscript) render(w io.Writer) {
+	fmt.Fprintf(w, "# Game %s\n", g.id)
+	if len(g.players) > 0 {
+		fmt.Fprintf(w, "\nPlayers: %s\n", strings.Join(g.players, ", "))
+	}
+	if g.match != nil {
+		fmt.Fprintf(w, "\nGame %d of match %s\n", detailInt(g.match.Details, "game"), detailString(g.match.Details, "match_id"))
+	}
+
+	// Order rounds by number, not by string ("10" after "9").
+	sort.SliceStable(g.roundOrder, func(i, j int) bool {
+		a, errA := strconv.Atoi(g.roundOrder[i])
+		b, errB := strconv.Atoi(g.roundOrder[j])
+		if errA != nil || errB != nil {
+			return errA == nil && errB != nil
+		}
+		return a < b
+	})
+
+	totals := make(map[string]int)
+	for _, p := range g.players {
+		totals[p] = 0
+	}
+
+	for _, roundID := range g.roundOrder {
+		g.renderRound(w, roundID, totals)
+	}
+
+	g.renderDecisionReview(w)
+
+	// Prefer the final scores recorded at GameEnd; fall back to the last banked totals.
+	if g.end != nil {
+		if scores, ok := g.end.Details["scores"].(map[string]interface{}); ok {
+			for name, v := range scores {
+				if f, ok := v.(float64); ok {
+					totals[name] = int(f)
+				}
+			}
+		}
+	}
+
+	fmt.Fprintln(w, "\n## Final Scoreboard")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "| Player | Score |")
+	fmt.Fprintln(w, "| :--- | ---: |")
+	names := make([]string, 0, len(totals))
+	for name := range totals {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if totals[names[i]] != totals[names[j]] {
+			return totals[names[i]] > totals[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		fmt.Fprintf(w, "| %s | %d |\n", name, totals[name])
+	}
+
+	if g.end != nil {
+		winners := detailStrings(g.end.Details, "winners")
+		if len(winners) > 0 {
+			fmt.Fprintf(w, "\nWinner(s): %s\n", strings.Join(winners, ", "))
+		} else {
+			fmt.Fprintln(w, "\nNo winner determined.")
+		}
+	}
+}
+
+func (g *gameTranscript) renderRound(w io.Writer, roundID string, totals map[string]int) {
+	records := g.rounds[roundID]
+
+	dealer := ""
+	for _, r := range records {
+		if r.EventType == "RoundStart" {
+			dealer = detailString(r.Details, "dealer")
+			break
+		}
+	}
+	if dealer != "" {
+		fmt.Fprintf(w, "\n## Round %s (Dealer: %s)\n\n", roundID, dealer)
+	} else {
+		fmt.Fprintf(w, "\n## Round %s\n\n", roundID)
+	}
+
+	// Cards in draw order, per player in order of first appearance
+	cards := make(map[string][]string)
+	var order []string
+
+	for _, r := range records {
+		player := g.name(r.PlayerID)
+		switch r.EventType {
+		case "InitialDeal", "CardPlayed":
+			if _, ok := cards[player]; !ok {
+				order = append(order, player)
+			}
+			card := detailString(r.Details, "card")
+			cards[player] = append(cards[player], card)
+			if r.EventType == "InitialDeal" {
+				fmt.Fprintf(w, "- %s is dealt %s\n", player, card)
+			} else {
+				fmt.Fprintf(w, "- %s flips %s\n", player, card)
+			}
+		case "ActionTarget":
+			fmt.Fprintf(w, "- %s plays %s on %s\n", player, detailString(r.Details, "action"), detailString(r.Details, "target"))
+		case "Stay":
+			totals[player] = detailInt(r.Details, "total_score")
+			fmt.Fprintf(w, "- %s stays and banks %d (total %d)\n", player, detailInt(r.Details, "banked_score"), totals[player])
+		case "Frozen":
+			totals[player] = detailInt(r.Details, "total_score")
+			fmt.Fprintf(w, "- %s is frozen and banks %d (total %d)\n", player, detailInt(r.Details, "banked_score"), totals[player])
+		case "Flip7":
+			totals[player] = detailInt(r.Details, "total_score")
+			fmt.Fprintf(w, "- %s completes Flip 7 and banks %d (total %d)\n", player, detailInt(r.Details, "banked_score"), totals[player])
+		case "Bust":
+			fmt.Fprintf(w, "- %s busts with %s\n", player, detailString(r.Details, "hand"))
+		case "ActionResolved":
+			if backfired, _ := r.Details["backfired"].(bool); backfired {
+				fmt.Fprintf(w, "- %s's %s backfired: %s completed Flip 7\n", player, detailString(r.Details, "action"), detailString(r.Details, "target"))
+			}
+		case "Reshuffle":
+			fmt.Fprintf(w, "- Deck reshuffled from %d discarded cards\n", detailInt(r.Details, "discard_count"))
+		}
+	}
+
+	if len(order) > 0 {
+		fmt.Fprintln(w, "\nCards in draw order:")
+		for _, player := range order {
+			fmt.Fprintf(w, "- %s: %s\n", player, strings.Join(cards[player], ", "))
+		}
+	}
+}
+
+func detailString(details map[string]interface{}, key string) string {
+	if v, ok := details[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func detailInt(details map[string]interface{}, key string) int {
+	// Numbers decoded from the JSON details column are float64
+	if v, ok := details[key].(float64); ok {
+		return int(v)
+	}
+	return 0
+}
+
+func detailStrings(details map[string]interface{}, key string) []string {
+	values, ok := details[key].([]interface{})
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		if s, ok := v.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
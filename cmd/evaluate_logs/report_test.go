@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/infrastructure/logging"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// playScriptedGame plays a short manual game (two rounds, Me wins with 201) and returns the log path.
+func playScriptedGame(t *testing.T) string {
+	t.Helper()
+
+	logPath := filepath.Join(t.TempDir(), "game.csv")
+	csvLogger, err := logging.NewCSVLogger(logPath)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+
+	input := strings.Join([]string{
+		"",    // Start a new game
+		"2",   // Players
+		"Bob", // Name for Player 2
+		"1",   // Me deals first
+		"",    // Default winning score (200)
+		// Round 1: Me reaches Flip 7 after Bob's Flip Three
+		"12", "5", // Initial deal
+		"x2", "T", "1", "11", "10", "9", "+10", "S", "8", "7", "6",
+		// Round 2: Bob busts, Me's Second Chance absorbs a duplicate 12
+		"3", "12", // Initial deal
+		"3", "C", "11", "10", "12", "9", "8", "S",
+	}, "\n") + "\n"
+
+	svc := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), csvLogger)
+	svc.GameID = "golden"
+
+	// Silence the interactive prompts
+	oldStdout := os.Stdout
+	devNull, _ := os.Open(os.DevNull)
+	os.Stdout = devNull
+	svc.Run()
+	os.Stdout = oldStdout
+	devNull.Close()
+
+	csvLogger.Close()
+	return logPath
+}
+
+func TestMain_ReportGolden(t *testing.T) {
+	logPath := playScriptedGame(t)
+
+	oldArgs := os.Args
+	defer func() { os.Args = oldArgs }()
+	os.Args = []string{"evaluate_logs", "-report", logPath}
+
+	var buf bytes.Buffer
+	oldStdout := os.Stdout
+	r, w, _ := os.Pipe()
+	os.Stdout = w
+
+	main()
+
+	w.Close()
+	os.Stdout = oldStdout
+	if _, err := buf.ReadFrom(r); err != nil {
+		t.Fatalf("Failed to read captured stdout: %v", err)
+	}
+
+	goldenPath := filepath.Join("testdata", "report_golden.md")
+	if *update {
+		if err := os.WriteFile(goldenPath, buf.Bytes(), 0644); err != nil {
+			t.Fatalf("Failed to update golden file: %v", err)
+		}
+	}
+
+	expected, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("Failed to read golden file: %v", err)
+	}
+	if buf.String() != string(expected) {
+		t.Errorf("Report does not match %s (run with -update to regenerate).\nGot:\n%s", goldenPath, buf.String())
+	}
+}
+
+func TestRenderReport_GroupsRoundsPerGame(t *testing.T) {
+	// Two games share the same round IDs, and round 10 must come after round 9.
+	records := []LogRecord{
+		{GameID: "g1", RoundID: "10", PlayerID: "a", EventType: "CardPlayed", Details: map[string]interface{}{"card": "7"}},
+		{GameID: "g2", RoundID: "1", PlayerID: "b", EventType: "CardPlayed", Details: map[string]interface{}{"card": "4"}},
+		{GameID: "g1", RoundID: "9", PlayerID: "a", EventType: "CardPlayed", Details: map[string]interface{}{"card": "3"}},
+	}
+
+	var buf bytes.Buffer
+	renderReport(&buf, records)
+	output := buf.String()
+
+	g1 := strings.Index(output, "# Game g1")
+	g2 := strings.Index(output, "# Game g2")
+	r9 := strings.Index(output, "## Round 9")
+	r10 := strings.Index(output, "## Round 10")
+	if g1 < 0 || g2 < 0 || r9 < 0 || r10 < 0 {
+		t.Fatalf("Missing sections in report:\n%s", output)
+	}
+	if !(g1 < r9 && r9 < r10 && r10 < g2) {
+		t.Errorf("Expected g1 rounds 9 then 10 before g2, got:\n%s", output)
+	}
+	if strings.Count(output, "## Round 1\n") != 1 {
+		t.Errorf("Expected exactly one round 1 (from g2), got:\n%s", output)
+	}
+}
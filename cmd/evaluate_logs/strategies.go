@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// gameSeats is the strategy behind each seat of one game, taken from the optional
+// "strategy" detail of GameStart (player ID -> strategy name).
+type gameSeats struct {
+	byID   map[string]string // Player ID -> strategy
+	byName map[string]string // Player name -> strategy (GameEnd lists winners by name)
+}
+
+// indexStrategies builds the per-game seat index before the event pass.
+// Games whose GameStart carries no strategy detail (manual games) are left out.
+func indexStrategies(records []LogRecord) map[string]gameSeats {
+	index := make(map[string]gameSeats)
+	for _, r := range records {
+		if r.EventType != "GameStart" {
+			continue
+		}
+		strategies, ok := r.Details["strategy"].(map[string]interface{})
+		if !ok || len(strategies) == 0 {
+			continue
+		}
+		seats := gameSeats{byID: make(map[string]string), byName: make(map[string]string)}
+		for id, v := range strategies {
+			if name, ok := v.(string); ok {
+				seats.byID[id] = name
+			}
+		}
+		players := detailStrings(r.Details, "players")
+		for i, id := range detailStrings(r.Details, "player_ids") {
+			if strategy, ok := seats.byID[id]; ok && i < len(players) {
+				seats.byName[players[i]] = strategy
+			}
+		}
+		index[r.GameID] = seats
+	}
+	return index
+}
+
+// strategyStats aggregates the seats played by one strategy across the games of a log.
+type strategyStats struct {
+	name       string
+	seats      int // Seats taken, one per game per player using the strategy
+	wins       int
+	winRounds  int // Rounds played in the games won
+	seatRounds int // Rounds played across all seats
+	busts      int
+}
+
+// summarizeStrategies computes win rate, average rounds per win and bust rate per strategy
+// for the games in index, sorted by strategy name.
+func summarizeStrategies(records []LogRecord, index map[string]gameSeats) []*strategyStats {
+	byName := make(map[string]*strategyStats)
+	get := func(name string) *strategyStats {
+		st, ok := byName[name]
+		if !ok {
+			st = &strategyStats{name: name}
+			byName[name] = st
+		}
+		return st
+	}
+
+	// A game's round count is the highest round number it logged
+	rounds := make(map[string]int)
+	for _, r := range records {
+		seats, ok := index[r.GameID]
+		if !ok {
+			continue
+		}
+		if n, err := strconv.Atoi(r.RoundID); err == nil && n > rounds[r.GameID] {
+			rounds[r.GameID] = n
+		}
+
+		switch r.EventType {
+		case "Bust":
+			if strategy, ok := seats.byID[r.PlayerID]; ok {
+				get(strategy).busts++
+			}
+		case "GameEnd":
+			for _, winner := range detailStrings(r.Details, "winners") {
+				if strategy, ok := seats.byName[winner]; ok {
+					st := get(strategy)
+					st.wins++
+					st.winRounds += rounds[r.GameID]
+				}
+			}
+		}
+	}
+
+	for gameID, seats := range index {
+		for _, strategy := range seats.byID {
+			st := get(strategy)
+			st.seats++
+			st.seatRounds += rounds[gameID]
+		}
+	}
+
+	stats := make([]*strategyStats, 0, len(byName))
+	for _, st := range byName {
+		stats = append(stats, st)
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].name < stats[j].name })
+	return stats
+}
+
+func writeStrategySummary(w io.Writer, stats []*strategyStats) {
+	fmt.Fprintln(w, "\nResults by Strategy:")
+	for _, st := range stats {
+		fmt.Fprintf(w, "- %s: won %d of %d (%.1f%%)", st.name, st.wins, st.seats, percent(st.wins, st.seats))
+		if st.wins > 0 {
+			fmt.Fprintf(w, ", %.1f rounds per win", float64(st.winRounds)/float64(st.wins))
+		}
+		fmt.Fprintf(w, ", busted in %d of %d rounds (%.1f%%)\n", st.busts, st.seatRounds, percent(st.busts, st.seatRounds))
+	}
+}
+
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total) * 100
+}
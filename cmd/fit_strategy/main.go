@@ -0,0 +1,126 @@
+// Command fit_strategy fits the simplest model of a player's hit/stay choices to their Manual
+// Mode games: the hand score they stay on, per bust-rate decile. The fitted PersonalizedStrategy
+// is registered as "personal:<log file>" and can be played against the built-in strategies.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+	"flip7_strategy/internal/infrastructure/logging"
+)
+
+var (
+	player   = flag.String("player", "", "name of the player to fit (default: every user-controlled seat in the log)")
+	binWidth = flag.Int("bin", strategy.DefaultPersonalBinWidth, "width of the hand score bins, in points")
+	games    = flag.Int("games", 0, "games of a free-for-all against every built-in strategy to play with the fitted strategy (0 to skip)")
+)
+
+func main() {
+	flag.Parse()
+	if flag.NArg() != 1 {
+		fmt.Println("Usage: fit_strategy [-player NAME] [-bin N] [-games N] <log_file>")
+		return
+	}
+
+	path := flag.Arg(0)
+	file, err := os.Open(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open file: %v\n", err)
+		os.Exit(1)
+	}
+	records, err := logging.ReadLogRecords(file, os.Stderr)
+	file.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read header: %v\n", err)
+		os.Exit(1)
+	}
+
+	fit, err := strategy.FitPersonalizedStrategy(observedDecisions(records, *player), *binWidth)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Cannot fit a strategy: %v (DecisionAnalysis events are logged for user-controlled seats of Manual Mode)\n", err)
+		os.Exit(1)
+	}
+	label := "personal:" + filepath.Base(path)
+	if err := strategy.Register(label, func() domain.Strategy { return fit.Strategy(label) }); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to register the strategy: %v\n", err)
+		os.Exit(1)
+	}
+
+	writeFitSummary(os.Stdout, label, fit)
+	if *games > 0 {
+		fmt.Println()
+		sim := application.NewSimulationService()
+		if err := sim.RunLineupEvaluation(*games, strategy.Names(), runtime.NumCPU()); err != nil {
+			fmt.Fprintf(os.Stderr, "Evaluation failed: %v\n", err)
+			os.Exit(1)
+		}
+	}
+}
+
+// observedDecisions returns the hit/stay choices of the DecisionAnalysis events in records,
+// in log order. With a player name, only that player's choices are kept; names are resolved
+// through the GameStart event of each game.
+func observedDecisions(records []logging.LogRecord, player string) []strategy.ObservedDecision {
+	names := make(map[string]string) // Game ID + player ID -> name
+	var decisions []strategy.ObservedDecision
+	for _, r := range records {
+		switch r.EventType {
+		case "GameStart":
+			players, _ := r.Details["players"].([]interface{})
+			ids, _ := r.Details["player_ids"].([]interface{})
+			for i, id := range ids {
+				if i < len(players) {
+					names[r.GameID+"/"+fmt.Sprint(id)] = fmt.Sprint(players[i])
+				}
+			}
+		case "DecisionAnalysis":
+			if player != "" && names[r.GameID+"/"+r.PlayerID] != player {
+				continue
+			}
+			chosen, _ := r.Details["chosen"].(string)
+			if chosen != "hit" && chosen != "stay" {
+				continue
+			}
+			score, _ := r.Details["hand_score"].(float64)
+			bustRate, _ := r.Details["bust_rate"].(float64)
+			decisions = append(decisions, strategy.ObservedDecision{
+				HandScore: int(score),
+				BustRate:  bustRate,
+				Stayed:    chosen == "stay",
+			})
+		}
+	}
+	return decisions
+}
+
+// writeFitSummary prints the fitted cut points per bust-rate decile and how well they match
+// the decisions.
+func writeFitSummary(w io.Writer, label string, fit strategy.PersonalFit) {
+	total := 0
+	for _, n := range fit.Decisions {
+		total += n
+	}
+	fmt.Fprintf(w, "Fitted %s on %d decisions (hand score bins of %d points):\n", label, total, fit.BinWidth)
+
+	table := console.NewTable()
+	table.AddHeader("Bust Rate", "Decisions", "Stays", "Stay From")
+	for decile, stayFrom := range fit.StayFrom {
+		observed := "-"
+		if fit.Decisions[decile] > 0 {
+			observed = fmt.Sprintf("%d", fit.Decisions[decile])
+		}
+		table.AddRow(fmt.Sprintf("%d-%d%%", decile*10, decile*10+10), observed, fit.Stays[decile], stayFrom)
+	}
+	table.Render(w)
+	fmt.Fprintf(w, "The fitted cut points disagree with %d of the %d decisions (%.1f%%).\n",
+		fit.Disagreements, total, float64(fit.Disagreements)/float64(total)*100)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/logging"
+)
+
+func TestObservedDecisions(t *testing.T) {
+	decision := func(game, player string, score, bustRate float64, chosen string) logging.LogRecord {
+		return logging.LogRecord{GameID: game, PlayerID: player, EventType: "DecisionAnalysis", Details: map[string]interface{}{
+			"hand_score": score, "bust_rate": bustRate, "chosen": chosen,
+		}}
+	}
+	records := []logging.LogRecord{
+		{GameID: "g1", PlayerID: "system", EventType: "GameStart", Details: map[string]interface{}{
+			"players": []interface{}{"Me", "Bot"}, "player_ids": []interface{}{"id-me", "id-bot"},
+		}},
+		decision("g1", "id-me", 12, 0.25, "hit"),
+		{GameID: "g1", PlayerID: "id-me", EventType: "TurnEnd", Details: map[string]interface{}{"action": "hit"}},
+		decision("g1", "id-bot", 20, 0.4, "stay"),
+		{GameID: "g2", PlayerID: "system", EventType: "GameStart", Details: map[string]interface{}{
+			"players": []interface{}{"Bot", "Me"}, "player_ids": []interface{}{"id-bot2", "id-me2"},
+		}},
+		decision("g2", "id-me2", 25, 0.5, "stay"),
+		decision("g2", "id-me2", 30, 0.5, "draw"), // Not a hit/stay choice
+	}
+
+	tests := []struct {
+		name   string
+		player string
+		want   []strategy.ObservedDecision
+	}{
+		{"Every player", "", []strategy.ObservedDecision{
+			{HandScore: 12, BustRate: 0.25},
+			{HandScore: 20, BustRate: 0.4, Stayed: true},
+			{HandScore: 25, BustRate: 0.5, Stayed: true},
+		}},
+		{"One player across games", "Me", []strategy.ObservedDecision{
+			{HandScore: 12, BustRate: 0.25},
+			{HandScore: 25, BustRate: 0.5, Stayed: true},
+		}},
+		{"Unknown player", "Nobody", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := observedDecisions(records, tt.player)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestWriteFitSummary(t *testing.T) {
+	var decisions []strategy.ObservedDecision
+	for score := 5; score <= 30; score += 5 {
+		decisions = append(decisions, strategy.ObservedDecision{HandScore: score, BustRate: 0.15, Stayed: score >= 20})
+	}
+	fit, err := strategy.FitPersonalizedStrategy(decisions, strategy.DefaultPersonalBinWidth)
+	if err != nil {
+		t.Fatalf("FitPersonalizedStrategy: %v", err)
+	}
+
+	var buf bytes.Buffer
+	writeFitSummary(&buf, "personal:game.csv", fit)
+
+	output := buf.String()
+	for _, want := range []string{
+		"Fitted personal:game.csv on 6 decisions (hand score bins of 5 points):",
+		"Stay From",
+		"10-20%",
+		"disagree with 0 of the 6 decisions (0.0%)",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected the summary to contain %q, got:\n%s", want, output)
+		}
+	}
+}
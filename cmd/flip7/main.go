@@ -2,19 +2,93 @@ package main
 
 import (
 	"bufio"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
 	"strings"
+	"time"
 
 	"flip7_strategy/internal/application"
 	"flip7_strategy/internal/domain"
 	"flip7_strategy/internal/domain/strategy"
 	"flip7_strategy/internal/infrastructure/console"
 	"flip7_strategy/internal/infrastructure/logging"
+	"flip7_strategy/pkg/flip7"
 )
 
+var (
+	csvOutput    = flag.Bool("csv", false, "print simulation result tables as CSV")
+	quiet        = flag.Bool("quiet", false, "do not show a progress bar during simulations")
+	mode         = flag.String("mode", "", "run a mode directly instead of showing the menu (auto, manual, replay, import, profiles)")
+	replayLog    = flag.String("log", "", "CSV game log to replay (with -mode=replay) or to append an imported game to (with -mode=import, default game_logs.csv)")
+	replayGameID = flag.String("game", "", "game ID to replay (optional if the log holds a single game), or to give an imported game (default: the transcript file name)")
+	transcript   = flag.String("transcript", "", "hand-written game transcript to import (with -mode=import)")
+	deckFile     = flag.String("deck", "", "file listing the deck order (e.g. \"7,12,+4,F,3,x2,C\") for automatic and interactive games")
+	stepMode     = flag.Bool("step", false, "pause after every turn of Automatic Play")
+	language     = flag.String("lang", "", "language of the Manual Mode and Participating prompts (en, ja); defaults to $FLIP7_LANG, then English")
+	shadow       = flag.String("shadow", "", "strategies that shadow your seat in Manual Mode, comma-separated (e.g. Adaptive,ExpectedValue); their choices are compared with yours at the end")
+	advisorNames = flag.String("advisors", "Adaptive", "strategies that suggest moves and targets in Manual Mode, comma-separated, 1 to 4 (e.g. Adaptive,ExpectedValue,Heuristic-27,Aggressive); with more than one, each one's choice is shown with the majority highlighted")
+	exportPath   = flag.String("out", "", "JSON file to write the final state of the game to (Automatic Play, Participating and Manual Mode)")
+	exhaustion   = flag.String("exhaustion", "bank", "what happens to the hands in play when the deck and discard pile run out: bank (as if frozen) or discard; the game then ends")
+	teePath      = flag.String("tee", "", "file to append a copy of the game output to (Automatic Play, Participating and Manual Mode), e.g. to keep a record of game night")
+	scriptPath   = flag.String("script", "", "Manual Mode session to play back (with -mode=manual): one answer per line, # comments, <enter> for an empty answer, and EXPECT score <player> <value> checks; exits 1 if a check fails")
+	holdActions  = flag.Bool("holdable-actions", false, "house rule for Manual Mode: a drawn Freeze or Flip Three may be kept and played at the start of a later turn (PLAY F / PLAY T)")
+	hybrid       = flag.Bool("hybrid", false, "Manual Mode asks who plays each seat: a player at the table (you enter the cards), the app's AI, or a person playing in the app; the app draws for its seats from the tracked deck")
+	memoryModel  = flag.String("memory", "full", "memory model of the bust rates Manual Mode shows: full (every card since the shuffle), round (only this round's cards) or none (only your hand); MEM switches it mid-game")
+	turnModel    = flag.String("turn-model", "Heuristic-27", "strategy Manual Mode assumes the opponents play when it estimates how many more turns you get this round")
+	profilesFile = flag.String("profiles", "", "JSON file keeping the players' lifetime stats over Manual Mode games, shown by -mode=profiles (default ~/.flip7/profiles.json); \"off\" plays Manual Mode without profiles")
+	matchGames   = flag.Int("match", 1, "play a best-of-N series instead of a single game (Automatic Play, Participating and Manual Mode): the same players play up to N games, the first deal passing to the next seat each game, and the most games won, then the most points, wins the series")
+	opponentHit  = flag.Int("opponent-hit-below", domain.DefaultOpponentHitBelow, "hand score below which Manual Mode assumes opponents hit when it estimates their Flip 7 threat")
+)
+
+// gameOutput is where the games print: standard output, and the -tee file as well when set.
+var gameOutput io.Writer = os.Stdout
+
 func main() {
+	flag.Parse()
+	if *teePath != "" {
+		f, err := os.OpenFile(*teePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to open %s: %v\n", *teePath, err)
+			os.Exit(1)
+		}
+		defer f.Close()
+		gameOutput = io.MultiWriter(os.Stdout, f)
+	}
+
+	switch *mode {
+	case "":
+	case "auto":
+		runAutomatic(bufio.NewReader(os.Stdin), *stepMode)
+		return
+	case "manual":
+		if *scriptPath != "" {
+			os.Exit(runManualScript())
+		}
+		runManualMode(bufio.NewReader(os.Stdin))
+		return
+	case "replay":
+		os.Exit(runReplay())
+	case "import":
+		os.Exit(runImport())
+	case "profiles":
+		os.Exit(runProfiles())
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown mode %q. Supported: auto, manual, replay, import, profiles\n", *mode)
+		os.Exit(2)
+	}
+
 	fmt.Println("Welcome to Flip 7 Strategy!")
+	reader := bufio.NewReader(os.Stdin)
+	if resumeSweep(reader) {
+		return
+	}
+
 	fmt.Println("Select Mode:")
 	fmt.Println("1. Automatic Play (Sample Game)")
 	fmt.Println("2. Participating (Interactive)")
@@ -25,17 +99,25 @@ func main() {
 	fmt.Println("7. Strategy Combination Evaluation (1vs1)")
 	fmt.Println("8. Manual Mode (Real Game Helper)")
 	fmt.Println("9. Target Selection Simulation (Risk Thresholds)")
+	fmt.Println("10. Winning Score Sensitivity (100 / 150 / 200)")
+	fmt.Println("11. Optimize Adaptive Strategy (Threat Threshold)")
+	fmt.Println("12. Lineup Evaluation (N-Player Free-for-All)")
+	fmt.Println("13. Counting Value (Card Counting On / Off)")
+	fmt.Println("14. Seat Advantage (Same Strategy in Every Seat)")
+	fmt.Println("15. Team Evaluation (2 vs 2, Combined Score)")
+	fmt.Println("16. Best-Response Training (Probabilistic Risk Threshold)")
+	fmt.Println("17. Holdable Actions (House Rule: Hold vs Play at Once)")
+	fmt.Println("18. Exploitability Search (Best Exploiter of a Strategy, 1vs1)")
 
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Print("Enter choice (1-9): ")
+	fmt.Print("Enter choice (1-18): ")
 	input, _ := reader.ReadString('\n')
 	input = strings.TrimSpace(input)
 
 	switch input {
 	case "1":
-		runAutomatic()
+		runAutomatic(reader, *stepMode || askStepMode(reader))
 	case "2":
-		runInteractive()
+		runInteractive(reader)
 	case "3":
 		runCounting()
 	case "4":
@@ -50,73 +132,621 @@ func main() {
 		runManualMode(reader)
 	case "9":
 		runTargetSelectionSimulation()
+	case "10":
+		runThresholdSensitivity()
+	case "11":
+		runAdaptiveOptimization()
+	case "12":
+		runLineupEvaluation(reader)
+	case "13":
+		runCountingValue()
+	case "14":
+		runSeatAdvantage(reader)
+	case "15":
+		runTeamEvaluation()
+	case "16":
+		runBestResponseTraining(reader)
+	case "17":
+		runHoldableActions()
+	case "18":
+		runExploitabilitySearch(reader)
 	default:
 		fmt.Println("Invalid choice. Defaulting to Automatic.")
-		runAutomatic()
+		runAutomatic(reader, *stepMode)
 	}
 }
 
-func runAutomatic() {
+// askStepMode asks whether Automatic Play should pause after every turn.
+func askStepMode(reader *bufio.Reader) bool {
+	fmt.Print("Pause after every turn? (y to step through, Enter to play straight through): ")
+	input, _ := reader.ReadString('\n')
+	return strings.EqualFold(strings.TrimSpace(input), "y")
+}
+
+// runAutomatic plays the sample game. With step set it pauses after every turn (see console.TurnStepper).
+func runAutomatic(reader *bufio.Reader, step bool) {
 	fmt.Println("\n--- Automatic Play ---")
-	p1 := domain.NewPlayer("Alice (Cautious)", &strategy.CautiousStrategy{})
-	p2 := domain.NewPlayer("Bob (Aggressive)", &strategy.AggressiveStrategy{})
-	p3 := domain.NewPlayer("Charlie (Probabilistic)", &strategy.ProbabilisticStrategy{})
+	seats := []flip7.Seat{
+		{Name: "Alice (Cautious)", Strategy: flip7.NewCautiousStrategy()},
+		{Name: "Bob (Aggressive)", Strategy: flip7.NewAggressiveStrategy()},
+		{Name: "Charlie (Probabilistic)", Strategy: flip7.NewProbabilisticStrategy()},
+	}
+
+	cards, err := loadDeckFile()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load deck: %v\n", err)
+		return
+	}
+	if cards != nil || step || *exportPath != "" || *teePath != "" || *matchGames > 1 {
+		// The public Simulator always shuffles, plays straight through, prints to stdout and
+		// returns only the scores, so a fixed deck order, stepping, an export, a tee or a
+		// series runs on the engine directly.
+		players := make([]*domain.Player, len(seats))
+		for i, seat := range seats {
+			players[i] = domain.NewPlayer(seat.Name, seat.Strategy)
+		}
+		game := domain.NewGame(players)
+		game.ExhaustionRule = exhaustionRule()
+		var stepper *console.TurnStepper
+		if step {
+			stepper = console.NewTurnStepper(reader, gameOutput)
+		}
+		playSeries(game, func(game *domain.Game) {
+			svc := application.NewGameServiceWithOutput(game, gameOutput)
+			svc.Style = outputStyle()
+			stats := domain.NewGameStats()
+			svc.Events.Subscribe(stats)
+			if cards != nil {
+				svc.UseFixedDeck(cards)
+				cards = nil // The next games of a series shuffle
+			}
+			if stepper != nil {
+				svc.AfterTurn = stepper.AfterTurn
+			}
+			svc.RunGame()
+			printGameOver(game, stats, application.ExportModeAutomatic)
+		})
+		return
+	}
 
-	players := []*domain.Player{p1, p2, p3}
-	game := domain.NewGame(players)
-	svc := application.NewGameService(game)
-	svc.RunGame()
+	sim := flip7.NewSimulator()
+	sim.Verbose = true
+	playAndPrint(sim, seats)
+}
 
-	printWinner(game)
+// playSeries plays first with play, then, with -match, the next games of the series until it
+// is decided. play plays a game to its end; a game it aborts stops the series.
+func playSeries(first *domain.Game, play func(game *domain.Game)) {
+	if *matchGames <= 1 {
+		play(first)
+		return
+	}
+	series := &application.MatchService{
+		Match: application.NewMatch(fmt.Sprintf("match_%d", time.Now().Unix()), *matchGames, first.DealerIndex),
+		PlayGame: func(game *domain.Game) (string, bool) {
+			play(game)
+			return game.ID.String(), game.IsCompleted && game.EndReason != domain.GameEndReasonAborted
+		},
+		Out:      gameOutput,
+		Messages: selectedMessages(),
+	}
+	series.Start(first.Players)
+	series.Run(first)
 }
 
-func runInteractive() {
+// interactiveSaveFile is where "save" writes an interactive game unless another path is entered.
+const interactiveSaveFile = "flip7_save.txt"
+
+func runInteractive(reader *bufio.Reader) {
 	fmt.Println("\n--- Interactive Play ---")
-	p1 := domain.NewPlayer("Alice (Cautious)", &strategy.CautiousStrategy{})
-	p2 := domain.NewPlayer("Bob (Aggressive)", &strategy.AggressiveStrategy{})
-	p3 := domain.NewPlayer("You (Human)", console.NewHumanStrategy())
+	human := console.NewHumanStrategyWithIO(reader, gameOutput)
+	human.Messages = selectedMessages()
+
+	game := resumeInteractive(reader, human)
+	resumed := game != nil
+	if !resumed {
+		game = domain.NewGame([]*domain.Player{
+			domain.NewPlayer("You (Human)", human),
+			domain.NewPlayer("Alice (Cautious)", flip7.NewCautiousStrategy()),
+			domain.NewPlayer("Bob (Aggressive)", flip7.NewAggressiveStrategy()),
+		})
+		game.WinningScore = readWinningScore(reader)
+		game.ExhaustionRule = exhaustionRule()
+	}
 
-	players := []*domain.Player{p3, p1, p2}
-	game := domain.NewGame(players)
-	svc := application.NewGameService(game)
-	svc.RunGame()
+	var you *domain.Player
+	for _, p := range game.Players {
+		if p.Strategy == domain.Strategy(human) {
+			you = p
+		}
+	}
 
-	printWinner(game)
+	// newService sets up a game on the engine, and the human's save command to save it.
+	newService := func(game *domain.Game) (*application.GameService, *domain.GameStats) {
+		svc := application.NewGameServiceWithOutput(game, gameOutput)
+		svc.Style = outputStyle()
+		stats := domain.NewGameStats()
+		svc.Events.Subscribe(stats)
+		human.SaveAndQuit = func() error {
+			return saveInteractive(reader, game, you)
+		}
+		return svc, stats
+	}
+
+	if resumed {
+		// A saved game is resumed on its own: save files do not keep a series. It only counts
+		// what happens after it was resumed.
+		svc, stats := newService(game)
+		if err := svc.ResumeGame(); err != nil {
+			fmt.Printf("Failed to resume the game: %v\n", err)
+			return
+		}
+		printGameOver(game, stats, application.ExportModeInteractive)
+		return
+	}
+
+	cards, err := loadDeckFile()
+	if err != nil {
+		fmt.Printf("Failed to load deck: %v\n", err)
+		return
+	}
+	playSeries(game, func(game *domain.Game) {
+		svc, stats := newService(game)
+		if cards != nil {
+			svc.UseFixedDeck(cards)
+			cards = nil // The next games of a series shuffle
+		}
+		svc.RunGame()
+		printGameOver(game, stats, application.ExportModeInteractive)
+	})
+}
+
+// resumeInteractive offers to resume a game saved with "save". It returns nil to start a new game.
+func resumeInteractive(reader *bufio.Reader, human *console.HumanStrategy) *domain.Game {
+	fmt.Print("Resume a saved game? (Enter save file path, or press Enter to start new): ")
+	path, _ := reader.ReadString('\n')
+	path = strings.TrimSpace(path)
+	if path == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		fmt.Printf("Failed to read save file: %v. Starting new game.\n", err)
+		return nil
+	}
+	game, err := application.LoadInteractiveGame(strings.TrimSpace(string(content)), human)
+	if err != nil {
+		fmt.Printf("Failed to load game: %v. Starting new game.\n", err)
+		return nil
+	}
+	fmt.Println("Game resumed successfully!")
+	return game
+}
+
+// saveInteractive writes the game paused on your turn to a save file and exits.
+func saveInteractive(reader *bufio.Reader, game *domain.Game, you *domain.Player) error {
+	code, err := application.SaveInteractiveGame(game, you)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Save file (press Enter for %s): ", interactiveSaveFile)
+	path, _ := reader.ReadString('\n')
+	path = strings.TrimSpace(path)
+	if path == "" {
+		path = interactiveSaveFile
+	}
+	if err := os.WriteFile(path, []byte(code+"\n"), 0o644); err != nil {
+		return err
+	}
+	fmt.Printf("Game saved to %s. Select Participating and enter this path to resume.\n", path)
+	os.Exit(0)
+	return nil
+}
+
+// printGameOver prints the winners, final standings and end-of-game statistics of a game played
+// in mode, and exports the final state when -out is given.
+func printGameOver(game *domain.Game, stats *domain.GameStats, mode string) {
+	if len(game.Winners) > 0 {
+		fmt.Printf("\nGame Over! Winners:\n")
+		for _, winner := range game.Winners {
+			fmt.Printf("- %s with %d points!\n", winner.Name, winner.TotalScore)
+		}
+	} else {
+		fmt.Println("\nGame Over! No winner?")
+	}
+	fmt.Print(selectedMessages().FinalStandings(game.FinalStandings()))
+	fmt.Print(selectedMessages().GameStats(game, stats))
+
+	if *exportPath == "" {
+		return
+	}
+	export := application.NewGameExport(game, mode, nil, time.Now())
+	if err := application.WriteGameExport(*exportPath, export); err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to export the game to %s: %v\n", *exportPath, err)
+		return
+	}
+	fmt.Printf("Final game state exported to %s\n", *exportPath)
+}
+
+// loadDeckFile reads the deck order given with -deck. It returns nil cards when no file was given.
+func loadDeckFile() ([]domain.Card, error) {
+	if *deckFile == "" {
+		return nil, nil
+	}
+	content, err := os.ReadFile(*deckFile)
+	if err != nil {
+		return nil, err
+	}
+	return domain.ParseDeckOrder(string(content))
+}
+
+// playAndPrint plays one game and prints the winners and final standings.
+func playAndPrint(sim *flip7.Simulator, seats []flip7.Seat) {
+	result, err := sim.RunGame(seats)
+	if err != nil {
+		fmt.Printf("Failed to start the game: %v\n", err)
+		return
+	}
+
+	if len(result.Winners) > 0 {
+		fmt.Printf("\nGame Over! Winners:\n")
+		for _, winner := range result.Winners {
+			fmt.Printf("- %s with %d points!\n", winner, result.Scores[winner])
+		}
+	} else {
+		fmt.Println("\nGame Over! No winner?")
+	}
+	// The Simulator only returns the scores; rank them as the engine ranks a game's players.
+	players := make([]*domain.Player, len(seats))
+	for i, seat := range seats {
+		players[i] = domain.NewPlayer(seat.Name, seat.Strategy)
+		players[i].TotalScore = result.Scores[seat.Name]
+	}
+	fmt.Print(selectedMessages().FinalStandings(domain.NewGame(players).FinalStandings()))
+}
+
+// runReplay re-applies a logged game through the rules and lists every divergence.
+// It returns the process exit code: 0 if the log is consistent, 1 otherwise.
+func runReplay() int {
+	if *replayLog == "" {
+		fmt.Fprintln(os.Stderr, "Usage: flip7 -mode=replay -log=<file.csv> [-game=<id>]")
+		return 2
+	}
+	file, err := os.Open(*replayLog)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open log: %v\n", err)
+		return 1
+	}
+	defer file.Close()
+
+	records, err := logging.ReadLogRecords(file, os.Stderr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read header: %v\n", err)
+		return 1
+	}
+	report, err := application.ReplayGame(records, *replayGameID)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Replay failed: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("Replayed game %s: %d rounds, %d records\n", report.GameID, report.Rounds, report.Records)
+	if report.Consistent() {
+		fmt.Println("The log is consistent with the rules.")
+		return 0
+	}
+	fmt.Printf("%d divergence(s):\n", len(report.Divergences))
+	for _, d := range report.Divergences {
+		fmt.Printf("- %s\n", d)
+	}
+	return 1
+}
+
+// runImport checks a hand-written transcript against the rules and, if every move is legal,
+// appends it to the game log. It returns the process exit code: 0 if the game was imported,
+// 1 if the transcript could not be read or has illegal moves (nothing is logged then).
+func runImport() int {
+	if *transcript == "" {
+		fmt.Fprintln(os.Stderr, "Usage: flip7 -mode=import -transcript=<file.txt> [-log=<file.csv>] [-game=<id>]")
+		return 2
+	}
+	file, err := os.Open(*transcript)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open transcript: %v\n", err)
+		return 1
+	}
+	defer file.Close()
+
+	parsed, err := application.ParseTranscript(file)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to parse transcript: %v\n", err)
+		return 1
+	}
+	gameID := *replayGameID
+	if gameID == "" {
+		base := filepath.Base(*transcript)
+		gameID = strings.TrimSuffix(base, filepath.Ext(base))
+	}
+
+	report := application.ImportTranscript(parsed, gameID, nil)
+	if !report.Valid() {
+		fmt.Printf("%d problem(s) in %s; nothing was logged:\n", len(report.Issues), *transcript)
+		for _, issue := range report.Issues {
+			fmt.Printf("- %s\n", issue)
+		}
+		return 1
+	}
+
+	logPath := *replayLog
+	if logPath == "" {
+		logPath = "game_logs.csv"
+	}
+	logger, err := logging.NewCSVLogger(logPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open log: %v\n", err)
+		return 1
+	}
+	defer logger.Close()
+	report = application.ImportTranscript(parsed, gameID, logger)
+
+	fmt.Printf("Imported game %s into %s: %d rounds, %d moves\n", gameID, logPath, report.Rounds, report.Moves)
+	for _, p := range report.Game.Players {
+		fmt.Printf("- %s: %d\n", p.Name, p.TotalScore)
+	}
+	if len(report.Game.Winners) == 0 {
+		fmt.Printf("Nobody reached %d points.\n", report.Game.TargetScore())
+	}
+	return 0
+}
+
+// newSimulationService creates a SimulationService that honors the -csv and -quiet flags.
+// The progress bar goes to stderr, and only when it is a terminal.
+func newSimulationService() *application.SimulationService {
+	sim := application.NewSimulationService()
+	sim.CSV = *csvOutput
+	if !*quiet && isTerminal(os.Stderr) {
+		sim.Progress = console.NewProgressBar(os.Stderr).Update
+	}
+	return sim
+}
+
+// isTerminal reports whether f is a terminal rather than a file or pipe.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
 }
 
 func runCounting() {
 	fmt.Println("\n--- Counting Mode ---")
-	sim := application.NewSimulationService()
+	sim := newSimulationService()
 	sim.RunMonteCarlo(1000) // Run 1000 games
 }
 
 func runOptimization() {
 	fmt.Println("\n--- Optimization Mode ---")
-	sim := application.NewSimulationService()
+	sim := newSimulationService()
+	sim.CheckpointPath = application.DefaultSweepCheckpoint
 	sim.RunHeuristicOptimization(500) // Run 500 games per threshold
 }
 
+func runAdaptiveOptimization() {
+	fmt.Println("\n--- Adaptive Optimization ---")
+	sim := newSimulationService()
+	sim.CheckpointPath = application.DefaultSweepCheckpoint
+	sim.RunAdaptiveOptimization(500) // Run 500 games per threat threshold
+}
+
+// resumeSweep offers to finish an optimization sweep that was interrupted, and runs it if
+// accepted. Declining discards the checkpoint. It reports whether a sweep was run.
+func resumeSweep(reader *bufio.Reader) bool {
+	checkpoint, err := application.LoadSweepCheckpoint(application.DefaultSweepCheckpoint)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			fmt.Printf("Ignoring checkpoint %s: %v\n", application.DefaultSweepCheckpoint, err)
+		}
+		return false
+	}
+	if checkpoint.Done() {
+		return false
+	}
+
+	fmt.Printf("Found an interrupted %s optimization (%d of %d configurations done).\n",
+		checkpoint.Sweep, len(checkpoint.Results), len(checkpoint.Configs))
+	fmt.Print("Resume it? (y to resume, n to discard): ")
+	answer, _ := reader.ReadString('\n')
+	if !strings.EqualFold(strings.TrimSpace(answer), "y") {
+		os.Remove(application.DefaultSweepCheckpoint)
+		return false
+	}
+
+	sim := newSimulationService()
+	sim.CheckpointPath = application.DefaultSweepCheckpoint
+	if fixed, ok := strings.CutPrefix(checkpoint.Sweep, application.SweepExploitability+":"); ok {
+		if _, err := sim.RunExploitabilitySearch(fixed, checkpoint.GamesPerConfig); err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+		return true
+	}
+	switch checkpoint.Sweep {
+	case application.SweepHeuristic:
+		sim.RunHeuristicOptimization(checkpoint.GamesPerConfig)
+	case application.SweepAdaptive:
+		sim.RunAdaptiveOptimization(checkpoint.GamesPerConfig)
+	default:
+		fmt.Printf("Unknown sweep %q in checkpoint.\n", checkpoint.Sweep)
+		return false
+	}
+	return true
+}
+
 func runSinglePlayerOptimization() {
 	fmt.Println("\n--- Single Player Optimization ---")
-	sim := application.NewSimulationService()
+	sim := newSimulationService()
 	sim.RunSinglePlayerOptimization(1000)
 }
 
 func runMultiplayerEvaluation() {
 	fmt.Println("\n--- Multiplayer Evaluation ---")
-	sim := application.NewSimulationService()
+	sim := newSimulationService()
 	sim.RunMultiplayerEvaluation(1000)
 }
 
 func runStrategyCombinationEvaluation() {
 	fmt.Println("\n--- Strategy Combination Evaluation ---")
-	sim := application.NewSimulationService()
+	sim := newSimulationService()
 	sim.RunStrategyCombinationEvaluation(1000)
 }
 
+// runLineupEvaluation asks for a lineup of registered strategies, or a lineup size to try every
+// lineup of that size, and plays it on one table per CPU.
+func runLineupEvaluation(reader *bufio.Reader) {
+	fmt.Println("\n--- Lineup Evaluation ---")
+	fmt.Printf("Strategies: %s\n", strings.Join(strategy.Names(), ", "))
+	fmt.Println("1. Enter a lineup")
+	fmt.Println("2. Every lineup of k strategies")
+	fmt.Print("Enter choice (1-2): ")
+	choice, _ := reader.ReadString('\n')
+
+	sim := newSimulationService()
+	tables := runtime.NumCPU()
+	var err error
+	switch strings.TrimSpace(choice) {
+	case "1":
+		fmt.Print("Lineup (comma-separated, e.g. Cautious,Adaptive,ExpectedValue): ")
+		input, _ := reader.ReadString('\n')
+		var lineup []string
+		for _, name := range strings.Split(input, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				lineup = append(lineup, name)
+			}
+		}
+		// A multiple of the lineup size, so every strategy sits in every seat equally often
+		games := 1000
+		if len(lineup) > 0 {
+			games -= games % len(lineup)
+		}
+		err = sim.RunLineupEvaluation(games, lineup, tables)
+	case "2":
+		fmt.Printf("Lineup size (2-%d): ", application.MaxLineupSubsetSize)
+		input, _ := reader.ReadString('\n')
+		k, convErr := strconv.Atoi(strings.TrimSpace(input))
+		if convErr != nil {
+			fmt.Println("Invalid lineup size.")
+			return
+		}
+		err = sim.RunLineupSubsets(k*100, k, tables)
+	default:
+		fmt.Println("Invalid choice.")
+		return
+	}
+	if err != nil {
+		fmt.Printf("Lineup evaluation failed: %v\n", err)
+	}
+}
+
+func runThresholdSensitivity() {
+	fmt.Println("\n--- Winning Score Sensitivity ---")
+	sim := newSimulationService()
+	sim.RunThresholdSensitivity(1000, []int{100, 150, 200})
+}
+
+func runCountingValue() {
+	fmt.Println("\n--- Counting Value ---")
+	sim := newSimulationService()
+	sim.RunCountingValueExperiment(1000)
+}
+
+func runHoldableActions() {
+	fmt.Println("\n--- Holdable Actions ---")
+	sim := newSimulationService()
+	sim.RunHoldableActionsComparison(1000)
+}
+
+// defaultSeatAdvantagePlayers is the table size of the seat advantage analysis unless another is entered.
+const defaultSeatAdvantagePlayers = 4
+
+func runSeatAdvantage(reader *bufio.Reader) {
+	fmt.Println("\n--- Seat Advantage ---")
+	fmt.Printf("Number of players (press Enter for %d): ", defaultSeatAdvantagePlayers)
+	input, _ := reader.ReadString('\n')
+	playerCount := defaultSeatAdvantagePlayers
+	if input = strings.TrimSpace(input); input != "" {
+		n, err := strconv.Atoi(input)
+		if err != nil || n < 2 {
+			fmt.Printf("Invalid number of players. Defaulting to %d.\n", defaultSeatAdvantagePlayers)
+		} else {
+			playerCount = n
+		}
+	}
+
+	// A multiple of the table size, so every seat deals first equally often
+	games := 1000 - 1000%playerCount
+	sim := newSimulationService()
+	if _, err := sim.RunSeatAdvantageAnalysis(games, playerCount); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
+
+func runTeamEvaluation() {
+	fmt.Println("\n--- Team Evaluation ---")
+	sim := newSimulationService()
+	sim.RunTeamEvaluation(1000)
+}
+
+// Budget of the best-response training: games per threshold and the iteration cap.
+const (
+	bestResponseGames    = 200
+	bestResponseMaxIters = 20
+)
+
+func runBestResponseTraining(reader *bufio.Reader) {
+	fmt.Println("\n--- Best-Response Training ---")
+	fmt.Printf("Number of players (press Enter for %d): ", defaultSeatAdvantagePlayers)
+	input, _ := reader.ReadString('\n')
+	playerCount := defaultSeatAdvantagePlayers
+	if input = strings.TrimSpace(input); input != "" {
+		n, err := strconv.Atoi(input)
+		if err != nil || n < 2 {
+			fmt.Printf("Invalid number of players. Defaulting to %d.\n", defaultSeatAdvantagePlayers)
+		} else {
+			playerCount = n
+		}
+	}
+
+	sim := newSimulationService()
+	if _, err := sim.RunBestResponseTraining(playerCount, bestResponseGames, bestResponseMaxIters); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
+
+// Budget and default opponent of the exploitability search.
+const (
+	exploitabilityGames    = 200
+	defaultExploitedTarget = "Adaptive"
+)
+
+// runExploitabilitySearch asks for a registered strategy and searches for its best exploiter.
+func runExploitabilitySearch(reader *bufio.Reader) {
+	fmt.Println("\n--- Exploitability Search ---")
+	fmt.Printf("Strategies: %s\n", strings.Join(strategy.Names(), ", "))
+	fmt.Printf("Strategy to exploit (press Enter for %s): ", defaultExploitedTarget)
+	input, _ := reader.ReadString('\n')
+	fixed := defaultExploitedTarget
+	if input = strings.TrimSpace(input); input != "" {
+		fixed = input
+	}
+
+	sim := newSimulationService()
+	sim.CheckpointPath = application.DefaultSweepCheckpoint
+	if _, err := sim.RunExploitabilitySearch(fixed, exploitabilityGames); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
+
 func runTargetSelectionSimulation() {
 	fmt.Println("\n--- Target Selection Simulation ---")
-	sim := application.NewSimulationService()
+	sim := newSimulationService()
 	sim.RunTargetSelectionSimulation(1000)
 }
 
@@ -128,21 +758,208 @@ func runManualMode(reader *bufio.Reader) {
 		defer logger.Close()
 	}
 
-	svc := application.NewManualGameService(reader, logger)
+	svc := newManualService(reader, logger)
+	svc.Profiles = manualProfiles()
 	svc.Run()
 }
 
-func printWinner(game *domain.Game) {
-	if len(game.Winners) > 0 {
-		fmt.Printf("\nGame Over! Winners:\n")
-		for _, winner := range game.Winners {
-			fmt.Printf("- %s with %d points!\n", winner.Name, winner.TotalScore)
+// profilesPath returns the -profiles file, or the default one. It returns "" with -profiles=off.
+func profilesPath() (string, error) {
+	switch *profilesFile {
+	case "off":
+		return "", nil
+	case "":
+		return application.DefaultProfilesPath()
+	}
+	return *profilesFile, nil
+}
+
+// manualProfiles loads the profiles Manual Mode keeps stats in. It returns nil, so the game is
+// played without profiles, with -profiles=off or when they cannot be read.
+func manualProfiles() *application.ProfileStore {
+	path, err := profilesPath()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: cannot locate the profiles file: %v. Playing without profiles.\n", err)
+		return nil
+	}
+	if path == "" {
+		return nil
+	}
+	store, err := application.LoadProfileStore(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v. Playing without profiles.\n", err)
+		return nil
+	}
+	return store
+}
+
+// runProfiles prints the leaderboard of the player profiles. It returns the process exit code.
+func runProfiles() int {
+	path, err := profilesPath()
+	if err != nil || path == "" {
+		fmt.Fprintln(os.Stderr, "Usage: flip7 -mode=profiles [-profiles=<file.json>]")
+		return 2
+	}
+	store, err := application.LoadProfileStore(path)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read profiles: %v\n", err)
+		return 1
+	}
+	if len(store.Profiles) == 0 {
+		fmt.Printf("No profiles in %s yet. They are created when players are named in Manual Mode.\n", path)
+		return 0
+	}
+	table := store.LeaderboardTable()
+	if *csvOutput {
+		if err := table.RenderCSV(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to write CSV: %v\n", err)
+			return 1
 		}
-	} else {
-		fmt.Println("\nGame Over! No winner?")
+		return 0
 	}
-	fmt.Println("Final Scores:")
-	for _, p := range game.Players {
-		fmt.Printf("- %s: %d\n", p.Name, p.TotalScore)
+	fmt.Printf("Leaderboard (%s):\n", path)
+	table.Render(os.Stdout)
+	return 0
+}
+
+// newManualService sets up Manual Mode on reader with the options given on the command line.
+func newManualService(reader *bufio.Reader, logger *logging.CSVLogger) *application.ManualGameService {
+	svc := application.NewManualGameServiceWithOutput(reader, logger, gameOutput)
+	if logger == nil {
+		svc.Logger = nil // A nil *CSVLogger in the interface would not read as "no logger"
+	}
+	svc.Messages = selectedMessages()
+	svc.ShadowAdvisors = shadowAdvisors()
+	svc.SuggestionAdvisors = suggestionAdvisors()
+	svc.ExhaustionRule = exhaustionRule()
+	svc.HoldableActions = *holdActions
+	svc.Hybrid = *hybrid
+	svc.Memory = memory()
+	svc.MatchGames = *matchGames
+	svc.ExportPath = *exportPath
+	svc.OpponentHitBelow = *opponentHit
+	if models := strategiesNamed(*turnModel, "Assuming opponents hit below -opponent-hit-below"); len(models) > 0 {
+		svc.TurnOutlookModel = models[0]
+	}
+	return svc
+}
+
+// runManualScript plays the -script session back in Manual Mode, without logging it, and checks
+// its EXPECT directives against the final scores. It returns the process exit code: 0 if every
+// score is as expected, 1 otherwise.
+func runManualScript() int {
+	f, err := os.Open(*scriptPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to open script: %v\n", err)
+		return 1
+	}
+	script, err := application.ParseManualScript(f)
+	f.Close()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to read %s: %v\n", *scriptPath, err)
+		return 1
+	}
+
+	svc := newManualService(bufio.NewReader(strings.NewReader(script.Input)), nil)
+	svc.Run()
+
+	failures := script.Check(svc.Game)
+	for _, failure := range failures {
+		fmt.Fprintf(os.Stderr, "%s: %s\n", *scriptPath, failure)
+	}
+	if len(failures) > 0 {
+		return 1
+	}
+	fmt.Printf("%s: all %d score expectation(s) met.\n", *scriptPath, len(script.Expects))
+	return 0
+}
+
+// exhaustionRule returns the rule chosen with -exhaustion. An unknown rule is reported and banks the hands.
+func exhaustionRule() domain.ExhaustionRule {
+	rule, err := domain.ParseExhaustionRule(*exhaustion)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v. Banking the hands in play.\n", err)
+	}
+	return rule
+}
+
+// memory returns the memory model chosen with -memory. An unknown model is reported and counts every card.
+func memory() domain.MemoryModel {
+	model, err := domain.ParseMemoryModel(*memoryModel)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v. Counting every card.\n", err)
+	}
+	return model
+}
+
+// shadowAdvisors builds the strategies named with -shadow. Unknown names are reported and skipped.
+func shadowAdvisors() []domain.Strategy {
+	return strategiesNamed(*shadow, "Not shadowing with it")
+}
+
+// suggestionAdvisors builds the strategies named with -advisors. Unknown names are reported and
+// skipped, and so are the names after the first application.MaxSuggestionAdvisors.
+func suggestionAdvisors() []domain.Strategy {
+	advisors := strategiesNamed(*advisorNames, "Not suggesting with it")
+	if len(advisors) > application.MaxSuggestionAdvisors {
+		fmt.Fprintf(os.Stderr, "Warning: at most %d advisors can suggest moves. Using the first %d.\n", application.MaxSuggestionAdvisors, application.MaxSuggestionAdvisors)
+		advisors = advisors[:application.MaxSuggestionAdvisors]
+	}
+	return advisors
+}
+
+// strategiesNamed builds the strategies of a comma-separated list of names. An unknown name is
+// reported, followed by skipping, and left out.
+func strategiesNamed(list, skipping string) []domain.Strategy {
+	var strategies []domain.Strategy
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		strat, err := strategy.New(name)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v. %s.\n", err, skipping)
+			continue
+		}
+		strategies = append(strategies, strat)
+	}
+	return strategies
+}
+
+// selectedMessages returns the catalog of the language chosen with -lang or, without the flag,
+// the FLIP7_LANG environment variable (e.g. FLIP7_LANG=ja). An unsupported language falls back to English.
+func selectedMessages() *console.Messages {
+	name := *language
+	if name == "" {
+		name = os.Getenv("FLIP7_LANG")
+	}
+	lang, err := console.ParseLanguage(name)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v. Using English.\n", err)
+	}
+	m := console.NewMessages(lang)
+	m.Style = outputStyle()
+	return m
+}
+
+// outputStyle emphasizes the key moments of the games printed to gameOutput, unless it is not
+// a terminal (e.g. with -tee) or NO_COLOR or FLIP7_NO_COLOR is set.
+func outputStyle() console.Style {
+	return console.Style{Color: console.ColorEnabled(gameOutput)}
+}
+
+// readWinningScore asks for the score needed to win, defaulting to flip7.DefaultWinningScore.
+func readWinningScore(reader *bufio.Reader) int {
+	fmt.Printf("Enter winning score (press Enter for %d): ", flip7.DefaultWinningScore)
+	input, _ := reader.ReadString('\n')
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return flip7.DefaultWinningScore
+	}
+	score, err := strconv.Atoi(input)
+	if err != nil || score < 1 {
+		fmt.Printf("Invalid winning score. Defaulting to %d.\n", flip7.DefaultWinningScore)
+		return flip7.DefaultWinningScore
 	}
+	return score
 }
@@ -0,0 +1,112 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// HighRiskFlipThreeTarget is the Flip Three bust risk above which a target counts as high-risk.
+const HighRiskFlipThreeTarget = 0.8
+
+// ActionStats counts how the players of one strategy used their action cards.
+type ActionStats struct {
+	Freezes      int
+	FreezeSelf   int
+	FreezeLeader int // Another player with the highest banked score among the actor's opponents
+	FreezeOther  int
+
+	FlipThrees        int
+	FlipThreeHighRisk int // Target's Flip Three bust risk was above HighRiskFlipThreeTarget when chosen
+
+	SecondChances       int // Second Chances drawn
+	SecondChancesPassed int
+}
+
+// actionCollector builds ActionStats per strategy name from the CardPlayed events of games.
+type actionCollector struct {
+	stats map[string]*ActionStats
+}
+
+func newActionCollector() *actionCollector {
+	return &actionCollector{stats: make(map[string]*ActionStats)}
+}
+
+// Publish implements domain.EventSink.
+func (c *actionCollector) Publish(e domain.Event) {
+	if played, ok := e.(domain.CardPlayed); ok {
+		c.record(played.Round, played.Discards, played.Actor, played.Target, played.Action)
+	}
+}
+
+func (c *actionCollector) record(round *domain.Round, discards []domain.Card, actor, target *domain.Player, action domain.ActionType) {
+	name := actor.Strategy.Name()
+	st, ok := c.stats[name]
+	if !ok {
+		st = &ActionStats{}
+		c.stats[name] = st
+	}
+
+	switch action {
+	case domain.ActionFreeze:
+		st.Freezes++
+		switch {
+		case target.ID == actor.ID:
+			st.FreezeSelf++
+		case isLeader(round, actor, target):
+			st.FreezeLeader++
+		default:
+			st.FreezeOther++
+		}
+	case domain.ActionFlipThree:
+		st.FlipThrees++
+		deck := domain.NewReshuffleView(round.Deck, discards)
+		if deck.EstimateFlipThreeRisk(target.CurrentHand.NumberCards, target.CurrentHand.HasSecondChance()) > HighRiskFlipThreeTarget {
+			st.FlipThreeHighRisk++
+		}
+	case domain.ActionSecondChance:
+		st.SecondChances++
+		if target != nil && target.ID != actor.ID {
+			st.SecondChancesPassed++
+		}
+	}
+}
+
+// isLeader reports whether target has the highest banked score among actor's opponents (ties included).
+func isLeader(round *domain.Round, actor, target *domain.Player) bool {
+	for _, p := range round.Players {
+		if p.ID != actor.ID && p.TotalScore > target.TotalScore {
+			return false
+		}
+	}
+	return true
+}
+
+// actionStatsTable builds the "Action Card Usage" table, one row per strategy.
+func actionStatsTable(stats map[string]*ActionStats) *console.Table {
+	table := console.NewTable()
+	table.AddHeader("Strategy", "Freezes", "Self", "Leader", "Other", "Flip Threes", "High-risk", "Second Chances", "Passed")
+	names := make([]string, 0, len(stats))
+	for name := range stats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		st := stats[name]
+		table.AddRow(name,
+			st.Freezes, share(st.FreezeSelf, st.Freezes), share(st.FreezeLeader, st.Freezes), share(st.FreezeOther, st.Freezes),
+			st.FlipThrees, share(st.FlipThreeHighRisk, st.FlipThrees),
+			st.SecondChances, share(st.SecondChancesPassed, st.SecondChances))
+	}
+	return table
+}
+
+// share formats n as a percentage of total.
+func share(n, total int) string {
+	if total == 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", float64(n)/float64(total)*100)
+}
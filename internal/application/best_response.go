@@ -0,0 +1,169 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// BestResponseGrid is the risk thresholds RunBestResponseTraining tries for a seat.
+var BestResponseGrid = []float64{0.05, 0.10, 0.15, 0.20, 0.25, 0.30, 0.35, 0.40, 0.45, 0.50}
+
+// BestResponseEpsilon is how much a seat's win rate must improve (as a share of games) for it
+// to adopt another threshold. Smaller gains are taken for noise.
+const BestResponseEpsilon = 0.01
+
+// BestResponseStep is one iteration of RunBestResponseTraining: one seat's sweep over the grid.
+type BestResponseStep struct {
+	Iteration int
+	Seat      int     // Index of the seat that swept
+	From, To  float64 // Its threshold before and after the sweep (equal when it kept it)
+	// WinRateFrom and WinRateTo are the seat's win rates with From and To during the sweep.
+	WinRateFrom, WinRateTo float64
+	Thresholds             []float64 // Every seat's threshold after the sweep
+}
+
+// Improved reports whether the seat adopted another threshold.
+func (st BestResponseStep) Improved() bool {
+	return st.From != st.To
+}
+
+// BestResponseResult is the outcome of RunBestResponseTraining.
+type BestResponseResult struct {
+	Thresholds []float64 // Final threshold of every seat
+	// Converged is true when every seat in turn found no threshold better by more than
+	// BestResponseEpsilon, false when the run stopped at maxIters.
+	Converged bool
+	Trace     []BestResponseStep
+	Seed      int64 // Iteration i deals its decks from Seed+i
+}
+
+// RunBestResponseTraining looks for an equilibrium of ProbabilisticStrategy's risk threshold by
+// iterated best response. All playersN seats start at DefaultProbabilisticRiskThreshold. Each
+// iteration, the next seat in turn plays gamesPerEval games at every threshold of
+// BestResponseGrid while the others keep theirs, and adopts the one it won most with if that
+// beats its current threshold by more than BestResponseEpsilon. Training stops once every seat
+// in a row has kept its threshold, or after maxIters iterations.
+// All thresholds of one sweep are played on the same decks, and the first dealer rotates
+// through the seats. It prints every iteration and then the final thresholds.
+func (s *SimulationService) RunBestResponseTraining(playersN, gamesPerEval, maxIters int) (BestResponseResult, error) {
+	if playersN < 2 {
+		return BestResponseResult{}, errors.New("best-response training needs at least 2 players")
+	}
+	if gamesPerEval < 1 {
+		return BestResponseResult{}, errors.New("best-response training needs at least 1 game per evaluation")
+	}
+	fmt.Printf("Running Best-Response Training (%d players, %d games per threshold, at most %d iterations)...\n", playersN, gamesPerEval, maxIters)
+
+	result := BestResponseResult{Thresholds: make([]float64, playersN), Seed: time.Now().UnixNano()}
+	for i := range result.Thresholds {
+		result.Thresholds[i] = strategy.DefaultProbabilisticRiskThreshold
+	}
+
+	stable := 0 // Seats in a row that kept their threshold
+	for iter := 1; iter <= maxIters && stable < playersN; iter++ {
+		seat := (iter - 1) % playersN
+		progress := s.startProgress(len(BestResponseGrid) * gamesPerEval)
+		rates := make([]float64, len(BestResponseGrid))
+		for i, threshold := range BestResponseGrid {
+			rates[i] = playBestResponse(result.Thresholds, seat, threshold, gamesPerEval, result.Seed+int64(iter), progress)
+		}
+
+		current := gridIndex(result.Thresholds[seat])
+		best := current
+		for i, rate := range rates {
+			if rate > rates[best] {
+				best = i
+			}
+		}
+		if rates[best]-rates[current] <= BestResponseEpsilon {
+			best = current
+		}
+
+		step := BestResponseStep{
+			Iteration:   iter,
+			Seat:        seat,
+			From:        BestResponseGrid[current],
+			To:          BestResponseGrid[best],
+			WinRateFrom: rates[current],
+			WinRateTo:   rates[best],
+		}
+		result.Thresholds[seat] = step.To
+		step.Thresholds = append([]float64(nil), result.Thresholds...)
+		result.Trace = append(result.Trace, step)
+
+		if step.Improved() {
+			stable = 0
+			fmt.Printf("Iteration %d: Seat%d %.2f -> %.2f (win rate %.2f%% -> %.2f%%)\n",
+				iter, seat+1, step.From, step.To, step.WinRateFrom*100, step.WinRateTo*100)
+		} else {
+			stable++
+			fmt.Printf("Iteration %d: Seat%d keeps %.2f (win rate %.2f%%)\n", iter, seat+1, step.From, step.WinRateFrom*100)
+		}
+	}
+	result.Converged = stable >= playersN
+
+	fmt.Println()
+	table := console.NewTable()
+	table.AddHeader("Seat", "Risk Threshold")
+	for i, threshold := range result.Thresholds {
+		table.AddRow(fmt.Sprintf("Seat%d", i+1), fmt.Sprintf("%.2f", threshold))
+	}
+	s.printTable(table)
+	if result.Converged {
+		fmt.Printf("\nConverged after %d iterations.\n", len(result.Trace))
+	} else {
+		fmt.Printf("\nStopped after %d iterations without converging.\n", len(result.Trace))
+	}
+	return result, nil
+}
+
+// gridIndex returns the index of the BestResponseGrid value closest to threshold.
+func gridIndex(threshold float64) int {
+	best := 0
+	for i, t := range BestResponseGrid {
+		if math.Abs(t-threshold) < math.Abs(BestResponseGrid[best]-threshold) {
+			best = i
+		}
+	}
+	return best
+}
+
+// playBestResponse plays n games with every seat at its threshold except seat, which plays
+// threshold, and returns seat's win rate. The decks are dealt from seed.
+func playBestResponse(thresholds []float64, seat int, threshold float64, n int, seed int64, progress *progressTracker) float64 {
+	rng := rand.New(rand.NewSource(seed))
+	wins := 0.0
+	for g := 0; g < n; g++ {
+		players := make([]*domain.Player, len(thresholds))
+		for i, t := range thresholds {
+			strat := strategy.NewProbabilisticStrategy()
+			strat.RiskThreshold = t
+			if i == seat {
+				strat.RiskThreshold = threshold
+			}
+			players[i] = domain.NewPlayer(fmt.Sprintf("Seat%d", i+1), strat)
+		}
+
+		game := domain.NewGame(players)
+		game.DealerIndex = g % len(players)
+		game.Deck = shuffledDeck(domain.StandardDeckCards(), rng)
+		svc := NewGameService(game)
+		svc.Silent = true
+		svc.Warnings = progress.warnings
+		svc.DeckFactory = func(cards []domain.Card) *domain.Deck { return shuffledDeck(cards, rng) }
+		svc.RunGame()
+		progress.gameDone()
+
+		if containsPlayer(game.Winners, players[seat]) {
+			wins += 1.0 / float64(len(game.Winners))
+		}
+	}
+	return wins / float64(n)
+}
@@ -0,0 +1,146 @@
+package application
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+	"flip7_strategy/internal/stats"
+)
+
+// countingValueStrategies are the deck-aware strategies whose card counting is measured.
+var countingValueStrategies = []struct {
+	Name string
+	New  func() domain.Strategy
+}{
+	{"Probabilistic", func() domain.Strategy { return strategy.NewProbabilisticStrategy() }},
+	{"ExpectedValue", func() domain.Strategy { return strategy.NewExpectedValueStrategy() }},
+	{"Adaptive", func() domain.Strategy { return strategy.NewAdaptiveStrategy() }},
+}
+
+// countingValueOpponents returns the fixed opponents of the counting value experiment,
+// fresh for every game. None of them looks at the deck to decide whether to hit.
+func countingValueOpponents() []*domain.Player {
+	return []*domain.Player{
+		domain.NewPlayer("Alice (Cautious)", strategy.NewCautiousStrategy()),
+		domain.NewPlayer("Bob (Aggressive)", strategy.NewAggressiveStrategy()),
+		domain.NewPlayer("Dave (Heuristic)", strategy.NewHeuristicStrategy(strategy.DefaultHeuristicThreshold)),
+	}
+}
+
+// CountingValueResult compares a deck-aware strategy that counts cards with its amnesiac
+// version (see strategy.AmnesiacStrategy) over the same games.
+type CountingValueResult struct {
+	Strategy     string
+	Games        int     // Games played in each condition
+	CountingWins float64 // A game won by k players on equal scores counts 1/k
+	AmnesiacWins float64
+	// Margin is the 95% confidence margin of Delta. Both conditions play the same decks,
+	// so it is computed from the per-game differences.
+	Margin float64
+}
+
+// CountingWinRate returns the share of games won while counting cards.
+func (r CountingValueResult) CountingWinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return r.CountingWins / float64(r.Games)
+}
+
+// AmnesiacWinRate returns the share of games won without counting cards.
+func (r CountingValueResult) AmnesiacWinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return r.AmnesiacWins / float64(r.Games)
+}
+
+// Delta is the win rate that card counting is worth: CountingWinRate - AmnesiacWinRate.
+func (r CountingValueResult) Delta() float64 {
+	return r.CountingWinRate() - r.AmnesiacWinRate()
+}
+
+// RunCountingValueExperiment measures how much card counting helps each deck-aware strategy.
+// Every strategy plays n games against the same fixed opponents twice: counting as usual, and
+// amnesiac, seeing a full deck on every decision. It prints the win rate of both conditions
+// and their difference.
+func (s *SimulationService) RunCountingValueExperiment(n int) []CountingValueResult {
+	fmt.Printf("Running Counting Value Experiment (%d games per strategy and condition)...\n", n)
+
+	seed := time.Now().UnixNano()
+	progress := s.startProgress(len(countingValueStrategies) * 2 * n)
+	results := make([]CountingValueResult, len(countingValueStrategies))
+	for i, cs := range countingValueStrategies {
+		results[i] = playCountingValue(cs.Name, cs.New, n, seed, progress)
+	}
+
+	fmt.Println("\nDelta is the win rate gained by counting cards; ± is its 95% confidence margin.")
+	s.printTable(countingValueTable(results))
+	return results
+}
+
+// playCountingValue plays the experiment for one strategy: n games in each condition,
+// with game i of both shuffled from seed+i.
+func playCountingValue(name string, newStrategy func() domain.Strategy, n int, seed int64, progress *progressTracker) CountingValueResult {
+	result := CountingValueResult{Strategy: name, Games: n}
+	diffs := make([]float64, n)
+	for i := 0; i < n; i++ {
+		// Both conditions deal from the same shuffles, so they only differ where counting
+		// changed a decision.
+		counting := playCountingValueGame(newStrategy(), i, seed+int64(i), progress.warnings)
+		progress.gameDone()
+		amnesiac := playCountingValueGame(strategy.NewAmnesiacStrategy(newStrategy()), i, seed+int64(i), progress.warnings)
+		progress.gameDone()
+
+		result.CountingWins += counting
+		result.AmnesiacWins += amnesiac
+		diffs[i] = counting - amnesiac
+	}
+	result.Margin = stats.MeanMargin(diffs, stats.Z95)
+	return result
+}
+
+// playCountingValueGame plays one game of tested against the fixed opponents and returns
+// tested's share of the win. Tested takes seat i mod 4, so every seat is played equally often.
+// Strategy warnings go to warnings.
+func playCountingValueGame(tested domain.Strategy, i int, seed int64, warnings *StrategyWarnings) float64 {
+	opponents := countingValueOpponents()
+	me := domain.NewPlayer("Tested", tested)
+	seat := i % (len(opponents) + 1)
+	players := append(append(append([]*domain.Player{}, opponents[:seat]...), me), opponents[seat:]...)
+
+	rng := rand.New(rand.NewSource(seed))
+	game := domain.NewGame(players)
+	game.Deck = shuffledDeck(domain.StandardDeckCards(), rng)
+	svc := NewGameService(game)
+	svc.Silent = true
+	svc.Warnings = warnings
+	svc.DeckFactory = func(cards []domain.Card) *domain.Deck { return shuffledDeck(cards, rng) }
+	svc.RunGame()
+
+	for _, winner := range game.Winners {
+		if winner == me {
+			return 1.0 / float64(len(game.Winners))
+		}
+	}
+	return 0
+}
+
+// countingValueTable lists the results by the value of counting, largest first.
+func countingValueTable(results []CountingValueResult) *console.Table {
+	table := console.NewTable()
+	table.AddHeader("Strategy", "Counting", "Amnesiac", "Delta", "±")
+	for _, r := range results {
+		table.AddRow(r.Strategy,
+			fmt.Sprintf("%.2f%%", r.CountingWinRate()*100),
+			fmt.Sprintf("%.2f%%", r.AmnesiacWinRate()*100),
+			fmt.Sprintf("%+.2f", r.Delta()*100),
+			fmt.Sprintf("%.2f", r.Margin*100))
+	}
+	table.SortBy(3, true)
+	return table
+}
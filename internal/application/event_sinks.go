@@ -0,0 +1,283 @@
+package application
+
+import (
+	"strconv"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/logger"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// consolePrinter writes the game log lines of GameService for the events it publishes.
+// It prints through the service, so Silent and Out apply.
+type consolePrinter struct {
+	service *GameService
+}
+
+func (c consolePrinter) Publish(e domain.Event) {
+	s := c.service
+	if s.Silent {
+		return // Skips formatting lines nobody sees; simulations publish millions of events
+	}
+	switch e := e.(type) {
+	case domain.RoundStarted:
+		s.log("--- New Round! Dealer: %s ---\n", e.Dealer.Name)
+	case domain.CardDrawn:
+		// Flip Three draws are logged by the executor.
+		switch e.Source {
+		case domain.DrawInitialDeal:
+			s.log("%s dealt: %v\n", e.Player.Name, e.Card)
+		case domain.DrawHit:
+			s.log("%s drew: %v\n", e.Player.Name, e.Card)
+		}
+	case domain.CardPlayed:
+		switch e.Action {
+		case domain.ActionFreeze:
+			s.log("%s uses Freeze on %s\n", e.Actor.Name, e.Target.Name)
+		case domain.ActionFlipThree:
+			s.log("%s uses Flip Three on %s\n", e.Actor.Name, e.Target.Name)
+		case domain.ActionSecondChance:
+			if e.Target == nil {
+				s.log("All other active players already have a Second Chance. Discarding card.\n")
+			}
+		}
+	case domain.ActionHeld:
+		s.log("%s holds %v for a later turn\n", e.Player.Name, e.Card)
+	case domain.SecondChancePassed:
+		s.log("%s gives Second Chance to %s\n", e.From.Name, e.To.Name)
+	case domain.PlayerBusted:
+		s.log("%s %s\n", e.Player.Name, s.Style.Red("BUSTED!"))
+	case domain.Flip7Achieved:
+		s.log("%s %s\n", e.Player.Name, s.Style.Bold(s.Style.Green("FLIP 7! Bonus!")))
+		s.log("%s banked %d points! Total: %d\n", e.Player.Name, e.Banked, e.Player.TotalScore)
+	case domain.StayOverridden:
+		s.log("%s cannot stay before flipping a card. Hitting instead.\n", e.Player.Name)
+	case domain.PlayerStayed:
+		s.log("%s banked %d points! Total: %d\n", e.Player.Name, e.Banked, e.Player.TotalScore)
+	case domain.PlayerFrozen:
+		s.log("%s banked %d points! Total: %d\n", e.Player.Name, e.Banked, e.Player.TotalScore)
+	case domain.RoundExhausted:
+		for _, h := range e.Hands {
+			if e.Rule == domain.ExhaustionDiscardHands {
+				s.log("%s's hand is discarded.\n", h.Player.Name)
+			} else {
+				s.log("%s banked %d points! Total: %d\n", h.Player.Name, h.Banked, h.Player.TotalScore)
+			}
+		}
+	case domain.RoundEnded:
+		s.log("%s", console.FormatRoundSummary(e.Round.Summary(e.Number)))
+	}
+}
+
+// LoggerSink records the events of a game with a GameLogger: the events of the manual mode
+// log, with the same types and details in every mode, so the same tools (evaluate_logs) can
+// read any game. A nil Logger logs nothing.
+type LoggerSink struct {
+	Logger logger.GameLogger
+	GameID string
+	round  int
+}
+
+// NewLoggerSink returns a sink that logs the events of game gameID.
+func NewLoggerSink(l logger.GameLogger, gameID string) *LoggerSink {
+	return &LoggerSink{Logger: l, GameID: gameID}
+}
+
+func (l *LoggerSink) Publish(e domain.Event) {
+	if l.Logger == nil {
+		return
+	}
+	switch e := e.(type) {
+	case domain.GameStarted:
+		l.round = 0
+		l.log("system", "GameStart", map[string]interface{}{
+			"num_players":   len(e.Game.Players),
+			"players":       getPlayerNames(e.Game.Players),
+			"player_ids":    getPlayerIDs(e.Game.Players),
+			"winning_score": e.Game.WinningScore,
+		})
+	case domain.RoundStarted:
+		l.round = e.Round
+		l.log("system", "RoundStart", map[string]interface{}{
+			"dealer": e.Dealer.Name,
+		})
+	case domain.TurnStarted:
+		l.log(e.Player.ID.String(), "TurnStart", map[string]interface{}{
+			"score":      e.Player.TotalScore,
+			"hand_score": e.HandScore,
+		})
+	case domain.TurnEnded:
+		l.log(e.Player.ID.String(), "TurnEnd", map[string]interface{}{
+			"action":      e.Action,
+			"duration_ms": e.Duration.Milliseconds(),
+		})
+	case domain.CardDrawn:
+		eventType := "CardPlayed"
+		if e.Source == domain.DrawInitialDeal {
+			eventType = "InitialDeal"
+		}
+		l.log(e.Player.ID.String(), eventType, map[string]interface{}{
+			"card": e.Card.String(),
+		})
+	case domain.CardPlayed:
+		if e.Action == domain.ActionFreeze || e.Action == domain.ActionFlipThree {
+			l.log(e.Actor.ID.String(), "ActionTarget", map[string]interface{}{
+				"action": string(e.Action),
+				"target": e.Target.Name,
+			})
+		}
+	case domain.SecondChancePassed:
+		l.log(e.From.ID.String(), "ActionTarget", map[string]interface{}{
+			"action": string(domain.ActionGiveSecondChance),
+			"target": e.To.Name,
+		})
+	case domain.HeldActionPlayed:
+		l.log(e.Player.ID.String(), "PlayHeld", map[string]interface{}{
+			"card": e.Card.String(),
+		})
+	case domain.ActionResolved:
+		l.log(e.Actor.ID.String(), "ActionResolved", map[string]interface{}{
+			"action":    string(e.Action),
+			"target":    e.Target.Name,
+			"outcome":   e.Outcome,
+			"backfired": e.Backfired,
+		})
+	case domain.FlipThreeProgress:
+		l.log(e.Player.ID.String(), "FlipThreeProgress", map[string]interface{}{
+			"card":       e.Card,
+			"hand_score": e.HandScore,
+			"bust_rate":  e.BustRate,
+		})
+	case domain.PlayerBusted:
+		info, _ := e.Player.CurrentHand.BustInfo()
+		details := map[string]interface{}{
+			"hand":            console.FormatHand(e.Player.CurrentHand), // Draw order
+			"duplicate_value": int(info.Duplicate),
+			"hand_score_lost": info.ScoreLost,
+		}
+		if e.PreHitBustRate != nil {
+			details["pre_hit_bust_rate"] = *e.PreHitBustRate
+		}
+		l.log(e.Player.ID.String(), "Bust", details)
+	case domain.PlayerStayed:
+		l.logBanked(e.Player, "Stay", e.Banked)
+	case domain.PlayerFrozen:
+		l.logBanked(e.Player, "Frozen", e.Banked)
+	case domain.DeckReshuffled:
+		l.log("system", "Reshuffle", map[string]interface{}{
+			"discard_count": e.Discarded,
+		})
+	case domain.RoundExhausted:
+		l.log("system", "DeckExhausted", map[string]interface{}{
+			"rule": string(e.Rule),
+		})
+		for _, h := range e.Hands {
+			l.logBanked(h.Player, "Exhausted", h.Banked)
+		}
+	case domain.Flip7Achieved:
+		l.logBanked(e.Player, "Flip7", e.Banked)
+	case domain.RoundEnded:
+		l.log("system", "RoundSummary", roundSummaryDetails(e.Round.Summary(e.Number)))
+	case domain.GameEnded:
+		scores := make(map[string]int, len(e.Game.Players))
+		for _, p := range e.Game.Players {
+			scores[p.Name] = p.TotalScore
+		}
+		l.log("system", "GameEnd", map[string]interface{}{
+			"winners":    getPlayerNames(e.Game.Winners),
+			"winner_ids": getPlayerIDs(e.Game.Winners),
+			"scores":     scores,
+			"standings":  standingsDetails(e.Game),
+		})
+	case DecisionAnalyzed:
+		l.log(e.Player.ID.String(), "DecisionAnalysis", map[string]interface{}{
+			"bust_rate":  e.BustRate,
+			"hand_score": e.HandScore,
+			"ev_hit":     e.EVIfHit,
+			"suggested":  string(e.Suggested),
+			"chosen":     e.Chosen,
+		})
+	case ShadowDecided:
+		l.log(e.Player.ID.String(), "ShadowDecision", map[string]interface{}{
+			"advisor":  e.Advisor,
+			"decision": e.Decision,
+			"shadow":   e.Shadow,
+			"chosen":   e.Chosen,
+			"agreed":   e.Agreed,
+		})
+	case MatchStarted:
+		l.Logger.Log(e.Match.ID, "0", "system", "MatchStart", map[string]interface{}{
+			"best_of":    e.Match.BestOf,
+			"players":    getPlayerNames(e.Players),
+			"player_ids": getPlayerIDs(e.Players),
+		})
+	case MatchGameFinished:
+		l.Logger.Log(e.GameID, "0", "system", "GameInMatch", map[string]interface{}{
+			"match_id":   e.Match.ID,
+			"game":       e.Number,
+			"winners":    getPlayerNames(e.Winners),
+			"winner_ids": getPlayerIDs(e.Winners),
+		})
+	case MatchEnded:
+		wins := make(map[string]int, len(e.Players))
+		points := make(map[string]int, len(e.Players))
+		for _, p := range e.Players {
+			wins[p.Name] = e.Match.Wins[p.ID.String()]
+			points[p.Name] = e.Match.Points[p.ID.String()]
+		}
+		l.Logger.Log(e.Match.ID, "0", "system", "MatchEnd", map[string]interface{}{
+			"games":      e.Match.Played,
+			"winners":    getPlayerNames(e.Winners),
+			"winner_ids": getPlayerIDs(e.Winners),
+			"wins":       wins,
+			"points":     points,
+		})
+	}
+}
+
+func (l *LoggerSink) logBanked(p *domain.Player, eventType string, banked int) {
+	l.log(p.ID.String(), eventType, map[string]interface{}{
+		"banked_score": banked,
+		"total_score":  p.TotalScore,
+	})
+}
+
+// roundSummaryDetails returns the details of a RoundSummary log event.
+func roundSummaryDetails(summary domain.RoundSummary) map[string]interface{} {
+	players := make([]map[string]interface{}, len(summary.Results))
+	for i, r := range summary.Results {
+		players[i] = map[string]interface{}{
+			"player_id":    r.Player.ID.String(),
+			"name":         r.Player.Name,
+			"hand":         console.FormatHand(r.Hand),
+			"status":       string(r.Outcome),
+			"banked_score": r.Banked,
+			"total_score":  r.Total,
+		}
+	}
+	return map[string]interface{}{
+		"reason":         string(summary.Reason),
+		"deck_remaining": summary.DeckRemaining,
+		"players":        players,
+	}
+}
+
+// standingsDetails returns the final standings of g for the details of a GameEnd log event.
+func standingsDetails(g *domain.Game) []map[string]interface{} {
+	standings := g.FinalStandings()
+	details := make([]map[string]interface{}, len(standings))
+	for i, st := range standings {
+		details[i] = map[string]interface{}{
+			"player_id": st.Player.ID.String(),
+			"name":      st.Player.Name,
+			"rank":      st.Rank,
+			"score":     st.Player.TotalScore,
+			"margin":    st.Margin,
+		}
+	}
+	return details
+}
+
+func (l *LoggerSink) log(playerID, eventType string, details map[string]interface{}) {
+	l.Logger.Log(l.GameID, strconv.Itoa(l.round), playerID, eventType, details)
+}
@@ -0,0 +1,130 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// SweepExploitability is the sweep name of RunExploitabilitySearch. Its checkpoints record it
+// as SweepExploitability + ":" + the name of the fixed strategy.
+const SweepExploitability = "exploitability"
+
+// ExploiterHeuristicThresholds is the Heuristic thresholds RunExploitabilitySearch tries, next
+// to the Probabilistic risk thresholds of BestResponseGrid.
+var ExploiterHeuristicThresholds = []int{10, 15, 20, 25, 30, 35, 40, 45, 50}
+
+// exploiterCandidate is one simple strategy RunExploitabilitySearch tries.
+type exploiterCandidate struct {
+	name  string
+	build func() domain.Strategy
+}
+
+// exploiterCandidates returns the candidates of RunExploitabilitySearch, in grid order: every
+// threshold of ExploiterHeuristicThresholds, then every risk threshold of BestResponseGrid.
+func exploiterCandidates() []exploiterCandidate {
+	var candidates []exploiterCandidate
+	for _, threshold := range ExploiterHeuristicThresholds {
+		candidates = append(candidates, exploiterCandidate{
+			name:  fmt.Sprintf("Heuristic-%d", threshold),
+			build: func() domain.Strategy { return strategy.NewHeuristicStrategy(threshold) },
+		})
+	}
+	for _, risk := range BestResponseGrid {
+		candidates = append(candidates, exploiterCandidate{
+			name: fmt.Sprintf("Probabilistic-%.2f", risk),
+			build: func() domain.Strategy {
+				strat := strategy.NewProbabilisticStrategy()
+				strat.RiskThreshold = risk
+				return strat
+			},
+		})
+	}
+	return candidates
+}
+
+// ExploiterResult is how one candidate fared against the fixed strategy.
+type ExploiterResult struct {
+	Name string // e.g. "Heuristic-25" or "Probabilistic-0.20"
+	SweepResult
+}
+
+// ExploitabilityResult is the outcome of RunExploitabilitySearch.
+type ExploitabilityResult struct {
+	Fixed      string            // Name of the strategy exploited
+	Candidates []ExploiterResult // Every candidate, in grid order
+	Best       ExploiterResult   // The candidate with the best win rate
+}
+
+// Exploitability is how far the best exploiter's win rate is above an even 50%, in
+// percentage points. A strategy no candidate beats more than half the time scores 0 or less.
+func (r ExploitabilityResult) Exploitability() float64 {
+	return r.Best.WinRate() - 50
+}
+
+// RunExploitabilitySearch measures how exploitable the registered strategy fixedName is: every
+// candidate (Heuristic thresholds and Probabilistic risk thresholds, see exploiterCandidates)
+// plays n games 1v1 against it, dealing first in every other game, and the candidate with the
+// best win rate is the best exploiter found. It prints every candidate's win rate, then the
+// best exploiter and the exploitability. With CheckpointPath set, an interrupted search can be
+// resumed as the other sweeps can.
+func (s *SimulationService) RunExploitabilitySearch(fixedName string, n int) (ExploitabilityResult, error) {
+	if _, err := strategy.New(fixedName); err != nil {
+		return ExploitabilityResult{}, err
+	}
+	return s.searchExploiters(fixedName, func() domain.Strategy {
+		fixed, _ := strategy.New(fixedName) // Checked above
+		return fixed
+	}, n)
+}
+
+// searchExploiters runs RunExploitabilitySearch against the strategy made by fixed, called
+// for every game so no state carries over from one to the next.
+func (s *SimulationService) searchExploiters(fixedName string, fixed func() domain.Strategy, n int) (ExploitabilityResult, error) {
+	if n < 1 {
+		return ExploitabilityResult{}, errors.New("the exploitability search needs at least 1 game per candidate")
+	}
+	candidates := exploiterCandidates()
+	fmt.Printf("Running Exploitability Search against %s (%d candidates, %d games each)...\n", fixedName, len(candidates), n)
+
+	configs := make([]int, len(candidates))
+	for i := range configs {
+		configs[i] = i
+	}
+	played := make(map[int]int, len(candidates)) // Candidate -> games dealt so far
+	results := s.runSweep(SweepExploitability+":"+fixedName, configs, n, "Exploiter", func(config int) []*domain.Player {
+		players := []*domain.Player{
+			domain.NewPlayer("Exploiter", candidates[config].build()),
+			domain.NewPlayer("Fixed", fixed()),
+		}
+		if played[config]%2 == 1 {
+			players[0], players[1] = players[1], players[0] // The first seat deals first
+		}
+		played[config]++
+		return players
+	})
+
+	result := ExploitabilityResult{Fixed: fixedName, Candidates: make([]ExploiterResult, len(results))}
+	bestRate := math.Inf(-1)
+	for i, r := range results {
+		result.Candidates[i] = ExploiterResult{Name: candidates[r.Config].name, SweepResult: r}
+		if r.WinRate() > bestRate {
+			bestRate = r.WinRate()
+			result.Best = result.Candidates[i]
+		}
+	}
+
+	table := console.NewTable()
+	table.AddHeader("Exploiter", "Win Rate", "Seed")
+	for _, c := range result.Candidates {
+		table.AddRow(c.Name, fmt.Sprintf("%.2f%%", c.WinRate()), c.Seed)
+	}
+	s.printTable(table)
+	fmt.Printf("\nBest exploiter of %s: %s (Win Rate: %.2f%%)\n", fixedName, result.Best.Name, result.Best.WinRate())
+	fmt.Printf("Exploitability: %+.2f points above 50%%\n", result.Exploitability())
+	return result, nil
+}
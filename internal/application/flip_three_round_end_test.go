@@ -0,0 +1,118 @@
+package application
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/testutil"
+)
+
+// fixedTargetStrategy stays and always targets Target with action cards.
+type fixedTargetStrategy struct {
+	Target *domain.Player
+}
+
+func (s *fixedTargetStrategy) Name() string { return "FixedTarget" }
+func (s *fixedTargetStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, score int, others []*domain.Player) domain.TurnChoice {
+	return domain.TurnChoiceStay
+}
+func (s *fixedTargetStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	return s.Target
+}
+
+// setupFlipThreeIntoFlip7 builds a game where P1 draws Flip Three onto P2, whose hand holds 1-5.
+// The deck is 6, 7, 8 in that order, so P2 completes Flip 7 on the second forced draw.
+func setupFlipThreeIntoFlip7() (*domain.Game, *domain.Player, *domain.Player) {
+	p2 := domain.NewPlayer("P2", nil)
+	p1 := domain.NewPlayer("P1", &fixedTargetStrategy{Target: p2})
+	players := []*domain.Player{p1, p2}
+	game := domain.NewGame(players)
+
+	p1.StartNewRound()
+	p1.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 10})
+	p2.StartNewRound()
+	for v := 1; v <= 5; v++ {
+		p2.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)})
+	}
+
+	deck := domain.NewDeckFromCards(nil)
+	for _, v := range []int{6, 7, 8} {
+		deck.Cards = append(deck.Cards, domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)})
+		deck.RemainingCounts[domain.NumberValue(v)]++
+	}
+	game.Deck = deck
+	game.CurrentRound = testutil.NewRound(players, p1, deck)
+
+	return game, p1, p2
+}
+
+func assertFlipThreeEndedRound(t *testing.T, game *domain.Game, p1, p2 *domain.Player) {
+	t.Helper()
+	round := game.CurrentRound
+
+	if !round.IsEnded || round.EndReason != domain.RoundEndReasonFlip7 {
+		t.Errorf("Expected round to end with %s, got ended=%v reason=%s", domain.RoundEndReasonFlip7, round.IsEnded, round.EndReason)
+	}
+	// 1+2+3+4+5+6+7 = 28, plus 15 Flip 7 bonus
+	if p2.TotalScore != 43 {
+		t.Errorf("Expected P2 to bank 43, got %d", p2.TotalScore)
+	}
+	// The third forced draw never happens
+	if len(round.Deck.Cards) != 1 || round.Deck.Cards[0].Value != 8 {
+		t.Errorf("Expected only the 8 to remain in the deck, got %v", round.Deck.Cards)
+	}
+	if len(p1.CurrentHand.RawNumberCards) != 1 {
+		t.Errorf("Expected P1's number cards to be untouched, got %v", p1.CurrentHand.RawNumberCards)
+	}
+
+	// The drawer's Flip Three card is accounted for exactly once (hand or discard pile)
+	count := 0
+	for _, c := range p1.CurrentHand.ActionCards {
+		if c.ActionType == domain.ActionFlipThree {
+			count++
+		}
+	}
+	for _, c := range game.DiscardPile {
+		if c.ActionType == domain.ActionFlipThree {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected the Flip Three card to be tracked once, got %d", count)
+	}
+}
+
+func TestFlipThree_Flip7OnSecondDrawEndsRound(t *testing.T) {
+	flipThree := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}
+
+	t.Run("GameService", func(t *testing.T) {
+		game, p1, p2 := setupFlipThreeIntoFlip7()
+		svc := NewGameService(game)
+		svc.Silent = true
+
+		svc.ProcessCardDraw(p1, flipThree)
+
+		assertFlipThreeEndedRound(t, game, p1, p2)
+	})
+
+	t.Run("ManualGameService", func(t *testing.T) {
+		game, p1, p2 := setupFlipThreeIntoFlip7()
+		// Target P2 (second candidate), then the forced draws; the 8 must never be read.
+		reader := bufio.NewReader(strings.NewReader("2\n6\n7\n8\n"))
+		svc := NewManualGameService(reader, nil)
+		svc.Game = game
+
+		svc.processCard(p1, flipThree)
+
+		assertFlipThreeEndedRound(t, game, p1, p2)
+		// The round ended during resolution, so the drawer's hand is not mutated afterwards
+		if len(p1.CurrentHand.ActionCards) != 0 {
+			t.Errorf("Expected P1's hand to be left untouched, got action cards %v", p1.CurrentHand.ActionCards)
+		}
+		if rest, _ := reader.ReadString('\n'); rest != "8\n" {
+			t.Errorf("Expected the third card input to be left unread, got %q", rest)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+package application
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+
+	"flip7_strategy/internal/domain"
+)
+
+// GameExportVersion is the format version of GameExport. It changes only when a field is
+// renamed, removed or changes meaning; new fields keep the version.
+const GameExportVersion = 1
+
+// Game modes recorded in GameExport.Mode.
+const (
+	ExportModeAutomatic   = "automatic"
+	ExportModeInteractive = "interactive"
+	ExportModeManual      = "manual"
+)
+
+// GameExport is the final state of a completed game in a stable, machine-readable form.
+// It keeps what a finished game means to downstream tools (players, scores, winners, why it
+// ended) and leaves out what only matters mid-game, such as the deck and the last round's hands.
+// Field names follow the JSON tags of the domain structs.
+type GameExport struct {
+	Version      int                  `json:"version"`
+	ExportedAt   time.Time            `json:"exported_at"`
+	Mode         string               `json:"mode"`
+	GameID       string               `json:"game_id"`
+	IsCompleted  bool                 `json:"is_completed"`
+	EndReason    domain.GameEndReason `json:"end_reason"`
+	RoundCount   int                  `json:"round_count"`
+	WinningScore int                  `json:"winning_score"`
+	Players      []PlayerExport       `json:"players"` // In seat order
+	Winners      []string             `json:"winners"` // Player IDs; empty without a winner
+	// Standings ranks every player by final score (see domain.Game.FinalStandings).
+	Standings []StandingExport `json:"standings"`
+}
+
+// StandingExport is a player's place in GameExport.Standings.
+type StandingExport struct {
+	PlayerID string `json:"player_id"`
+	Rank     int    `json:"rank"`   // Players level on score share a rank
+	Margin   int    `json:"margin"` // Points behind the highest score
+}
+
+// PlayerExport is one player of a GameExport.
+type PlayerExport struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Strategy   string `json:"strategy"` // Strategy name; empty for a user-controlled seat of Manual Mode
+	TotalScore int    `json:"total_score"`
+	Team       string `json:"team,omitempty"`
+	Dropped    bool   `json:"dropped,omitempty"`
+	// ScoreHistory is the total score after each completed round, when the mode records it.
+	ScoreHistory []int `json:"score_history,omitempty"`
+}
+
+// NewGameExport builds the export of game, played in mode and exported at exportedAt.
+// scoreHistory maps player IDs to their total score after each round (ManualGameService.ScoreHistory);
+// it may be nil.
+func NewGameExport(game *domain.Game, mode string, scoreHistory map[string][]int, exportedAt time.Time) GameExport {
+	export := GameExport{
+		Version:      GameExportVersion,
+		ExportedAt:   exportedAt,
+		Mode:         mode,
+		GameID:       game.ID.String(),
+		IsCompleted:  game.IsCompleted,
+		EndReason:    game.EndReason,
+		RoundCount:   game.RoundCount,
+		WinningScore: game.TargetScore(),
+		Players:      make([]PlayerExport, len(game.Players)),
+		Winners:      make([]string, len(game.Winners)),
+	}
+	for i, p := range game.Players {
+		player := PlayerExport{
+			ID:           p.ID.String(),
+			Name:         p.Name,
+			TotalScore:   p.TotalScore,
+			Team:         p.Team,
+			Dropped:      p.Dropped,
+			ScoreHistory: scoreHistory[p.ID.String()],
+		}
+		if p.Strategy != nil {
+			player.Strategy = p.Strategy.Name()
+		}
+		export.Players[i] = player
+	}
+	for i, w := range game.Winners {
+		export.Winners[i] = w.ID.String()
+	}
+	for _, st := range game.FinalStandings() {
+		export.Standings = append(export.Standings, StandingExport{PlayerID: st.Player.ID.String(), Rank: st.Rank, Margin: st.Margin})
+	}
+	return export
+}
+
+// WriteGameExport writes export to path as indented JSON, replacing the file if it exists.
+func WriteGameExport(path string, export GameExport) error {
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0644)
+}
@@ -0,0 +1,148 @@
+package application_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+// gameExportV1 is version 1 of the export format as downstream tools read it. Fields may be
+// added to GameExport without a new version, but none of these may change.
+type gameExportV1 struct {
+	Version      int       `json:"version"`
+	ExportedAt   time.Time `json:"exported_at"`
+	Mode         string    `json:"mode"`
+	GameID       string    `json:"game_id"`
+	IsCompleted  bool      `json:"is_completed"`
+	EndReason    string    `json:"end_reason"`
+	RoundCount   int       `json:"round_count"`
+	WinningScore int       `json:"winning_score"`
+	Players      []struct {
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		Strategy     string `json:"strategy"`
+		TotalScore   int    `json:"total_score"`
+		ScoreHistory []int  `json:"score_history"`
+	} `json:"players"`
+	Winners []string `json:"winners"`
+}
+
+// readExport reads an export file both as version 1 and as raw JSON fields.
+func readExport(t *testing.T, path string) (gameExportV1, map[string]json.RawMessage) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read the export: %v", err)
+	}
+	var export gameExportV1
+	if err := json.Unmarshal(data, &export); err != nil {
+		t.Fatalf("Export is not valid JSON: %v", err)
+	}
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Export is not a JSON object: %v", err)
+	}
+	return export, raw
+}
+
+func TestGameExport_SchemaV1(t *testing.T) {
+	// P1 is dealt 8 and P2 3, both stay: P1 wins round 1 with 8 of the 5 points needed.
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.WinningScore = 5
+	game.Deck = domain.NewDeckInOrder(numbers(8, 3, 1, 2))
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.RunGame()
+
+	exportedAt := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "game.json")
+	export := application.NewGameExport(game, application.ExportModeAutomatic, nil, exportedAt)
+	if err := application.WriteGameExport(path, export); err != nil {
+		t.Fatalf("WriteGameExport failed: %v", err)
+	}
+
+	got, raw := readExport(t, path)
+	for _, field := range []string{"version", "exported_at", "mode", "game_id", "is_completed", "end_reason", "round_count", "winning_score", "players", "winners"} {
+		if _, ok := raw[field]; !ok {
+			t.Errorf("Expected the required field %q in the export", field)
+		}
+	}
+	// Transient state stays out of the export.
+	for _, field := range []string{"deck", "current_round", "discard_pile", "dealer_index"} {
+		if _, ok := raw[field]; ok {
+			t.Errorf("Expected no %q in the export", field)
+		}
+	}
+
+	if got.Version != 1 || got.Mode != "automatic" || got.GameID != game.ID.String() || !got.ExportedAt.Equal(exportedAt) {
+		t.Errorf("Unexpected metadata: version %d, mode %q, game %q, exported at %v", got.Version, got.Mode, got.GameID, got.ExportedAt)
+	}
+	if !got.IsCompleted || got.EndReason != "winner" || got.RoundCount != 1 || got.WinningScore != 5 {
+		t.Errorf("Unexpected outcome: completed %v, reason %q, %d round(s), winning score %d",
+			got.IsCompleted, got.EndReason, got.RoundCount, got.WinningScore)
+	}
+	if len(got.Players) != 2 {
+		t.Fatalf("Expected 2 players, got %d", len(got.Players))
+	}
+	for i, p := range []*domain.Player{p1, p2} {
+		e := got.Players[i]
+		if e.ID != p.ID.String() || e.Name != p.Name || e.Strategy != "Mock" || e.TotalScore != p.TotalScore {
+			t.Errorf("Player %d: expected %s (Mock) with %d points, got %+v", i, p.Name, p.TotalScore, e)
+		}
+	}
+	if !reflect.DeepEqual(got.Winners, []string{p1.ID.String()}) {
+		t.Errorf("Expected P1's ID as the only winner, got %v", got.Winners)
+	}
+	// Standings were added after version 1, so they are read from the Go struct.
+	standings := []application.StandingExport{{PlayerID: p1.ID.String(), Rank: 1}, {PlayerID: p2.ID.String(), Rank: 2, Margin: 5}}
+	if _, ok := raw["standings"]; !ok || !reflect.DeepEqual(export.Standings, standings) {
+		t.Errorf("Expected P1 first and P2 5 points behind in the standings, got %+v", export.Standings)
+	}
+}
+
+func TestManualMode_ExportsFinalState(t *testing.T) {
+	// Me is dealt 12 and Bot 3; Bot stays, then Me hits 11 and stays on 23, short of 30.
+	// In round 2 Me is dealt 10 and Bot 1; both stay and Me wins with 33.
+	input := strings.Join([]string{"", "2", "Bot", "1", "30",
+		"12", "3", "11", "S", "S",
+		"1", "10", "S", "S",
+	}, "\n") + "\n"
+	path := filepath.Join(t.TempDir(), "game.json")
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.ExportPath = path
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	if !strings.Contains(out.String(), "Final game state exported to "+path) {
+		t.Errorf("Expected the export to be reported, got:\n%s", out.String())
+	}
+	got, _ := readExport(t, path)
+	if got.Mode != "manual" || got.EndReason != "winner" || got.RoundCount != 2 {
+		t.Errorf("Expected a manual game won in round 2, got mode %q, reason %q, %d round(s)", got.Mode, got.EndReason, got.RoundCount)
+	}
+	if len(got.Players) != 2 {
+		t.Fatalf("Expected 2 players, got %+v", got.Players)
+	}
+	me, bot := got.Players[0], got.Players[1]
+	if me.Strategy != "" || me.TotalScore != 33 || !reflect.DeepEqual(me.ScoreHistory, []int{23, 33}) {
+		t.Errorf("Expected the user's seat without a strategy, 33 points and history [23 33], got %+v", me)
+	}
+	if bot.TotalScore != 4 || !reflect.DeepEqual(bot.ScoreHistory, []int{3, 4}) {
+		t.Errorf("Expected Bot with 4 points and history [3 4], got %+v", bot)
+	}
+	if !reflect.DeepEqual(got.Winners, []string{me.ID}) {
+		t.Errorf("Expected Me to win, got %v", got.Winners)
+	}
+}
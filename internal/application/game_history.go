@@ -0,0 +1,118 @@
+package application
+
+// GameMemento represents a snapshot of the game state, encoded as a base64 string.
+// It is used by GameHistory to support undo/redo functionality.
+type GameMemento string
+
+// DefaultMaxHistory is the number of states GameHistory keeps when MaxLen is not set.
+const DefaultMaxHistory = 200
+
+// HistoryPhase tells where in a round a history entry was recorded.
+type HistoryPhase string
+
+const (
+	// HistoryPhaseTurn is a state recorded during a round: after a dealt card or a completed turn.
+	HistoryPhaseTurn HistoryPhase = "turn"
+	// HistoryPhaseRoundStart is the state a round starts from, recorded once the previous round's
+	// hands are collected and the deal has passed on. Undo does not go back past it.
+	HistoryPhaseRoundStart HistoryPhase = "round_start"
+)
+
+// historyEntry is a state of GameHistory with the phase it was recorded in.
+type historyEntry struct {
+	memento GameMemento
+	phase   HistoryPhase
+}
+
+// GameHistory manages the history of game states for undo/redo.
+// Once it holds more than MaxLen states the oldest ones are evicted, so Undo stops at the oldest kept state.
+// Undo also stops at the start of the current round (see HistoryPhaseRoundStart): the round before
+// it is already scored and its cards collected.
+type GameHistory struct {
+	MaxLen       int // Maximum number of states kept; 0 means DefaultMaxHistory
+	entries      []historyEntry
+	currentIndex int
+}
+
+// Push adds a new memento recorded during a round, truncating any future redo states.
+// A memento equal to the current state is not pushed (and keeps the redo states).
+func (h *GameHistory) Push(memento GameMemento) {
+	h.push(historyEntry{memento: memento, phase: HistoryPhaseTurn})
+}
+
+// PushRoundStart adds the memento a new round starts from, truncating any future redo states.
+func (h *GameHistory) PushRoundStart(memento GameMemento) {
+	h.push(historyEntry{memento: memento, phase: HistoryPhaseRoundStart})
+}
+
+func (h *GameHistory) push(entry historyEntry) {
+	if h.currentIndex >= 0 && h.currentIndex < len(h.entries) && h.entries[h.currentIndex] == entry {
+		return
+	}
+	// If we are in the middle of the history (after undo), remove future states
+	if h.currentIndex < len(h.entries)-1 {
+		h.entries = h.entries[:h.currentIndex+1]
+	}
+	h.entries = append(h.entries, entry)
+
+	if excess := len(h.entries) - h.maxLen(); excess > 0 {
+		// Copy rather than reslice so the evicted snapshots can be garbage collected.
+		h.entries = append([]historyEntry(nil), h.entries[excess:]...)
+	}
+	h.currentIndex = len(h.entries) - 1
+}
+
+func (h *GameHistory) maxLen() int {
+	if h.MaxLen <= 0 {
+		return DefaultMaxHistory
+	}
+	return h.MaxLen
+}
+
+// Len returns the number of states kept in the history.
+func (h *GameHistory) Len() int {
+	return len(h.entries)
+}
+
+// AtRoundStart reports whether the current state is the start of a round, so Undo is refused
+// because it would step back into the previous round.
+func (h *GameHistory) AtRoundStart() bool {
+	return h.currentIndex > 0 && h.currentIndex < len(h.entries) && h.entries[h.currentIndex].phase == HistoryPhaseRoundStart
+}
+
+// UndoSteps returns how many times Undo can currently succeed: back to the start of the
+// current round or to the oldest kept state.
+func (h *GameHistory) UndoSteps() int {
+	steps := 0
+	for i := h.currentIndex; i > 0 && i < len(h.entries) && h.entries[i].phase != HistoryPhaseRoundStart; i-- {
+		steps++
+	}
+	return steps
+}
+
+// RedoSteps returns how many times Redo can currently succeed.
+func (h *GameHistory) RedoSteps() int {
+	if steps := len(h.entries) - 1 - h.currentIndex; steps > 0 {
+		return steps
+	}
+	return 0
+}
+
+// Undo moves the pointer back and returns the previous memento.
+// It fails at the oldest kept state and at the start of a round.
+func (h *GameHistory) Undo() (GameMemento, bool) {
+	if h.UndoSteps() > 0 {
+		h.currentIndex--
+		return h.entries[h.currentIndex].memento, true
+	}
+	return "", false
+}
+
+// Redo moves the pointer forward and returns the next memento.
+func (h *GameHistory) Redo() (GameMemento, bool) {
+	if h.currentIndex < len(h.entries)-1 {
+		h.currentIndex++
+		return h.entries[h.currentIndex].memento, true
+	}
+	return "", false
+}
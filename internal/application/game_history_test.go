@@ -1,6 +1,7 @@
 package application
 
 import (
+	"strconv"
 	"testing"
 )
 
@@ -11,20 +12,20 @@ func TestGameHistory_Push(t *testing.T) {
 
 	// Case 1: Push to empty history
 	h.Push("state1")
-	if len(h.mementos) != 1 {
-		t.Errorf("Expected length 1, got %d", len(h.mementos))
+	if len(h.entries) != 1 {
+		t.Errorf("Expected length 1, got %d", len(h.entries))
 	}
 	if h.currentIndex != 0 {
 		t.Errorf("Expected index 0, got %d", h.currentIndex)
 	}
-	if h.mementos[0] != "state1" {
-		t.Errorf("Expected state1, got %s", h.mementos[0])
+	if h.entries[0].memento != "state1" {
+		t.Errorf("Expected state1, got %s", h.entries[0].memento)
 	}
 
 	// Case 2: Push new state
 	h.Push("state2")
-	if len(h.mementos) != 2 {
-		t.Errorf("Expected length 2, got %d", len(h.mementos))
+	if len(h.entries) != 2 {
+		t.Errorf("Expected length 2, got %d", len(h.entries))
 	}
 	if h.currentIndex != 1 {
 		t.Errorf("Expected index 1, got %d", h.currentIndex)
@@ -33,20 +34,20 @@ func TestGameHistory_Push(t *testing.T) {
 	// Case 3: Undo then Push (Truncation)
 	h.Undo() // Index becomes 0 ("state1")
 	h.Push("state3")
-	if len(h.mementos) != 2 {
-		t.Errorf("Expected length 2 (truncated), got %d", len(h.mementos))
+	if len(h.entries) != 2 {
+		t.Errorf("Expected length 2 (truncated), got %d", len(h.entries))
 	}
 	if h.currentIndex != 1 {
 		t.Errorf("Expected index 1, got %d", h.currentIndex)
 	}
-	if h.mementos[1] != "state3" {
-		t.Errorf("Expected state3, got %s", h.mementos[1])
+	if h.entries[1].memento != "state3" {
+		t.Errorf("Expected state3, got %s", h.entries[1].memento)
 	}
 }
 
 func TestGameHistory_Undo(t *testing.T) {
 	h := &GameHistory{
-		mementos:     []GameMemento{"state1", "state2"},
+		entries:      []historyEntry{{memento: "state1"}, {memento: "state2"}},
 		currentIndex: 1,
 	}
 
@@ -74,7 +75,7 @@ func TestGameHistory_Undo(t *testing.T) {
 
 func TestGameHistory_Redo(t *testing.T) {
 	h := &GameHistory{
-		mementos:     []GameMemento{"state1", "state2"},
+		entries:      []historyEntry{{memento: "state1"}, {memento: "state2"}},
 		currentIndex: 0,
 	}
 
@@ -99,3 +100,106 @@ func TestGameHistory_Redo(t *testing.T) {
 		t.Errorf("Expected index to remain 1, got %d", h.currentIndex)
 	}
 }
+
+func TestGameHistory_PushSkipsDuplicateOfCurrent(t *testing.T) {
+	h := &GameHistory{}
+	h.Push("state1")
+	h.Push("state1") // e.g. a rejected input that changed nothing
+	if h.Len() != 1 {
+		t.Errorf("Expected length 1, got %d", h.Len())
+	}
+
+	h.Push("state2")
+	h.Undo() // At state1, state2 can be redone
+	h.Push("state1")
+	if h.RedoSteps() != 1 {
+		t.Errorf("Expected pushing the current state to keep 1 redo step, got %d", h.RedoSteps())
+	}
+
+	// Only the current state counts as a duplicate.
+	h.Redo()
+	h.Push("state1")
+	if h.Len() != 3 || h.currentIndex != 2 {
+		t.Errorf("Expected state1 to be pushed after state2, got length %d index %d", h.Len(), h.currentIndex)
+	}
+}
+
+func TestGameHistory_EvictsOldestBeyondMaxLen(t *testing.T) {
+	h := &GameHistory{MaxLen: 3}
+	for _, m := range []GameMemento{"s1", "s2", "s3", "s4", "s5"} {
+		h.Push(m)
+	}
+	if h.Len() != 3 {
+		t.Fatalf("Expected length 3, got %d", h.Len())
+	}
+	if h.UndoSteps() != 2 {
+		t.Errorf("Expected 2 undo steps, got %d", h.UndoSteps())
+	}
+
+	// Undo reaches the oldest kept state and refuses to go past it.
+	for _, want := range []GameMemento{"s4", "s3"} {
+		m, ok := h.Undo()
+		if !ok || m != want {
+			t.Errorf("Expected undo to %s, got %s (ok=%v)", want, m, ok)
+		}
+	}
+	if _, ok := h.Undo(); ok {
+		t.Error("Expected undo past the evicted boundary to fail")
+	}
+	if h.UndoSteps() != 0 || h.RedoSteps() != 2 {
+		t.Errorf("Expected 0 undo and 2 redo steps, got %d and %d", h.UndoSteps(), h.RedoSteps())
+	}
+
+	// Redo still walks back to the newest state.
+	for _, want := range []GameMemento{"s4", "s5"} {
+		m, ok := h.Redo()
+		if !ok || m != want {
+			t.Errorf("Expected redo to %s, got %s (ok=%v)", want, m, ok)
+		}
+	}
+
+	// Pushing after undoing to the boundary truncates the redo states without evicting.
+	h.Undo()
+	h.Undo()
+	h.Push("s6")
+	if h.Len() != 2 || h.entries[0].memento != "s3" || h.entries[1].memento != "s6" {
+		t.Errorf("Expected [s3 s6], got %v", h.entries)
+	}
+}
+
+func TestGameHistory_DefaultMaxLen(t *testing.T) {
+	h := &GameHistory{}
+	for i := 0; i < DefaultMaxHistory+10; i++ {
+		h.Push(GameMemento(strconv.Itoa(i)))
+	}
+	if h.Len() != DefaultMaxHistory {
+		t.Errorf("Expected length %d, got %d", DefaultMaxHistory, h.Len())
+	}
+	if h.entries[0].memento != "10" {
+		t.Errorf("Expected oldest kept state 10, got %s", h.entries[0].memento)
+	}
+}
+
+func TestGameHistory_RoundStartIsUndoBoundary(t *testing.T) {
+	h := &GameHistory{}
+	h.Push("round1-start")
+	h.Push("round1-turn")
+	h.PushRoundStart("round2-start")
+	h.Push("round2-deal")
+
+	if h.UndoSteps() != 1 {
+		t.Errorf("Expected 1 undo step back to the start of round 2, got %d", h.UndoSteps())
+	}
+	if m, ok := h.Undo(); !ok || m != "round2-start" {
+		t.Errorf("Expected undo to round2-start, got %s (ok=%v)", m, ok)
+	}
+	if !h.AtRoundStart() || h.UndoSteps() != 0 {
+		t.Errorf("Expected to be at a round start with no undo step, got %v and %d", h.AtRoundStart(), h.UndoSteps())
+	}
+	if m, ok := h.Undo(); ok {
+		t.Errorf("Expected undo past the start of the round to fail, got %s", m)
+	}
+	if m, ok := h.Redo(); !ok || m != "round2-deal" {
+		t.Errorf("Expected redo to round2-deal, got %s (ok=%v)", m, ok)
+	}
+}
@@ -2,13 +2,45 @@ package application
 
 import (
 	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
 	"fmt"
+	"io"
+	"os"
 )
 
 // GameService orchestrates the game.
 type GameService struct {
-	Game                *domain.Game
-	Silent              bool
+	Game   *domain.Game
+	Silent bool
+	// Out receives the game log unless Silent is set; nil means os.Stdout.
+	Out io.Writer
+	// Style emphasizes busts and Flip 7s in the game log; the zero value prints plain text.
+	Style console.Style
+	// Warnings receives warnings about strategies that break the rules, even when Silent is
+	// set, so strategy authors notice them in simulations. Simulations share one between the
+	// games of a run, so each strategy is warned about once per run; nil means a
+	// StrategyWarnings of the game's own, writing to os.Stderr.
+	Warnings *StrategyWarnings
+	// DeckFactory builds the deck when the discard pile is reshuffled.
+	// Nil uses domain.NewDeckFromCards; tests can supply domain.NewDeckInOrder to control the order.
+	DeckFactory func(cards []domain.Card) *domain.Deck
+	// ValidateCards checks card conservation (domain.Game.ValidateConservation) after every
+	// round and panics on a violation. It is a debugging aid for tests and simulations
+	// that start from a full deck.
+	ValidateCards bool
+	// MaxRounds ends the game without a winner once this many rounds have been played.
+	// 0 means no limit; simulations set it so a strategy that never reaches the target cannot hang.
+	MaxRounds int
+	// Events receives the domain events of the game. The game log is printed by a sink
+	// subscribed by NewGameService; statistics and loggers subscribe their own.
+	Events domain.EventBus
+	// Interceptor, if set, is called before and after every decision and draw, and when an
+	// action's target is chosen (see TurnInterceptor). It is meant for robustness tests.
+	Interceptor TurnInterceptor
+	// AfterTurn, if set, is called after every turn once its events are published, e.g. to
+	// step through a sample game. Returning StepRunToEnd clears it; StepAbort ends the game
+	// with GameEndReasonAborted.
+	AfterTurn           func(round *domain.Round, player *domain.Player) domain.StepControl
 	secondChanceHandler *domain.SecondChanceHandler
 }
 
@@ -18,7 +50,15 @@ type gameServiceFlipThreeCardSource struct {
 }
 
 func (gs *gameServiceFlipThreeCardSource) GetNextCard(cardNum int, target *domain.Player) (domain.Card, error) {
-	return gs.service.DrawCard()
+	gs.service.intercept(Interception{Point: InterceptBeforeDraw, Player: target, Source: domain.DrawFlipThree})
+	card, err := gs.service.DrawCard()
+	if err != nil {
+		gs.service.exhaustRound()
+		return card, err
+	}
+	gs.service.intercept(Interception{Point: InterceptAfterDraw, Player: target, Source: domain.DrawFlipThree, Card: &card})
+	gs.service.Events.Publish(domain.CardDrawn{Player: target, Card: card, Source: domain.DrawFlipThree})
+	return card, nil
 }
 
 // gameServiceFlipThreeCardProcessor implements FlipThreeCardProcessor for AI mode.
@@ -36,91 +76,235 @@ func (gp *gameServiceFlipThreeCardProcessor) ProcessQueuedAction(target *domain.
 	return nil
 }
 
+func (gp *gameServiceFlipThreeCardProcessor) DiscardCard(card domain.Card) {
+	gp.service.Game.DiscardPile = append(gp.service.Game.DiscardPile, card)
+}
+
 // strategyTargetSelector wraps a Strategy to implement TargetSelector interface.
+// It enforces the ChooseTarget contract: a strategy that returns nil or a player outside the
+// candidates gets the first candidate instead, and the log warns about it.
 type strategyTargetSelector struct {
 	strategy domain.Strategy
 	deck     *domain.Deck
+	warn     func(format string, a ...interface{})
 }
 
 func (sts *strategyTargetSelector) SelectTarget(actionType domain.ActionType, candidates []*domain.Player, actor *domain.Player) *domain.Player {
+	if len(candidates) == 0 {
+		return nil
+	}
 	target := sts.strategy.ChooseTarget(actionType, candidates, actor)
-
-	// Validate that the target is in the candidates list
 	if target != nil {
 		for _, candidate := range candidates {
 			if candidate.ID == target.ID {
 				return target
 			}
 		}
-		// Target not in candidates - return first candidate as fallback
-		if len(candidates) > 0 {
-			return candidates[0]
+	}
+
+	if sts.warn != nil {
+		chosen := "nil"
+		if target != nil {
+			chosen = target.Name
+		}
+		sts.warn("Warning: %s chose %s as the %s target, which is not a candidate; targeting %s instead.\n",
+			sts.strategy.Name(), chosen, actionType, candidates[0].Name)
+	}
+	return candidates[0]
+}
+
+// selectorFor returns the TargetSelector used for every target choice made by p.
+// The same adapter is used during the initial deal and regular turns, so deck-aware
+// strategies always see the current deck, with the discard pile to be reshuffled after it,
+// interactive players are always prompted and every choice is checked against the candidates.
+func (s *GameService) selectorFor(p *domain.Player) domain.TargetSelector {
+	deck := s.Game.CurrentRound.Deck
+	if ds, ok := p.Strategy.(domain.DeckAware); ok {
+		ds.SetDeck(domain.NewReshuffleView(deck, s.Game.DiscardPile))
+	}
+	return &strategyTargetSelector{strategy: p.Strategy, deck: deck, warn: s.log}
+}
+
+// notifyReshuffle tells the ReshuffleAware strategies among strategies that deck, made from the
+// discard pile, is the deck now.
+func notifyReshuffle(deck domain.DeckView, strategies ...domain.Strategy) {
+	for _, strat := range strategies {
+		if ra, ok := strat.(domain.ReshuffleAware); ok {
+			ra.OnReshuffle(deck)
 		}
 	}
-	return nil
 }
 
 func NewGameService(game *domain.Game) *GameService {
-	return &GameService{
+	s := &GameService{
 		Game:                game,
 		secondChanceHandler: domain.NewSecondChanceHandler(),
 	}
+	s.Events.Subscribe(consolePrinter{service: s})
+	return s
+}
+
+// NewGameServiceWithOutput is NewGameService printing the game log to out instead of os.Stdout.
+func NewGameServiceWithOutput(game *domain.Game, out io.Writer) *GameService {
+	s := NewGameService(game)
+	s.Out = out
+	return s
+}
+
+// UseFixedDeck makes the game deal cards exactly in the given order, for teaching and for
+// reproducing bug reports. Once those cards run out the discard pile is shuffled as usual,
+// and the log warns that the game is no longer deterministic from there.
+func (s *GameService) UseFixedDeck(cards []domain.Card) {
+	s.Game.Deck = domain.NewDeckInOrder(cards)
+	s.DeckFactory = func(discards []domain.Card) *domain.Deck {
+		s.log("%s\n", "Warning: the fixed deck order is used up; the reshuffled discard pile is random from here on.")
+		return domain.NewDeckFromCards(discards)
+	}
 }
 
 func (s *GameService) log(format string, a ...interface{}) {
-	if !s.Silent {
-		fmt.Printf(format, a...)
+	if s.Silent {
+		return
+	}
+	out := s.Out
+	if out == nil {
+		out = os.Stdout
+	}
+	fmt.Fprintf(out, format, a...)
+}
+
+// warnStayOverride tells the author of p's strategy, once per Warnings, that it chose to stay
+// before flipping a card. Simulations are silent and play thousands of rounds, so the warning
+// ignores Silent but is not repeated.
+func (s *GameService) warnStayOverride(p *domain.Player) {
+	if s.Warnings == nil {
+		s.Warnings = NewStrategyWarnings(nil)
+	}
+	name := p.Strategy.Name()
+	s.Warnings.warnOnce("stay-override:"+name, "Warning: strategy %s chose to stay before flipping a card this round; it hits instead. Check hand.CanStay() in Decide.\n", name)
+}
+
+// lowDeckWarning returns a warning when a reshuffle is near, or "" while enough cards are left.
+func lowDeckWarning(deck *domain.Deck) string {
+	remaining := deck.Remaining()
+	if remaining > domain.LowDeckThreshold {
+		return ""
 	}
+	return fmt.Sprintf("Low deck: %d card(s) left before the discard pile is reshuffled.", remaining)
 }
 
 // RunGame loops until a winner is found.
+// The first round uses Game.Deck if it is already set (e.g. a rigged deck in tests);
+// otherwise a fresh shuffled deck is created.
 func (s *GameService) RunGame() {
 	if s.Game.Deck == nil {
 		s.Game.Deck = domain.NewDeck()
 	}
+	s.prepareStrategies()
+	s.playRounds()
+}
+
+// ResumeGame continues a game restored mid-round (see LoadInteractiveGame): the current round
+// picks up at CurrentRound.CurrentTurnIndex without a new deal, then play continues as in RunGame.
+func (s *GameService) ResumeGame() error {
+	round := s.Game.CurrentRound
+	if s.Game.IsCompleted {
+		return domain.ErrGameCompleted
+	}
+	if round == nil || round.IsEnded || round.Deck == nil {
+		return fmt.Errorf("no round in progress to resume")
+	}
+	s.prepareStrategies()
+
+	s.log("--- Resuming Round %d! Dealer: %s ---\n", s.Game.RoundCount, round.Dealer.Name)
+	s.playTurns(round.CurrentTurnIndex)
+	if s.finishRound() {
+		s.playRounds()
+	}
+	return nil
+}
 
+// prepareStrategies lets strategies that plan around the winning score follow this game's target.
+func (s *GameService) prepareStrategies() {
+	for _, p := range s.Game.Players {
+		if ws, ok := p.Strategy.(domain.WinningScoreAware); ok {
+			ws.SetWinningScore(s.Game.TargetScore())
+		}
+	}
+}
+
+// playRounds deals and plays new rounds until the game is completed.
+func (s *GameService) playRounds() {
 	for !s.Game.IsCompleted {
 		s.Game.RoundCount++
 		s.Game.CurrentRound = domain.NewRound(s.Game.Players, s.Game.Players[s.Game.DealerIndex], s.Game.Deck)
 		s.PlayRound()
-
-		if s.Game.CurrentRound.EndReason == domain.RoundEndReasonAborted {
-			s.log("Game aborted due to empty deck/discard.\n")
-			s.Game.IsCompleted = true
+		if !s.finishRound() {
 			break
 		}
+	}
+}
 
-		// Move all cards from players' hands to the discard pile.
-		// The deck persists across rounds and is passed to the next dealer.
+// finishRound clears the table after a round, checks for winners and the round limit,
+// and passes the deal on. It returns false once the game is completed.
+func (s *GameService) finishRound() bool {
+	round := s.Game.CurrentRound
+	s.Events.Publish(domain.RoundEnded{Round: round, Number: s.Game.RoundCount, Reason: round.EndReason})
+	if !s.wrapUpRound() {
+		s.Events.Publish(domain.GameEnded{Game: s.Game})
+		return false
+	}
+	return true
+}
 
-		// Collect cards from players' hands
-		for _, p := range s.Game.Players {
-			// Number cards
-			for _, val := range p.CurrentHand.RawNumberCards {
-				s.Game.DiscardPile = append(s.Game.DiscardPile, domain.Card{Type: domain.CardTypeNumber, Value: val})
-			}
-			// Modifier cards
-			s.Game.DiscardPile = append(s.Game.DiscardPile, p.CurrentHand.ModifierCards...)
-			// Action cards
-			s.Game.DiscardPile = append(s.Game.DiscardPile, p.CurrentHand.ActionCards...)
-		}
+// wrapUpRound does the work of finishRound after the round has been reported.
+func (s *GameService) wrapUpRound() bool {
+	if s.Game.IsCompleted {
+		return false // Stopped by AfterTurn
+	}
+	if s.Game.CurrentRound.EndReason == domain.RoundEndReasonAborted {
+		s.log("Game aborted: the round could not go on.\n")
+		s.Game.End(domain.GameEndReasonAborted)
+		return false
+	}
 
-		// Check for winner
-		winners := s.Game.DetermineWinners()
-		if len(winners) > 0 {
-			s.Game.IsCompleted = true
-			s.Game.Winners = winners
-			break
+	// Move all cards from players' hands to the discard pile.
+	// The deck persists across rounds and is passed to the next dealer.
+	s.Game.DiscardHands()
+	if s.ValidateCards {
+		if err := s.Game.ValidateConservation(); err != nil {
+			panic(fmt.Sprintf("round %d: %v", s.Game.RoundCount, err))
 		}
+	}
+
+	if s.Game.CurrentRound.EndReason == domain.RoundEndReasonExhausted {
+		s.log("No cards left to play on. The highest total score wins.\n")
+		s.Game.EndExhausted()
+		return false
+	}
 
-		// Rotate dealer
-		s.Game.DealerIndex = (s.Game.DealerIndex + 1) % len(s.Game.Players)
+	// Check for winner
+	winners := s.Game.DetermineWinners()
+	if len(winners) > 0 {
+		s.Game.End(domain.GameEndReasonWinner)
+		s.Game.Winners = winners
+		return false
+	}
 
-		// Update deck reference for the next round
-		// If a reshuffle happened during PlayRound, s.Game.CurrentRound.Deck points to the new deck.
-		// We must update our local 'deck' variable so the next round uses the valid deck.
-		s.Game.Deck = s.Game.CurrentRound.Deck
+	if s.MaxRounds > 0 && s.Game.RoundCount >= s.MaxRounds {
+		s.log("Round limit of %d reached without a winner.\n", s.MaxRounds)
+		s.Game.End(domain.GameEndReasonRoundLimit)
+		return false
 	}
+
+	// Rotate dealer
+	s.Game.NextDealer()
+
+	// Update deck reference for the next round
+	// If a reshuffle happened during PlayRound, s.Game.CurrentRound.Deck points to the new deck.
+	// We must update our local 'deck' variable so the next round uses the valid deck.
+	s.Game.Deck = s.Game.CurrentRound.Deck
+	return true
 }
 
 // DrawCard handles drawing a card, reshuffling from discard pile if necessary.
@@ -133,12 +317,19 @@ func (s *GameService) DrawCard() (domain.Card, error) {
 
 	// Deck is empty, try to reshuffle
 	if len(s.Game.DiscardPile) == 0 {
-		return domain.Card{}, fmt.Errorf("deck is empty and discard pile is empty")
+		return domain.Card{}, fmt.Errorf("%w and discard pile is empty", domain.ErrDeckEmpty)
 	}
 
 	s.log("Deck empty. Reshuffling %d cards from discard pile...\n", len(s.Game.DiscardPile))
-	round.Deck = domain.NewDeckFromCards(s.Game.DiscardPile)
+	if s.DeckFactory != nil {
+		round.Reshuffle(s.DeckFactory(s.Game.DiscardPile))
+	} else {
+		round.Reshuffle(domain.NewDeckFromCards(s.Game.DiscardPile))
+	}
 	s.Game.DiscardPile = []domain.Card{} // Clear discard pile
+	for _, p := range s.Game.Players {
+		notifyReshuffle(round.Deck, p.Strategy)
+	}
 
 	// Try drawing again
 	return round.Deck.Draw()
@@ -147,7 +338,7 @@ func (s *GameService) DrawCard() (domain.Card, error) {
 // PlayRound handles a single round.
 func (s *GameService) PlayRound() {
 	round := s.Game.CurrentRound
-	s.log("--- New Round! Dealer: %s ---\n", round.Dealer.Name)
+	s.Events.Publish(domain.RoundStarted{Round: s.Game.RoundCount, Dealer: round.Dealer})
 
 	// Initial Deal: Sequential starting from Dealer (which is ActivePlayers[0])
 	// "If draw an action card(i.e., flip_three, freeze) then he choose immediately even if other one doesn't draw yet."
@@ -160,20 +351,20 @@ func (s *GameService) PlayRound() {
 	copy(initialActive, round.ActivePlayers)
 
 	for _, p := range initialActive {
-		// Check if player is still active (might have been removed by someone else's action? Unlikely in initial deal unless FlipThree targets them?)
-		// Actually, FlipThree could target another player and bust them.
-		if p.CurrentHand.Status != domain.HandStatusActive {
+		// An action dealt earlier may already have frozen or busted this player.
+		if !round.DealsInitialCardTo(p) {
 			continue
 		}
 
+		s.intercept(Interception{Point: InterceptBeforeDraw, Player: p, Source: domain.DrawInitialDeal})
 		card, err := s.DrawCard()
 		if err != nil {
 			s.log("%s\n", "Deck and discard pile empty during initial deal!")
-			round.IsEnded = true
-			round.EndReason = domain.RoundEndReasonAborted
+			s.exhaustRound()
 			return
 		}
-		s.log("%s dealt: %v\n", p.Name, card)
+		s.intercept(Interception{Point: InterceptAfterDraw, Player: p, Source: domain.DrawInitialDeal, Card: &card})
+		s.Events.Publish(domain.CardDrawn{Player: p, Card: card, Source: domain.DrawInitialDeal})
 
 		s.ProcessCardDraw(p, card)
 		if round.IsEnded {
@@ -181,98 +372,218 @@ func (s *GameService) PlayRound() {
 		}
 	}
 
-	// Turns
+	s.playTurns(0)
+}
+
+// playTurns gives the active players their turns until none is left.
+// The first pass starts at ActivePlayers[start], so a resumed round continues with the player whose turn it was.
+func (s *GameService) playTurns(start int) {
+	round := s.Game.CurrentRound
 	for len(round.ActivePlayers) > 0 {
-		active := make([]*domain.Player, len(round.ActivePlayers))
-		copy(active, round.ActivePlayers)
+		if start < 0 || start >= len(round.ActivePlayers) {
+			start = 0
+		}
+		active := make([]*domain.Player, len(round.ActivePlayers)-start)
+		copy(active, round.ActivePlayers[start:])
+		start = 0
 
 		for _, p := range active {
 			if p.CurrentHand.Status != domain.HandStatusActive {
+				// Play takes players out as they leave, but a resumed round may still list one
+				// (Manual Mode keeps busted players); left in, it would keep this loop going.
+				round.RemoveActivePlayer(p)
+				continue
+			}
+
+			if warning := lowDeckWarning(round.Deck); warning != "" {
+				s.log("%s\n", warning)
+			}
+
+			if !s.playHeldAction(p) {
+				// The held action ended p's turn: p froze itself, or the round is over.
+				if !s.stepAfterTurn(p) || round.IsEnded {
+					return
+				}
 				continue
 			}
 
 			// Strategy Decision
+			s.intercept(Interception{Point: InterceptBeforeDecision, Player: p})
 			choice := p.Strategy.Decide(round.Deck, p.CurrentHand, p.TotalScore, round.Players)
-			s.log("%s decides to %s\n", p.Name, choice)
+			s.intercept(Interception{Point: InterceptAfterDecision, Player: p, Choice: choice})
+			if !s.Silent { // Formatting the line allocates even when it is not printed
+				s.log("%s decides to %s\n", p.Name, choice)
+			}
+
+			// A player cannot stay before flipping a card this round (same rule as manual mode).
+			if choice == domain.TurnChoiceStay && !p.CurrentHand.CanStay() {
+				s.warnStayOverride(p)
+				s.Events.Publish(domain.StayOverridden{Player: p})
+				choice = domain.TurnChoiceHit
+			}
 
 			if choice == domain.TurnChoiceStay {
 				p.CurrentHand.Status = domain.HandStatusStayed
 				score := p.BankCurrentHand()
-				s.log("%s banked %d points! Total: %d\n", p.Name, score, p.TotalScore)
 				s.Game.CurrentRound.RemoveActivePlayer(p)
+				s.Events.Publish(domain.PlayerStayed{Player: p, Banked: score})
 			} else {
 				// Hit
+				s.intercept(Interception{Point: InterceptBeforeDraw, Player: p, Source: domain.DrawHit})
 				card, err := s.DrawCard()
 				if err != nil {
 					s.log("%s\n", "Deck and discard pile empty!")
-					round.IsEnded = true
-					round.EndReason = domain.RoundEndReasonAborted
+					s.exhaustRound()
 					return
 				}
-				s.log("%s drew: %v\n", p.Name, card)
+				s.intercept(Interception{Point: InterceptAfterDraw, Player: p, Source: domain.DrawHit, Card: &card})
+				s.Events.Publish(domain.CardDrawn{Player: p, Card: card, Source: domain.DrawHit})
 
 				s.ProcessCardDraw(p, card)
-				if round.IsEnded {
-					return
-				}
+			}
+
+			if !s.stepAfterTurn(p) || round.IsEnded {
+				return
 			}
 		}
 	}
 }
 
+// exhaustRound ends the current round when a card must be drawn but the deck and the discard
+// pile are empty. The hands still in play are handled by Game.ExhaustionRule, and the game
+// ends with the round (see wrapUpRound).
+func (s *GameService) exhaustRound() {
+	rule := s.Game.RoundExhaustionRule()
+	hands := s.Game.CurrentRound.Exhaust(rule)
+	s.Events.Publish(domain.RoundExhausted{Rule: rule, Hands: hands})
+}
+
+// stepAfterTurn calls AfterTurn after p's turn. It returns false if the game was stopped.
+func (s *GameService) stepAfterTurn(p *domain.Player) bool {
+	if s.AfterTurn == nil {
+		return true
+	}
+	round := s.Game.CurrentRound
+	switch s.AfterTurn(round, p) {
+	case domain.StepRunToEnd:
+		s.AfterTurn = nil
+	case domain.StepAbort:
+		s.log("Game stopped after %s's turn.\n", p.Name)
+		if !round.IsEnded {
+			round.End(domain.RoundEndReasonAborted)
+		}
+		s.Game.End(domain.GameEndReasonAborted)
+		return false
+	}
+	return true
+}
+
 // ProcessCardDraw handles adding a card and resolving its effects.
 func (s *GameService) ProcessCardDraw(p *domain.Player, card domain.Card) {
 	round := s.Game.CurrentRound
 
+	// The player flipped this card, even if it ends up passed or discarded.
+	p.CurrentHand.HasDrawnThisRound = true
+
 	// Check for Second Chance Passing Logic BEFORE adding to hand
 	// Rule: "If they are dealt another Second Chance card, they then choose another active player to give it to."
 	if card.Type == domain.CardTypeAction && card.ActionType == domain.ActionSecondChance {
-		// Create a selector for the strategy
-		selector := &strategyTargetSelector{strategy: p.Strategy, deck: round.Deck}
-
-		// Set deck for strategies that need it
-		if ds, ok := p.Strategy.(interface{ SetDeck(*domain.Deck) }); ok {
-			ds.SetDeck(round.Deck)
-		}
-
-		result := s.secondChanceHandler.HandleSecondChance(p, round.ActivePlayers, selector)
+		result := s.secondChanceHandler.HandleSecondChance(p, round.ActivePlayers, s.selectorFor(p))
 
 		if result.ShouldDiscard {
-			s.log("All other active players already have a Second Chance. Discarding card.\n")
+			s.reportAction(p, nil, domain.ActionSecondChance)
 			s.Game.DiscardPile = append(s.Game.DiscardPile, card)
 			return
 		} else if result.PassToPlayer != nil {
-			s.log("%s gives Second Chance to %s\n", p.Name, result.PassToPlayer.Name)
-			result.PassToPlayer.CurrentHand.ActionCards = append(result.PassToPlayer.CurrentHand.ActionCards, card)
+			s.reportAction(p, result.PassToPlayer, domain.ActionSecondChance)
+			s.Events.Publish(domain.SecondChancePassed{From: p, To: result.PassToPlayer})
+			if !result.PassToPlayer.CurrentHand.ReceiveSecondChance(card) {
+				s.log("%s cannot take the Second Chance. Discarding card.\n", result.PassToPlayer.Name)
+				s.Game.DiscardPile = append(s.Game.DiscardPile, card)
+			}
 			return
 		}
 		// Otherwise, fall through to add to player's hand
+		s.reportAction(p, p, domain.ActionSecondChance)
+	} else if _, holds := s.actionHolder(p); card.Type == domain.CardTypeAction && holds {
+		// Holdable-actions house rule: the card waits in the hand for a later turn.
+		p.CurrentHand.AddCard(card)
+		s.Events.Publish(domain.ActionHeld{Player: p, Card: card})
+		return
+	} else if card.Type == domain.CardTypeAction {
+		// Freeze and Flip Three take effect at once and are then discarded: they never stay in a hand.
+		s.ResolveAction(p, card)
+		s.Game.DiscardPile = append(s.Game.DiscardPile, card)
+		return
 	}
 
+	hadSecondChance := p.CurrentHand.HasSecondChance()
 	busted, flip7, discarded := p.CurrentHand.AddCard(card)
 	if len(discarded) > 0 {
 		s.Game.DiscardPile = append(s.Game.DiscardPile, discarded...)
 	}
+	if hadSecondChance && !p.CurrentHand.HasSecondChance() {
+		s.Events.Publish(domain.SecondChanceUsed{Player: p, Card: card})
+	}
 
 	if busted {
-		s.log("%s BUSTED!\n", p.Name)
 		s.Game.CurrentRound.RemoveActivePlayer(p)
+		s.Events.Publish(domain.PlayerBusted{Player: p, Card: card})
 	} else if flip7 {
-		s.log("%s FLIP 7! Bonus!\n", p.Name)
 		p.CurrentHand.Status = domain.HandStatusStayed
 		score := p.BankCurrentHand()
-		s.log("%s banked %d points! Total: %d\n", p.Name, score, p.TotalScore)
 		s.Game.CurrentRound.RemoveActivePlayer(p)
 		round.EndReason = domain.RoundEndReasonFlip7
 		round.IsEnded = true
-	} else {
-		// Resolve Immediate Actions
-		if card.Type == domain.CardTypeAction {
-			s.ResolveAction(p, card)
-		}
+		s.Events.Publish(domain.Flip7Achieved{Player: p, Banked: score})
 	}
 }
 
+// actionHolder returns p's strategy if p keeps the Freeze and Flip Three cards it draws: the
+// game uses the holdable-actions house rule and p's strategy is an ActionHolder. Actions drawn
+// during a Flip Three are still resolved once the three cards are flipped, as in the standard
+// rules.
+func (s *GameService) actionHolder(p *domain.Player) (domain.ActionHolder, bool) {
+	if !s.Game.HoldableActions {
+		return nil, false
+	}
+	holder, ok := p.Strategy.(domain.ActionHolder)
+	return holder, ok
+}
+
+// playHeldAction lets p play one of its held action cards before its turn. It returns false
+// if p's turn is over because of it: p froze itself or the round ended.
+func (s *GameService) playHeldAction(p *domain.Player) bool {
+	holder, ok := s.actionHolder(p)
+	if !ok {
+		return true
+	}
+	held := p.CurrentHand.HeldActions()
+	if len(held) == 0 {
+		return true
+	}
+	round := s.Game.CurrentRound
+	chosen := holder.PlayHeldAction(domain.HeldActionContext{
+		Deck:         round.Deck,
+		Hand:         p.CurrentHand,
+		PlayerScore:  p.TotalScore,
+		OtherPlayers: round.Players,
+		Held:         held,
+	})
+	if chosen == nil {
+		return true
+	}
+	card, ok := p.CurrentHand.TakeHeldAction(chosen.ActionType)
+	if !ok {
+		s.log("Warning: %s cannot play %v: it is not held. Playing on.\n", p.Name, *chosen)
+		return true
+	}
+	s.Events.Publish(domain.HeldActionPlayed{Player: p, Card: card})
+	s.ResolveAction(p, card)
+	s.Game.DiscardPile = append(s.Game.DiscardPile, card)
+	return !round.IsEnded && p.CurrentHand.Status == domain.HandStatusActive
+}
+
 // ResolveAction handles the effect of an action card.
 func (s *GameService) ResolveAction(p *domain.Player, card domain.Card) {
 	round := s.Game.CurrentRound
@@ -281,29 +592,48 @@ func (s *GameService) ResolveAction(p *domain.Player, card domain.Card) {
 	case domain.ActionFreeze:
 		candidates := []*domain.Player{}
 		candidates = append(candidates, round.ActivePlayers...)
-		if ds, ok := p.Strategy.(interface{ SetDeck(*domain.Deck) }); ok {
-			ds.SetDeck(round.Deck)
+		candidates = domain.TeamTargets(domain.ActionFreeze, candidates, p)
+		target := s.selectorFor(p).SelectTarget(domain.ActionFreeze, candidates, p)
+		if target == nil || !s.targetStillActive(target) {
+			return // Nobody left to target
 		}
-		target := p.Strategy.ChooseTarget(domain.ActionFreeze, candidates, p)
-		s.log("%s uses Freeze on %s\n", p.Name, target.Name)
+		s.reportAction(p, target, domain.ActionFreeze)
 
 		target.CurrentHand.Status = domain.HandStatusFrozen
 		score := target.BankCurrentHand()
-		s.log("%s banked %d points! Total: %d\n", target.Name, score, target.TotalScore)
 		s.Game.CurrentRound.RemoveActivePlayer(target)
+		s.Events.Publish(domain.PlayerFrozen{Player: target, By: p, Banked: score})
 
 	case domain.ActionFlipThree:
 		candidates := []*domain.Player{}
 		candidates = append(candidates, round.ActivePlayers...)
-		if ds, ok := p.Strategy.(interface{ SetDeck(*domain.Deck) }); ok {
-			ds.SetDeck(round.Deck)
+		candidates = domain.TeamTargets(domain.ActionFlipThree, candidates, p)
+		target := s.selectorFor(p).SelectTarget(domain.ActionFlipThree, candidates, p)
+		if target == nil || !s.targetStillActive(target) {
+			return // Nobody left to target
 		}
-		target := p.Strategy.ChooseTarget(domain.ActionFlipThree, candidates, p)
-		s.log("%s uses Flip Three on %s\n", p.Name, target.Name)
+		s.reportAction(p, target, domain.ActionFlipThree)
 		s.ExecuteFlipThree(target)
 	}
 }
 
+// targetStillActive calls the interceptor once an action's target is chosen and reports whether
+// the target is still in play to be affected. Only an interceptor can take it out in between;
+// the action is then wasted, as if it had no target.
+func (s *GameService) targetStillActive(target *domain.Player) bool {
+	s.intercept(Interception{Point: InterceptTargetChosen, Player: target})
+	if s.Interceptor == nil || target.CurrentHand.Status == domain.HandStatusActive {
+		return true
+	}
+	s.log("%s is no longer in play. The action has no effect.\n", target.Name)
+	return false
+}
+
+// reportAction publishes the CardPlayed event of an action card.
+func (s *GameService) reportAction(actor, target *domain.Player, action domain.ActionType) {
+	s.Events.Publish(domain.CardPlayed{Round: s.Game.CurrentRound, Discards: s.Game.DiscardPile, Actor: actor, Target: target, Action: action})
+}
+
 // ExecuteFlipThree handles the specific logic of Flip Three (nested actions).
 func (s *GameService) ExecuteFlipThree(target *domain.Player) {
 	// Create FlipThree executor with AI mode implementations
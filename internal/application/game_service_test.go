@@ -1,10 +1,16 @@
 package application_test
 
 import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
 
 	"flip7_strategy/internal/application"
 	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/testutil"
 )
 
 // MockStrategy for predictable testing
@@ -14,7 +20,7 @@ type MockStrategy struct {
 }
 
 func (s *MockStrategy) Name() string { return "Mock" }
-func (s *MockStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, score int, others []*domain.Player) domain.TurnChoice {
+func (s *MockStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, score int, others []*domain.Player) domain.TurnChoice {
 	return s.DecideResult
 }
 func (s *MockStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
@@ -84,10 +90,10 @@ func TestFreezeLogic(t *testing.T) {
 	svc := application.NewGameService(game)
 	svc.Silent = true
 
-	game.CurrentRound = domain.NewRound(players, p1, domain.NewDeck())
-
-	// Give P1 some points
+	// Give P1 some points, then build the round without resetting the hand
+	p1.StartNewRound()
 	p1.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 10})
+	game.CurrentRound = testutil.NewRound(players, p1, domain.NewDeck())
 
 	// Resolve Freeze on P1
 	card := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
@@ -101,6 +107,70 @@ func TestFreezeLogic(t *testing.T) {
 	}
 }
 
+func TestResolveAction_MisbehavingTargetFallsBack(t *testing.T) {
+	outsider := domain.NewPlayer("Outsider", &MockStrategy{})
+	for _, tc := range []struct {
+		name   string
+		target *domain.Player
+		chose  string
+	}{
+		{"nil target", nil, "nil"},
+		{"target outside the candidates", outsider, "Outsider"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p1 := domain.NewPlayer("P1", &actionTargetStrategy{Targets: map[domain.ActionType]*domain.Player{domain.ActionFreeze: tc.target}})
+			p2 := domain.NewPlayer("P2", &MockStrategy{})
+			players := []*domain.Player{p1, p2}
+			game := domain.NewGame(players)
+			svc := application.NewGameService(game)
+			var out strings.Builder
+			svc.Out = &out
+
+			p1.StartNewRound()
+			p2.StartNewRound()
+			p1.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 10})
+			game.CurrentRound = testutil.NewRound(players, p1, domain.NewDeck())
+
+			// P1 is the first candidate, so the Freeze falls back to them.
+			svc.ResolveAction(p1, domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze})
+
+			if p1.CurrentHand.Status != domain.HandStatusFrozen || p1.TotalScore != 10 {
+				t.Errorf("Expected P1 to be frozen with 10 points, got %s with %d", p1.CurrentHand.Status, p1.TotalScore)
+			}
+			if p2.CurrentHand.Status != domain.HandStatusActive {
+				t.Errorf("Expected P2 to stay active, got %s", p2.CurrentHand.Status)
+			}
+			want := fmt.Sprintf("Warning: Mock chose %s as the freeze target, which is not a candidate; targeting P1 instead.", tc.chose)
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("Expected the log to contain %q, got:\n%s", want, out.String())
+			}
+		})
+	}
+}
+
+func TestResolveAction_NeverFreezesTeammate(t *testing.T) {
+	// P3 freezes the first candidate. P1 is first in the turn order but is P3's partner, so the
+	// Freeze goes to P2.
+	p1 := domain.NewPlayer("P1", &MockStrategy{})
+	p2 := domain.NewPlayer("P2", &MockStrategy{})
+	p3 := domain.NewPlayer("P3", &MockStrategy{})
+	p1.Team, p2.Team, p3.Team = "B", "A", "B"
+	players := []*domain.Player{p1, p2, p3}
+	game := domain.NewGame(players)
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	game.CurrentRound = domain.NewRound(players, p1, domain.NewDeck())
+
+	svc.ResolveAction(p3, domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze})
+
+	if p1.CurrentHand.Status != domain.HandStatusActive {
+		t.Errorf("Expected the partner P1 to stay active, got %s", p1.CurrentHand.Status)
+	}
+	if p2.CurrentHand.Status != domain.HandStatusFrozen {
+		t.Errorf("Expected P2 to be frozen, got %s", p2.CurrentHand.Status)
+	}
+}
+
 func TestReshuffleLogic(t *testing.T) {
 	// Setup
 	p1 := domain.NewPlayer("P1", &MockStrategy{})
@@ -156,48 +226,203 @@ func TestReshuffleLogic(t *testing.T) {
 	}
 }
 
+// reshuffleCountingStrategy is a MockStrategy that records the decks it is told about by
+// OnReshuffle.
+type reshuffleCountingStrategy struct {
+	MockStrategy
+	decks []domain.DeckView
+}
+
+func (s *reshuffleCountingStrategy) OnReshuffle(deck domain.DeckView) {
+	s.decks = append(s.decks, deck)
+}
+
+func TestDrawCard_NotifiesReshuffle(t *testing.T) {
+	counter := &reshuffleCountingStrategy{}
+	p1 := domain.NewPlayer("P1", counter)
+	p2 := domain.NewPlayer("P2", &MockStrategy{})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	game.CurrentRound = domain.NewRound(game.Players, p1, domain.NewDeckInOrder(numbers(3)))
+	game.DiscardPile = numbers(1, 2)
+
+	// The first draw empties the deck, the second reshuffles, the third draws the last card.
+	for i := 0; i < 3; i++ {
+		if _, err := svc.DrawCard(); err != nil {
+			t.Fatalf("Draw %d: %v", i+1, err)
+		}
+	}
+	if len(counter.decks) != 1 || counter.decks[0] != game.CurrentRound.Deck {
+		t.Errorf("Expected one notification with the reshuffled deck, got %d", len(counter.decks))
+	}
+	if got := game.CurrentRound.Reshuffles; got != 1 {
+		t.Errorf("Expected the round to count 1 reshuffle, got %d", got)
+	}
+	if _, err := svc.DrawCard(); err == nil || len(counter.decks) != 1 {
+		t.Errorf("Expected no notification once nothing is left to reshuffle, got %d (err %v)", len(counter.decks), err)
+	}
+}
+
+// numbers builds number cards in the given order.
+func numbers(values ...int) []domain.Card {
+	cards := make([]domain.Card, len(values))
+	for i, v := range values {
+		cards[i] = domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+	}
+	return cards
+}
+
+// playerNames returns the names of players, in order.
+func playerNames(players []*domain.Player) []string {
+	names := make([]string, len(players))
+	for i, p := range players {
+		names[i] = p.Name
+	}
+	return names
+}
+
+// fullDeckStartingWith returns a full deck that deals top first and then the rest of
+// the standard cards, so card conservation can be checked after a scripted game.
+func fullDeckStartingWith(top ...domain.Card) *domain.Deck {
+	remaining := make(map[domain.Card]int)
+	for _, c := range domain.StandardDeckCards() {
+		remaining[c]++
+	}
+	cards := append([]domain.Card{}, top...)
+	for _, c := range top {
+		remaining[c]--
+	}
+	for _, c := range domain.StandardDeckCards() {
+		if remaining[c] > 0 {
+			remaining[c]--
+			cards = append(cards, c)
+		}
+	}
+	return domain.NewDeckInOrder(cards)
+}
+
 func TestRoundCountIncrement(t *testing.T) {
-	// We want to verify that RunGame increments RoundCount.
-	// To do this without running a long game, we can use a rigged deck that ensures a quick win.
-	// P1 needs 200 points.
-	// Round 1: P1 draws 200 points worth of cards, stays, wins.
-	// Game should end. RoundCount should be 1.
+	// P1 always stays, so each round banks exactly the dealt card.
+	// Round 1 banks 12, round 2 banks 10: the game ends after two rounds at 22 >= 20.
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1})
+	game.WinningScore = 20
+	game.Deck = domain.NewDeckInOrder(numbers(12, 10, 9))
+	svc := application.NewGameService(game)
+	svc.Silent = true
+
+	svc.RunGame()
+
+	if game.RoundCount != 2 {
+		t.Errorf("Expected RoundCount to be 2, got %d", game.RoundCount)
+	}
+	if !game.IsCompleted {
+		t.Errorf("Expected game to be completed")
+	}
+	if p1.TotalScore != 22 {
+		t.Errorf("Expected P1 to have 22 points, got %d", p1.TotalScore)
+	}
+}
 
+func TestRunGame_AlwaysStayingDrawsOneCardPerRound(t *testing.T) {
 	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
-	players := []*domain.Player{p1}
-	game := domain.NewGame(players)
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.Deck = fullDeckStartingWith(numbers(12, 11, 10, 9, 8, 7)...)
 	svc := application.NewGameService(game)
 	svc.Silent = true
+	svc.MaxRounds = 3
 
-	// Rig the deck for the first round
-	// Note: RunGame creates a NEW deck for the first round.
-	// We cannot easily inject a pre-configured deck into RunGame's first round because it calls domain.NewDeck().
-	// However, we can modify the GameService to accept a DeckFactory or similar, OR we can rely on the fact that
-	// if we can't rig the deck easily, we might need to test PlayRound directly or accept that RunGame is hard to test deterministically without DI.
+	draws := make(map[string]int)
+	overrides := 0
+	svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+		switch e := e.(type) {
+		case domain.CardDrawn:
+			draws[e.Player.Name]++
+		case domain.StayOverridden:
+			overrides++
+		}
+	}))
+	svc.RunGame()
 
-	// BUT, we can check if RoundCount increments by manually running PlayRound loop?
-	// The PR comment suggested: "Add a test that runs a complete game and verifies final RoundCount"
-	// Let's try to run a game where P1 wins quickly.
-	// Since we can't rig the deck in RunGame easily (it calls NewDeck), we might have to rely on probability or just test that it's NOT 0 after a game.
-	// A better approach for this specific test might be to manually set up the game state as if it were inside RunGame loop.
+	// The dealt card is the first flip, so staying is allowed on the first turn.
+	if draws["P1"] != 3 || draws["P2"] != 3 {
+		t.Errorf("Expected each player to draw 1 card in each of 3 rounds, got %v", draws)
+	}
+	if overrides != 0 {
+		t.Errorf("Expected no Stay to be overridden, got %d", overrides)
+	}
+	if p1.TotalScore != 12+9+8 || p2.TotalScore != 11+10+7 {
+		t.Errorf("Expected P1 and P2 to bank their dealt cards, got %d and %d", p1.TotalScore, p2.TotalScore)
+	}
+}
 
-	// Actually, let's just test that PlayRound doesn't reset it, and we can infer RunGame works if we trust the loop.
-	// But to satisfy the reviewer, let's try to make a test that runs RunGame.
+// emptyHandStayStrategy stays even when it has not flipped a card yet.
+type emptyHandStayStrategy struct {
+	MockStrategy
+}
 
-	// If we can't rig the deck, maybe we can rig the player?
-	// If P1 starts with 200 points?
-	// NewGame takes players.
-	p1.TotalScore = 200
-	// RunGame loop checks IsCompleted.
-	// If P1 has 200, DetermineWinners will find him.
-	// But RunGame loop condition is !s.Game.IsCompleted.
-	// Inside loop:
-	// 1. NewRound
-	// 2. PlayRound
-	// 3. Check winners.
+func (s *emptyHandStayStrategy) Name() string { return "EmptyHandStay" }
 
-	// So if P1 has 200, PlayRound runs once, then winners found, loop breaks.
-	// RoundCount should be 1.
+func TestResumeGame_StayBeforeFirstFlipHits(t *testing.T) {
+	p1 := domain.NewPlayer("P1", &emptyHandStayStrategy{MockStrategy{DecideResult: domain.TurnChoiceStay}})
+	game := domain.NewGame([]*domain.Player{p1})
+	game.RoundCount = 1
+	// A round resumed before P1 has flipped anything, e.g. from a hand-made save.
+	game.CurrentRound = domain.NewRound(game.Players, p1, fullDeckStartingWith(numbers(5)...))
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.MaxRounds = 1
+	var warnings bytes.Buffer
+	svc.Warnings = application.NewStrategyWarnings(&warnings)
+
+	var events []string
+	svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+		events = append(events, describeEvent(e))
+	}))
+	if err := svc.ResumeGame(); err != nil {
+		t.Fatalf("ResumeGame failed: %v", err)
+	}
+
+	want := []string{"StayOverridden", "CardDrawn P1 5 hit", "PlayerStayed"}
+	if len(events) < len(want) || strings.Join(events[:len(want)], "\n") != strings.Join(want, "\n") {
+		got := strings.Join(events, "\n")
+		t.Errorf("Unexpected events:\n%s\nwant them to start with:\n%s", got, strings.Join(want, "\n"))
+	}
+	if p1.TotalScore != 5 {
+		t.Errorf("Expected P1 to bank the card it was made to draw, got %d", p1.TotalScore)
+	}
+	if !strings.Contains(warnings.String(), "Warning: strategy EmptyHandStay chose to stay before flipping a card") {
+		t.Errorf("Expected a warning even though the game is silent, got %q", warnings.String())
+	}
+
+	// Another game sharing the warnings, as the games of a simulation run do, does not repeat it.
+	again := domain.NewGame([]*domain.Player{p1})
+	again.RoundCount = 1
+	again.CurrentRound = domain.NewRound(again.Players, p1, fullDeckStartingWith(numbers(5)...))
+	next := application.NewGameService(again)
+	next.Silent = true
+	next.MaxRounds = 1
+	next.Warnings = svc.Warnings
+	if err := next.ResumeGame(); err != nil {
+		t.Fatalf("ResumeGame failed: %v", err)
+	}
+	if n := strings.Count(warnings.String(), "Warning:"); n != 1 {
+		t.Errorf("Expected the shared warnings to warn once, got %d:\n%s", n, warnings.String())
+	}
+}
+
+func TestRunGame_ScriptedFlip7EndsFirstRound(t *testing.T) {
+	// Deal: P1 gets 1, P2 gets 2. P1 hits 3, P2 stays with 2.
+	// P1 keeps hitting 4..8 and completes Flip 7 on the 8: 1+3+4+5+6+7+8 = 34, +15 bonus = 49.
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.WinningScore = 40
+	game.Deck = domain.NewDeckInOrder(numbers(1, 2, 3, 4, 5, 6, 7, 8, 9, 10))
+	svc := application.NewGameService(game)
+	svc.Silent = true
 
 	svc.RunGame()
 
@@ -207,4 +432,968 @@ func TestRoundCountIncrement(t *testing.T) {
 	if !game.IsCompleted {
 		t.Errorf("Expected game to be completed")
 	}
+	if game.CurrentRound.EndReason != domain.RoundEndReasonFlip7 {
+		t.Errorf("Expected EndReason %s, got %s", domain.RoundEndReasonFlip7, game.CurrentRound.EndReason)
+	}
+	if p1.TotalScore != 49 {
+		t.Errorf("Expected P1 to have 49 points, got %d", p1.TotalScore)
+	}
+	if p2.TotalScore != 2 {
+		t.Errorf("Expected P2 to have 2 points, got %d", p2.TotalScore)
+	}
+	if len(game.Winners) != 1 || game.Winners[0] != p1 {
+		t.Errorf("Expected P1 to be the only winner, got %v", game.Winners)
+	}
+
+	// Both hands go to the discard pile at round end; the unused cards stay in the deck.
+	if len(game.DiscardPile) != 8 {
+		t.Errorf("Expected 8 cards in the discard pile, got %d", len(game.DiscardPile))
+	}
+	if len(game.CurrentRound.Deck.Cards) != 2 {
+		t.Errorf("Expected 2 cards left in the deck, got %d", len(game.CurrentRound.Deck.Cards))
+	}
+}
+
+func TestRunGame_DeckFactoryControlsReshuffle(t *testing.T) {
+	// The deck holds a single card, so round 2 must reshuffle the discard pile.
+	// The factory records the reshuffle and keeps the discard order.
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1})
+	game.WinningScore = 20
+	game.Deck = domain.NewDeckInOrder(numbers(12))
+	svc := application.NewGameService(game)
+	svc.Silent = true
+
+	reshuffles := 0
+	svc.DeckFactory = func(cards []domain.Card) *domain.Deck {
+		reshuffles++
+		return domain.NewDeckInOrder(cards)
+	}
+
+	svc.RunGame()
+
+	if reshuffles != 1 {
+		t.Errorf("Expected 1 reshuffle, got %d", reshuffles)
+	}
+	// Round 1 banks 12, round 2 redraws the discarded 12: 24 >= 20
+	if p1.TotalScore != 24 {
+		t.Errorf("Expected P1 to have 24 points, got %d", p1.TotalScore)
+	}
+	if game.RoundCount != 2 {
+		t.Errorf("Expected RoundCount to be 2, got %d", game.RoundCount)
+	}
+}
+
+// winningScoreStrategy is a MockStrategy that records the winning score it is given.
+type winningScoreStrategy struct {
+	MockStrategy
+	WinningScore int
+}
+
+func (s *winningScoreStrategy) SetWinningScore(score int) { s.WinningScore = score }
+
+func TestRunGame_UsesConfiguredWinningScore(t *testing.T) {
+	strat := &winningScoreStrategy{MockStrategy: MockStrategy{DecideResult: domain.TurnChoiceStay}}
+	p1 := domain.NewPlayer("P1", strat)
+	game := domain.NewGame([]*domain.Player{p1})
+	game.WinningScore = 100
+	svc := application.NewGameService(game)
+	svc.Silent = true
+
+	// 100 already wins a game played to 100, so the game ends after one round
+	p1.TotalScore = 100
+	svc.RunGame()
+
+	if strat.WinningScore != 100 {
+		t.Errorf("Expected strategy to be given winning score 100, got %d", strat.WinningScore)
+	}
+	if game.RoundCount != 1 {
+		t.Errorf("Expected RoundCount to be 1, got %d", game.RoundCount)
+	}
+	if len(game.Winners) != 1 || game.Winners[0].ID != p1.ID {
+		t.Errorf("Expected P1 to win")
+	}
+}
+
+func TestRunGame_FixedDeckOrder(t *testing.T) {
+	// Both players hit until their number cards reach 15, to 30 points.
+	// Round 1 (P1 deals): P1 10, P2 3; P1 hits 6 and banks 16; P2 hits +4, 5 and 9 and banks 17+4 = 21.
+	// Round 2 (P2 deals): P2 x2, P1 12; P2 hits 11, P1 hits 4 and banks 16, P2 hits 7 and banks 18×2 = 36.
+	cards, err := domain.ParseDeckOrder("10,3,6,+4, 5\n9 x2,12,11,4,7")
+	if err != nil {
+		t.Fatalf("ParseDeckOrder failed: %v", err)
+	}
+	heuristic := strategy.NewHeuristicStrategy(15)
+	heuristic.SumMode = strategy.HeuristicRawNumbers // Modifiers must not stop them early
+	p1 := domain.NewPlayer("P1", heuristic)
+	p2 := domain.NewPlayer("P2", heuristic)
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.WinningScore = 30
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.UseFixedDeck(cards)
+
+	svc.RunGame()
+
+	if game.RoundCount != 2 {
+		t.Errorf("Expected 2 rounds, got %d", game.RoundCount)
+	}
+	if p1.TotalScore != 32 || p2.TotalScore != 57 {
+		t.Errorf("Expected final scores 32 and 57, got %d and %d", p1.TotalScore, p2.TotalScore)
+	}
+	if len(game.Winners) != 1 || game.Winners[0] != p2 {
+		t.Errorf("Expected P2 to be the only winner, got %v", game.Winners)
+	}
+}
+
+func TestRunGame_FixedDeckWarnsWhenReshuffled(t *testing.T) {
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.WinningScore = 10
+	var out bytes.Buffer
+	svc := application.NewGameService(game)
+	svc.Out = &out
+	svc.UseFixedDeck(numbers(3, 4))
+
+	svc.RunGame()
+
+	if !strings.Contains(out.String(), "fixed deck order is used up") {
+		t.Errorf("Expected a warning when the fixed deck runs out, got:\n%s", out.String())
+	}
+}
+
+func TestRunGame_AfterTurnStopsGame(t *testing.T) {
+	// Deal: P1 gets 1, P2 gets 2. Both keep hitting: P1 3, P2 4, P1 5, then the hook stops the game.
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.Deck = domain.NewDeckInOrder(numbers(1, 2, 3, 4, 5, 6, 7, 8))
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	var turns []string
+	svc.AfterTurn = func(round *domain.Round, player *domain.Player) domain.StepControl {
+		turns = append(turns, player.Name)
+		if len(turns) == 3 {
+			return domain.StepAbort
+		}
+		return domain.StepContinue
+	}
+
+	svc.RunGame()
+
+	if strings.Join(turns, ",") != "P1,P2,P1" {
+		t.Errorf("Expected the hook after the turns of P1, P2 and P1, got %v", turns)
+	}
+	if !game.IsCompleted || game.EndReason != domain.GameEndReasonAborted || len(game.Winners) != 0 {
+		t.Errorf("Expected the game to be aborted without winners, got completed=%v reason=%q winners=%v",
+			game.IsCompleted, game.EndReason, game.Winners)
+	}
+	if game.RoundCount != 1 || game.CurrentRound.EndReason != domain.RoundEndReasonAborted {
+		t.Errorf("Expected round 1 to be aborted, got round %d ended with %q", game.RoundCount, game.CurrentRound.EndReason)
+	}
+	// The table is left as it was after the third turn.
+	if got := fmt.Sprint(p1.CurrentHand.RawNumberCards, p2.CurrentHand.RawNumberCards); got != "[1 3 5] [2 4]" {
+		t.Errorf("Expected hands [1 3 5] and [2 4], got %s", got)
+	}
+	if p1.TotalScore != 0 || p2.TotalScore != 0 || game.CurrentRound.Deck.Remaining() != 3 {
+		t.Errorf("Expected nothing banked and 3 cards left, got %d, %d and %d cards",
+			p1.TotalScore, p2.TotalScore, game.CurrentRound.Deck.Remaining())
+	}
+}
+
+func TestRunGame_DeckExhausted(t *testing.T) {
+	// Deal: P1 gets 1, P2 gets 2. Both keep hitting: P1 3, P2 4, then P1 finds the deck and the
+	// discard pile empty and the round, and the game, end there.
+	tests := []struct {
+		name        string
+		rule        domain.ExhaustionRule
+		wantScores  [2]int
+		wantStatus  domain.HandStatus
+		wantWinners string
+	}{
+		{"Default banks the hands in play", "", [2]int{4, 6}, domain.HandStatusFrozen, "P2"},
+		{"Discard scores them as 0", domain.ExhaustionDiscardHands, [2]int{0, 0}, domain.HandStatusBusted, "P1,P2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+			p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+			game := domain.NewGame([]*domain.Player{p1, p2})
+			game.ExhaustionRule = tt.rule
+			game.Deck = domain.NewDeckInOrder(numbers(1, 2, 3, 4))
+			svc := application.NewGameService(game)
+			var out strings.Builder
+			svc.Out = &out
+
+			svc.RunGame()
+
+			if !game.IsCompleted || game.EndReason != domain.GameEndReasonExhausted {
+				t.Fatalf("Expected the game to end exhausted, got completed=%v reason=%q", game.IsCompleted, game.EndReason)
+			}
+			if game.RoundCount != 1 || game.CurrentRound.EndReason != domain.RoundEndReasonExhausted {
+				t.Errorf("Expected round 1 to be exhausted, got round %d ended with %q", game.RoundCount, game.CurrentRound.EndReason)
+			}
+			if got := [2]int{p1.TotalScore, p2.TotalScore}; got != tt.wantScores {
+				t.Errorf("Expected scores %v, got %v", tt.wantScores, got)
+			}
+			for _, p := range game.Players {
+				if p.CurrentHand.Status != tt.wantStatus {
+					t.Errorf("Expected %s's hand to be %s, got %s", p.Name, tt.wantStatus, p.CurrentHand.Status)
+				}
+			}
+			if got := strings.Join(playerNames(game.Winners), ","); got != tt.wantWinners {
+				t.Errorf("Expected winners %s, got %s", tt.wantWinners, got)
+			}
+			if !strings.Contains(out.String(), "The highest total score wins.") {
+				t.Errorf("Expected the log to explain the end of the game, got:\n%s", out.String())
+			}
+		})
+	}
+}
+
+func TestRunGame_DeckExhaustedDuringFlipThree(t *testing.T) {
+	// Deal: P1 gets 1, P2 gets 2. P1 hits a Flip Three and targets themselves (the first
+	// candidate), draws 5 and then finds no card: the Flip Three does not abort the round.
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	cards := append(numbers(1, 2), domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree})
+	game.Deck = domain.NewDeckInOrder(append(cards, numbers(5)...))
+	svc := application.NewGameService(game)
+	svc.Silent = true
+
+	svc.RunGame()
+
+	if game.EndReason != domain.GameEndReasonExhausted || game.CurrentRound.EndReason != domain.RoundEndReasonExhausted {
+		t.Fatalf("Expected the round and game to end exhausted, got %q and %q", game.CurrentRound.EndReason, game.EndReason)
+	}
+	if p1.TotalScore != 6 || p2.TotalScore != 2 {
+		t.Errorf("Expected P1 to bank 6 and P2 2, got %d and %d", p1.TotalScore, p2.TotalScore)
+	}
+	if len(game.Winners) != 1 || game.Winners[0] != p1 {
+		t.Errorf("Expected P1 to win with the highest total score, got %v", playerNames(game.Winners))
+	}
+}
+
+func TestRunGame_AfterTurnRunToEnd(t *testing.T) {
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1})
+	game.WinningScore = 20
+	game.Deck = domain.NewDeckInOrder(numbers(12, 10, 9))
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	calls := 0
+	svc.AfterTurn = func(round *domain.Round, player *domain.Player) domain.StepControl {
+		calls++
+		return domain.StepRunToEnd
+	}
+
+	svc.RunGame()
+
+	if calls != 1 {
+		t.Errorf("Expected the hook to be called once, got %d", calls)
+	}
+	if game.RoundCount != 2 || game.EndReason != domain.GameEndReasonWinner {
+		t.Errorf("Expected a winner after 2 rounds, got %d rounds ended with %q", game.RoundCount, game.EndReason)
+	}
+}
+
+func TestRunGame_AllBustRoundContinuesDeck(t *testing.T) {
+	// Round 1: P1 is dealt 5, P2 6, then both hit a duplicate and bust. Round 2 is dealt by P2
+	// from what is left of the same deck: P2 7, P1 8, P2 hits 9 and the hook stops the game.
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.Deck = fullDeckStartingWith(numbers(5, 6, 5, 6, 7, 8, 9)...)
+	firstDeck := game.Deck
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.ValidateCards = true
+	var secondRound *domain.Round
+	svc.AfterTurn = func(round *domain.Round, player *domain.Player) domain.StepControl {
+		if game.RoundCount == 2 {
+			secondRound = round
+			return domain.StepAbort
+		}
+		return domain.StepContinue
+	}
+
+	svc.RunGame()
+
+	if secondRound == nil {
+		t.Fatalf("Expected a second round, the game ended after %d rounds", game.RoundCount)
+	}
+	if p1.TotalScore != 0 || p2.TotalScore != 0 {
+		t.Errorf("Expected no points after an all-bust round, got %d and %d", p1.TotalScore, p2.TotalScore)
+	}
+	if secondRound.Dealer != p2 || game.DealerIndex != 1 {
+		t.Errorf("Expected P2 to deal round 2, got %s (dealer index %d)", secondRound.Dealer.Name, game.DealerIndex)
+	}
+	// Both busted hands are collected once, and round 2 does not disturb the discard pile.
+	if got := fmt.Sprint(game.DiscardPile); got != fmt.Sprint(numbers(5, 5, 6, 6)) {
+		t.Errorf("Expected the discard pile to hold the busted hands [5 5 6 6], got %s", got)
+	}
+	if secondRound.Deck != firstDeck || firstDeck.Remaining() != 94-7 {
+		t.Errorf("Expected round 2 to continue the first deck with %d cards left, got a new deck: %v (%d left)",
+			94-7, secondRound.Deck != firstDeck, secondRound.Deck.Remaining())
+	}
+	if err := game.ValidateConservation(); err != nil {
+		t.Errorf("Expected every card to be accounted for, got %v", err)
+	}
+}
+
+// actionTargetStrategy is a MockStrategy variant that picks a specific target per action type.
+type actionTargetStrategy struct {
+	MockStrategy
+	Targets map[domain.ActionType]*domain.Player
+}
+
+func (s *actionTargetStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	if target, ok := s.Targets[action]; ok {
+		return target
+	}
+	return candidates[0]
+}
+
+func TestInitialDeal_SecondChancePassedBySelector(t *testing.T) {
+	// P1 (dealer) is dealt a Flip Three during the initial deal and targets themselves.
+	// The forced draws contain two Second Chances: the first is kept, the second must be
+	// passed, and the selector picks P3 (not the first candidate P2).
+	p1Strategy := &actionTargetStrategy{MockStrategy: MockStrategy{DecideResult: domain.TurnChoiceStay}}
+	p1 := domain.NewPlayer("P1", p1Strategy)
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p3 := domain.NewPlayer("P3", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p1Strategy.Targets = map[domain.ActionType]*domain.Player{
+		domain.ActionFlipThree:        p1,
+		domain.ActionGiveSecondChance: p3,
+	}
+	players := []*domain.Player{p1, p2, p3}
+	game := domain.NewGame(players)
+	svc := application.NewGameService(game)
+	svc.Silent = true
+
+	deck := domain.NewDeckFromCards([]domain.Card{})
+	deck.Cards = []domain.Card{
+		{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree},    // P1 initial card
+		{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}, // P1 forced draw 1
+		{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}, // P1 forced draw 2 -> passed
+		{Type: domain.CardTypeNumber, Value: 5},                              // P1 forced draw 3
+		{Type: domain.CardTypeNumber, Value: 6},                              // P2 initial card
+		{Type: domain.CardTypeNumber, Value: 7},                              // P3 initial card
+	}
+	deck.RemainingCounts = map[domain.NumberValue]int{5: 1, 6: 1, 7: 1}
+	game.CurrentRound = domain.NewRound(players, p1, deck)
+
+	svc.PlayRound()
+
+	if !p1.CurrentHand.HasSecondChance() {
+		t.Errorf("Expected P1 to keep the first Second Chance")
+	}
+	if p2.CurrentHand.HasSecondChance() {
+		t.Errorf("Expected P2 not to receive the Second Chance")
+	}
+	if !p3.CurrentHand.HasSecondChance() {
+		t.Errorf("Expected P3 to receive the passed Second Chance")
+	}
+	if len(p2.CurrentHand.RawNumberCards) != 1 || len(p3.CurrentHand.RawNumberCards) != 1 {
+		t.Errorf("Expected P2 and P3 to be dealt their initial cards after the Flip Three")
+	}
+}
+
+func TestInitialDeal_ActionsAgainstEarlierAndLaterSeats(t *testing.T) {
+	// Seats are P1 (dealer), P2, P3 and everyone stays on their turn. An action dealt to
+	// one seat targets a seat that has or has not been dealt yet.
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	flipThree := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}
+	tests := []struct {
+		name        string
+		deal        []domain.Card
+		target      int // Seat targeted by the dealt action
+		wantStatus  domain.HandStatus
+		wantNumbers int // Number cards in the target's hand
+		wantTotals  [3]int
+	}{
+		{
+			// P1 freezes P3 before P3's card: P3 banks an empty hand and is out
+			name:        "Freeze later seat",
+			deal:        append([]domain.Card{freeze}, numbers(6)...),
+			target:      2,
+			wantStatus:  domain.HandStatusFrozen,
+			wantNumbers: 0,
+			wantTotals:  [3]int{0, 6, 0},
+		},
+		{
+			// P2 freezes P1, who banks the card already dealt
+			name:        "Freeze earlier seat",
+			deal:        append(append(numbers(5), freeze), numbers(7)...),
+			target:      0,
+			wantStatus:  domain.HandStatusFrozen,
+			wantNumbers: 1,
+			wantTotals:  [3]int{5, 0, 7},
+		},
+		{
+			// P1 makes P3 draw three before P3's card; P3 is still dealt afterwards
+			name:        "Flip Three later seat",
+			deal:        append([]domain.Card{flipThree}, numbers(2, 3, 4, 6, 7)...),
+			target:      2,
+			wantStatus:  domain.HandStatusStayed,
+			wantNumbers: 4,
+			wantTotals:  [3]int{0, 6, 16},
+		},
+		{
+			// P3 busts on the forced draws and is not dealt
+			name:        "Flip Three busts later seat",
+			deal:        append([]domain.Card{flipThree}, numbers(4, 4, 6)...),
+			target:      2,
+			wantStatus:  domain.HandStatusBusted,
+			wantNumbers: 2, // Both 4s
+			wantTotals:  [3]int{0, 6, 0},
+		},
+		{
+			// P2 makes P1 draw three on top of the card already dealt
+			name:        "Flip Three earlier seat",
+			deal:        append(append(numbers(5), flipThree), numbers(2, 3, 4, 7)...),
+			target:      0,
+			wantStatus:  domain.HandStatusStayed,
+			wantNumbers: 4,
+			wantTotals:  [3]int{14, 0, 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			strategies := make([]*actionTargetStrategy, 3)
+			players := make([]*domain.Player, 3)
+			for i := range players {
+				strategies[i] = &actionTargetStrategy{MockStrategy: MockStrategy{DecideResult: domain.TurnChoiceStay}}
+				players[i] = domain.NewPlayer(fmt.Sprintf("P%d", i+1), strategies[i])
+			}
+			target := players[tt.target]
+			for _, s := range strategies {
+				s.Targets = map[domain.ActionType]*domain.Player{
+					domain.ActionFreeze:    target,
+					domain.ActionFlipThree: target,
+				}
+			}
+			game := domain.NewGame(players)
+			game.CurrentRound = domain.NewRound(players, players[0], fullDeckStartingWith(tt.deal...))
+			svc := application.NewGameService(game)
+			svc.Silent = true
+
+			svc.PlayRound()
+
+			if target.CurrentHand.Status != tt.wantStatus {
+				t.Errorf("Expected %s to end with status %v, got %v", target.Name, tt.wantStatus, target.CurrentHand.Status)
+			}
+			if got := len(target.CurrentHand.RawNumberCards); got != tt.wantNumbers {
+				t.Errorf("Expected %s to hold %d number cards, got %d", target.Name, tt.wantNumbers, got)
+			}
+			for i, p := range players {
+				if p.TotalScore != tt.wantTotals[i] {
+					t.Errorf("Expected %s to bank %d, got %d", p.Name, tt.wantTotals[i], p.TotalScore)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkSilentSixPlayerGame(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		players := []*domain.Player{
+			domain.NewPlayer("Cautious", &strategy.CautiousStrategy{}),
+			domain.NewPlayer("Aggressive", strategy.NewAggressiveStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.65))),
+			domain.NewPlayer("Probabilistic", strategy.NewProbabilisticStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.70))),
+			domain.NewPlayer("Heuristic", strategy.NewHeuristicStrategyWithSelector(27, strategy.NewRiskBasedTargetSelector(0.65))),
+			domain.NewPlayer("ExpectedValue", strategy.NewExpectedValueStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.80))),
+			domain.NewPlayer("Adaptive", strategy.NewAdaptiveStrategy()),
+		}
+		svc := application.NewGameService(domain.NewGame(players))
+		svc.Silent = true
+		svc.RunGame()
+	}
+}
+
+func TestRunGame_ConservesCards(t *testing.T) {
+	// Deal: P1 gets Second Chance, P2 gets 2. P1 hits 5, P2 stays with 2 and wins at 2 points.
+	// P1 hits 5 (Second Chance absorbs it: both go to the discard pile), then 5 again and busts.
+	secondChance := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.WinningScore = 2
+	game.Deck = fullDeckStartingWith(append([]domain.Card{secondChance}, numbers(2, 5, 5, 5)...)...)
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.ValidateCards = true
+
+	svc.RunGame()
+
+	if p1.TotalScore != 0 || p2.TotalScore != 2 {
+		t.Errorf("Expected P1 0 and P2 2 points, got %d and %d", p1.TotalScore, p2.TotalScore)
+	}
+	// Second Chance and the absorbed 5, then both hands (5, 5 and 2) at round end.
+	if len(game.DiscardPile) != 5 {
+		t.Errorf("Expected 5 cards in the discard pile, got %d: %v", len(game.DiscardPile), game.DiscardPile)
+	}
+	if err := game.ValidateConservation(); err != nil {
+		t.Errorf("Expected cards to be conserved, got: %v", err)
+	}
+}
+
+func TestRunGame_DiscardsResolvedActions(t *testing.T) {
+	// Deal: P1 gets 2, P2 gets 3. P1 hits a Flip Three on themselves and draws 4, Freeze
+	// (queued) and 6, then the queued Freeze is resolved on P2.
+	p1Strategy := &actionTargetStrategy{MockStrategy: MockStrategy{DecideResult: domain.TurnChoiceHit}}
+	p1 := domain.NewPlayer("P1", p1Strategy)
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p1Strategy.Targets = map[domain.ActionType]*domain.Player{
+		domain.ActionFlipThree: p1,
+		domain.ActionFreeze:    p2,
+	}
+	flipThree := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	top := append(numbers(2, 3), flipThree)
+	top = append(top, numbers(4)...)
+	top = append(top, freeze)
+	top = append(top, numbers(6)...)
+	game.Deck = fullDeckStartingWith(top...)
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.ValidateCards = true
+	var hand []domain.Card
+	var discarded string
+	var conservation error
+	svc.AfterTurn = func(round *domain.Round, player *domain.Player) domain.StepControl {
+		hand = append([]domain.Card{}, p1.CurrentHand.ActionCards...)
+		discarded = fmt.Sprint(game.DiscardPile)
+		conservation = game.ValidateConservation()
+		return domain.StepAbort
+	}
+
+	svc.RunGame()
+
+	if p2.TotalScore != 3 {
+		t.Errorf("Expected the queued Freeze to bank P2's 3, got %d", p2.TotalScore)
+	}
+	if len(hand) != 0 {
+		t.Errorf("Expected no action cards left in P1's hand, got %v", hand)
+	}
+	if want := fmt.Sprint([]domain.Card{freeze, flipThree}); discarded != want {
+		t.Errorf("Expected the resolved actions %s in the discard pile, got %s", want, discarded)
+	}
+	if conservation != nil {
+		t.Errorf("Expected every card to be accounted for, got %v", conservation)
+	}
+}
+
+func TestRunGame_ValidateCardsAcrossSimulatedGames(t *testing.T) {
+	// ValidateCards panics on the first round that loses or duplicates a card.
+	// Every other game plays the holdable-actions house rule, with Adaptive holding its actions.
+	for i := 0; i < 50; i++ {
+		holdable := i%2 == 1
+		var adaptive domain.Strategy = strategy.NewAdaptiveStrategy()
+		if holdable {
+			adaptive = strategy.NewHoldingStrategy(adaptive)
+		}
+		players := []*domain.Player{
+			domain.NewPlayer("Cautious", strategy.NewCautiousStrategy()),
+			domain.NewPlayer("Aggressive", strategy.NewAggressiveStrategy()),
+			domain.NewPlayer("Probabilistic", strategy.NewProbabilisticStrategy()),
+			domain.NewPlayer("Adaptive", adaptive),
+		}
+		game := domain.NewGame(players)
+		game.HoldableActions = holdable
+		svc := application.NewGameService(game)
+		svc.Silent = true
+		svc.ValidateCards = true
+
+		svc.RunGame()
+
+		if err := game.ValidateConservation(); err != nil {
+			t.Fatalf("Game %d: %v", i, err)
+		}
+	}
+}
+
+func TestGameService_LowDeckWarning(t *testing.T) {
+	// One player is dealt a 5 and stays; the deck holds size cards, so size-1 are left at the decision.
+	play := func(size int, silent bool) string {
+		cards := numbers(5)
+		for len(cards) < size {
+			cards = append(cards, numbers(12)...)
+		}
+		p := domain.NewPlayer("Solo", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+		game := domain.NewGame([]*domain.Player{p})
+		game.WinningScore = 5
+		game.Deck = domain.NewDeckInOrder(cards)
+
+		var out bytes.Buffer
+		svc := application.NewGameService(game)
+		svc.Out = &out
+		svc.Silent = silent
+		svc.RunGame()
+		return out.String()
+	}
+
+	warning := fmt.Sprintf("Low deck: %d card(s) left", domain.LowDeckThreshold)
+	if out := play(domain.LowDeckThreshold+1, false); !strings.Contains(out, warning) {
+		t.Errorf("Expected a warning with %d cards left, got:\n%s", domain.LowDeckThreshold, out)
+	}
+	if out := play(domain.LowDeckThreshold+2, false); strings.Contains(out, "Low deck") {
+		t.Errorf("Expected no warning with %d cards left, got:\n%s", domain.LowDeckThreshold+1, out)
+	}
+	if out := play(domain.LowDeckThreshold+1, true); out != "" {
+		t.Errorf("Expected Silent to suppress all output, got:\n%s", out)
+	}
+}
+
+// describeEvent renders an event compactly for comparing event sequences.
+func describeEvent(e domain.Event) string {
+	switch e := e.(type) {
+	case domain.RoundStarted:
+		return fmt.Sprintf("RoundStarted %d dealer=%s", e.Round, e.Dealer.Name)
+	case domain.CardDrawn:
+		return fmt.Sprintf("CardDrawn %s %s %s", e.Player.Name, e.Card, e.Source)
+	case domain.CardPlayed:
+		return fmt.Sprintf("CardPlayed %s %s->%s", e.Action, e.Actor.Name, e.Target.Name)
+	case domain.PlayerFrozen:
+		return fmt.Sprintf("PlayerFrozen %s by=%s banked=%d", e.Player.Name, e.By.Name, e.Banked)
+	case domain.PlayerBusted:
+		return fmt.Sprintf("PlayerBusted %s on %s", e.Player.Name, e.Card)
+	case domain.RoundEnded:
+		return fmt.Sprintf("RoundEnded %d", e.Number)
+	default:
+		return e.EventName()
+	}
+}
+
+func TestGameService_PublishesEventsOfScriptedRound(t *testing.T) {
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p2Strategy := &actionTargetStrategy{MockStrategy: MockStrategy{DecideResult: domain.TurnChoiceHit}}
+	p2 := domain.NewPlayer("P2", p2Strategy)
+	p2Strategy.Targets = map[domain.ActionType]*domain.Player{domain.ActionFreeze: p1}
+
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	// P1 is dealt a 5, P2 a Freeze that banks P1's 5; P2 then hits 7 twice and busts.
+	top := append(numbers(5), domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze})
+	game.Deck = fullDeckStartingWith(append(top, numbers(7, 7)...)...)
+
+	var events []string
+	logger := &recordingLogger{}
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.MaxRounds = 1
+	svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+		events = append(events, describeEvent(e))
+	}))
+	svc.Events.Subscribe(application.NewLoggerSink(logger, "game"))
+	svc.RunGame()
+
+	want := []string{
+		"RoundStarted 1 dealer=P1",
+		"CardDrawn P1 5 initial_deal",
+		"CardDrawn P2 freeze initial_deal",
+		"CardPlayed freeze P2->P1",
+		"PlayerFrozen P1 by=P2 banked=5",
+		"CardDrawn P2 7 hit",
+		"CardDrawn P2 7 hit",
+		"PlayerBusted P2 on 7",
+		"RoundEnded 1",
+		"GameEnded",
+	}
+	if strings.Join(events, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Unexpected events:\n%s\nwant:\n%s", strings.Join(events, "\n"), strings.Join(want, "\n"))
+	}
+
+	var logged []string
+	for _, e := range logger.events {
+		logged = append(logged, e.eventType)
+	}
+	wantLogged := "RoundStart InitialDeal InitialDeal ActionTarget Frozen CardPlayed CardPlayed Bust RoundSummary GameEnd"
+	if got := strings.Join(logged, " "); got != wantLogged {
+		t.Errorf("Expected the logger sink to write %q, got %q", wantLogged, got)
+	}
+}
+
+func TestGameService_GameStatsCountScriptedRound(t *testing.T) {
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p2Strategy := &actionTargetStrategy{MockStrategy: MockStrategy{DecideResult: domain.TurnChoiceHit}}
+	p2 := domain.NewPlayer("P2", p2Strategy)
+	p2Strategy.Targets = map[domain.ActionType]*domain.Player{domain.ActionFreeze: p1}
+
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	// P1 is dealt a 5, P2 a Freeze that banks P1's 5; P2 then hits a Second Chance, which
+	// absorbs the second 7, and busts on the third.
+	top := append(numbers(5), domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze})
+	top = append(top, domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance})
+	game.Deck = fullDeckStartingWith(append(top, numbers(7, 7, 7)...)...)
+
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.MaxRounds = 1
+	stats := domain.NewGameStats()
+	svc.Events.Subscribe(stats)
+	svc.RunGame()
+
+	got1, got2 := stats.Player(p1), stats.Player(p2)
+	want1 := domain.PlayerGameStats{FreezesReceived: 1, Scores: []int{5}}
+	want2 := domain.PlayerGameStats{Busts: 1, FreezesGiven: 1, SecondChancesUsed: 1, Scores: []int{0}}
+	if !reflect.DeepEqual(*got1, want1) {
+		t.Errorf("Expected P1 stats %+v, got %+v", want1, *got1)
+	}
+	if !reflect.DeepEqual(*got2, want2) {
+		t.Errorf("Expected P2 stats %+v, got %+v", want2, *got2)
+	}
+}
+
+func TestGameService_PrintsRoundSummary(t *testing.T) {
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p2Strategy := &actionTargetStrategy{MockStrategy: MockStrategy{DecideResult: domain.TurnChoiceHit}}
+	p2 := domain.NewPlayer("P2", p2Strategy)
+	p2Strategy.Targets = map[domain.ActionType]*domain.Player{domain.ActionFreeze: p1}
+
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	// The round of TestGameService_PublishesEventsOfScriptedRound: P1 is frozen on 5 and P2 busts on 7.
+	top := append(numbers(5), domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze})
+	game.Deck = fullDeckStartingWith(append(top, numbers(7, 7)...)...)
+	remaining := game.Deck.Remaining() - len(top) - 2
+
+	var out strings.Builder
+	svc := application.NewGameServiceWithOutput(game, &out)
+	svc.MaxRounds = 1
+	svc.RunGame()
+
+	want := "P2 BUSTED!\n" +
+		"\n--- Round 1 Summary (every player is out) ---\n" +
+		" - P1: [5] frozen | +5 | Total: 5\n" +
+		" - P2: [7, 7] busted | +0 | Total: 0\n" +
+		fmt.Sprintf("Cards left in the deck: %d\n", remaining) +
+		"Round limit of 1 reached without a winner.\n"
+	if !strings.HasSuffix(out.String(), want) {
+		t.Errorf("Expected the output to end with:\n%s\ngot:\n%s", want, out.String())
+	}
+}
+
+// holdingMockStrategy is an ActionHolder that plays the first card it holds on its PlayAt-th
+// chance (never if 0) and otherwise follows Decisions, staying once they run out.
+type holdingMockStrategy struct {
+	MockStrategy
+	Decisions []domain.TurnChoice
+	PlayAt    int
+	Offers    int // PlayHeldAction calls
+}
+
+func (s *holdingMockStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, score int, others []*domain.Player) domain.TurnChoice {
+	if len(s.Decisions) == 0 {
+		return domain.TurnChoiceStay
+	}
+	choice := s.Decisions[0]
+	s.Decisions = s.Decisions[1:]
+	return choice
+}
+
+func (s *holdingMockStrategy) PlayHeldAction(ctx domain.HeldActionContext) *domain.Card {
+	s.Offers++
+	if s.Offers == s.PlayAt {
+		return &ctx.Held[0]
+	}
+	return nil
+}
+
+func TestRunGame_HoldableActionsHoldAcrossTurns(t *testing.T) {
+	// Deal: P1 gets 2, P2 gets 3. P1 hits a Freeze and keeps it; P2 hits 5. P1 holds on to the
+	// Freeze and hits 6; P2 hits 7. P1 then freezes P2 (3+5+7 = 15) and stays with 2+6 = 8.
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	holder := &holdingMockStrategy{Decisions: []domain.TurnChoice{domain.TurnChoiceHit, domain.TurnChoiceHit}, PlayAt: 2}
+	p1 := domain.NewPlayer("P1", holder)
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceHit})
+	holder.ChooseTargetResult = p2
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.HoldableActions = true
+	top := append(numbers(2, 3), freeze)
+	top = append(top, numbers(5, 6, 7)...)
+	game.Deck = fullDeckStartingWith(top...)
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.ValidateCards = true
+	svc.MaxRounds = 1
+	var events []string
+	svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+		events = append(events, e.EventName())
+	}))
+
+	svc.RunGame()
+
+	if p2.TotalScore != 15 {
+		t.Errorf("Expected the held Freeze to bank P2's 15, got %d", p2.TotalScore)
+	}
+	if p1.TotalScore != 8 {
+		t.Errorf("Expected P1 to stay with 8 after playing the Freeze, got %d", p1.TotalScore)
+	}
+	if holder.Offers != 2 {
+		t.Errorf("Expected P1 to be offered the held Freeze on 2 turns, got %d", holder.Offers)
+	}
+	got := strings.Join(events, " ")
+	if !strings.Contains(got, "ActionHeld") || !strings.Contains(got, "CardPlayed PlayerFrozen") {
+		t.Errorf("Expected the Freeze to be held, then played on P2, got events %s", got)
+	}
+}
+
+func TestRunGame_HoldableActionsDiscardUnplayedAtRoundEnd(t *testing.T) {
+	// Deal: P1 gets 2, P2 gets 3. P1 hits a Freeze and keeps it; P2 stays. P1 never plays the
+	// Freeze and stays with 2: the card leaves with the hand, unplayed.
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	holder := &holdingMockStrategy{Decisions: []domain.TurnChoice{domain.TurnChoiceHit}}
+	p1 := domain.NewPlayer("P1", holder)
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.HoldableActions = true
+	game.Deck = fullDeckStartingWith(append(numbers(2, 3), freeze)...)
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.ValidateCards = true
+	svc.MaxRounds = 1
+	var held []domain.Card
+	svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+		if _, ok := e.(domain.RoundEnded); ok {
+			held = p1.CurrentHand.HeldActions()
+		}
+		if _, ok := e.(domain.CardPlayed); ok {
+			t.Errorf("Expected no action to be played, got %+v", e)
+		}
+	}))
+
+	svc.RunGame()
+
+	if fmt.Sprint(held) != fmt.Sprint([]domain.Card{freeze}) {
+		t.Errorf("Expected P1 to hold the Freeze until the round ended, got %v", held)
+	}
+	if p1.TotalScore != 2 || p2.TotalScore != 3 {
+		t.Errorf("Expected the held Freeze to change no score, got P1 %d and P2 %d", p1.TotalScore, p2.TotalScore)
+	}
+	if len(p1.CurrentHand.ActionCards) != 0 {
+		t.Errorf("Expected the hand to be cleared, got %v", p1.CurrentHand.ActionCards)
+	}
+	discarded := 0
+	for _, c := range game.DiscardPile {
+		if c == freeze {
+			discarded++
+		}
+	}
+	if discarded != 1 {
+		t.Errorf("Expected the unplayed Freeze in the discard pile once, got %v", game.DiscardPile)
+	}
+}
+
+func TestRunGame_HoldableActionsNeedRuleAndHolder(t *testing.T) {
+	// Deal: P1 gets 2, P2 gets 3. P1 hits a Freeze, which must freeze P2 at once unless both
+	// the game plays the house rule and P1's strategy can hold actions.
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	tests := []struct {
+		name     string
+		holdable bool
+		holder   bool
+	}{
+		{"standard rules with a holder", false, true},
+		{"house rule without a holder", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := MockStrategy{DecideResult: domain.TurnChoiceHit}
+			var strat domain.Strategy = &actionTargetStrategy{MockStrategy: mock}
+			holder := &holdingMockStrategy{MockStrategy: mock, Decisions: []domain.TurnChoice{domain.TurnChoiceHit}, PlayAt: 1}
+			if tt.holder {
+				strat = holder
+			}
+			p1 := domain.NewPlayer("P1", strat)
+			p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+			holder.ChooseTargetResult = p2
+			if s, ok := strat.(*actionTargetStrategy); ok {
+				s.Targets = map[domain.ActionType]*domain.Player{domain.ActionFreeze: p2}
+			}
+			game := domain.NewGame([]*domain.Player{p1, p2})
+			game.HoldableActions = tt.holdable
+			game.Deck = fullDeckStartingWith(append(numbers(2, 3), freeze)...)
+			svc := application.NewGameService(game)
+			svc.Silent = true
+			svc.MaxRounds = 1
+			var frozen bool
+			svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+				if _, ok := e.(domain.ActionHeld); ok {
+					t.Errorf("Expected the Freeze not to be held")
+				}
+				if f, ok := e.(domain.PlayerFrozen); ok && f.Player == p2 {
+					frozen = true
+				}
+			}))
+
+			svc.RunGame()
+
+			if !frozen {
+				t.Error("Expected the Freeze to freeze P2 when drawn")
+			}
+			if holder.Offers != 0 {
+				t.Errorf("Expected no held action to be offered, got %d offers", holder.Offers)
+			}
+		})
+	}
+}
+
+func TestRunGame_ModifierOnlyHandsBankCalculatorScore(t *testing.T) {
+	plus10 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus10}
+	x2 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+
+	tests := []struct {
+		name     string
+		modifier domain.Card
+		frozen   bool // P2 is dealt a Freeze and freezes P1; otherwise P2 is dealt 3 and P1 stays
+		want     int
+	}{
+		{"Stay on +10", plus10, false, 10},
+		{"Stay on x2", x2, false, 0},
+		{"Frozen on +10", plus10, true, 10},
+		{"Frozen on x2", x2, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+			p2Strategy := &actionTargetStrategy{MockStrategy: MockStrategy{DecideResult: domain.TurnChoiceStay}}
+			p2 := domain.NewPlayer("P2", p2Strategy)
+			p2Strategy.Targets = map[domain.ActionType]*domain.Player{domain.ActionFreeze: p1}
+			second := numbers(3)[0]
+			if tt.frozen {
+				second = freeze
+			}
+			game := domain.NewGame([]*domain.Player{p1, p2})
+			game.Deck = fullDeckStartingWith(tt.modifier, second)
+			svc := application.NewGameService(game)
+			svc.Silent = true
+			svc.MaxRounds = 1
+			var status domain.HandStatus
+			var calculated int
+			svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+				if _, ok := e.(domain.RoundEnded); ok {
+					status = p1.CurrentHand.Status
+					calculated = domain.NewScoreCalculator().Total(p1.CurrentHand)
+				}
+			}))
+
+			svc.RunGame()
+
+			wantStatus := domain.HandStatusStayed
+			if tt.frozen {
+				wantStatus = domain.HandStatusFrozen
+			}
+			if status != wantStatus {
+				t.Errorf("Expected P1 to end the round %s, got %s", wantStatus, status)
+			}
+			if p1.TotalScore != tt.want || p1.TotalScore != calculated {
+				t.Errorf("Expected P1 to bank %d, the calculator's %d, got %d", tt.want, calculated, p1.TotalScore)
+			}
+		})
+	}
 }
@@ -0,0 +1,127 @@
+package application
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+	"flip7_strategy/internal/stats"
+)
+
+// HoldableActionsResult compares Adaptive holding its action cards (see strategy.HoldingStrategy)
+// with Adaptive resolving them at once, both playing the holdable-actions house rule
+// (domain.Game.HoldableActions) over the same games.
+type HoldableActionsResult struct {
+	Games         int     // Games played in each condition
+	HoldingWins   float64 // A game won by k players on equal scores counts 1/k
+	ImmediateWins float64
+	// HoldingHeldPlays and ImmediateHeldPlays count the held action cards Adaptive played from
+	// its hand. Only the holding condition keeps any, so ImmediateHeldPlays stays 0.
+	HoldingHeldPlays   int
+	ImmediateHeldPlays int
+	// Margin is the 95% confidence margin of Delta. Both conditions play the same decks,
+	// so it is computed from the per-game differences.
+	Margin float64
+}
+
+// HoldingWinRate returns the share of games won while holding action cards.
+func (r HoldableActionsResult) HoldingWinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return r.HoldingWins / float64(r.Games)
+}
+
+// ImmediateWinRate returns the share of games won while resolving action cards at once.
+func (r HoldableActionsResult) ImmediateWinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return r.ImmediateWins / float64(r.Games)
+}
+
+// Delta is the win rate that holding action cards is worth: HoldingWinRate - ImmediateWinRate.
+func (r HoldableActionsResult) Delta() float64 {
+	return r.HoldingWinRate() - r.ImmediateWinRate()
+}
+
+// RunHoldableActionsComparison measures what holding Freeze and Flip Three cards is worth under
+// the holdable-actions house rule. Adaptive plays n games against the counting value opponents
+// twice: holding its action cards, and resolving them as soon as they are drawn. The opponents
+// resolve theirs at once in both. It prints the win rate of both conditions and their difference.
+func (s *SimulationService) RunHoldableActionsComparison(n int) HoldableActionsResult {
+	fmt.Printf("Running Holdable Actions Comparison (%d games per condition)...\n", n)
+
+	seed := time.Now().UnixNano()
+	progress := s.startProgress(2 * n)
+	result := HoldableActionsResult{Games: n}
+	diffs := make([]float64, n)
+	for i := 0; i < n; i++ {
+		// Both conditions deal from the same shuffles, so they only differ where holding
+		// changed what happened.
+		holding, holdingPlays := playHoldableActionsGame(strategy.NewHoldingStrategy(strategy.NewAdaptiveStrategy()), i, seed+int64(i), progress.warnings)
+		progress.gameDone()
+		immediate, immediatePlays := playHoldableActionsGame(strategy.NewAdaptiveStrategy(), i, seed+int64(i), progress.warnings)
+		progress.gameDone()
+
+		result.HoldingWins += holding
+		result.ImmediateWins += immediate
+		result.HoldingHeldPlays += holdingPlays
+		result.ImmediateHeldPlays += immediatePlays
+		diffs[i] = holding - immediate
+	}
+	result.Margin = stats.MeanMargin(diffs, stats.Z95)
+
+	fmt.Println("\nDelta is the win rate gained by holding action cards; ± is its 95% confidence margin.")
+	s.printTable(holdableActionsTable(result))
+	return result
+}
+
+// playHoldableActionsGame plays one holdable-actions game of tested against the counting value
+// opponents and returns tested's share of the win and the number of held action cards it
+// played. Tested takes seat i mod 4, so every seat is played equally often. Strategy warnings
+// go to warnings.
+func playHoldableActionsGame(tested domain.Strategy, i int, seed int64, warnings *StrategyWarnings) (float64, int) {
+	opponents := countingValueOpponents()
+	me := domain.NewPlayer("Tested", tested)
+	seat := i % (len(opponents) + 1)
+	players := append(append(append([]*domain.Player{}, opponents[:seat]...), me), opponents[seat:]...)
+
+	rng := rand.New(rand.NewSource(seed))
+	game := domain.NewGame(players)
+	game.HoldableActions = true
+	game.Deck = shuffledDeck(domain.StandardDeckCards(), rng)
+	svc := NewGameService(game)
+	svc.Silent = true
+	svc.Warnings = warnings
+	svc.DeckFactory = func(cards []domain.Card) *domain.Deck { return shuffledDeck(cards, rng) }
+	heldPlays := 0
+	svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+		if played, ok := e.(domain.HeldActionPlayed); ok && played.Player == me {
+			heldPlays++
+		}
+	}))
+	svc.RunGame()
+
+	for _, winner := range game.Winners {
+		if winner == me {
+			return 1.0 / float64(len(game.Winners)), heldPlays
+		}
+	}
+	return 0, heldPlays
+}
+
+// holdableActionsTable shows both conditions and their difference.
+func holdableActionsTable(r HoldableActionsResult) *console.Table {
+	table := console.NewTable()
+	table.AddHeader("Strategy", "Holding", "Immediate", "Delta", "±")
+	table.AddRow("Adaptive",
+		fmt.Sprintf("%.2f%%", r.HoldingWinRate()*100),
+		fmt.Sprintf("%.2f%%", r.ImmediateWinRate()*100),
+		fmt.Sprintf("%+.2f", r.Delta()*100),
+		fmt.Sprintf("%.2f", r.Margin*100))
+	return table
+}
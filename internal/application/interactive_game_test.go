@@ -0,0 +1,153 @@
+package application_test
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+func TestInteractiveGame_HonorsHumanChoices(t *testing.T) {
+	// Deal: You are dealt Freeze and freeze Bot before Bot is dealt.
+	// Turns: garbage is re-prompted, then You hit 4, hit 5 and stay with 9.
+	input := strings.Join([]string{
+		"2",    // Freeze target: Bot
+		"what", // Invalid, asked again
+		"hit",  // Draws 4
+		"h",    // Draws 5
+		"stay", // Banks 9
+	}, "\n") + "\n"
+
+	var out bytes.Buffer
+	you := domain.NewPlayer("You", console.NewHumanStrategyWithIO(strings.NewReader(input), &out))
+	bot := domain.NewPlayer("Bot", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{you, bot})
+	game.WinningScore = 9
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	game.Deck = fullDeckStartingWith(append([]domain.Card{freeze}, numbers(4, 5)...)...)
+
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.RunGame()
+
+	if you.TotalScore != 9 {
+		t.Errorf("Expected You to bank 9 points, got %d", you.TotalScore)
+	}
+	if bot.TotalScore != 0 || bot.CurrentHand.Status != domain.HandStatusFrozen {
+		t.Errorf("Expected Bot to be frozen with 0 points, got %s with %d", bot.CurrentHand.Status, bot.TotalScore)
+	}
+	if len(game.Winners) != 1 || game.Winners[0] != you {
+		t.Errorf("Expected You to win, got %v", game.Winners)
+	}
+	if !strings.Contains(out.String(), "Invalid input") {
+		t.Errorf("Expected the invalid choice to be reported, got:\n%s", out.String())
+	}
+	if err := game.ValidateConservation(); err != nil {
+		t.Errorf("Expected cards to be conserved, got: %v", err)
+	}
+}
+
+func TestInteractiveGame_EndOfInputStays(t *testing.T) {
+	// Deal: You 3, Bot 2. You hit 4, Bot stays, then input runs out and You stay with 7.
+	var out bytes.Buffer
+	you := domain.NewPlayer("You", console.NewHumanStrategyWithIO(strings.NewReader("hit\n"), &out))
+	bot := domain.NewPlayer("Bot", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	game := domain.NewGame([]*domain.Player{you, bot})
+	game.WinningScore = 5
+	game.Deck = fullDeckStartingWith(numbers(3, 2, 4)...)
+
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.RunGame()
+
+	if you.TotalScore != 7 || bot.TotalScore != 2 {
+		t.Errorf("Expected You 7 and Bot 2, got %d and %d", you.TotalScore, bot.TotalScore)
+	}
+	if !strings.Contains(out.String(), "Warning: error reading input") {
+		t.Errorf("Expected a warning when input runs out, got:\n%s", out.String())
+	}
+}
+
+func TestInteractiveGame_SaveAndResume(t *testing.T) {
+	// Alice deals. Deal: Alice 12, Bob 12, You 3. Alice stays (12% bust risk is too much for Cautious),
+	// Bob hits 11 and stays on his next turn, You save on your first turn and then stay.
+	newTable := func(human *console.HumanStrategy) *domain.Game {
+		game := domain.NewGame([]*domain.Player{
+			domain.NewPlayer("You", human),
+			domain.NewPlayer("Alice", strategy.NewCautiousStrategy()),
+			domain.NewPlayer("Bob", strategy.NewHeuristicStrategy(22)),
+		})
+		game.DealerIndex = 1
+		game.WinningScore = 10
+		game.Deck = fullDeckStartingWith(numbers(12, 12, 3, 11)...)
+		return game
+	}
+
+	human := console.NewHumanStrategyWithIO(strings.NewReader("save\nstay\n"), io.Discard)
+	game := newTable(human)
+	var code string
+	human.SaveAndQuit = func() error {
+		var err error
+		code, err = application.SaveInteractiveGame(game, game.Players[0])
+		return err
+	}
+	svc := application.NewGameService(game)
+	svc.Silent = true
+	svc.RunGame()
+	if code == "" {
+		t.Fatal("Expected the save option to be offered during the human's turn")
+	}
+
+	resumedHuman := console.NewHumanStrategyWithIO(strings.NewReader("stay\n"), io.Discard)
+	resumed, err := application.LoadInteractiveGame(code, resumedHuman)
+	if err != nil {
+		t.Fatalf("Failed to load the save: %v", err)
+	}
+
+	you, alice, bob := resumed.Players[0], resumed.Players[1], resumed.Players[2]
+	if you.Name != "You" || you.Strategy != resumedHuman {
+		t.Errorf("Expected You to keep the human seat, got %s playing %s", you.Name, you.Strategy.Name())
+	}
+	if _, ok := alice.Strategy.(*strategy.CautiousStrategy); !ok {
+		t.Errorf("Expected Alice to be Cautious, got %T", alice.Strategy)
+	}
+	if h, ok := bob.Strategy.(*strategy.HeuristicStrategy); !ok || h.Threshold != 22 {
+		t.Errorf("Expected Bob to be Heuristic-22, got %s", bob.Strategy.Name())
+	}
+	round := resumed.CurrentRound
+	if current := round.ActivePlayers[round.CurrentTurnIndex]; current != you {
+		t.Errorf("Expected the game to resume on your turn, got %s's", current.Name)
+	}
+
+	resumedSvc := application.NewGameService(resumed)
+	resumedSvc.Silent = true
+	if err := resumedSvc.ResumeGame(); err != nil {
+		t.Fatalf("Failed to resume: %v", err)
+	}
+	for i, p := range game.Players {
+		if got := resumed.Players[i].TotalScore; got != p.TotalScore {
+			t.Errorf("Expected %s to finish with %d as in the uninterrupted game, got %d", p.Name, p.TotalScore, got)
+		}
+	}
+	if bob.TotalScore != 23 || len(resumed.Winners) != 1 || resumed.Winners[0] != bob {
+		t.Errorf("Expected Bob to win with 23, got %d (winners %v)", bob.TotalScore, resumed.Winners)
+	}
+	if err := resumed.ValidateConservation(); err != nil {
+		t.Errorf("Expected cards to be conserved, got: %v", err)
+	}
+}
+
+func TestSaveInteractiveGame_RejectsUnregisteredStrategy(t *testing.T) {
+	bot := domain.NewPlayer("Bot", &MockStrategy{})
+	game := domain.NewGame([]*domain.Player{bot})
+	game.CurrentRound = domain.NewRound(game.Players, bot, domain.NewDeck())
+
+	if _, err := application.SaveInteractiveGame(game, bot); err == nil {
+		t.Error("Expected an error for a strategy the registry cannot rebuild")
+	}
+}
@@ -0,0 +1,98 @@
+package application
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// SaveInteractiveGame encodes an interactive game (mode 2) paused on current's turn as a save code.
+// It uses the manual mode format: human players are recorded as user controlled and every
+// AI player by the name of its strategy, so LoadInteractiveGame can rebuild it.
+func SaveInteractiveGame(game *domain.Game, current *domain.Player) (string, error) {
+	if game.CurrentRound == nil {
+		return "", errors.New("no round in progress")
+	}
+
+	var userControlledIDs []string
+	strategies := make(map[string]string)
+	for _, p := range game.Players {
+		if _, ok := p.Strategy.(*console.HumanStrategy); ok {
+			userControlledIDs = append(userControlledIDs, p.ID.String())
+			continue
+		}
+		if _, err := strategy.New(p.Strategy.Name()); err != nil {
+			return "", fmt.Errorf("cannot save %s: %w", p.Name, err)
+		}
+		strategies[p.ID.String()] = p.Strategy.Name()
+	}
+
+	// The round draws from its own deck, which replaces Game.Deck only at the end of the round.
+	snapshot := *game
+	snapshot.Deck = game.CurrentRound.Deck
+
+	wrapper := gameStateWrapper{
+		Version:           saveFormatVersion,
+		Game:              &snapshot,
+		UserControlledIDs: userControlledIDs,
+		Strategies:        strategies,
+	}
+	if current != nil {
+		wrapper.CurrentPlayerID = current.ID.String()
+	}
+
+	data, err := json.Marshal(wrapper)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// LoadInteractiveGame decodes a save code written by SaveInteractiveGame, ready for GameService.ResumeGame.
+// User-controlled players get human as their strategy; AI players get a fresh instance of the recorded strategy.
+func LoadInteractiveGame(code string, human domain.Strategy) (*domain.Game, error) {
+	decoded, err := base64.StdEncoding.DecodeString(code)
+	if err != nil {
+		return nil, fmt.Errorf("invalid code: %w", err)
+	}
+	wrapper, err := decodeSave(decoded)
+	if err != nil {
+		return nil, err
+	}
+
+	game := wrapper.Game
+	if game == nil || len(game.Players) == 0 {
+		return nil, errors.New("invalid save code: game state is missing")
+	}
+	if game.IsCompleted {
+		return nil, fmt.Errorf("cannot resume the loaded game: %w", domain.ErrGameCompleted)
+	}
+	if game.CurrentRound == nil || game.CurrentRound.IsEnded || game.Deck == nil {
+		return nil, errors.New("cannot resume the loaded game: no round in progress")
+	}
+	if err := validateSave(wrapper); err != nil {
+		return nil, fmt.Errorf("invalid save code: %w", err)
+	}
+
+	relinkPointers(game, wrapper.UserControlledIDs)
+	for _, p := range game.Players {
+		if p.Strategy == nil {
+			p.Strategy = human
+			continue
+		}
+		name, ok := wrapper.Strategies[p.ID.String()]
+		if !ok {
+			return nil, fmt.Errorf("invalid save code: no strategy recorded for %s", p.Name)
+		}
+		if p.Strategy, err = strategy.New(name); err != nil {
+			return nil, fmt.Errorf("invalid save code: %s: %w", p.Name, err)
+		}
+	}
+	resumeTurnOf(game.CurrentRound, wrapper.CurrentPlayerID)
+	return game, nil
+}
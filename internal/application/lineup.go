@@ -0,0 +1,268 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// lineupResult is the outcome of the games of one lineup.
+type lineupResult struct {
+	standings *standingsAggregator
+	targeting *headToHead
+	seats     map[string]map[int]int // Strategy -> seat -> games played there
+}
+
+// headToHead counts, per action, how often each strategy of a lineup aimed it at each other one.
+// It is safe for concurrent use by the games of several tables.
+type headToHead struct {
+	mu     sync.Mutex
+	counts map[domain.ActionType]map[string]map[string]int // Action -> actor -> target -> count
+}
+
+func newHeadToHead() *headToHead {
+	return &headToHead{counts: make(map[domain.ActionType]map[string]map[string]int)}
+}
+
+// Publish implements domain.EventSink, counting the targets of Freeze and Flip Three.
+// A player targeting themselves is counted under "Self", not under their own strategy.
+func (h *headToHead) Publish(e domain.Event) {
+	played, ok := e.(domain.CardPlayed)
+	if !ok || (played.Action != domain.ActionFreeze && played.Action != domain.ActionFlipThree) {
+		return
+	}
+	actor, target, action := played.Actor, played.Target, played.Action
+	targetName := target.Strategy.Name()
+	if target.ID == actor.ID {
+		targetName = "Self"
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	byActor, ok := h.counts[action]
+	if !ok {
+		byActor = make(map[string]map[string]int)
+		h.counts[action] = byActor
+	}
+	byTarget, ok := byActor[actor.Strategy.Name()]
+	if !ok {
+		byTarget = make(map[string]int)
+		byActor[actor.Strategy.Name()] = byTarget
+	}
+	byTarget[targetName]++
+}
+
+// Table builds the targeting matrix of one action: a row per acting strategy and a column
+// per target strategy of the lineup, plus Self.
+func (h *headToHead) Table(action domain.ActionType, names []string) *console.Table {
+	table := console.NewTable()
+	header := []string{"Actor \\ Target"}
+	header = append(header, names...)
+	table.AddHeader(append(header, "Self")...)
+	for _, actor := range names {
+		row := []interface{}{actor}
+		for _, target := range append(append([]string{}, names...), "Self") {
+			row = append(row, h.counts[action][actor][target])
+		}
+		table.AddRow(row...)
+	}
+	return table
+}
+
+// seatLineup returns the strategies seated for the given game, seat 1 first.
+// Each game rotates the lineup by one seat, so over any multiple of len(lineup) games every
+// strategy sits in every seat (and deals first) equally often.
+func seatLineup(lineup []string, game int) []string {
+	seated := make([]string, len(lineup))
+	for seat := range lineup {
+		seated[seat] = lineup[(game+seat)%len(lineup)]
+	}
+	return seated
+}
+
+// uniqueNames returns the names of lineup without repeats, in lineup order.
+func uniqueNames(lineup []string) []string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, name := range lineup {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// playLineup plays n games of lineup (registry names, see strategy.New) spread over the
+// given number of tables played in parallel. Every player gets a fresh strategy.
+func (s *SimulationService) playLineup(n int, lineup []string, tables int, progress *progressTracker) (*lineupResult, error) {
+	if len(lineup) < 2 {
+		return nil, errors.New("a lineup needs at least 2 strategies")
+	}
+	for _, name := range lineup {
+		if _, err := strategy.New(name); err != nil {
+			return nil, err
+		}
+	}
+	if tables < 1 {
+		tables = 1
+	}
+
+	result := &lineupResult{
+		standings: newStandingsAggregator(),
+		targeting: newHeadToHead(),
+		seats:     make(map[string]map[int]int),
+	}
+	var mu sync.Mutex // Guards standings and seats
+	var wg sync.WaitGroup
+	for t := 0; t < tables; t++ {
+		wg.Add(1)
+		go func(table int) {
+			defer wg.Done()
+			for i := table; i < n; i += tables {
+				seated := seatLineup(lineup, i)
+				players := make([]*domain.Player, len(seated))
+				for seat, name := range seated {
+					strat, _ := strategy.New(name) // Validated above
+					players[seat] = domain.NewPlayer(fmt.Sprintf("P%d-%s", seat+1, name), strat)
+				}
+
+				game := domain.NewGame(players)
+				svc := NewGameService(game)
+				svc.Silent = true
+				svc.Warnings = progress.warnings
+				svc.Events.Subscribe(result.targeting)
+				svc.RunGame()
+				progress.gameDone()
+
+				results := make([]finalStanding, len(players))
+				for seat, p := range players {
+					results[seat] = finalStanding{
+						Strategy: p.Strategy.Name(),
+						Score:    p.TotalScore,
+						Winner:   containsPlayer(game.Winners, p),
+					}
+				}
+
+				mu.Lock()
+				result.standings.Record(results, len(game.Winners))
+				for seat, name := range seated {
+					if result.seats[name] == nil {
+						result.seats[name] = make(map[int]int)
+					}
+					result.seats[name][seat+1]++
+				}
+				mu.Unlock()
+			}
+		}(t)
+	}
+	wg.Wait()
+	return result, nil
+}
+
+// RunLineupEvaluation plays n free-for-all games of a lineup of registered strategies
+// (see strategy.Names) on the given number of parallel tables. Seats rotate every game,
+// so with n a multiple of the lineup size no strategy gains from its seat.
+// It reports each strategy's win rate and placements, and whom each strategy aimed its
+// Freeze and Flip Three cards at.
+func (s *SimulationService) RunLineupEvaluation(n int, lineup []string, tables int) error {
+	fmt.Printf("Running Lineup Evaluation: %s (%d games)...\n", strings.Join(lineup, ", "), n)
+
+	result, err := s.playLineup(n, lineup, tables, s.startProgress(n))
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("\n--- Standings ---")
+	s.printTable(result.standings.Table(len(lineup)))
+
+	names := uniqueNames(lineup)
+	fmt.Println("\n--- Freeze Targets ---")
+	s.printTable(result.targeting.Table(domain.ActionFreeze, names))
+	fmt.Println("\n--- Flip Three Targets ---")
+	s.printTable(result.targeting.Table(domain.ActionFlipThree, names))
+	return nil
+}
+
+// MaxLineupSubsetSize bounds RunLineupSubsets, whose number of lineups grows quickly with k.
+const MaxLineupSubsetSize = 6
+
+// RunLineupSubsets plays n games of every lineup of k distinct registered strategies and
+// reports each strategy's win rate and average placement per lineup, then over all of them.
+func (s *SimulationService) RunLineupSubsets(n int, k int, tables int) error {
+	names := strategy.Names()
+	if k < 2 || k > len(names) || k > MaxLineupSubsetSize {
+		return fmt.Errorf("lineup size must be between 2 and %d", min(len(names), MaxLineupSubsetSize))
+	}
+
+	lineups := subsets(names, k)
+	fmt.Printf("Running Lineup Evaluation for %d lineups of %d strategies (%d games each)...\n", len(lineups), k, n)
+
+	perLineup := console.NewTable()
+	perLineup.AddHeader("Lineup", "Strategy", "Win Rate", "Avg Place")
+	overall := make(map[string]*strategyStandings)
+
+	progress := s.startProgress(len(lineups) * n)
+	for _, lineup := range lineups {
+		result, err := s.playLineup(n, lineup, tables, progress)
+		if err != nil {
+			return err
+		}
+		label := strings.Join(lineup, ", ")
+		for _, name := range lineup {
+			st := result.standings.Strategies[name]
+			perLineup.AddRow(label, name, fmt.Sprintf("%.2f%%", st.Wins/float64(st.Games)*100), fmt.Sprintf("%.2f", st.AvgPlacement()))
+
+			total, ok := overall[name]
+			if !ok {
+				total = &strategyStandings{}
+				overall[name] = total
+			}
+			total.Games += st.Games
+			total.Wins += st.Wins
+			total.RankSum += st.RankSum
+		}
+	}
+
+	fmt.Println("\n--- Lineups ---")
+	s.printTable(perLineup)
+
+	summary := console.NewTable()
+	summary.AddHeader("Strategy", "Games", "Win Rate", "Avg Place")
+	sorted := make([]string, 0, len(overall))
+	for name := range overall {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	for _, name := range sorted {
+		st := overall[name]
+		summary.AddRow(name, st.Games, fmt.Sprintf("%.2f%%", st.Wins/float64(st.Games)*100), fmt.Sprintf("%.2f", st.AvgPlacement()))
+	}
+	summary.SortBy(2, true)
+	fmt.Printf("\n--- All Lineups of %d ---\n", k)
+	s.printTable(summary)
+	return nil
+}
+
+// subsets returns every k-element subset of names, each in the order of names.
+func subsets(names []string, k int) [][]string {
+	var result [][]string
+	var pick func(start int, chosen []string)
+	pick = func(start int, chosen []string) {
+		if len(chosen) == k {
+			result = append(result, append([]string{}, chosen...))
+			return
+		}
+		for i := start; i <= len(names)-(k-len(chosen)); i++ {
+			pick(i+1, append(chosen, names[i]))
+		}
+	}
+	pick(0, nil)
+	return result
+}
@@ -0,0 +1,194 @@
+package application
+
+import (
+	"fmt"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// DeckTracker keeps the deck of a Manual Mode game in step with the cards drawn at the table.
+type DeckTracker interface {
+	// Remove takes card, just drawn at the table, out of the deck of g's current round. It
+	// fails with domain.ErrNoActiveRound without a round, with domain.ErrDeckEmpty when nothing
+	// at all is left to draw and with domain.ErrCardNotInDeck when no copy of card can be drawn.
+	Remove(g *domain.Game, card domain.Card) error
+}
+
+// TableDeckTracker is the DeckTracker of Manual Mode. A card that is not in the deck but in the
+// discard pile means the physical deck ran out and was rebuilt from the discards, so it
+// shuffles the discard pile into a new deck first, as the table did.
+type TableDeckTracker struct {
+	// OnReshuffle, if set, is called once the discard pile has become the new deck, before the
+	// card is taken from it, with the number of cards that were in the pile.
+	OnReshuffle func(g *domain.Game, discarded int)
+}
+
+// Remove implements DeckTracker.
+func (t TableDeckTracker) Remove(g *domain.Game, card domain.Card) error {
+	// Check if deck is active
+	if g.CurrentRound == nil || g.CurrentRound.Deck == nil {
+		return fmt.Errorf("%w: no deck to draw from", domain.ErrNoActiveRound)
+	}
+
+	// Nothing at all can be drawn: the round is exhausted, whatever the card.
+	if g.CurrentRound.Deck.Remaining() == 0 && len(g.DiscardPile) == 0 {
+		return fmt.Errorf("%w and discard pile is empty", domain.ErrDeckEmpty)
+	}
+
+	// Reject cards that cannot be drawn before touching the deck: a reshuffle would not help.
+	copies := countCopies(g, card)
+	if copies.deck == 0 && copies.discard == 0 {
+		return copies.unavailableError(card)
+	}
+
+	deck := g.CurrentRound.Deck
+
+	// Try removing from current deck
+	if removeCard(deck, card) {
+		return nil
+	}
+
+	// The card is not in the deck but is in the discard pile: the physical deck must have run out
+	// and been rebuilt from the discards, so do the same.
+	// Create new deck from discards, plus any cards left in the old deck
+	discarded := len(g.DiscardPile)
+	newDeck := domain.NewDeckFromCards(g.DiscardPile)
+	newDeck.Cards = append(newDeck.Cards, deck.Cards...)
+	for _, c := range deck.Cards {
+		if c.Type == domain.CardTypeNumber {
+			newDeck.RemainingCounts[c.Value]++
+		}
+	}
+	newDeck.Shuffle()
+
+	// Update references
+	g.CurrentRound.Reshuffle(newDeck)
+	g.Deck = newDeck
+	g.DiscardPile = []domain.Card{} // Clear discard pile
+	if t.OnReshuffle != nil {
+		t.OnReshuffle(g, discarded)
+	}
+
+	// Remove the card from the new deck
+	if removeCard(g.CurrentRound.Deck, card) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s (all copies already drawn?)", domain.ErrCardNotInDeck, card)
+}
+
+// removeCardFromDeck takes a card drawn at the table out of the tracked deck (see DeckTracker).
+func (s *ManualGameService) removeCardFromDeck(card domain.Card) error {
+	tracker := s.DeckTracker
+	if tracker == nil {
+		tracker = TableDeckTracker{OnReshuffle: s.reshuffled}
+	}
+	return tracker.Remove(s.Game, card)
+}
+
+// reshuffled reports a reshuffle of the discard pile into a new deck.
+func (s *ManualGameService) reshuffled(g *domain.Game, discarded int) {
+	s.say(console.MsgReshuffle, console.Args{"count": discarded})
+	s.publish(domain.DeckReshuffled{Discarded: discarded})
+	notifyReshuffle(g.CurrentRound.Deck, s.advisingStrategies()...)
+	// The reshuffle is a step of its own in the history, so Undo can return to just after it.
+	s.PushState()
+}
+
+// advisingStrategies returns every strategy the service consults: those of the app's seats,
+// the suggestion and shadow advisors, and the turn outlook model.
+func (s *ManualGameService) advisingStrategies() []domain.Strategy {
+	var strategies []domain.Strategy
+	for _, p := range s.Game.Players {
+		if strat, ok := s.appSeat(p); ok {
+			strategies = append(strategies, strat)
+		}
+	}
+	strategies = append(strategies, s.SuggestionAdvisors...)
+	strategies = append(strategies, s.ShadowAdvisors...)
+	if s.TurnOutlookModel != nil {
+		strategies = append(strategies, s.TurnOutlookModel)
+	}
+	return strategies
+}
+
+// removeCard takes one copy of card out of d, wherever it is. It reports false if d has none.
+func removeCard(d *domain.Deck, card domain.Card) bool {
+	for i, c := range d.Cards {
+		if sameCard(c, card) {
+			d.Cards = append(d.Cards[:i], d.Cards[i+1:]...)
+			if card.Type == domain.CardTypeNumber {
+				d.RemainingCounts[card.Value]--
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// cardCopies counts where the copies of one kind of card are.
+type cardCopies struct {
+	total   int // Copies in a standard deck
+	deck    int
+	discard int
+	hands   int
+}
+
+// countCopies locates every copy of card in the deck of the current round, the discard pile
+// and the players' hands.
+func countCopies(g *domain.Game, card domain.Card) cardCopies {
+	count := func(cards []domain.Card) int {
+		n := 0
+		for _, c := range cards {
+			if sameCard(c, card) {
+				n++
+			}
+		}
+		return n
+	}
+
+	copies := cardCopies{
+		total:   count(domain.StandardDeckCards()),
+		deck:    count(g.CurrentRound.Deck.Cards),
+		discard: count(g.DiscardPile),
+	}
+	for _, p := range g.Players {
+		h := p.CurrentHand
+		if h == nil {
+			continue
+		}
+		for _, v := range h.RawNumberCards {
+			if card.Type == domain.CardTypeNumber && card.Value == v {
+				copies.hands++
+			}
+		}
+		copies.hands += count(h.ModifierCards) + count(h.ActionCards)
+	}
+	return copies
+}
+
+// unavailableError explains why a card with no copy in the deck or discard pile cannot be drawn.
+func (c cardCopies) unavailableError(card domain.Card) error {
+	if c.hands >= c.total {
+		return fmt.Errorf("%w: all %d copies of %s are already accounted for (deck: %d, discard: %d, hands: %d)",
+			domain.ErrCardNotInDeck, c.total, card, c.deck, c.discard, c.hands)
+	}
+	return fmt.Errorf("%w: no copy of %s is left to draw (deck: %d, discard: %d, hands: %d of %d)",
+		domain.ErrCardNotInDeck, card, c.deck, c.discard, c.hands, c.total)
+}
+
+// sameCard reports whether two cards are the same kind of card.
+func sameCard(a, b domain.Card) bool {
+	if a.Type != b.Type {
+		return false
+	}
+	switch a.Type {
+	case domain.CardTypeNumber:
+		return a.Value == b.Value
+	case domain.CardTypeModifier:
+		return a.ModifierType == b.ModifierType
+	case domain.CardTypeAction:
+		return a.ActionType == b.ActionType
+	}
+	return false
+}
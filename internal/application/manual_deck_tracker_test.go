@@ -0,0 +1,97 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+// trackedGame returns a game of one player whose round deck holds deck and whose discard
+// pile holds discards.
+func trackedGame(deck, discards []domain.Card) *domain.Game {
+	p := domain.NewPlayer("P1", nil)
+	g := domain.NewGame([]*domain.Player{p})
+	g.Deck = domain.NewDeckFromCards(deck)
+	g.CurrentRound = domain.NewRound(g.Players, p, g.Deck)
+	g.DiscardPile = discards
+	return g
+}
+
+func TestTableDeckTracker_Remove(t *testing.T) {
+	one := domain.Card{Type: domain.CardTypeNumber, Value: 1}
+	two := domain.Card{Type: domain.CardTypeNumber, Value: 2}
+	three := domain.Card{Type: domain.CardTypeNumber, Value: 3}
+
+	t.Run("from the deck", func(t *testing.T) {
+		g := trackedGame([]domain.Card{one, two}, []domain.Card{three})
+		reshuffles := 0
+		tracker := application.TableDeckTracker{OnReshuffle: func(*domain.Game, int) { reshuffles++ }}
+		if err := tracker.Remove(g, two); err != nil {
+			t.Fatalf("Remove: %v", err)
+		}
+		if got := g.CurrentRound.Deck.Remaining(); got != 1 {
+			t.Errorf("Expected 1 card left in the deck, got %d", got)
+		}
+		if reshuffles != 0 || len(g.DiscardPile) != 1 {
+			t.Errorf("Expected no reshuffle, got %d with %d discards left", reshuffles, len(g.DiscardPile))
+		}
+	})
+
+	t.Run("reshuffles the discard pile", func(t *testing.T) {
+		g := trackedGame([]domain.Card{one}, []domain.Card{two, three, three})
+		var reshuffledDeck *domain.Deck
+		discarded := 0
+		tracker := application.TableDeckTracker{OnReshuffle: func(g *domain.Game, n int) {
+			reshuffledDeck, discarded = g.CurrentRound.Deck, n
+			if g.CurrentRound.Deck.Remaining() != 4 {
+				t.Errorf("Expected the card to be taken after the reshuffle is reported, deck has %d", g.CurrentRound.Deck.Remaining())
+			}
+		}}
+		if err := tracker.Remove(g, three); err != nil {
+			t.Fatalf("Remove: %v", err)
+		}
+		if discarded != 3 {
+			t.Errorf("Expected a reshuffle of 3 discards, got %d", discarded)
+		}
+		// The old deck's card is kept: one 1, one 2 and the other 3 are left.
+		if deck := g.CurrentRound.Deck; deck != reshuffledDeck || g.Deck != deck || deck.Remaining() != 3 {
+			t.Errorf("Expected the reshuffled deck with 3 cards in round and game, got %d", deck.Remaining())
+		}
+		if counts := g.CurrentRound.Deck.RemainingCounts; counts[1] != 1 || counts[2] != 1 || counts[3] != 1 {
+			t.Errorf("Expected one each of 1, 2 and 3 left, got %v", counts)
+		}
+		if len(g.DiscardPile) != 0 {
+			t.Errorf("Expected the discard pile to be emptied, got %d cards", len(g.DiscardPile))
+		}
+	})
+
+	t.Run("nothing to draw", func(t *testing.T) {
+		g := trackedGame(nil, nil)
+		err := application.TableDeckTracker{}.Remove(g, one)
+		if !errors.Is(err, domain.ErrDeckEmpty) {
+			t.Errorf("Expected ErrDeckEmpty, got %v", err)
+		}
+	})
+
+	t.Run("card not left", func(t *testing.T) {
+		g := trackedGame([]domain.Card{one}, []domain.Card{two})
+		err := application.TableDeckTracker{OnReshuffle: func(*domain.Game, int) {
+			t.Error("Expected no reshuffle for a card that is nowhere")
+		}}.Remove(g, three)
+		if !errors.Is(err, domain.ErrCardNotInDeck) {
+			t.Errorf("Expected ErrCardNotInDeck, got %v", err)
+		}
+		if g.CurrentRound.Deck.Remaining() != 1 || len(g.DiscardPile) != 1 {
+			t.Error("Expected the deck and discard pile to be left alone")
+		}
+	})
+
+	t.Run("no round", func(t *testing.T) {
+		g := domain.NewGame([]*domain.Player{domain.NewPlayer("P1", nil)})
+		if err := (application.TableDeckTracker{}).Remove(g, one); !errors.Is(err, domain.ErrNoActiveRound) {
+			t.Errorf("Expected ErrNoActiveRound, got %v", err)
+		}
+	})
+}
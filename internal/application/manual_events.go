@@ -0,0 +1,43 @@
+package application
+
+import "flip7_strategy/internal/domain"
+
+// DecisionAnalyzed is published after the turn of a user-controlled player in Manual Mode,
+// with the advice shown before it next to the choice made, for review after the game.
+type DecisionAnalyzed struct {
+	Player    *domain.Player
+	BustRate  float64
+	HandScore int
+	EVIfHit   float64
+	Suggested domain.TurnChoice
+	Chosen    string // "hit", "stay" or "play"
+}
+
+// ShadowDecided is published for every shadow advisor once the user has made a decision the
+// advisor was consulted on: Decision is "turn" for hit or stay, else the action targeted.
+type ShadowDecided struct {
+	Player   *domain.Player
+	Advisor  string
+	Decision string
+	Shadow   string
+	Chosen   string
+	Agreed   bool
+}
+
+func (DecisionAnalyzed) EventName() string { return "DecisionAnalyzed" }
+func (ShadowDecided) EventName() string    { return "ShadowDecided" }
+
+// publish delivers e to the sinks subscribed to Events, after the sink logging the game with
+// Logger. That sink follows the service: it logs under the current GameID and round, which
+// change as games of a series and save codes are played.
+func (s *ManualGameService) publish(e domain.Event) {
+	if s.logSink == nil {
+		s.logSink = &LoggerSink{}
+		s.Events.Subscribe(s.logSink)
+	}
+	s.logSink.Logger, s.logSink.GameID = s.Logger, s.GameID
+	if s.Game != nil {
+		s.logSink.round = s.Game.RoundCount
+	}
+	s.Events.Publish(e)
+}
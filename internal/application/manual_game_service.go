@@ -2,9 +2,9 @@ package application
 
 import (
 	"bufio"
-	"encoding/base64"
-	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strconv"
 	"strings"
@@ -14,53 +14,9 @@ import (
 	"flip7_strategy/internal/domain"
 	"flip7_strategy/internal/domain/logger"
 	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
 )
 
-// GameMemento represents a snapshot of the game state, encoded as a base64 string.
-// It is used by GameHistory to support undo/redo functionality.
-type GameMemento string
-
-// GameHistory manages the history of game states for undo/redo.
-type GameHistory struct {
-	mementos     []GameMemento
-	currentIndex int
-}
-
-// Push adds a new memento to the history, truncating any future redo states.
-func (h *GameHistory) Push(memento GameMemento) {
-	// If we are in the middle of the history (after undo), remove future states
-	if h.currentIndex < len(h.mementos)-1 {
-		h.mementos = h.mementos[:h.currentIndex+1]
-	}
-	h.mementos = append(h.mementos, memento)
-	h.currentIndex = len(h.mementos) - 1
-}
-
-// Undo moves the pointer back and returns the previous memento.
-func (h *GameHistory) Undo() (GameMemento, bool) {
-	if h.currentIndex > 0 {
-		h.currentIndex--
-		return h.mementos[h.currentIndex], true
-	}
-	return "", false
-}
-
-// Redo moves the pointer forward and returns the next memento.
-func (h *GameHistory) Redo() (GameMemento, bool) {
-	if h.currentIndex < len(h.mementos)-1 {
-		h.currentIndex++
-		return h.mementos[h.currentIndex], true
-	}
-	return "", false
-}
-
-// gameStateWrapper wraps the game state with metadata for serialization.
-type gameStateWrapper struct {
-	Game              *domain.Game `json:"game"`
-	UserControlledIDs []string     `json:"user_controlled_ids"` // IDs of players with nil strategy
-	GameID            string       `json:"game_id"`             // GameID for logging continuity
-}
-
 // ManualGameService handles the manual mode where the user inputs game events.
 type ManualGameService struct {
 	Game                *domain.Game
@@ -69,6 +25,76 @@ type ManualGameService struct {
 	GameID              string
 	secondChanceHandler *domain.SecondChanceHandler
 	History             GameHistory
+	Clock               func() time.Time  // Time source for turn durations; time.Now if nil
+	ScoreHistory        map[string][]int  // Player ID -> total score after each completed round
+	Stats               *domain.GameStats // Counts for the end-of-game screen; nil until the first one
+	initialDeal         *initialDealProgress
+	turnPlayerID        string          // Player whose turn prompt is showing; empty between turns
+	pendingHit          *turnAnalysis   // Advice shown for the current turn's draw, until the draw is processed
+	warnedAnomalies     map[string]bool // Anomalies already reported this round
+	// Messages is the language of the prompts and messages; nil is English.
+	Messages *console.Messages
+	// Out receives the prompts and messages; nil means os.Stdout.
+	Out io.Writer
+	// Events receives the domain events of the game, with Manual Mode's DecisionAnalyzed,
+	// ShadowDecided and match events. Logger logs them through a LoggerSink (see publish).
+	Events  domain.EventBus
+	logSink *LoggerSink
+	// Input is where the answers are read from; nil means a ReaderInput on Reader.
+	Input InputPort
+	// DeckTracker takes the cards drawn at the table out of the deck; nil means a
+	// TableDeckTracker reporting its reshuffles.
+	DeckTracker DeckTracker
+	// Persister makes the save codes and the undo history's steps; nil means a SaveCodec
+	// reporting the dealer it resets.
+	Persister StatePersister
+	// ShadowAdvisors shadow the user-controlled seats without affecting the game: at every
+	// hit/stay and target choice the user makes, what each advisor would have chosen is logged
+	// as a ShadowDecision event, and their agreement is summarized when the game ends.
+	ShadowAdvisors []domain.Strategy
+	shadowRecords  map[string]*shadowRecord // Advisor name -> its choices against the user's
+	// SuggestionAdvisors are the strategies asked for the suggested move and target, at most
+	// MaxSuggestionAdvisors of them (the rest are ignored). Empty means Adaptive alone, shown
+	// as a single suggestion; with more, every advisor's choice is shown and the majority's is
+	// the suggestion.
+	SuggestionAdvisors []domain.Strategy
+	// ExhaustionRule is the Game.ExhaustionRule of a new game set up by Run; a resumed game
+	// keeps its own. Empty means domain.ExhaustionBankHands.
+	ExhaustionRule domain.ExhaustionRule
+	// HoldableActions is the Game.HoldableActions of a new game set up by Run; a resumed game
+	// keeps its own. With it, a drawn Freeze or Flip Three stays in the hand until the user
+	// types PLAY F or PLAY T on a later turn of its holder.
+	HoldableActions bool
+	// ExportPath, if set, is where the final state of the game is written as JSON (see GameExport)
+	// once the game is over.
+	ExportPath string
+	// OpponentHitBelow is the hand score below which opponents are assumed to hit when the turn
+	// analysis estimates their Flip 7 threat; 0 means domain.DefaultOpponentHitBelow.
+	OpponentHitBelow int
+	// TurnOutlookModel plays the opponents when the turn analysis estimates how many more turns
+	// the player gets this round; nil means hitting below OpponentHitBelow, as Heuristic-27 does
+	// by default.
+	TurnOutlookModel domain.Strategy
+	// Profiles, if set, keeps lifetime stats of the people at the table. Setting up a new game
+	// then asks the user's name too, matches every name entered against the profiles (offering
+	// to create the missing ones), and a finished game is added to the players' profiles.
+	Profiles *ProfileStore
+	// Hybrid, in a new game set up by Run, asks who plays each seat (see SeatKind): a player at
+	// the table whose cards the user enters, the app's AI, or a person deciding in the app.
+	Hybrid bool
+	// AppSeats maps the IDs of the players whose seat the app plays to the strategy deciding for
+	// them (a console.HumanStrategy for a person playing in the app). Their cards are drawn from
+	// the tracked deck; everyone else is at the table. Save codes keep it (see AppSeat).
+	AppSeats map[string]domain.Strategy
+	// Memory is the memory model of the bust rates shown at a turn (domain.MemoryFull when
+	// empty). The MEM command switches to the next model mid-game.
+	Memory domain.MemoryModel
+	// MatchGames, above 1, makes a new game set up by Run the first of a best-of-MatchGames
+	// series (see Match), played one game after the other with the same players.
+	MatchGames int
+	// Match is the series the game belongs to, nil for a single game. Save codes keep it, so a
+	// resumed game goes on with the rest of its series.
+	Match *Match
 }
 
 // manualFlipThreeCardSource implements FlipThreeCardSource for manual mode.
@@ -76,21 +102,45 @@ type manualFlipThreeCardSource struct {
 	service *ManualGameService
 }
 
+// GetNextCard shows the target's hand and bust risk before prompting for forced card cardNum,
+// since both change from one forced draw to the next.
 func (ms *manualFlipThreeCardSource) GetNextCard(cardNum int, target *domain.Player) (domain.Card, error) {
+	ms.service.printFlipThreeProgress(cardNum, target)
+	if _, app := ms.service.appSeat(target); app {
+		card, err := ms.service.drawForApp(target)
+		if errors.Is(err, domain.ErrDeckEmpty) {
+			ms.service.exhaustRound()
+		}
+		return card, err
+	}
+
 	// Keep retrying until valid card is entered
 	for {
-		fmt.Printf("Input card %d/3 for %s: ", cardNum, target.Name)
-		input, _ := ms.service.Reader.ReadString('\n')
-		input = strings.TrimSpace(input)
+		ms.service.ask(console.MsgFlipThreeCardPrompt, console.Args{"number": cardNum, "name": target.Name})
+		input, ok := ms.service.readAnswer()
+		if !ok {
+			return domain.Card{}, errInputClosed
+		}
+		if strings.EqualFold(input, "EMPTY") {
+			ms.service.exhaustRound()
+			return domain.Card{}, fmt.Errorf("%w: no card left on the table", domain.ErrDeckEmpty)
+		}
 
 		card, err := ms.service.parseInput(input)
 		if err != nil {
-			fmt.Printf("Invalid input: %v. Try again.\n", err)
+			ms.service.say(console.MsgInvalidInput, console.Args{"err": err})
 			continue // Retry
 		}
 
 		if err := ms.service.removeCardFromDeck(card); err != nil {
-			fmt.Printf("Error: %v. Try again.\n", err)
+			if errors.Is(err, domain.ErrNoActiveRound) {
+				return domain.Card{}, err // Retrying cannot help
+			}
+			if errors.Is(err, domain.ErrDeckEmpty) {
+				ms.service.exhaustRound()
+				return domain.Card{}, err
+			}
+			ms.service.say(console.MsgErrorTryAgain, console.Args{"err": err})
 			continue // Retry
 		}
 
@@ -98,24 +148,44 @@ func (ms *manualFlipThreeCardSource) GetNextCard(cardNum int, target *domain.Pla
 	}
 }
 
+// printFlipThreeProgress shows and publishes the hand, hand score and bust risk target draws forced
+// card cardNum of a Flip Three with. There is no suggestion: the target has no choice.
+func (s *ManualGameService) printFlipThreeProgress(cardNum int, target *domain.Player) {
+	hand := target.CurrentHand
+	score := domain.NewScoreCalculator().Compute(hand).Total
+	risk := s.Game.CurrentRound.Deck.EstimateHitRisk(hand.NumberCards, hand.HasSecondChance())
+	s.say(console.MsgFlipThreeProgress, console.Args{
+		"name":   target.Name,
+		"number": cardNum,
+		"hand":   s.formatHand(hand),
+		"score":  score,
+		"rate":   risk * 100,
+	})
+	s.publish(domain.FlipThreeProgress{Player: target, Card: cardNum, HandScore: score, BustRate: risk})
+}
+
 // manualFlipThreeCardProcessor implements FlipThreeCardProcessor for manual mode.
 type manualFlipThreeCardProcessor struct {
 	service *ManualGameService
 }
 
 func (mp *manualFlipThreeCardProcessor) ProcessImmediateCard(target *domain.Player, card domain.Card) error {
-	mp.service.processCard(target, card)
+	mp.service.processDrawnCard(target, card, domain.DrawFlipThree)
 	return nil
 }
 
 func (mp *manualFlipThreeCardProcessor) ProcessQueuedAction(target *domain.Player, card domain.Card) error {
-	mp.service.processCard(target, card)
+	mp.service.resolveActionManual(target, card)
 	return nil
 }
 
+func (mp *manualFlipThreeCardProcessor) DiscardCard(card domain.Card) {
+	mp.service.Game.DiscardPile = append(mp.service.Game.DiscardPile, card)
+}
+
 // SelectTarget implements domain.TargetSelector interface for manual mode.
 func (s *ManualGameService) SelectTarget(actionType domain.ActionType, candidates []*domain.Player, actor *domain.Player) *domain.Player {
-	return s.promptForTarget(actionType, candidates, actor)
+	return s.chooseTarget(actionType, candidates, actor)
 }
 
 // NewManualGameService creates a new ManualGameService.
@@ -125,22 +195,122 @@ func NewManualGameService(reader *bufio.Reader, logger logger.GameLogger) *Manua
 		Logger:              logger,
 		GameID:              fmt.Sprintf("game_%d", time.Now().Unix()),
 		secondChanceHandler: domain.NewSecondChanceHandler(),
+		Clock:               time.Now,
+		Messages:            console.NewMessages(console.LanguageEnglish),
+	}
+}
+
+// NewManualGameServiceWithOutput is NewManualGameService printing the prompts and messages to
+// out instead of os.Stdout.
+func NewManualGameServiceWithOutput(reader *bufio.Reader, logger logger.GameLogger, out io.Writer) *ManualGameService {
+	s := NewManualGameService(reader, logger)
+	s.Out = out
+	return s
+}
+
+// out returns the writer for prompts and messages.
+func (s *ManualGameService) out() io.Writer {
+	if s.Out == nil {
+		return os.Stdout
 	}
+	return s.Out
+}
+
+// say prints the message id in the service's language on a line of its own.
+func (s *ManualGameService) say(id console.MessageID, args console.Args) {
+	fmt.Fprintln(s.out(), s.Messages.Format(id, args))
+}
+
+// ask prints the prompt id in the service's language, leaving the cursor on its line.
+func (s *ManualGameService) ask(id console.MessageID, args console.Args) {
+	fmt.Fprint(s.out(), s.Messages.Format(id, args))
+}
+
+// now returns the current time from the injected Clock.
+func (s *ManualGameService) now() time.Time {
+	if s.Clock == nil {
+		return time.Now()
+	}
+	return s.Clock()
 }
 
 // Run starts the manual game loop.
+// It returns early if the input ends before the game has been set up.
 func (s *ManualGameService) Run() {
-	fmt.Println("\n--- Manual Mode ---")
-	s.setupPlayers()
+	s.say(console.MsgManualModeHeader, nil)
+	if !s.setupPlayers() {
+		return
+	}
 	s.PushState() // Push initial state
+	if s.Match == nil {
+		s.gameLoop()
+		return
+	}
+	s.matchService().Run(s.Game)
+}
+
+// matchService returns the MatchService playing the series, its events published on Events.
+func (s *ManualGameService) matchService() *MatchService {
+	series := &MatchService{
+		Match:    s.Match,
+		PlayGame: s.playMatchGame,
+		Out:      s.out(),
+		Messages: s.Messages,
+	}
+	series.Events.Subscribe(domain.EventSinkFunc(s.publish))
+	return series
+}
+
+// playMatchGame plays game of the series to its end. A game other than the one set up or
+// resumed by Run is the next of the series: it starts afresh, with its own log ID and history.
+func (s *ManualGameService) playMatchGame(game *domain.Game) (string, bool) {
+	if game != s.Game {
+		s.Game = game
+		s.GameID = fmt.Sprintf("%s_game%d", s.Match.ID, s.Match.Played+1)
+		s.ScoreHistory = nil
+		s.Stats = nil
+		s.History = GameHistory{}
+		s.shadowRecords = nil
+		s.logGameStart()
+		s.say(console.MsgGameStarted, nil)
+		s.PushState()
+	}
 	s.gameLoop()
+	return s.GameID, s.Game.IsCompleted && s.Game.EndReason != domain.GameEndReasonAborted
 }
 
-func (s *ManualGameService) setupPlayers() {
-	fmt.Println("Do you want to resume a game? (Enter save code, file path, or press Enter to start new)")
-	fmt.Print("Save Code / File Path: ")
-	input, _ := s.Reader.ReadString('\n')
-	input = strings.TrimSpace(input)
+// uniqueName returns name, or name with the lowest free suffix (e.g. "Tom (2)") when one of
+// players already has it, ignoring case, and says so. Logs, profiles and the target prompts
+// tell players apart by name, so two players must never share one.
+func (s *ManualGameService) uniqueName(name string, players []*domain.Player) string {
+	taken := func(candidate string) bool {
+		for _, p := range players {
+			if strings.EqualFold(p.Name, candidate) {
+				return true
+			}
+		}
+		return false
+	}
+	if !taken(name) {
+		return name
+	}
+	unique := name
+	for n := 2; taken(unique); n++ {
+		unique = fmt.Sprintf("%s (%d)", name, n)
+	}
+	s.say(console.MsgDuplicateName, console.Args{"name": name, "unique": unique})
+	return unique
+}
+
+// setupPlayers resumes a saved game or sets up a new one from the prompts.
+// It returns false if the input ended before the game was ready.
+func (s *ManualGameService) setupPlayers() bool {
+	s.say(console.MsgResumePrompt, nil)
+	s.ask(console.MsgSaveCodePrompt, nil)
+	input, ok := s.readLine()
+	if !ok {
+		return false
+	}
 
 	saveCode := input
 	// Check if input is a file (and not just a short string that happens to match a filename, though unlikely for a JWT)
@@ -149,84 +319,125 @@ func (s *ManualGameService) setupPlayers() {
 		if _, err := os.Stat(input); err == nil {
 			content, err := os.ReadFile(input)
 			if err == nil {
-				saveCode = strings.TrimSpace(string(content))
-				fmt.Printf("Read save code from file: %s\n", input)
+				saveCode = cleanInput(string(content))
+				s.say(console.MsgReadSaveFile, console.Args{"path": input})
 			}
 		}
 	}
 
 	if saveCode != "" {
 		if err := s.LoadState(saveCode); err != nil {
-			fmt.Printf("Failed to load game: %v. Starting new game.\n", err)
+			s.say(console.MsgLoadFailed, console.Args{"err": err})
 		} else {
-			fmt.Println("Game resumed successfully!")
+			s.say(console.MsgResumed, nil)
 			s.History = GameHistory{} // Clear history for resumed game to avoid mix-ups
-			return
+			return true
 		}
 	}
 
-	fmt.Print("Enter number of players: ")
-	numPlayersStr, err := s.Reader.ReadString('\n')
-	if err != nil {
-		fmt.Println("Error reading input. Defaulting to 2 players.")
-		numPlayersStr = "2"
+	s.ask(console.MsgPlayerCountPrompt, nil)
+	numPlayersStr, ok := s.readAnswer()
+	if !ok {
+		return false
 	}
-	numPlayers, err := strconv.Atoi(strings.TrimSpace(numPlayersStr))
-	if err != nil || numPlayers < 1 {
-		fmt.Println("Invalid number of players. Defaulting to 2.")
+	numPlayers, err := strconv.Atoi(numPlayersStr)
+	if err != nil || numPlayers < 1 || numPlayers > maxTablePlayers {
+		s.say(console.MsgInvalidPlayerCount, nil)
 		numPlayers = 2
 	}
 
 	var players []*domain.Player
 
 	// Setup "Me" (User)
-	me := domain.NewPlayer("Me", nil) // Strategy is nil because user controls it
+	myName := "Me"
+	if s.Profiles != nil {
+		s.ask(console.MsgYourNamePrompt, nil)
+		name, ok := s.readLine()
+		if !ok {
+			return false
+		}
+		if name != "" {
+			if myName, ok = s.matchProfile(name); !ok {
+				return false
+			}
+		}
+	}
+	me := domain.NewPlayer(myName, nil) // Strategy is nil because user controls it
 	players = append(players, me)
 
 	// Setup other players
 	for i := 1; i < numPlayers; i++ {
-		fmt.Printf("Enter name for Player %d: ", i+1)
-		name, err := s.Reader.ReadString('\n')
-		if err != nil {
-			name = ""
+		s.ask(console.MsgPlayerNamePrompt, console.Args{"number": i + 1})
+		name, ok := s.readLine()
+		if !ok {
+			return false
 		}
-		name = strings.TrimSpace(name)
 		if name == "" {
 			name = fmt.Sprintf("Player %d", i+1)
+		} else if s.Profiles != nil {
+			if name, ok = s.matchProfile(name); !ok {
+				return false
+			}
 		}
+		name = s.uniqueName(name, players)
 		// Assign a default strategy for others just to satisfy the struct, though we won't use it for decision making in manual mode
 		// actually, we might want to use it for "Best Choice" suggestions if we were simulating them, but here we just track state.
 		// Let's use ProbabilisticStrategy as a placeholder.
 		players = append(players, domain.NewPlayer(name, &strategy.ProbabilisticStrategy{}))
 	}
+	if s.Hybrid && !s.setupSeats(players) {
+		return false
+	}
 
 	// Set start player
-	fmt.Println("Select start player:")
+	s.say(console.MsgStartPlayerPrompt, nil)
 	for i, p := range players {
-		fmt.Printf("%d. %s\n", i+1, p.Name)
+		fmt.Fprintf(s.out(), "%d. %s\n", i+1, p.Name)
 	}
-	fmt.Print("Enter choice: ")
-	startIdxStr, err := s.Reader.ReadString('\n')
-	if err != nil {
-		startIdxStr = "1"
+	s.ask(console.MsgChoicePrompt, nil)
+	startIdxStr, ok := s.readAnswer()
+	if !ok {
+		return false
 	}
-	startIdx, err := strconv.Atoi(strings.TrimSpace(startIdxStr))
+	startIdx, err := strconv.Atoi(startIdxStr)
 	if err != nil || startIdx < 1 || startIdx > numPlayers {
-		fmt.Println("Invalid choice. Defaulting to Me.")
+		s.say(console.MsgInvalidStartPlayer, nil)
 		startIdx = 1
 	}
 
+	s.ask(console.MsgWinningScorePrompt, console.Args{"score": domain.WinningThreshold})
+	winningScoreStr, ok := s.readAnswer()
+	if !ok {
+		return false
+	}
+	winningScore := domain.WinningThreshold
+	if winningScoreStr != "" {
+		winningScore, err = strconv.Atoi(winningScoreStr)
+		if err != nil || winningScore < 1 {
+			s.say(console.MsgInvalidWinningScore, console.Args{"score": domain.WinningThreshold})
+			winningScore = domain.WinningThreshold
+		}
+	}
+
 	s.Game = domain.NewGame(players)
+	s.Game.ExhaustionRule = s.ExhaustionRule
+	s.Game.HoldableActions = s.HoldableActions
 	s.Game.DealerIndex = startIdx - 1 // Set initial dealer index
-
-	if s.Logger != nil {
-		s.Logger.Log(s.GameID, "0", "system", "GameStart", map[string]interface{}{
-			"num_players": len(players),
-			"players":     getPlayerNames(players),
-		})
+	s.Game.WinningScore = winningScore
+	if s.MatchGames > 1 {
+		s.Match = NewMatch(fmt.Sprintf("match_%d", s.now().Unix()), s.MatchGames, s.Game.DealerIndex)
+		s.GameID = s.Match.ID + "_game1"
+		s.matchService().Start(players)
 	}
 
-	fmt.Println("Game started!")
+	s.logGameStart()
+	s.say(console.MsgGameStarted, nil)
+	return true
+}
+
+// logGameStart announces the game that starts, with its players and target.
+func (s *ManualGameService) logGameStart() {
+	s.publish(domain.GameStarted{Game: s.Game})
 }
 
 func getPlayerNames(players []*domain.Player) []string {
@@ -237,398 +448,280 @@ func getPlayerNames(players []*domain.Player) []string {
 	return names
 }
 
-func (s *ManualGameService) gameLoop() {
-	for !s.Game.IsCompleted {
-		s.Game.RoundCount++
-		s.playRound()
-		// Collect cards from players' hands to discard pile at end of round
-		if s.Game.CurrentRound != nil { // Could be nil on first iteration or error
-			for _, p := range s.Game.Players {
-				// Number cards
-				for _, val := range p.CurrentHand.RawNumberCards {
-					s.Game.DiscardPile = append(s.Game.DiscardPile, domain.Card{Type: domain.CardTypeNumber, Value: val})
-				}
-				// Modifier cards
-				s.Game.DiscardPile = append(s.Game.DiscardPile, p.CurrentHand.ModifierCards...)
-				// Action cards
-				s.Game.DiscardPile = append(s.Game.DiscardPile, p.CurrentHand.ActionCards...)
-
-				// Clear the hand after collecting to prevent double counting or memory issues
-				p.CurrentHand.RawNumberCards = nil
-				p.CurrentHand.ModifierCards = nil
-				p.CurrentHand.ActionCards = nil
-			}
-		}
+func getPlayerIDs(players []*domain.Player) []string {
+	ids := make([]string, len(players))
+	for i, p := range players {
+		ids[i] = p.ID.String()
+	}
+	return ids
+}
 
-		// Rotate dealer for next round
-		s.Game.DealerIndex = (s.Game.DealerIndex + 1) % len(s.Game.Players)
+// printRoundSummary prints the recap of the round that just ended and publishes its end.
+func (s *ManualGameService) printRoundSummary() {
+	round := s.Game.CurrentRound
+	fmt.Fprint(s.out(), s.Messages.RoundSummary(round.Summary(s.Game.RoundCount)))
+	s.publish(domain.RoundEnded{Round: round, Number: s.Game.RoundCount, Reason: round.EndReason})
+}
 
-		// Check for winners
-		winners := s.Game.DetermineWinners()
-		if len(winners) > 0 {
-			s.Game.Winners = winners
-			s.Game.IsCompleted = true
-		}
-		// Update deck reference for the next round
-		// If a reshuffle happened during PlayRound, s.Game.CurrentRound.Deck points to the new deck.
-		if s.Game.CurrentRound != nil && s.Game.CurrentRound.Deck != nil {
-			s.Game.Deck = s.Game.CurrentRound.Deck
-		}
+// recordScoreHistory appends every player's total score for the round that just ended.
+func (s *ManualGameService) recordScoreHistory() {
+	if s.ScoreHistory == nil {
+		s.ScoreHistory = make(map[string][]int)
 	}
-	s.printWinner()
-
-	if s.Logger != nil {
-		s.Logger.Log(s.GameID, strconv.Itoa(s.Game.RoundCount), "system", "GameEnd", map[string]interface{}{
-			"winners": getPlayerNames(s.Game.Winners),
-		})
+	for _, p := range s.Game.Players {
+		id := p.ID.String()
+		s.ScoreHistory[id] = append(s.ScoreHistory[id], p.TotalScore)
 	}
+	s.stats().RecordRound(s.Game.Players)
 }
 
-func (s *ManualGameService) playRound() {
-	// Initialize new round only if not resuming
-	if s.Game.CurrentRound == nil || s.Game.CurrentRound.IsEnded {
-		if s.Game.Deck == nil {
-			s.Game.Deck = domain.NewDeck()
-		}
-		dealer := s.Game.Players[s.Game.DealerIndex]
-		s.Game.CurrentRound = domain.NewRound(s.Game.Players, dealer, s.Game.Deck)
-		fmt.Printf("\n--- New Round! Dealer: %s ---\n", dealer.Name)
-
-		if s.Logger != nil {
-			s.Logger.Log(s.GameID, strconv.Itoa(s.Game.RoundCount), "system", "RoundStart", map[string]interface{}{
-				"dealer": dealer.Name,
-			})
-		}
-		// Push state at start of new round (stable point)
-		s.PushState()
-	} else {
-		fmt.Println("Resuming round...")
+// stats returns the counts for the end-of-game screen, starting them if needed.
+func (s *ManualGameService) stats() *domain.GameStats {
+	if s.Stats == nil {
+		s.Stats = domain.NewGameStats()
 	}
+	return s.Stats
+}
 
-	for !s.Game.CurrentRound.IsEnded {
-		// Label for restarting turn loop if undo/redo happens
-	StartOfTurn:
-		if len(s.Game.CurrentRound.ActivePlayers) == 0 {
-			s.Game.CurrentRound.End(domain.RoundEndReasonNoActivePlayers)
-			break
-		}
-
-		// Robustness check: Ensure there is at least one player with Active status
-		hasActive := false
-		for _, p := range s.Game.CurrentRound.ActivePlayers {
-			if p.CurrentHand.Status == domain.HandStatusActive {
-				hasActive = true
-				break
-			}
-		}
-		if !hasActive {
-			s.Game.CurrentRound.End(domain.RoundEndReasonNoActivePlayers)
-			break
-		}
-
-		// Ensure index is valid
-		if s.Game.CurrentRound.CurrentTurnIndex >= len(s.Game.CurrentRound.ActivePlayers) {
-			s.Game.CurrentRound.CurrentTurnIndex = 0
-		}
-
-		currentPlayer := s.Game.CurrentRound.ActivePlayers[s.Game.CurrentRound.CurrentTurnIndex]
-
-		// Skip players who are not active (busted, stayed, frozen)
-		if currentPlayer.CurrentHand.Status != domain.HandStatusActive {
-			// Fix: Remove inactive player from ActivePlayers list to prevent infinite loops
-			// and ensure the round can end (RoundEndReasonNoActivePlayers).
-			s.Game.CurrentRound.RemoveActivePlayer(currentPlayer)
-			continue
+func (s *ManualGameService) findPlayer(id string) *domain.Player {
+	for _, p := range s.Game.Players {
+		if p.ID.String() == id {
+			return p
 		}
+	}
+	return nil
+}
 
-		// Save Code is hidden by default. Use 'SAVE' command to view.
-
-		fmt.Printf("\n>>> Turn: %s (Score: %d)\n", currentPlayer.Name, currentPlayer.TotalScore)
-
-		calc := domain.NewScoreCalculator()
-		score := calc.Compute(currentPlayer.CurrentHand)
-
-		if s.Logger != nil {
-			s.Logger.Log(s.GameID, strconv.Itoa(s.Game.RoundCount), currentPlayer.ID.String(), "TurnStart", map[string]interface{}{
-				"score":      currentPlayer.TotalScore,
-				"hand_score": score.Total,
-			})
-		}
+// turnAnalysis is the advice shown at the start of a turn.
+type turnAnalysis struct {
+	player    *domain.Player // Whose turn it was shown for
+	bustRate  float64
+	suggested domain.TurnChoice
+	handScore int      // Points banked by staying now
+	evIfHit   float64  // Expected hand score after one more card (a bust counts as 0)
+	shadows   []string // Each shadow advisor's choice, in the order of ShadowAdvisors
+}
 
-		// Show current hand score before input
-		fmt.Printf("Current Hand: %s | Score: %d\n", s.formatHand(currentPlayer.CurrentHand), score.Total)
+func (s *ManualGameService) analyzeState(p *domain.Player) turnAnalysis {
+	deck := s.Game.CurrentRound.Deck
+	outcome := domain.NewHitOutcomeAnalyzer().Analyze(deck, p.CurrentHand)
 
-		s.analyzeState(currentPlayer)
+	risk := s.printBustRate(p)
+	draws := domain.SafeDraws(p.CurrentHand, deck)
+	fmt.Fprintln(s.out(), drawBreakdownSummary(s.Messages, draws))
+	if draws.ToFlip7 == 1 {
+		s.say(console.MsgFlip7Chance, console.Args{"chance": draws.Flip7Chance * 100})
+	}
+	if remaining := deck.Remaining(); remaining <= domain.LowDeckThreshold {
+		s.say(console.MsgLowDeck, console.Args{"count": remaining})
+	}
+	s.printFlip7Threat(p, deck)
+	s.printTurnOutlook(p, deck)
 
-		// Input loop for this turn (single action)
-		turnEnded := false
-		playerRemoved := false
-		shouldRestartTurn := false
+	fmt.Fprintln(s.out(), roundTargetSummary(s.Messages, domain.RoundTargets(p, s.getOpponents(p), s.Game.TargetScore())))
+	for _, w := range domain.StayingWinners(s.opponentsInPlay(p), s.Game.TargetScore()) {
+		s.say(console.MsgStayingWinner, console.Args{"name": w.Player.Name, "total": w.Total})
+	}
 
-		for !turnEnded {
-			fmt.Print("Input (0-12, +N, x2, F, T, C, S, U/UNDO/<, R/REDO/>, SAVE): ")
-			input, err := s.Reader.ReadString('\n')
-			if err != nil {
-				fmt.Println("Error reading input. Exiting game.")
-				s.Game.IsCompleted = true
-				return
-			}
-			input = strings.TrimSpace(input)
+	choice := s.suggestMove(p, deck)
 
-			// Check for Undo/Redo
-			if strings.EqualFold(input, "U") || strings.EqualFold(input, "UNDO") || input == "<" {
-				s.Undo()
-				shouldRestartTurn = true
-				break
-			}
-			if strings.EqualFold(input, "R") || strings.EqualFold(input, "REDO") || input == ">" {
-				s.Redo()
-				shouldRestartTurn = true
-				break
-			}
+	return turnAnalysis{
+		player:    p,
+		bustRate:  risk,
+		suggested: choice,
+		handScore: outcome.StayScore,
+		evIfHit:   outcome.ExpectedScore,
+		shadows:   s.shadowTurn(p, deck),
+	}
+}
 
-			// Check for SAVE command
-			if strings.EqualFold(input, "SAVE") {
-				code, err := s.SaveState()
-				if err == nil {
-					fmt.Printf("\n[Save Code]: %s\n", code)
-				} else {
-					fmt.Printf("\nFailed to generate save code: %v\n", err)
-				}
-				continue
-			}
+// printBustRate shows p's bust rate on the next hit and within a few hits under the Memory
+// model, naming the model and noting a reshuffle this round, and returns the bust rate.
+func (s *ManualGameService) printBustRate(p *domain.Player) float64 {
+	model := s.memory()
+	deck := model.Deck(s.Game, p)
+	risk := deck.EstimateHitRisk(p.CurrentHand.NumberCards, p.CurrentHand.HasSecondChance())
+	note := ""
+	if s.Game.CurrentRound.Reshuffles > 0 {
+		note = s.Messages.Format(console.MsgReshuffledThisRound, nil) // The counts start over
+	}
+	s.say(console.MsgBustRate, console.Args{"rate": risk * 100, "memory": s.Messages.MemoryModelName(model), "note": note})
+	s.printBustWithin(p.CurrentHand, deck)
+	return risk
+}
 
-			if strings.EqualFold(input, "S") {
-				// Validation: Cannot stay on first turn (empty hand) unless special conditions met
-				if !currentPlayer.CurrentHand.CanStay() {
-					fmt.Println("Invalid move: You must hit on your first turn (unless you have points or specific actions)!")
-					continue
-				}
-
-				currentPlayer.CurrentHand.Status = domain.HandStatusStayed
-				score := currentPlayer.BankCurrentHand()
-				fmt.Printf("%s banked %d points! Total: %d\n", currentPlayer.Name, score, currentPlayer.TotalScore)
-
-				if s.Logger != nil {
-					s.Logger.Log(s.GameID, strconv.Itoa(s.Game.RoundCount), currentPlayer.ID.String(), "Stay", map[string]interface{}{
-						"banked_score": score,
-						"total_score":  currentPlayer.TotalScore,
-					})
-				}
-
-				s.Game.CurrentRound.RemoveActivePlayer(currentPlayer)
-				playerRemoved = true
-				turnEnded = true
-			} else {
-				// Parse card or action
-				card, err := s.parseInput(input)
-				if err != nil {
-					fmt.Printf("Invalid input: %v. Try again.\n", err)
-					continue
-				}
-
-				// Remove card from deck (tracking)
-				if err := s.removeCardFromDeck(card); err != nil {
-					fmt.Printf("Error: %v. Try again.\n", err)
-					continue
-				}
-
-				// Process card
-				s.processCard(currentPlayer, card)
-
-				// Check if player was removed (Freeze only)
-				// processCard calls RemoveActivePlayer only for Freeze actions.
-				// Note: Flip7 ends the round without removing the player.
-				// Note: Busted players are NOT removed from ActivePlayers; only their status changes.
-				// We need to check if currentPlayer is still in ActivePlayers.
-				isActive := false
-				for _, p := range s.Game.CurrentRound.ActivePlayers {
-					if p.ID == currentPlayer.ID {
-						isActive = true
-						break
-					}
-				}
-				if !isActive {
-					playerRemoved = true
-				}
-
-				// Turn always ends after one action (Hit) or Action card
-				turnEnded = true
-			}
-		}
+// memory returns the Memory model, domain.MemoryFull when none is set.
+func (s *ManualGameService) memory() domain.MemoryModel {
+	if s.Memory == "" {
+		return domain.MemoryFull
+	}
+	return s.Memory
+}
 
-		if shouldRestartTurn {
-			goto StartOfTurn
-		}
+// switchMemory moves on to the next memory model and shows p's bust rate under it.
+func (s *ManualGameService) switchMemory(p *domain.Player) {
+	s.Memory = s.memory().Next()
+	s.say(console.MsgMemorySwitched, console.Args{"memory": s.Messages.MemoryModelName(s.Memory)})
+	s.printBustRate(p)
+}
 
-		// Check if round ended during this loop (Flip 7 or all stayed)
-		if s.Game.CurrentRound.IsEnded {
-			// Do NOT push state here to avoid "loop of death" on undo.
-			// Let gameLoop transition to new round and push there.
-			break
-		}
+// printBustWithin shows the chance that hand busts within one, two and three more hits (see
+// domain.Deck.EstimateMultiHitRisk), as when planning to take a few cards and stay.
+func (s *ManualGameService) printBustWithin(hand *domain.PlayerHand, deck *domain.Deck) {
+	within := func(hits int) float64 {
+		return deck.EstimateMultiHitRisk(hand.NumberCards, hand.HasSecondChance(), hits) * 100
+	}
+	s.say(console.MsgBustWithin, console.Args{"one": within(1), "two": within(2), "three": within(3)})
+}
 
-		// Update Turn Index
-		if !playerRemoved {
-			s.Game.CurrentRound.CurrentTurnIndex++
+// flip7ThreatMinUnique is the fewest distinct numbers an opponent must hold for the turn
+// analysis to show the Flip 7 threat; below that it is close to 0 and only adds noise.
+const flip7ThreatMinUnique = 5
+
+// printFlip7Threat shows the chance that an opponent still in play completes Flip 7 before the
+// turn comes back to p, once one of them holds flip7ThreatMinUnique distinct numbers.
+func (s *ManualGameService) printFlip7Threat(p *domain.Player, deck *domain.Deck) {
+	var hands []*domain.PlayerHand
+	threatened := false
+	for _, other := range s.opponentsInPlay(p) {
+		hands = append(hands, other.CurrentHand)
+		if len(other.CurrentHand.NumberCards) >= flip7ThreatMinUnique {
+			threatened = true
 		}
-		// If player removed (Freeze action), the next player slides into the current index, so we don't increment.
-		// Busted players remain in ActivePlayers but are skipped via the status check at the start of the loop.
-
-		// Push state if action successful and round not ended
-		// We push AFTER updating the turn index so that the saved state points to the NEXT player's turn.
-		// This ensures that when we Undo, we return to the start of the turn that was just completed (or rather,
-		// we return to the state where the previous player has finished, and it is now the current player's turn).
-		s.PushState()
 	}
+	if !threatened {
+		return
+	}
+	threat := domain.Flip7ThreatEstimator{HitBelow: s.OpponentHitBelow}.Estimate(deck, hands)
+	s.say(console.MsgOpponentFlip7Threat, console.Args{"chance": threat * 100})
 }
 
-func (s *ManualGameService) analyzeState(p *domain.Player) {
-	// Show bust rate
-	risk := s.Game.CurrentRound.Deck.EstimateHitRisk(p.CurrentHand.NumberCards, p.CurrentHand.HasSecondChance())
-	fmt.Printf("Bust Rate: %.2f%%\n", risk*100)
-
-	// Suggest best choice
-	adaptive := strategy.NewAdaptiveStrategy()
-	choice := adaptive.Decide(s.Game.CurrentRound.Deck, p.CurrentHand, p.TotalScore, s.getOpponents(p))
-	fmt.Printf("Suggested Move: %s\n", choice)
+// printTurnOutlook shows how many more turns p can expect this round (see domain.TurnPredictor).
+func (s *ManualGameService) printTurnOutlook(p *domain.Player, deck *domain.Deck) {
+	predictor := domain.TurnPredictor{Opponent: s.TurnOutlookModel, HitBelow: s.OpponentHitBelow}
+	prediction := predictor.Predict(deck, s.opponentsInPlay(p))
+	if prediction.Alone {
+		s.say(console.MsgTurnOutlookAlone, nil)
+		return
+	}
+	s.say(console.MsgTurnOutlook, console.Args{"turns": prediction.ExpectedTurns, "chance": prediction.EndsFirst * 100})
 }
 
-func (s *ManualGameService) getOpponents(p *domain.Player) []*domain.Player {
+// opponentsInPlay returns the other players still in the round, in the order they play after p.
+func (s *ManualGameService) opponentsInPlay(p *domain.Player) []*domain.Player {
+	round := s.Game.CurrentRound
+	start := 0
+	for i, other := range round.ActivePlayers {
+		if other.ID == p.ID {
+			start = i + 1
+		}
+	}
 	var opponents []*domain.Player
-	for _, other := range s.Game.Players {
-		if other.ID != p.ID {
-			opponents = append(opponents, other)
+	for k := 0; k < len(round.ActivePlayers); k++ {
+		other := round.ActivePlayers[(start+k)%len(round.ActivePlayers)]
+		if other.ID == p.ID || other.CurrentHand == nil || other.CurrentHand.Status != domain.HandStatusActive {
+			continue
 		}
+		opponents = append(opponents, other)
 	}
 	return opponents
 }
 
-func (s *ManualGameService) parseInput(input string) (domain.Card, error) {
-	input = strings.ToUpper(input)
-
-	// Modifiers
-	switch input {
-	case "+2":
-		return domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus2}, nil
-	case "+4":
-		return domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4}, nil
-	case "+6":
-		return domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus6}, nil
-	case "+8":
-		return domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus8}, nil
-	case "+10":
-		return domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus10}, nil
-	case "X2", "*2":
-		return domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}, nil
-	}
-
-	// Actions
-	switch input {
-	case "F":
-		return domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}, nil
-	case "T":
-		return domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}, nil
-	case "C":
-		return domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}, nil
-	}
-
-	// Numbers
-	if val, err := strconv.Atoi(input); err == nil {
-		if val >= 0 && val <= 12 {
-			return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(val)}, nil
-		}
-		return domain.Card{}, fmt.Errorf("number out of range")
+// roundTargetSummary formats a RoundTarget as one line in the language of m,
+// e.g. "Need +17 to lead, +62 to win; Bob would pass by staying now (+28)".
+func roundTargetSummary(m *console.Messages, t domain.RoundTarget) string {
+	lead := m.Format(console.MsgStayingLeads, nil)
+	if t.ToLead > 0 {
+		lead = m.Format(console.MsgNeedToLead, console.Args{"points": t.ToLead})
 	}
-
-	return domain.Card{}, fmt.Errorf("unknown input")
+	win := m.Format(console.MsgStayingWins, nil)
+	if t.ToWin > 0 {
+		win = m.Format(console.MsgNeedToWin, console.Args{"points": t.ToWin})
+	}
+	leapfrog := ""
+	if t.Leapfrog != nil {
+		leapfrog = m.Format(console.MsgLeapfrog, console.Args{"name": t.Leapfrog.Name, "points": t.LeapfrogPoints})
+	}
+	return m.Format(console.MsgRoundTargets, console.Args{"lead": lead, "win": win, "leapfrog": leapfrog})
 }
 
-func (s *ManualGameService) removeCardFromDeck(card domain.Card) error {
-	// Check if deck is active
-	if s.Game.CurrentRound == nil || s.Game.CurrentRound.Deck == nil {
-		return fmt.Errorf("no active round/deck")
+// drawBreakdownSummary formats a SafeDrawBreakdown as one line in the language of m, e.g.
+// "Unique numbers: 5/7 — safe values remaining: 0,2,4 (9 cards), unsafe: 3,9 (4 cards)".
+func drawBreakdownSummary(m *console.Messages, b domain.SafeDrawBreakdown) string {
+	values := func(draws []domain.NumberDraw) string {
+		if len(draws) == 0 {
+			return m.Format(console.MsgNoValues, nil)
+		}
+		parts := make([]string, len(draws))
+		for i, d := range draws {
+			parts[i] = strconv.Itoa(int(d.Value))
+		}
+		return strings.Join(parts, ",")
 	}
+	return m.Format(console.MsgDrawBreakdown, console.Args{
+		"unique":      b.UniqueNumbers,
+		"safe":        values(b.Safe),
+		"safeCards":   b.SafeCards,
+		"unsafe":      values(b.Unsafe),
+		"unsafeCards": b.UnsafeCards,
+	})
+}
 
-	deck := s.Game.CurrentRound.Deck
-
-	// Helper to find and remove card
-	findAndRemove := func(d *domain.Deck, target domain.Card) bool {
-		for i, c := range d.Cards {
-			match := false
-			if c.Type == target.Type {
-				if c.Type == domain.CardTypeNumber && c.Value == target.Value {
-					match = true
-				} else if c.Type == domain.CardTypeModifier && c.ModifierType == target.ModifierType {
-					match = true
-				} else if c.Type == domain.CardTypeAction && c.ActionType == target.ActionType {
-					match = true
-				}
-			}
+// printWhatIf prints a compact comparison of staying now versus hitting once or twice.
+// It only reads the deck and hand, so it can be used at any point of a turn.
+func (s *ManualGameService) printWhatIf(p *domain.Player) {
+	outcome := domain.NewHitOutcomeAnalyzer().Analyze(s.Game.CurrentRound.Deck, p.CurrentHand)
+	s.say(console.MsgWhatIfHeader, console.Args{"name": p.Name})
+	s.say(console.MsgWhatIfStay, console.Args{"points": outcome.StayScore})
+	s.say(console.MsgWhatIfHitOnce, console.Args{
+		"bust":     outcome.BustProbability * 100,
+		"flip7":    outcome.Flip7Probability * 100,
+		"safe":     outcome.ExpectedScoreIfSafe,
+		"expected": outcome.ExpectedScore,
+	})
+	s.say(console.MsgWhatIfHitTwice, console.Args{
+		"bust":     outcome.TwoDrawBustProbability * 100,
+		"expected": outcome.TwoDrawExpectedScore,
+	})
+}
 
-			if match {
-				// Remove it
-				d.Cards = append(d.Cards[:i], d.Cards[i+1:]...)
-				// Update counts if number
-				if target.Type == domain.CardTypeNumber {
-					d.RemainingCounts[target.Value]--
-				}
-				return true
-			}
+// warnInconsistencies prints the anomalies (see domain.ConsistencyChecker) that the last card
+// revealed, usually a card entered for the wrong player or not at all. Each is reported once a
+// round; input is never blocked, as the table may be right and the entry wrong or vice versa.
+func (s *ManualGameService) warnInconsistencies() {
+	if s.warnedAnomalies == nil {
+		s.warnedAnomalies = make(map[string]bool)
+	}
+	for _, a := range domain.NewConsistencyChecker().Check(s.Game) {
+		if s.warnedAnomalies[a.String()] {
+			continue
 		}
-		return false
+		s.warnedAnomalies[a.String()] = true
+		s.say(console.MsgAnomalyWarning, console.Args{"problem": a})
 	}
+}
 
-	// Try removing from current deck
-	if findAndRemove(deck, card) {
-		return nil
+// printAudit lists every anomaly of the current round (the CHECK command).
+func (s *ManualGameService) printAudit() {
+	anomalies := domain.NewConsistencyChecker().Check(s.Game)
+	if len(anomalies) == 0 {
+		s.say(console.MsgAuditClean, nil)
+		return
 	}
+	s.say(console.MsgAuditProblems, console.Args{"count": len(anomalies)})
+	for _, a := range anomalies {
+		fmt.Fprintf(s.out(), "  - %s\n", a)
+	}
+}
 
-	// If not found, it might be because the deck is empty (or the card is simply not there).
-	// We should try to replenish the deck from the discard pile IF the deck is effectively empty
-	// relative to the user's perception (i.e., they are trying to draw a card that should be there).
-	// However, we don't know "what" they are trying to draw until they input it.
-	// If the card IS valid but just not in the current small deck remnant, we reshuffle.
-
-	if len(s.Game.DiscardPile) > 0 {
-		fmt.Printf("Card not found in current deck. Attempting to reshuffle %d cards from discard pile...\n", len(s.Game.DiscardPile))
-		if s.Logger != nil {
-			s.Logger.Log(s.GameID, strconv.Itoa(s.Game.RoundCount), "system", "Reshuffle", map[string]interface{}{
-				"discard_count": len(s.Game.DiscardPile),
-			})
-		}
-
-		// Create new deck from discards
-		newDeck := domain.NewDeckFromCards(s.Game.DiscardPile)
-		// Append existing deck cards to new deck (in case there were a few left)
-		if len(deck.Cards) > 0 {
-			newDeck.Cards = append(newDeck.Cards, deck.Cards...)
-			// Re-calculate counts or merge counts (simpler to just rebuild counts from all cards)
-			// But NewDeckFromCards already builds counts from input. We need to add existing.
-			for _, c := range deck.Cards {
-				if c.Type == domain.CardTypeNumber {
-					newDeck.RemainingCounts[c.Value]++
-				}
-			}
-			// Only shuffle again if we added existing cards, otherwise NewDeckFromCards shuffle is sufficient.
-			// Actually, if we append cards to the end, we SHOULD shuffle everything again to mix them.
-			// Wait, the PR comment said: "NewDeckFromCards already shuffles... but then you only shuffle again if there were remaining cards... This creates inconsistent behavior".
-			// Suggestion: Move shuffle outside.
-		}
-		newDeck.Shuffle()
-
-		// Update references
-		s.Game.CurrentRound.Deck = newDeck
-		s.Game.Deck = newDeck
-		s.Game.DiscardPile = []domain.Card{} // Clear discard pile
-
-		// Try removing again from the new deck
-		if findAndRemove(s.Game.CurrentRound.Deck, card) {
-			return nil
+func (s *ManualGameService) getOpponents(p *domain.Player) []*domain.Player {
+	var opponents []*domain.Player
+	for _, other := range s.Game.Players {
+		if other.ID != p.ID {
+			opponents = append(opponents, other)
 		}
 	}
-
-	return fmt.Errorf("card not found in deck (already drawn?)")
+	return opponents
 }
 
 // processCard handles the logic of adding a card to a player's hand and resolving its effects.
@@ -641,6 +734,8 @@ func (s *ManualGameService) removeCardFromDeck(card domain.Card) error {
 //     1. The drawer must choose a target before knowing the full outcome
 //     2. The target processes the effect (e.g., draws 3 cards for Flip Three)
 //     3. Only after resolution does the drawer add the action card to their own hand
+//     If the resolution ended the round (Flip 7 during Flip Three), the drawer's hand is not
+//     touched and the card goes straight to the discard pile.
 //
 //   - Second Chance: Added to drawer's hand immediately. Per domain model (lines 173-175),
 //     if the drawer already has one, they must pass it to another active player (or discard
@@ -656,119 +751,244 @@ func (s *ManualGameService) removeCardFromDeck(card domain.Card) error {
 //
 // Number/Modifier Cards: Added to the player's hand immediately, checked for bust/flip7.
 func (s *ManualGameService) processCard(p *domain.Player, card domain.Card) {
-	fmt.Printf("Played: %v\n", card)
+	s.processDrawnCard(p, card, domain.DrawHit)
+}
 
-	if s.Logger != nil {
-		s.Logger.Log(s.GameID, strconv.Itoa(s.Game.RoundCount), p.ID.String(), "CardPlayed", map[string]interface{}{
-			"card": card.String(),
-		})
+// processDrawnCard is processCard for a card drawn the way source tells, which is published
+// with the draw.
+func (s *ManualGameService) processDrawnCard(p *domain.Player, card domain.Card, source domain.DrawSource) {
+	s.say(console.MsgPlayed, console.Args{"card": card})
+
+	// Only the turn's own draw was made at the risk shown before it; cards drawn while it
+	// resolves (Flip Three) were not.
+	pending := s.pendingHit
+	s.pendingHit = nil
+	if pending != nil && pending.player != p {
+		pending = nil
 	}
 
+	s.publish(domain.CardDrawn{Player: p, Card: card, Source: source})
+
+	// The player flipped this card, even if it ends up passed or discarded.
+	p.CurrentHand.HasDrawnThisRound = true
+
 	// Special handling for Second Chance BEFORE adding to hand
 	if card.Type == domain.CardTypeAction && card.ActionType == domain.ActionSecondChance {
 		result := s.secondChanceHandler.HandleSecondChance(p, s.Game.CurrentRound.ActivePlayers, s)
 
 		if result.ShouldDiscard {
-			fmt.Println("All other active players already have a Second Chance. Discarding card.")
-			fmt.Println("(Remove the Second Chance card from play)")
+			s.say(console.MsgSecondChanceDiscarded, nil)
+			s.Game.DiscardPile = append(s.Game.DiscardPile, card)
 			return
 		} else if result.PassToPlayer != nil {
-			fmt.Printf("%s already has a Second Chance! Giving it to %s\n", p.Name, result.PassToPlayer.Name)
-			fmt.Printf("(Give the Second Chance card to %s)\n", result.PassToPlayer.Name)
-			// Add the card to the target player's hand for tracking
-			result.PassToPlayer.CurrentHand.ActionCards = append(result.PassToPlayer.CurrentHand.ActionCards, card)
+			// Add the card to the target player's hand for tracking, unless they are already out
+			if !result.PassToPlayer.CurrentHand.ReceiveSecondChance(card) {
+				s.say(console.MsgSecondChanceRefused, console.Args{"name": result.PassToPlayer.Name})
+				s.Game.DiscardPile = append(s.Game.DiscardPile, card)
+				return
+			}
+			s.say(console.MsgSecondChancePassed, console.Args{"name": p.Name, "target": result.PassToPlayer.Name})
+			s.Game.CurrentRound.RecordSecondChancePassed(result.PassToPlayer)
+			s.publish(domain.SecondChancePassed{From: p, To: result.PassToPlayer})
 			return
 		}
 		// Otherwise, fall through to add to player's hand
 	}
 
+	// Holdable-actions house rule: Freeze and Flip Three wait in the hand until played with PLAY.
+	if card.Type == domain.CardTypeAction && card.ActionType != domain.ActionSecondChance && s.Game.HoldableActions {
+		p.CurrentHand.AddCard(card)
+		s.say(console.MsgActionHeld, console.Args{"name": p.Name, "card": card})
+		s.printHand(p.CurrentHand, domain.NewScoreCalculator().Total(p.CurrentHand))
+		return
+	}
+
 	// Special handling for Actions (Freeze and Flip Three)
-	if card.Type == domain.CardTypeAction {
-		if card.ActionType == domain.ActionFlipThree || card.ActionType == domain.ActionFreeze {
-			// Step 1: Prompt the drawer (p) to choose a target player for the action
-			target := s.promptForTarget(card.ActionType, s.Game.CurrentRound.ActivePlayers, p)
-			if target == nil {
-				fmt.Println("No target selected (or invalid). Action cancelled (card still played).")
-			} else {
-				// Step 2: Apply the action effect to the TARGET player
-				switch card.ActionType {
-				case domain.ActionFreeze:
-					fmt.Printf("Freezing %s!\n", target.Name)
-					target.CurrentHand.Status = domain.HandStatusFrozen
-					score := target.BankCurrentHand()
-					fmt.Printf("%s banked %d points! Total: %d\n", target.Name, score, target.TotalScore)
-					s.Game.CurrentRound.RemoveActivePlayer(target)
-				case domain.ActionFlipThree:
-					fmt.Printf("Flip Three on %s! They must draw 3 cards.\n", target.Name)
-					s.resolveFlipThreeManual(target)
-				}
-			}
-		}
-		// Step 3: Add the action card to the DRAWER's (p) hand after effect resolution
+	if card.Type == domain.CardTypeAction && card.ActionType != domain.ActionSecondChance {
+		// The drawer chooses a target and the effect is applied to it. The card then goes
+		// to the discard pile: Freeze and Flip Three never stay in a hand.
 		// Note: Per issue #17, action cards (Flip Three, Freeze) end the turn after resolution.
-		p.CurrentHand.AddCard(card)
+		s.resolveActionManual(p, card)
+		s.Game.DiscardPile = append(s.Game.DiscardPile, card)
+
+		// The effect may have ended the round (e.g. Flip 7 during Flip Three).
+		if s.Game.CurrentRound.IsEnded {
+			return
+		}
 
 		// Show current hand score
 		calc := domain.NewScoreCalculator()
 		score := calc.Compute(p.CurrentHand)
-		fmt.Printf("Current Hand: %s | Score: %d\n", s.formatHand(p.CurrentHand), score.Total)
+		s.printHand(p.CurrentHand, score.Total)
 
 		return
 	}
 
-	// Add card to hand logic (for Number and Modifier cards)
+	// Add card to hand logic (for Number, Modifier and Second Chance cards)
 	busted, flip7, discarded := p.CurrentHand.AddCard(card)
 
 	// Handle discarded cards (e.g., from Second Chance usage)
 	// In manual mode, inform the user to physically remove these cards
 	if len(discarded) > 0 {
-		fmt.Printf("Second Chance used! Remove %d card(s) from play: ", len(discarded))
+		removed := make([]string, len(discarded))
 		for i, c := range discarded {
-			if i > 0 {
-				fmt.Print(", ")
-			}
-			fmt.Print(c.String())
+			removed[i] = c.String()
 		}
-		fmt.Println()
+		s.say(console.MsgSecondChanceUsed, console.Args{"count": len(discarded), "cards": strings.Join(removed, ", ")})
+		s.stats().Player(p).SecondChancesUsed++
 		// Add to discard pile
 		s.Game.DiscardPile = append(s.Game.DiscardPile, discarded...)
 	}
 
 	if busted {
-		fmt.Println("BUSTED!")
+		info, ok := p.CurrentHand.BustInfo()
+		switch {
+		case !ok:
+			s.say(console.MsgBusted, nil)
+		case pending != nil:
+			s.say(console.MsgBustedDuplicateRisk, console.Args{"value": info.Duplicate, "rate": pending.bustRate * 100})
+		default:
+			s.say(console.MsgBustedDuplicate, console.Args{"value": info.Duplicate})
+		}
 		p.CurrentHand.Status = domain.HandStatusBusted
 		s.Game.CurrentRound.RemoveActivePlayer(p)
+		s.stats().Player(p).Busts++
 
-		if s.Logger != nil {
-			s.Logger.Log(s.GameID, strconv.Itoa(s.Game.RoundCount), p.ID.String(), "Bust", map[string]interface{}{
-				"hand": s.formatHand(p.CurrentHand),
-			})
+		busted := domain.PlayerBusted{Player: p, Card: card}
+		if pending != nil {
+			busted.PreHitBustRate = &pending.bustRate
 		}
+		s.publish(busted)
 
 		return
 	} else if flip7 {
-		fmt.Println("FLIP 7!")
+		s.say(console.MsgFlip7, nil)
 		p.CurrentHand.Status = domain.HandStatusStayed
-		score := p.BankCurrentHand()
-		fmt.Printf("%s banked %d points! Total: %d\n", p.Name, score, p.TotalScore)
+		score := s.bankHand(p)
+		s.stats().Player(p).Flip7s++
 
 		// Flip 7 ends the round immediately AND removes the player from active players
 		s.Game.CurrentRound.RemoveActivePlayer(p)
 		s.Game.CurrentRound.End(domain.RoundEndReasonFlip7)
 
-		if s.Logger != nil {
-			s.Logger.Log(s.GameID, strconv.Itoa(s.Game.RoundCount), p.ID.String(), "Flip7", map[string]interface{}{
-				"banked_score": score,
-				"total_score":  p.TotalScore,
-			})
-		}
+		s.publish(domain.Flip7Achieved{Player: p, Banked: score})
 
 		return
 	}
 	// Show current hand score
 	calc := domain.NewScoreCalculator()
 	score := calc.Compute(p.CurrentHand)
-	fmt.Printf("Current Hand: %s | Score: %d\n", s.formatHand(p.CurrentHand), score.Total)
+	s.printHand(p.CurrentHand, score.Total)
+}
+
+// playHeldAction plays the Freeze or Flip Three that p holds under the holdable-actions house
+// rule, as typed after PLAY ("F" or "T"): the user picks a target as for a drawn card, and the
+// card then goes to the discard pile. It returns true if p's turn is over: p froze itself or
+// the round ended. Otherwise p goes on to hit or stay.
+func (s *ManualGameService) playHeldAction(p *domain.Player, token string) bool {
+	if !s.Game.HoldableActions {
+		s.say(console.MsgHoldableActionsOff, nil)
+		return false
+	}
+	wanted, err := s.parseInput(token)
+	if err != nil || wanted.Type != domain.CardTypeAction || wanted.ActionType == domain.ActionSecondChance {
+		s.say(console.MsgInvalidHeldAction, nil)
+		return false
+	}
+	return s.playHeldCard(p, wanted.ActionType)
+}
+
+// playHeldCard plays the action p holds, as playHeldAction does once the card is known. An
+// app-controlled p chooses its target with its strategy.
+func (s *ManualGameService) playHeldCard(p *domain.Player, action domain.ActionType) bool {
+	card, ok := p.CurrentHand.TakeHeldAction(action)
+	if !ok {
+		s.say(console.MsgNoHeldAction, console.Args{"name": p.Name, "card": domain.Card{Type: domain.CardTypeAction, ActionType: action}})
+		return false
+	}
+
+	s.say(console.MsgPlayHeldAction, console.Args{"name": p.Name, "card": card})
+	s.publish(domain.HeldActionPlayed{Player: p, Card: card})
+	s.resolveActionManual(p, card)
+	s.Game.DiscardPile = append(s.Game.DiscardPile, card)
+	s.warnInconsistencies()
+
+	round := s.Game.CurrentRound
+	if round.IsEnded || p.CurrentHand.Status != domain.HandStatusActive {
+		return true
+	}
+	s.printHand(p.CurrentHand, domain.NewScoreCalculator().Total(p.CurrentHand))
+	return false
+}
+
+// resolveActionManual applies the effect of a Flip Three or Freeze drawn by p.
+// The drawer chooses a target (see chooseTarget); the card itself is not added to any hand here.
+// Other action cards have no effect to resolve.
+func (s *ManualGameService) resolveActionManual(p *domain.Player, card domain.Card) {
+	if card.ActionType != domain.ActionFlipThree && card.ActionType != domain.ActionFreeze {
+		return
+	}
+
+	// The drawer (p) chooses a target player for the action
+	target := s.chooseTarget(card.ActionType, s.Game.CurrentRound.ActivePlayers, p)
+	if target == nil && s.Game.IsCompleted {
+		return // Input ended at the prompt
+	}
+	if target == nil {
+		s.say(console.MsgActionCancelled, nil)
+		return
+	}
+
+	s.publish(domain.CardPlayed{
+		Round:    s.Game.CurrentRound,
+		Discards: s.Game.DiscardPile,
+		Actor:    p,
+		Target:   target,
+		Action:   card.ActionType,
+	})
+
+	// Apply the action effect to the TARGET player
+	switch card.ActionType {
+	case domain.ActionFreeze:
+		s.say(console.MsgFreezing, console.Args{"name": target.Name})
+		target.CurrentHand.Status = domain.HandStatusFrozen
+		score := s.bankHand(target)
+		s.Game.CurrentRound.RemoveActivePlayer(target)
+		s.stats().RecordFreeze(p, target)
+
+		s.publish(domain.PlayerFrozen{Player: target, By: p, Banked: score})
+	case domain.ActionFlipThree:
+		s.say(console.MsgFlipThreeOn, console.Args{"name": target.Name})
+		s.Game.CurrentRound.RecordFlipThree(target)
+		s.resolveFlipThreeManual(target)
+	}
+
+	s.logActionResolved(p, target, card.ActionType)
+}
+
+// logActionResolved publishes what an action did to its target (see domain.ActionResolved),
+// linking the drawer's choice to its outcome, and says so when it backfired.
+func (s *ManualGameService) logActionResolved(actor, target *domain.Player, action domain.ActionType) {
+	round := s.Game.CurrentRound
+	if round.EndReason == domain.RoundEndReasonAborted || round.EndReason == domain.RoundEndReasonExhausted {
+		return
+	}
+	outcome, backfired := actionOutcome(round, actor, target)
+	if backfired {
+		s.say(console.MsgFlipThreeBackfired, console.Args{"actor": actor.Name, "target": target.Name})
+	}
+
+	s.publish(domain.ActionResolved{Actor: actor, Target: target, Action: action, Outcome: outcome, Backfired: backfired})
+}
+
+// actionOutcome returns the outcome logged with ActionResolved for an action of actor on
+// target, and whether it backfired.
+func actionOutcome(round *domain.Round, actor, target *domain.Player) (string, bool) {
+	outcome := string(target.CurrentHand.Status)
+	if round.IsEnded && round.EndReason == domain.RoundEndReasonFlip7 && target.CurrentHand.Status == domain.HandStatusStayed {
+		outcome = "flip7"
+	}
+	return outcome, outcome == "flip7" && target.ID != actor.ID
 }
 
 // promptForTarget prompts the player to select a target for an action card.
@@ -777,14 +997,16 @@ func (s *ManualGameService) processCard(p *domain.Player, card domain.Card) {
 // - Freeze: Bank current points immediately (defensive play)
 // - Flip Three: Force yourself to draw 3 cards (aggressive play when needing points)
 // - GiveSecondChance: Pass Second Chance to a specific player
+//
+// It returns nil for an invalid choice, and also when the input ends (the game is then completed).
 func (s *ManualGameService) promptForTarget(actionType domain.ActionType, candidates []*domain.Player, actor *domain.Player) *domain.Player {
 	if len(candidates) == 0 {
 		// Provide action-specific error messages
 		switch actionType {
 		case domain.ActionGiveSecondChance:
-			fmt.Println("No valid targets available: All other players already have a Second Chance card.")
+			s.say(console.MsgNoSecondChanceTarget, nil)
 		default:
-			fmt.Println("No active players available to target.")
+			s.say(console.MsgNoTarget, nil)
 		}
 		return nil
 	}
@@ -792,32 +1014,34 @@ func (s *ManualGameService) promptForTarget(actionType domain.ActionType, candid
 	// Display appropriate message based on action type
 	switch actionType {
 	case domain.ActionFreeze:
-		fmt.Println("Select target to Freeze:")
+		s.say(console.MsgSelectFreezeTarget, nil)
 	case domain.ActionFlipThree:
-		fmt.Println("Select target for Flip Three:")
+		s.say(console.MsgSelectFlipThreeTarget, nil)
 	case domain.ActionGiveSecondChance:
-		fmt.Println("Select player to give Second Chance to:")
+		s.say(console.MsgSelectSecondChanceTarget, nil)
 	default:
-		fmt.Println("Select Target:")
+		s.say(console.MsgSelectTarget, nil)
 	}
 
-	// Suggestion Logic using AdaptiveStrategy
-	adaptive := strategy.NewAdaptiveStrategy()
-	if s.Game.CurrentRound != nil {
-		adaptive.SetDeck(s.Game.CurrentRound.Deck)
+	var deck domain.DeckView
+	if s.Game.CurrentRound != nil && s.Game.CurrentRound.Deck != nil {
+		deck = domain.NewReshuffleView(s.Game.CurrentRound.Deck, s.Game.DiscardPile)
 	}
-	suggested := adaptive.ChooseTarget(actionType, candidates, actor)
+	suggested, advice := s.suggestTarget(actionType, candidates, actor, deck)
+	shadows := s.shadowTarget(actionType, candidates, actor, deck)
 
-	for i, c := range candidates {
-		fmt.Printf("%d. %s\n", i+1, s.FormatCandidateOption(c, suggested))
-	}
+	s.Messages.WriteAdvisedTargetOptions(s.out(), actionType, candidates, actor, deck, suggested, advice)
 
-	fmt.Print("Enter choice: ")
-	input, _ := s.Reader.ReadString('\n')
-	idx, err := strconv.Atoi(strings.TrimSpace(input))
+	s.ask(console.MsgChoicePrompt, nil)
+	input, ok := s.readAnswer()
+	if !ok {
+		return nil
+	}
+	idx, err := strconv.Atoi(input)
 	if err != nil || idx < 1 || idx > len(candidates) {
 		return nil
 	}
+	s.recordShadows(actor, actionType, shadows, candidates[idx-1].Name)
 	return candidates[idx-1]
 }
 
@@ -829,13 +1053,9 @@ func (s *ManualGameService) promptForTarget(actionType domain.ActionType, candid
 func (s *ManualGameService) FormatCandidateOption(candidate *domain.Player, suggested *domain.Player) string {
 	marker := ""
 	if suggested != nil && candidate.ID == suggested.ID {
-		marker = " [Suggested]"
+		marker = s.Messages.Format(console.MsgTargetSuggested, nil)
 	}
-	handStr := "[]"
-	if candidate.CurrentHand != nil {
-		handStr = s.formatHand(candidate.CurrentHand)
-	}
-	return fmt.Sprintf("%s (Score: %d) Hand: %s%s", candidate.Name, candidate.TotalScore, handStr, marker)
+	return s.Messages.TargetOption("", candidate, nil, nil) + marker
 }
 
 // resolveFlipThreeManual handles the Flip Three action effect on the target player.
@@ -854,7 +1074,7 @@ func (s *ManualGameService) resolveFlipThreeManual(target *domain.Player) {
 
 	// Create logger function that prints to console
 	logger := func(message string) {
-		fmt.Println(message)
+		fmt.Fprintln(s.out(), message)
 	}
 
 	executor := domain.NewFlipThreeExecutor(source, processor, logger)
@@ -862,179 +1082,46 @@ func (s *ManualGameService) resolveFlipThreeManual(target *domain.Player) {
 }
 
 func (s *ManualGameService) formatHand(h *domain.PlayerHand) string {
-	var parts []string
-	for _, val := range h.RawNumberCards {
-		parts = append(parts, strconv.Itoa(int(val)))
-	}
-	for _, mod := range h.ModifierCards {
-		parts = append(parts, string(mod.ModifierType))
-	}
-	for _, act := range h.ActionCards {
-		parts = append(parts, string(act.ActionType))
-	}
-	return "[" + strings.Join(parts, ", ") + "]"
-}
-
-func (s *ManualGameService) printWinner() {
-	if len(s.Game.Winners) == 0 {
-		fmt.Println("Game Over. No winner determined.")
-		return
-	}
-	fmt.Println("Game Over. Winner(s):")
-	for _, winner := range s.Game.Winners {
-		fmt.Printf(" - %s with %d points\n", winner.Name, winner.TotalScore)
-	}
+	return console.DisplayHand(h)
 }
 
-// SaveState serializes the current game state to a base64 string.
-func (s *ManualGameService) SaveState() (string, error) {
-	// Collect IDs of user-controlled players (those with nil strategy)
-	var userControlledIDs []string
-	for _, p := range s.Game.Players {
-		if p.Strategy == nil {
-			userControlledIDs = append(userControlledIDs, p.ID.String())
-		}
-	}
-
-	wrapper := gameStateWrapper{
-		Game:              s.Game,
-		UserControlledIDs: userControlledIDs,
-		GameID:            s.GameID,
-	}
-
-	data, err := json.Marshal(wrapper)
-	if err != nil {
-		return "", err
-	}
-	return base64.StdEncoding.EncodeToString(data), nil
-}
-
-// LoadState deserializes the game state from a base64 string.
-func (s *ManualGameService) LoadState(encoded string) error {
-	decoded, err := base64.StdEncoding.DecodeString(encoded)
-	if err != nil {
-		return fmt.Errorf("invalid code: %v", err)
-	}
-
-	var wrapper gameStateWrapper
-	if err := json.Unmarshal(decoded, &wrapper); err != nil {
-		return fmt.Errorf("failed to parse game state: %v", err)
-	}
-
-	// Validate that the game state is not nil
-	if wrapper.Game == nil {
-		return fmt.Errorf("invalid save code: game state is missing")
-	}
-
-	// Validate that the loaded game is resumable
-	if wrapper.Game.IsCompleted {
-		return fmt.Errorf("cannot resume: the loaded game is already completed")
-	}
-	if wrapper.Game.CurrentRound != nil && wrapper.Game.CurrentRound.IsEnded {
-		return fmt.Errorf("cannot resume: the current round in the loaded game is already ended")
-	}
-
-	s.RelinkPointers(wrapper.Game, wrapper.UserControlledIDs)
-	s.Game = wrapper.Game
-	s.GameID = wrapper.GameID // Restore GameID for logging continuity
-	return nil
+// printHand shows a hand and its current score.
+func (s *ManualGameService) printHand(h *domain.PlayerHand, score int) {
+	s.say(console.MsgCurrentHand, console.Args{"hand": s.formatHand(h), "score": score})
 }
 
-// PushState captures the current game state and pushes it to history.
-func (s *ManualGameService) PushState() {
-	if s.Game == nil {
-		return
-	}
-	state, err := s.SaveState()
-	if err != nil {
-		fmt.Printf("Warning: Failed to save state for history: %v\n", err)
-		return
-	}
-	s.History.Push(GameMemento(state))
+// bankHand banks p's hand and prints how the points add up, so they can be checked against the table.
+func (s *ManualGameService) bankHand(p *domain.Player) int {
+	points := domain.NewScoreCalculator().Compute(p.CurrentHand)
+	score := p.BankCurrentHand()
+	s.say(console.MsgBanked, console.Args{"name": p.Name, "points": score, "total": p.TotalScore})
+	s.say(console.MsgBankedBreakdown, console.Args{"points": score, "breakdown": points.Breakdown()})
+	return score
 }
 
-// Undo reverts the game state to the previous memento.
-func (s *ManualGameService) Undo() {
-	memento, ok := s.History.Undo()
-	if !ok {
-		fmt.Println("Cannot undo: No previous state.")
-		return
-	}
-	if err := s.LoadState(string(memento)); err != nil {
-		fmt.Printf("Error undoing state: %v\n", err)
-		// Try to recover state index if loading fails
-		s.History.Redo()
+// printWinner prints the winners and the final standings, then the end-of-game statistics.
+func (s *ManualGameService) printWinner() {
+	if len(s.Game.Winners) == 0 {
+		s.say(console.MsgNoWinner, nil)
 	} else {
-		fmt.Println("Undid last action.")
+		s.say(console.MsgWinners, nil)
+		for _, winner := range s.Game.Winners {
+			s.say(console.MsgWinner, console.Args{"name": winner.Name, "score": winner.TotalScore})
+		}
 	}
+	fmt.Fprint(s.out(), s.Messages.FinalStandings(s.Game.FinalStandings()))
+	fmt.Fprint(s.out(), s.Messages.GameStats(s.Game, s.Stats))
 }
 
-// Redo advances the game state to the next memento.
-func (s *ManualGameService) Redo() {
-	memento, ok := s.History.Redo()
-	if !ok {
-		fmt.Println("Cannot redo: No future state.")
+// exportGame writes the final state of the game to ExportPath, if set.
+func (s *ManualGameService) exportGame() {
+	if s.ExportPath == "" {
 		return
 	}
-	if err := s.LoadState(string(memento)); err != nil {
-		fmt.Printf("Error redoing state: %v\n", err)
-		// Try to recover state index if loading fails
-		s.History.Undo()
-	} else {
-		fmt.Println("Redid action.")
-	}
-}
-
-// RelinkPointers restores pointer relationships after deserialization.
-// It ensures that all references to players point to the same instances and restores strategies.
-func (s *ManualGameService) RelinkPointers(g *domain.Game, userControlledIDs []string) {
-	// Create a set of user-controlled player IDs for quick lookup
-	userControlledSet := make(map[string]bool)
-	for _, id := range userControlledIDs {
-		userControlledSet[id] = true
-	}
-
-	playerMap := make(map[string]*domain.Player)
-	for _, p := range g.Players {
-		playerMap[p.ID.String()] = p
-		// Restore strategies based on user control
-		if userControlledSet[p.ID.String()] {
-			p.Strategy = nil
-		} else {
-			// Default to ProbabilisticStrategy for AI players in manual mode
-			p.Strategy = &strategy.ProbabilisticStrategy{}
-		}
-	}
-
-	if g.CurrentRound != nil {
-		// Relink Round Players
-		for i, p := range g.CurrentRound.Players {
-			if existing, ok := playerMap[p.ID.String()]; ok {
-				g.CurrentRound.Players[i] = existing
-			}
-		}
-		// Relink Active Players
-		for i, p := range g.CurrentRound.ActivePlayers {
-			if existing, ok := playerMap[p.ID.String()]; ok {
-				g.CurrentRound.ActivePlayers[i] = existing
-			}
-		}
-		// Relink Dealer
-		if g.CurrentRound.Dealer != nil {
-			if existing, ok := playerMap[g.CurrentRound.Dealer.ID.String()]; ok {
-				g.CurrentRound.Dealer = existing
-			}
-		}
-		// Relink Deck
-		if g.Deck != nil {
-			g.CurrentRound.Deck = g.Deck
-		}
-	}
-
-	// Relink Winners
-	for i, p := range g.Winners {
-		if existing, ok := playerMap[p.ID.String()]; ok {
-			g.Winners[i] = existing
-		}
+	export := NewGameExport(s.Game, ExportModeManual, s.ScoreHistory, s.now())
+	if err := WriteGameExport(s.ExportPath, export); err != nil {
+		s.say(console.MsgGameExportFailed, console.Args{"path": s.ExportPath, "err": err})
+		return
 	}
+	s.say(console.MsgGameExported, console.Args{"path": s.ExportPath})
 }
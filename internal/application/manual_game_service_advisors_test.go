@@ -0,0 +1,134 @@
+package application
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+)
+
+// deckRecordingAdvisor hits, targets the last candidate and records the deck it was shown.
+type deckRecordingAdvisor struct {
+	deck domain.DeckView
+}
+
+func (a *deckRecordingAdvisor) Name() string                 { return "Recorder" }
+func (a *deckRecordingAdvisor) SetDeck(deck domain.DeckView) { a.deck = deck }
+func (a *deckRecordingAdvisor) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+	return domain.TurnChoiceHit
+}
+func (a *deckRecordingAdvisor) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	return candidates[len(candidates)-1]
+}
+
+// newAdvisedService sets up Me holding a 1 and Bot holding a 3 over a rigged deck of four 1s and
+// six 12s: hitting busts 40% of the time but is worth 6/10 × 13 = 7.8 points on average, so
+// ExpectedValue hits while Aggressive (staying above 30% risk) stays.
+func newAdvisedService(advisors ...domain.Strategy) (*ManualGameService, *strings.Builder) {
+	me := domain.NewPlayer("Me", nil)
+	bot := domain.NewPlayer("Bot", nil)
+	players := []*domain.Player{me, bot}
+	var cards []domain.Card
+	for i := 0; i < 4; i++ {
+		cards = append(cards, domain.Card{Type: domain.CardTypeNumber, Value: 1})
+	}
+	for i := 0; i < 6; i++ {
+		cards = append(cards, domain.Card{Type: domain.CardTypeNumber, Value: 12})
+	}
+
+	svc := NewManualGameService(bufio.NewReader(strings.NewReader("")), nil)
+	var out strings.Builder
+	svc.Out = &out
+	svc.SuggestionAdvisors = advisors
+	svc.Game = domain.NewGame(players)
+	svc.Game.Deck = domain.NewDeckInOrder(cards)
+	svc.Game.CurrentRound = domain.NewRound(players, me, svc.Game.Deck)
+	me.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 1})
+	bot.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 3})
+	return svc, &out
+}
+
+func TestManualMode_SuggestionPanel(t *testing.T) {
+	tests := []struct {
+		name     string
+		advisors []domain.Strategy
+		want     string
+		choice   domain.TurnChoice
+	}{
+		{
+			name:     "default Adaptive alone",
+			advisors: nil,
+			want:     "Suggested Move: ",
+		},
+		{
+			name:     "majority",
+			advisors: []domain.Strategy{strategy.NewExpectedValueStrategy(), strategy.NewAggressiveStrategy(), strategy.NewHeuristicStrategy(27)},
+			want:     "Suggestions — ExpectedValue: [hit], Aggressive: stay, Heuristic-27: [hit] → majority: hit (2 of 3)\n",
+			choice:   domain.TurnChoiceHit,
+		},
+		{
+			name:     "no majority",
+			advisors: []domain.Strategy{strategy.NewAggressiveStrategy(), strategy.NewExpectedValueStrategy()},
+			want:     "Suggestions — Aggressive: stay, ExpectedValue: hit → no majority\n",
+			choice:   domain.TurnChoiceStay, // The first advisor's
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, out := newAdvisedService(tt.advisors...)
+			me := svc.Game.Players[0]
+
+			choice := svc.suggestMove(me, svc.Game.CurrentRound.Deck)
+
+			if !strings.HasPrefix(out.String(), tt.want) {
+				t.Errorf("Expected %q, got %q", tt.want, out.String())
+			}
+			if tt.choice != "" && choice != tt.choice {
+				t.Errorf("Expected the suggestion %s, got %s", tt.choice, choice)
+			}
+		})
+	}
+}
+
+func TestManualMode_SuggestionAdvisorsSeeTheDeck(t *testing.T) {
+	first, second := &deckRecordingAdvisor{}, &deckRecordingAdvisor{}
+	svc, _ := newAdvisedService(first, second)
+	me := svc.Game.Players[0]
+	deck := svc.Game.CurrentRound.Deck
+
+	svc.suggestMove(me, deck)
+
+	for i, advisor := range []*deckRecordingAdvisor{first, second} {
+		if advisor.deck != domain.DeckView(deck) {
+			t.Errorf("Advisor %d: expected to be shown the round's deck, got %v", i, advisor.deck)
+		}
+	}
+}
+
+func TestManualMode_SuggestionPanelTargets(t *testing.T) {
+	// ExpectedValue freezes the opponent and the recorder picks the last candidate, Bot as well.
+	recorder := &deckRecordingAdvisor{}
+	svc, out := newAdvisedService(strategy.NewExpectedValueStrategy(), recorder)
+	me, bot := svc.Game.Players[0], svc.Game.Players[1]
+	candidates := []*domain.Player{me, bot}
+
+	suggested, advice := svc.suggestTarget(domain.ActionFreeze, candidates, me, svc.Game.CurrentRound.Deck)
+	svc.Messages.WriteAdvisedTargetOptions(out, domain.ActionFreeze, candidates, me, svc.Game.CurrentRound.Deck, suggested, advice)
+
+	evTarget := strategy.NewExpectedValueStrategy().ChooseTarget(domain.ActionFreeze, candidates, me)
+	if evTarget != bot {
+		t.Fatalf("Expected ExpectedValue to freeze Bot, got %s", evTarget.Name)
+	}
+	if suggested != bot {
+		t.Errorf("Expected the majority's target Bot, got %v", suggested)
+	}
+	want := "2. Bot (Score: 0) Hand: [3] | Bust risk: 0% [Suggested] (ExpectedValue: B, Recorder: B)\n"
+	if !strings.Contains(out.String(), want) {
+		t.Errorf("Expected %q in:\n%s", want, out.String())
+	}
+	if recorder.deck != domain.DeckView(svc.Game.CurrentRound.Deck) {
+		t.Error("Expected the target advisor to be shown the round's deck")
+	}
+}
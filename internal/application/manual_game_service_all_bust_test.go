@@ -0,0 +1,51 @@
+package application_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestManualMode_AllBustRoundContinuesDeck(t *testing.T) {
+	// Round 1: Me deals, Me is dealt 5 and Bot 6, then both hit a duplicate and bust.
+	// Round 2 starts with Bot dealing; the input ends at Bot's initial card.
+	setup := []string{"", "2", "Bot", "1", ""}
+	round1 := []string{"5", "6", "5", "6"}
+
+	tests := []struct {
+		name     string
+		boundary []string
+	}{
+		{"no undo", nil},
+		// Undo at the first prompt of round 2 is refused: round 1 is already scored.
+		{"undo at the start of the round", []string{"U"}},
+		{"undo and redo at the start of the round", []string{"U", "R"}},
+		{"undo a card of the new deal", []string{"7", "U"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := append(append(append([]string{}, setup...), round1...), tt.boundary...)
+			service := runManual(t, strings.NewReader(strings.Join(lines, "\n")+"\n"))
+			game := service.Game
+
+			if game.RoundCount != 2 || game.CurrentRound.Dealer.Name != "Bot" {
+				t.Fatalf("Expected round 2 dealt by Bot, got round %d dealt by %s", game.RoundCount, game.CurrentRound.Dealer.Name)
+			}
+			for _, p := range game.Players {
+				history := service.ScoreHistory[p.ID.String()]
+				if p.TotalScore != 0 || fmt.Sprint(history) != "[0]" {
+					t.Errorf("Expected %s to score nothing in round 1, got total %d and history %v", p.Name, p.TotalScore, history)
+				}
+			}
+			// The busted hands are collected exactly once, however often the boundary was crossed.
+			if got := fmt.Sprint(game.DiscardPile); got != "[5 5 6 6]" {
+				t.Errorf("Expected the discard pile to hold the busted hands [5 5 6 6], got %s", got)
+			}
+			deck := game.CurrentRound.Deck
+			if game.Deck != deck || deck.Remaining() != 94-4 || deck.RemainingCounts[5] != 3 || deck.RemainingCounts[6] != 4 {
+				t.Errorf("Expected round 2 to continue the deck with 90 cards (three 5s, four 6s), got %d cards (%d 5s, %d 6s)",
+					deck.Remaining(), deck.RemainingCounts[5], deck.RemainingCounts[6])
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+)
+
+func TestManualMode_ModifierOnlyHandsBankCalculatorScore(t *testing.T) {
+	// Me is dealt only a modifier. Either Me stays on it, or Bot is dealt a Freeze and freezes Me.
+	tests := []struct {
+		name      string
+		answers   []string
+		want      int
+		breakdown string
+	}{
+		{"Stay on +10", []string{"+10", "7", "S"}, 10, "Banked 10 = 0 +10"},
+		{"Stay on x2", []string{"x2", "7", "S"}, 0, "Banked 0 = 0 ×2"},
+		{"Frozen on +10", []string{"+10", "F", "1"}, 10, "Banked 10 = 0 +10"},
+		{"Frozen on x2", []string{"x2", "F", "1"}, 0, "Banked 0 = 0 ×2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := strings.Join(append([]string{"", "2", "Bot", "1", ""}, tt.answers...), "\n") + "\n"
+			service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+			var out strings.Builder
+			service.Out = &out
+			service.Run()
+
+			if me := service.Game.Players[0]; me.TotalScore != tt.want {
+				t.Errorf("Expected Me to bank %d, got %d", tt.want, me.TotalScore)
+			}
+			// The breakdown is the calculator's, next to the points actually banked.
+			if !strings.Contains(out.String(), tt.breakdown) {
+				t.Errorf("Expected %q in the output:\n%s", tt.breakdown, out.String())
+			}
+		})
+	}
+}
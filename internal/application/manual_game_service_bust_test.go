@@ -0,0 +1,46 @@
+package application_test
+
+import (
+	"bufio"
+	"math"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+)
+
+func TestManualMode_BustShowsDuplicateAndRisk(t *testing.T) {
+	// Me is dealt 9 and Bot 0, then Me hits and draws a second 9: 8 of the 92 cards left are a 9.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "9", "0", "9"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	logger := &recordingLogger{}
+	service.Logger = logger
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	if want := "BUSTED! Duplicate 9 — you had a 9% bust chance"; !strings.Contains(out.String(), want) {
+		t.Errorf("Expected %q, got:\n%s", want, out.String())
+	}
+
+	var bust *recordedEvent
+	for i, e := range logger.events {
+		if e.eventType == "Bust" {
+			bust = &logger.events[i]
+		}
+	}
+	if bust == nil {
+		t.Fatal("Expected a Bust event")
+	}
+	if got := bust.details["duplicate_value"]; got != 9 {
+		t.Errorf("Expected duplicate_value 9, got %v", got)
+	}
+	if got := bust.details["hand_score_lost"]; got != 9 {
+		t.Errorf("Expected hand_score_lost 9, got %v", got)
+	}
+	rate, ok := bust.details["pre_hit_bust_rate"].(float64)
+	if !ok || math.Abs(rate-8.0/92) > 1e-9 {
+		t.Errorf("Expected pre_hit_bust_rate %.4f, got %v", 8.0/92, bust.details["pre_hit_bust_rate"])
+	}
+}
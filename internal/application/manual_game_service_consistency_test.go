@@ -0,0 +1,39 @@
+package application_test
+
+import (
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func TestManualMode_TracksCardAllowances(t *testing.T) {
+	input := strings.Join([]string{
+		"",      // No resume
+		"2",     // Players
+		"Bot",   // Player 2 name
+		"1",     // Me deals first
+		"",      // Default winning score
+		"CHECK", // Audit at the deal prompt does not consume input
+		"5",     // Initial deal: Me
+		"3",     // Bot
+		"CHECK", // Audit at the turn prompt
+		"T",     // Me draws Flip Three
+		"2",     // ... on Bot
+		"1",     // Bot's forced draws
+		"2",
+		"4", // Input ends at Bot's turn
+	}, "\n") + "\n"
+
+	service := runManual(t, strings.NewReader(input))
+
+	me, bot := service.Game.Players[0], service.Game.Players[1]
+	// Bot may hold 5 cards: the initial card, 3 forced draws and one on their turn.
+	allowances := service.Game.CurrentRound.CardAllowances
+	if a := allowances[me.ID.String()]; a == nil || *a != (domain.CardAllowance{Turns: 1}) {
+		t.Errorf("Expected Me to have had 1 turn, got %+v", a)
+	}
+	if a := allowances[bot.ID.String()]; a == nil || *a != (domain.CardAllowance{Turns: 1, FlipThrees: 1}) {
+		t.Errorf("Expected Bot to have had 1 turn and 1 Flip Three, got %+v", a)
+	}
+}
@@ -0,0 +1,34 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+)
+
+func TestManualMode_ShowsSafeDraws(t *testing.T) {
+	// Me is dealt 1 and Bot 0. Bot stays at once, and Me draws 2 to 6 to sit one number away from Flip 7.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "1", "0", "2", "S", "3", "4", "5", "6"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	for _, want := range []string{
+		// Me's first turn: 1 and 0 are out of the deck, and only 1 is in Me's hand.
+		"Unique numbers: 1/7 — safe values remaining: 2,3,4,5,6,7,8,9,10,11,12 (77 cards), unsafe: none (0 cards)",
+		// After 2 to 6, all five other copies of 1..6 are gone too: 57 of the 72 number cards left are 7..12.
+		"Unique numbers: 6/7 — safe values remaining: 7,8,9,10,11,12 (57 cards), unsafe: 2,3,4,5,6 (15 cards)",
+		"Flip 7 on the next number card: 79.2%",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected the output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+	if strings.Count(out.String(), "Flip 7 on the next number card") != 1 {
+		t.Errorf("Expected the Flip 7 chance only one number away, got:\n%s", out.String())
+	}
+}
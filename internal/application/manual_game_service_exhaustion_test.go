@@ -0,0 +1,102 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+func TestManualMode_TableRunsOutDuringFlipThree(t *testing.T) {
+	// Me is dealt 5 and Bot 7. Me stays, Bot draws a Flip Three on themselves and is dealt 2,
+	// then the cards on the table run out (EMPTY) before the second forced card.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "5", "7", "S", "T", "1", "2", "EMPTY"}, "\n") + "\n"
+	tests := []struct {
+		name        string
+		rule        domain.ExhaustionRule
+		wantBot     int
+		wantStatus  domain.HandStatus
+		wantWinner  string
+		wantMessage string
+	}{
+		{"Default banks the hands in play", "", 9, domain.HandStatusFrozen, "Bot", "Bot banked 9 points! Total: 9"},
+		{"Discard scores them as 0", domain.ExhaustionDiscardHands, 0, domain.HandStatusBusted, "Me", "Bot's hand is discarded (0 points)."},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+			service.ExhaustionRule = tt.rule
+			logger := &recordingLogger{}
+			service.Logger = logger
+			var out strings.Builder
+			service.Out = &out
+
+			service.Run()
+
+			game := service.Game
+			if !game.IsCompleted || game.EndReason != domain.GameEndReasonExhausted {
+				t.Fatalf("Expected the game to end exhausted, got completed=%v reason=%q\n%s", game.IsCompleted, game.EndReason, out.String())
+			}
+			if game.CurrentRound.EndReason != domain.RoundEndReasonExhausted {
+				t.Errorf("Expected the round to end exhausted, got %q", game.CurrentRound.EndReason)
+			}
+			me, bot := game.Players[0], game.Players[1]
+			if me.TotalScore != 5 || bot.TotalScore != tt.wantBot {
+				t.Errorf("Expected Me to keep 5 and Bot to have %d, got %d and %d", tt.wantBot, me.TotalScore, bot.TotalScore)
+			}
+			if bot.CurrentHand.Status != tt.wantStatus {
+				t.Errorf("Expected Bot's hand to be %s, got %s", tt.wantStatus, bot.CurrentHand.Status)
+			}
+			if len(game.Winners) != 1 || game.Winners[0].Name != tt.wantWinner {
+				t.Errorf("Expected %s to win with the highest total score, got %v", tt.wantWinner, game.Winners)
+			}
+			for _, line := range []string{
+				"No card left to draw: the deck and the discard pile are empty. The round ends.",
+				tt.wantMessage,
+				"No cards are left to play on. The highest total score wins.",
+				" - " + tt.wantWinner + " with",
+			} {
+				if !strings.Contains(out.String(), line) {
+					t.Errorf("Expected the output to contain %q, got:\n%s", line, out.String())
+				}
+			}
+
+			var exhausted []string
+			for _, e := range logger.events {
+				switch e.eventType {
+				case "DeckExhausted":
+					exhausted = append(exhausted, e.details["rule"].(string))
+				case "Exhausted":
+					if e.playerID != bot.ID.String() || e.details["banked_score"] != tt.wantBot {
+						t.Errorf("Expected Bot's hand to be logged with %d points, got %s %v", tt.wantBot, e.playerID, e.details)
+					}
+				}
+			}
+			if len(exhausted) != 1 || exhausted[0] != string(game.RoundExhaustionRule()) {
+				t.Errorf("Expected one DeckExhausted event with rule %q, got %v", game.RoundExhaustionRule(), exhausted)
+			}
+		})
+	}
+}
+
+func TestManualMode_TableRunsOutDuringDeal(t *testing.T) {
+	// Me is dealt 5, then the cards run out before Bot's initial card: Bot banks an empty hand.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "5", "EMPTY"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.Out = &strings.Builder{}
+
+	service.Run()
+
+	game := service.Game
+	if game.EndReason != domain.GameEndReasonExhausted {
+		t.Fatalf("Expected the game to end exhausted, got %q", game.EndReason)
+	}
+	if game.Players[0].TotalScore != 5 || game.Players[1].TotalScore != 0 {
+		t.Errorf("Expected scores 5 and 0, got %d and %d", game.Players[0].TotalScore, game.Players[1].TotalScore)
+	}
+	if len(game.Winners) != 1 || game.Winners[0].Name != "Me" {
+		t.Errorf("Expected Me to win, got %v", game.Winners)
+	}
+}
@@ -0,0 +1,38 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+)
+
+func TestManualMode_ShowsOpponentFlip7Threat(t *testing.T) {
+	// Me is dealt 12 and Bot 1; Me hits 11, 10, 9, 8 and Bot 2, 3, 4, 5, then both stay.
+	// The threat shows from the turn an opponent holds 5 distinct numbers: on Bot's last turn
+	// (Me has 50 points and is assumed to stay) and on Me's last turn (Bot has 15 and hits).
+	input := strings.Join([]string{"", "2", "Bot", "1", "",
+		"12", "1", "11", "2", "10", "3", "9", "4", "8", "5", "S", "S"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	var threats []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.HasPrefix(line, "Opponent Flip7 threat: ") {
+			threats = append(threats, line)
+		}
+	}
+	if len(threats) != 2 {
+		t.Fatalf("Expected the threat on the last two turns, got %q in:\n%s", threats, out.String())
+	}
+	if threats[0] != "Opponent Flip7 threat: ~0% this rotation" {
+		t.Errorf("Expected no threat from an opponent who stays, got %q", threats[0])
+	}
+	if !strings.HasSuffix(threats[1], "% this rotation") {
+		t.Errorf("Unexpected threat line %q", threats[1])
+	}
+}
@@ -0,0 +1,70 @@
+package application_test
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+func TestManualMode_PrintsGameStatsGolden(t *testing.T) {
+	input := strings.Join([]string{
+		"", "2", "Bot", "1", "20", // Me starts, playing to 20
+		// Round 1: Me takes a Second Chance that absorbs a second 5, Bot busts on a second 7.
+		"5", "7", "C", "7", "5", "S",
+		// Round 2: Bot freezes Me on 12 and stays on 10.
+		"10", "12", "F", "2", "S",
+		// Round 3: Me stays on 8 and wins with 25, Bot stays on 3.
+		"8", "3", "S", "S",
+	}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	output := out.String()
+	start := strings.Index(output, "Game Over.")
+	if start < 0 {
+		t.Fatalf("Expected the game to end, got:\n%s", output)
+	}
+	got := output[start:]
+
+	golden := filepath.Join("testdata", "game_stats", "manual_game.txt")
+	if *update {
+		if err := os.WriteFile(golden, []byte(got), 0644); err != nil {
+			t.Fatalf("Failed to update golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Failed to read golden file: %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("End-of-game screen does not match %s (run with -update to regenerate).\nGot:\n%s", golden, got)
+	}
+}
+
+func TestManualMode_GameStatsSurviveUndo(t *testing.T) {
+	// Me's Second Chance absorbs a second 5, then Bot busts on a second 7; the bust is undone
+	// and Bot hits 8 instead.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "5", "7", "C", "3", "5", "7", "U", "8"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.Out = &strings.Builder{}
+
+	service.Run()
+
+	if service.Game.Players[1].CurrentHand.Status == domain.HandStatusBusted {
+		t.Fatalf("Expected the bust to be undone")
+	}
+	if used := service.Stats.Player(service.Game.Players[0]).SecondChancesUsed; used != 1 {
+		t.Errorf("Expected the Second Chance used before the undo to be kept, got %d", used)
+	}
+	if busts := service.Stats.Player(service.Game.Players[1]).Busts; busts != 0 {
+		t.Errorf("Expected the undone bust not to be counted, got %d", busts)
+	}
+}
@@ -0,0 +1,61 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+)
+
+// runHoldableManualGame plays Manual Mode with Me and Bot, Me dealing, on the given answers
+// after the deal of 5 to Me and 7 to Bot.
+func runHoldableManualGame(holdable bool, answers ...string) (*application.ManualGameService, string) {
+	input := strings.Join(append([]string{"", "2", "Bot", "1", "", "5", "7"}, answers...), "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.HoldableActions = holdable
+	var out strings.Builder
+	service.Out = &out
+	service.Run()
+	return service, out.String()
+}
+
+func TestManualMode_HoldableActionsPlayHeldFreeze(t *testing.T) {
+	// Me hits a Freeze and keeps it; Bot hits 3. On the next turn Me freezes Bot (7+3 = 10)
+	// with PLAY F and then stays with 5.
+	service, out := runHoldableManualGame(true, "F", "3", "PLAY T", "PLAY F", "2", "S")
+
+	for _, want := range []string{
+		"Me holds freeze for a later turn.",
+		"Held: freeze. Type PLAY F or PLAY T to play one before hitting or staying.",
+		"Me does not hold flip_three.",
+		"Me plays the held freeze.",
+		"Freezing Bot!",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in the output:\n%s", want, out)
+		}
+	}
+	scores := map[string]int{}
+	for _, p := range service.Game.Players {
+		scores[p.Name] = p.TotalScore
+	}
+	if scores["Me"] != 5 || scores["Bot"] != 10 {
+		t.Errorf("Expected Me 5 and Bot 10, got %v", scores)
+	}
+}
+
+func TestManualMode_StandardRulesRefusePlay(t *testing.T) {
+	// Without the house rule the Freeze Me hits is played at once, on Bot (7).
+	service, out := runHoldableManualGame(false, "PLAY F", "F", "2")
+
+	if !strings.Contains(out, "Action cards cannot be held in this game: they are played when drawn.") {
+		t.Errorf("Expected PLAY to be refused, got:\n%s", out)
+	}
+	if strings.Contains(out, "for a later turn") {
+		t.Errorf("Expected the Freeze not to be held, got:\n%s", out)
+	}
+	if bot := service.Game.Players[1]; bot.TotalScore != 7 {
+		t.Errorf("Expected the Freeze to bank Bot's 7 when drawn, got %d", bot.TotalScore)
+	}
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+)
+
+func TestManualMode_ResumeMidDeal(t *testing.T) {
+	me := domain.NewPlayer("Me", nil)
+	bot := domain.NewPlayer("Bot", &strategy.ProbabilisticStrategy{})
+	players := []*domain.Player{me, bot}
+
+	svc := NewManualGameService(bufio.NewReader(strings.NewReader("5\n")), &MockLogger{})
+	svc.Game = domain.NewGame(players)
+	svc.Game.RoundCount = 1
+	svc.Game.Deck = domain.NewDeck()
+	svc.Game.CurrentRound = domain.NewRound(players, me, svc.Game.Deck)
+	svc.initialDeal = &initialDealProgress{Order: getPlayerIDs(svc.Game.CurrentRound.ActivePlayers)}
+
+	// Me is dealt a 5, then the game is saved before Bot's card
+	if !svc.dealInitialCard() {
+		t.Fatal("Expected the deal to continue")
+	}
+	code, err := svc.SaveState()
+	if err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+
+	// Resume: Bot is dealt 7, then both stay
+	resumed := NewManualGameService(bufio.NewReader(strings.NewReader("7\nS\nS\n")), &MockLogger{})
+	if err := resumed.LoadState(code); err != nil {
+		t.Fatalf("LoadState failed: %v", err)
+	}
+	if resumed.initialDeal == nil || resumed.initialDeal.Next != 1 {
+		t.Fatalf("Expected deal progress to be restored at the second player, got %+v", resumed.initialDeal)
+	}
+
+	resumed.playRound()
+
+	loadedMe := resumed.Game.Players[0]
+	loadedBot := resumed.Game.Players[1]
+	if !resumed.Game.CurrentRound.IsEnded {
+		t.Errorf("Expected the round to end")
+	}
+	if loadedMe.TotalScore != 5 {
+		t.Errorf("Expected Me to bank the dealt 5, got %d", loadedMe.TotalScore)
+	}
+	if loadedBot.TotalScore != 7 {
+		t.Errorf("Expected Bot to bank the dealt 7, got %d", loadedBot.TotalScore)
+	}
+}
+
+func TestManualMode_InitialDealActionsAgainstEarlierAndLaterSeats(t *testing.T) {
+	// Same rule as the simulator: seats are P1 (dealer), P2, P3 and everyone stays.
+	// Targets are entered as their 1-based position among the active players.
+	tests := []struct {
+		name        string
+		input       string
+		target      int
+		wantStatus  domain.HandStatus
+		wantNumbers int
+		wantTotals  [3]int
+	}{
+		{
+			// P1 freezes P3 before P3's card: P3 banks an empty hand and is never asked for one
+			name:        "Freeze later seat",
+			input:       "F\n3\n6\nS\nS\n",
+			target:      2,
+			wantStatus:  domain.HandStatusFrozen,
+			wantNumbers: 0,
+			wantTotals:  [3]int{0, 6, 0},
+		},
+		{
+			name:        "Freeze earlier seat",
+			input:       "5\nF\n1\n7\nS\nS\n",
+			target:      0,
+			wantStatus:  domain.HandStatusFrozen,
+			wantNumbers: 1,
+			wantTotals:  [3]int{5, 0, 7},
+		},
+		{
+			name:        "Flip Three later seat",
+			input:       "T\n3\n2\n3\n4\n6\n7\nS\nS\nS\n",
+			target:      2,
+			wantStatus:  domain.HandStatusStayed,
+			wantNumbers: 4,
+			wantTotals:  [3]int{0, 6, 16},
+		},
+		{
+			name:        "Flip Three busts later seat",
+			input:       "T\n3\n4\n4\n6\nS\nS\n",
+			target:      2,
+			wantStatus:  domain.HandStatusBusted,
+			wantNumbers: 2,
+			wantTotals:  [3]int{0, 6, 0},
+		},
+		{
+			name:        "Flip Three earlier seat",
+			input:       "5\nT\n1\n2\n3\n4\n7\nS\nS\nS\n",
+			target:      0,
+			wantStatus:  domain.HandStatusStayed,
+			wantNumbers: 4,
+			wantTotals:  [3]int{14, 0, 7},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := []*domain.Player{
+				domain.NewPlayer("P1", nil),
+				domain.NewPlayer("P2", nil),
+				domain.NewPlayer("P3", nil),
+			}
+			svc := NewManualGameService(bufio.NewReader(strings.NewReader(tt.input)), &MockLogger{})
+			svc.Game = domain.NewGame(players)
+			svc.Game.RoundCount = 1
+			svc.Game.Deck = domain.NewDeck()
+			svc.Game.CurrentRound = domain.NewRound(players, players[0], svc.Game.Deck)
+			svc.initialDeal = &initialDealProgress{Order: getPlayerIDs(svc.Game.CurrentRound.ActivePlayers)}
+
+			svc.playRound()
+
+			if !svc.Game.CurrentRound.IsEnded {
+				t.Fatalf("Expected the round to end")
+			}
+			target := players[tt.target]
+			if target.CurrentHand.Status != tt.wantStatus {
+				t.Errorf("Expected %s to end with status %v, got %v", target.Name, tt.wantStatus, target.CurrentHand.Status)
+			}
+			if got := len(target.CurrentHand.RawNumberCards); got != tt.wantNumbers {
+				t.Errorf("Expected %s to hold %d number cards, got %d", target.Name, tt.wantNumbers, got)
+			}
+			for i, p := range players {
+				if p.TotalScore != tt.wantTotals[i] {
+					t.Errorf("Expected %s to bank %d, got %d", p.Name, tt.wantTotals[i], p.TotalScore)
+				}
+			}
+		})
+	}
+}
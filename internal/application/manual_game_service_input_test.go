@@ -0,0 +1,129 @@
+package application_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+// runManual runs a manual game on input and fails the test if Run does not return in time.
+func runManual(t *testing.T, input io.Reader) *application.ManualGameService {
+	t.Helper()
+	service := application.NewManualGameService(bufio.NewReader(input), nil)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		service.Run()
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run did not return after the input ended")
+	}
+	return service
+}
+
+func TestManualMode_TruncatedInputEndsGame(t *testing.T) {
+	setup := []string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me deals first
+		"",    // Default winning score
+	}
+
+	tests := []struct {
+		name  string
+		lines []string
+		check func(t *testing.T, service *application.ManualGameService)
+	}{
+		{
+			name:  "setup",
+			lines: []string{"", "2", "Bot"}, // Ends at the start player prompt
+			check: func(t *testing.T, service *application.ManualGameService) {
+				if service.Game != nil {
+					t.Errorf("Expected no game to be set up, got %d players", len(service.Game.Players))
+				}
+			},
+		},
+		{
+			name:  "target selection",
+			lines: append(append([]string{}, setup...), "F"), // Me is dealt Freeze; ends at the target prompt
+			check: func(t *testing.T, service *application.ManualGameService) {
+				if !service.Game.IsCompleted {
+					t.Error("Expected the game to be completed")
+				}
+				if bot := service.Game.Players[1]; bot.CurrentHand.Status == domain.HandStatusFrozen {
+					t.Error("Expected no one to be frozen when no target was chosen")
+				}
+			},
+		},
+		{
+			name:  "Flip Three card entry",
+			lines: append(append([]string{}, setup...), "T", "2", "5"), // Bot draws one card, then the input ends
+			check: func(t *testing.T, service *application.ManualGameService) {
+				if !service.Game.IsCompleted {
+					t.Error("Expected the game to be completed")
+				}
+				if reason := service.Game.CurrentRound.EndReason; reason != domain.RoundEndReasonAborted {
+					t.Errorf("Expected the round to be aborted, got %q", reason)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := runManual(t, strings.NewReader(strings.Join(tt.lines, "\n")+"\n"))
+			tt.check(t, service)
+		})
+	}
+}
+
+func TestManualMode_FailingInputEndsGame(t *testing.T) {
+	service := runManual(t, iotest.ErrReader(errors.New("device unplugged")))
+	if service.Game != nil {
+		t.Errorf("Expected no game to be set up, got %d players", len(service.Game.Players))
+	}
+}
+
+func TestManualMode_TerminalInput(t *testing.T) {
+	// A Windows console sends CRLF line endings, a pasted answer may start with a byte order
+	// mark, and a Japanese IME types full-width digits. Me is dealt +10, then Bot a Freeze
+	// and Me is chosen as its target, so Me banks 10 only if every answer was understood.
+	tests := []struct {
+		name   string
+		target string
+	}{
+		{"ASCII", "1"},
+		{"Spaces", " 1 "},
+		{"CRLF", "1\r"},
+		{"Byte order mark", "\uFEFF1"},
+		{"Full-width", "１"},
+		{"Ideographic spaces", "　１　"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := []string{"\uFEFF", "２", "Bot", "１", "", "＋１０", "ｆ", tt.target}
+			input := strings.Join(lines, "\r\n") + "\r\n"
+			service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+			var out strings.Builder
+			service.Out = &out
+			service.Run()
+
+			if got := service.Game.Players[1].Name; got != "Bot" {
+				t.Errorf("Expected the second player to be named %q, got %q", "Bot", got)
+			}
+			if me := service.Game.Players[0]; me.TotalScore != 10 {
+				t.Errorf("Expected Me to be frozen on +10 and bank 10, got %d\n%s", me.TotalScore, out.String())
+			}
+		})
+	}
+}
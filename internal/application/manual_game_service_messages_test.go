@@ -0,0 +1,111 @@
+package application_test
+
+import (
+	"bufio"
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+func TestManualMode_JapaneseMessages(t *testing.T) {
+	// Me deals and is dealt a Freeze, aimed at Bot; then it is Me's turn until the input ends.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "F", "2"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.Messages = console.NewMessages(console.LanguageJapanese)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	for _, want := range []string{
+		"--- 新しいラウンド！ 親: Me ---",
+		"Meの最初のカード: ",
+		"フリーズする対象を選択:\n1. Me（あなた）（得点: 0）手札: []",
+		"2. Bot（得点: 0）手札: []",
+		"番号を入力: ",
+		"Botをフリーズ！",
+		">>> Meの番（得点: 0）",
+		"入力 (0-12, +N, x2, F, T, C, S, W, P/TABLE, MEM, U/UNDO/<, R/REDO/>, HIST, CHECK, SAVE, EMPTY): ",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected the output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "Select target") || strings.Contains(out.String(), "Turn:") {
+		t.Errorf("Expected no English prompts, got:\n%s", out.String())
+	}
+}
+
+func TestManualMode_TurnPromptText(t *testing.T) {
+	// Me is dealt 5 and Bot 7, then Me stays.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "5", "7", "S"}, "\n") + "\n"
+	var out bytes.Buffer
+	service := application.NewManualGameServiceWithOutput(bufio.NewReader(strings.NewReader(input)), nil, &out)
+
+	service.Run()
+
+	// The expected number of turns and the risk of several hits are estimated by simulation,
+	// so only their form is checked. Bot cannot complete Flip 7 before Me's next turn.
+	want := regexp.QuoteMeta("\n>>> Turn: Me (Score: 0)\n"+
+		"Current Hand: [5] | Score: 5\n"+
+		"Bust Rate: 4.35% (full counting)\n"+
+		"Bust within: 1 hit 4% | 2 hits ") + `\d+% \| 3 hits \d+%\n` +
+		regexp.QuoteMeta("Unique numbers: 1/7 — safe values remaining: 0,1,2,3,4,6,7,8,9,10,11,12 (73 cards), unsafe: 5 (4 cards)\n") +
+		`~\d+\.\d more turns expected; 0% chance the round ends before your next turn\n` +
+		regexp.QuoteMeta("Staying now leads, +195 to win; Bot would pass by staying now (+7)\n"+
+			"Suggested Move: hit\n"+
+			"Input (0-12, +N, x2, F, T, C, S, W, P/TABLE, MEM, U/UNDO/<, R/REDO/>, HIST, CHECK, SAVE, EMPTY): "+
+			"Me banked 5 points! Total: 5\n"+
+			"Banked 5 = 5\n")
+	if !regexp.MustCompile(want).MatchString(out.String()) {
+		t.Errorf("Expected Me's turn to match:\n%s\ngot:\n%s", want, out.String())
+	}
+}
+
+func TestManualMode_MemCommand(t *testing.T) {
+	// Me is dealt 5 and Bot 7; Me switches the memory model twice, then stays.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "5", "7", "MEM", "MEM", "S"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	// Nothing was discarded in the first round, so remembering it is full counting; without
+	// counting, Bot's 7 goes back into the deck: four 5s among 93 cards.
+	for _, want := range []string{
+		"Bust Rate: 4.35% (full counting)",
+		"Memory model: round memory.\nBust Rate: 4.35% (round memory)",
+		"Memory model: no counting.\nBust Rate: 4.30% (no counting)",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected the output to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestManualMode_TableCommand(t *testing.T) {
+	// Me is dealt 5 and Bot 7; Me asks for the table, then hits 3 on the same turn.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "5", "7", "P", "3"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	want := "\n--- Table: round 1 | deck 92 | discard 0 ---\n" +
+		"  | Player      | Status | Total | Hand | Hand score | SC\n" +
+		"--+-------------+--------+-------+------+------------+---\n" +
+		"→ | Me (dealer) | active |     0 | [5]  |          5 |\n" +
+		"  | Bot         | active |     0 | [7]  |          7 |\n"
+	if !strings.Contains(out.String(), want) {
+		t.Errorf("Expected the table:\n%s\ngot:\n%s", want, out.String())
+	}
+	if !strings.Contains(out.String(), "Played: 3\nCurrent Hand: [3, 5]") {
+		t.Errorf("Expected P not to use up the turn, so Me hits 3 after it, got:\n%s", out.String())
+	}
+}
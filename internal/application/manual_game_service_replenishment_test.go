@@ -2,6 +2,7 @@ package application
 
 import (
 	"bufio"
+	"errors"
 	"flip7_strategy/internal/domain"
 	"strings"
 	"testing"
@@ -48,6 +49,7 @@ func TestManualGameService_Replenishment(t *testing.T) {
 	}
 
 	// 2. Remove Card 2 (Should succeed due to replenishment)
+	historyBefore := svc.History.Len()
 	err = svc.removeCardFromDeck(card2)
 	if err != nil {
 		t.Errorf("Replenishment Failed: Expected success when removing card 2 from replenished deck, but got error: %v", err)
@@ -64,5 +66,129 @@ func TestManualGameService_Replenishment(t *testing.T) {
 		if svc.Game.Deck != svc.Game.CurrentRound.Deck {
 			t.Error("Expected Game.Deck to be updated to CurrentRound.Deck after replenishment")
 		}
+
+		// 3. The reshuffle is recorded as a history state of its own
+		if svc.History.Len() != historyBefore+1 {
+			t.Errorf("Expected the reshuffle to push a history state, got %d states (was %d)", svc.History.Len(), historyBefore)
+		}
+	}
+}
+
+func TestManualGameService_RemoveCardFromDeckErrors(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader(""))
+	svc := NewManualGameService(reader, &MockLogger{})
+
+	p1 := domain.NewPlayer("P1", nil)
+	svc.Game = domain.NewGame([]*domain.Player{p1})
+
+	card5 := domain.Card{Type: domain.CardTypeNumber, Value: 5}
+
+	// No round in progress: nothing to retry
+	if err := svc.removeCardFromDeck(card5); !errors.Is(err, domain.ErrNoActiveRound) {
+		t.Errorf("Expected ErrNoActiveRound, got %v", err)
+	}
+
+	// Round in progress, but every 5 has been drawn and the discard pile is empty
+	svc.Game.CurrentRound = domain.NewRound(svc.Game.Players, p1, domain.NewDeckFromCards([]domain.Card{
+		{Type: domain.CardTypeNumber, Value: 1},
+	}))
+	err := svc.removeCardFromDeck(card5)
+	if !errors.Is(err, domain.ErrCardNotInDeck) {
+		t.Errorf("Expected ErrCardNotInDeck, got %v", err)
+	}
+	if err != nil && !strings.Contains(err.Error(), card5.String()) {
+		t.Errorf("Expected the error to name the card, got %v", err)
+	}
+}
+
+func TestManualGameService_RemoveCardFromDeckRejectsAccountedCard(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader(""))
+	svc := NewManualGameService(reader, &MockLogger{})
+
+	p1 := domain.NewPlayer("P1", nil)
+	svc.Game = domain.NewGame([]*domain.Player{p1})
+	card1 := domain.Card{Type: domain.CardTypeNumber, Value: 1}
+	card2 := domain.Card{Type: domain.CardTypeNumber, Value: 2}
+	deck := domain.NewDeckInOrder([]domain.Card{{Type: domain.CardTypeNumber, Value: 3}})
+	svc.Game.CurrentRound = domain.NewRound(svc.Game.Players, p1, deck)
+	svc.Game.Deck = deck
+	svc.Game.DiscardPile = []domain.Card{card2}
+
+	// The only 1 is in P1's hand: a second one cannot exist anywhere
+	p1.CurrentHand.AddCard(card1)
+
+	err := svc.removeCardFromDeck(card1)
+	if !errors.Is(err, domain.ErrCardNotInDeck) {
+		t.Fatalf("Expected ErrCardNotInDeck, got %v", err)
+	}
+	expected := "all 1 copies of 1 are already accounted for (deck: 0, discard: 0, hands: 1)"
+	if !strings.Contains(err.Error(), expected) {
+		t.Errorf("Expected %q in the error, got %v", expected, err)
+	}
+
+	// No reshuffle was attempted
+	if svc.Game.CurrentRound.Deck != deck || len(deck.Cards) != 1 {
+		t.Error("Expected the deck to be left untouched")
+	}
+	if len(svc.Game.DiscardPile) != 1 {
+		t.Errorf("Expected the discard pile to be left untouched, got %d cards", len(svc.Game.DiscardPile))
+	}
+}
+
+func TestManualGameService_RemoveCardFromEmptyTable(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader(""))
+	svc := NewManualGameService(reader, nil)
+
+	p1 := domain.NewPlayer("P1", nil)
+	svc.Game = domain.NewGame([]*domain.Player{p1})
+	deck := domain.NewDeckInOrder(nil)
+	svc.Game.CurrentRound = domain.NewRound(svc.Game.Players, p1, deck)
+	svc.Game.Deck = deck
+
+	// Neither the deck nor the discard pile holds a card: no card can be drawn, whichever it is
+	err := svc.removeCardFromDeck(domain.Card{Type: domain.CardTypeNumber, Value: 5})
+	if !errors.Is(err, domain.ErrDeckEmpty) {
+		t.Errorf("Expected ErrDeckEmpty, got %v", err)
+	}
+}
+
+// reshuffleCounter records the decks it is told about by OnReshuffle.
+type reshuffleCounter struct {
+	firstNumberStrategy
+	decks []domain.DeckView
+}
+
+func (s *reshuffleCounter) OnReshuffle(deck domain.DeckView) {
+	s.decks = append(s.decks, deck)
+}
+
+func TestManualGameService_ReshuffleNotifiesStrategies(t *testing.T) {
+	var out strings.Builder
+	svc := NewManualGameServiceWithOutput(bufio.NewReader(strings.NewReader("")), nil, &out)
+	p1 := domain.NewPlayer("P1", nil)
+	bot := domain.NewPlayer("Bot", nil)
+	svc.Game = domain.NewGame([]*domain.Player{p1, bot})
+	svc.Game.Deck = domain.NewDeckInOrder([]domain.Card{{Type: domain.CardTypeNumber, Value: 1}})
+	svc.Game.CurrentRound = domain.NewRound(svc.Game.Players, p1, svc.Game.Deck)
+	svc.Game.DiscardPile = []domain.Card{{Type: domain.CardTypeNumber, Value: 2}, {Type: domain.CardTypeNumber, Value: 3}}
+	seat, outlook := &reshuffleCounter{}, &reshuffleCounter{}
+	svc.AppSeats = map[string]domain.Strategy{bot.ID.String(): seat}
+	svc.TurnOutlookModel = outlook
+
+	// 2 is only in the discard pile: the deck is rebuilt from it, then 3 is taken from that deck.
+	for _, v := range []domain.NumberValue{2, 3} {
+		if err := svc.removeCardFromDeck(domain.Card{Type: domain.CardTypeNumber, Value: v}); err != nil {
+			t.Fatalf("Remove %d: %v", v, err)
+		}
+	}
+	for name, counter := range map[string]*reshuffleCounter{"app seat": seat, "turn outlook model": outlook} {
+		if len(counter.decks) != 1 || counter.decks[0] != svc.Game.CurrentRound.Deck {
+			t.Errorf("Expected the %s to be told about the reshuffle once, got %d", name, len(counter.decks))
+		}
+	}
+
+	svc.printBustRate(p1)
+	if want := "(full counting) (deck reshuffled this round)"; !strings.Contains(out.String(), want) {
+		t.Errorf("Expected the bust rate to note the reshuffle, got:\n%s", out.String())
 	}
 }
@@ -0,0 +1,54 @@
+package application_test
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+func TestManualMode_PrintsRoundSummary(t *testing.T) {
+	// Me is dealt 5 and Bot 7. Me hits +4, Bot stays on 7 and Me stays on 5 +4; the input ends in round 2.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "5", "7", "+4", "S", "S"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	logger := &recordingLogger{}
+	service.Logger = logger
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	want := "\n--- Round 1 Summary (every player is out) ---\n" +
+		" - Me: [5, +4] stayed | +9 | Total: 9\n" +
+		" - Bot: [7] stayed | +7 | Total: 7\n" +
+		fmt.Sprintf("Cards left in the deck: %d\n", domain.NewDeck().Remaining()-3) +
+		"\n--- New Round! Dealer: Bot ---\n"
+	if !strings.Contains(out.String(), want) {
+		t.Errorf("Expected the output to contain:\n%s\ngot:\n%s", want, out.String())
+	}
+
+	var summaries []recordedEvent
+	for _, e := range logger.events {
+		if e.eventType == "RoundSummary" {
+			summaries = append(summaries, e)
+		}
+	}
+	if len(summaries) != 1 {
+		t.Fatalf("Expected 1 RoundSummary event for the round that ended, got %d", len(summaries))
+	}
+	details := summaries[0].details
+	if details["reason"] != string(domain.RoundEndReasonNoActivePlayers) {
+		t.Errorf("Expected reason %q, got %v", domain.RoundEndReasonNoActivePlayers, details["reason"])
+	}
+	players, _ := details["players"].([]map[string]interface{})
+	if len(players) != 2 {
+		t.Fatalf("Expected 2 players in the summary, got %v", details["players"])
+	}
+	me := players[0]
+	if me["name"] != "Me" || me["hand"] != "[5, plus_4]" || me["status"] != "stayed" || me["banked_score"] != 9 || me["total_score"] != 9 {
+		t.Errorf("Unexpected summary for Me: %v", me)
+	}
+}
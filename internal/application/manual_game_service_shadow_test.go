@@ -0,0 +1,85 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+func TestManualMode_ShadowAdvisors(t *testing.T) {
+	// Me is dealt 1 and Bot 0. Me draws a Freeze and freezes Bot, then stays; the input ends in round 2.
+	// The shadow always stays and targets the first candidate (Me), so it disagrees with the hit and
+	// with the Freeze target, and agrees with the stay.
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "1", "0", "F", "2", "S"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	logger := &recordingLogger{}
+	service.Logger = logger
+	service.ShadowAdvisors = []domain.Strategy{&MockStrategy{DecideResult: domain.TurnChoiceStay}}
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	type shadowEvent struct {
+		decision, shadow, chosen string
+		agreed                   bool
+	}
+	var got []shadowEvent
+	for _, e := range logger.events {
+		if e.eventType != "ShadowDecision" {
+			continue
+		}
+		if e.details["advisor"] != "Mock" {
+			t.Errorf("Expected the advisor to be Mock, got %v", e.details["advisor"])
+		}
+		got = append(got, shadowEvent{
+			decision: e.details["decision"].(string),
+			shadow:   e.details["shadow"].(string),
+			chosen:   e.details["chosen"].(string),
+			agreed:   e.details["agreed"].(bool),
+		})
+	}
+	want := []shadowEvent{
+		{decision: "freeze", shadow: "Me", chosen: "Bot"}, // Chosen while the hit resolves
+		{decision: "turn", shadow: "stay", chosen: "hit"},
+		{decision: "turn", shadow: "stay", chosen: "stay", agreed: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d ShadowDecision events, got %+v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("ShadowDecision %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	for _, line := range []string{
+		"--- Shadow Advisors ---",
+		"Mock: agreed on 1 of 3 decisions (33%)",
+		" - Round 1, Me: Mock would stay, you chose hit",
+		" - Round 1, Me's freeze: Mock would target Me, you chose Bot",
+	} {
+		if !strings.Contains(out.String(), line) {
+			t.Errorf("Expected the summary to contain %q, got:\n%s", line, out.String())
+		}
+	}
+	if n := strings.Count(out.String(), "Mock would"); n != 2 {
+		t.Errorf("Expected 2 divergences in the summary, got %d:\n%s", n, out.String())
+	}
+}
+
+func TestManualMode_NoShadowSummaryWithoutAdvisors(t *testing.T) {
+	input := strings.Join([]string{"", "2", "Bot", "1", "", "1", "0", "S"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	if strings.Contains(out.String(), "Shadow Advisors") {
+		t.Errorf("Expected no shadow summary without advisors, got:\n%s", out.String())
+	}
+}
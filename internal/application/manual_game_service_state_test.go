@@ -3,12 +3,19 @@ package application_test
 import (
 	"bufio"
 	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 
 	"flip7_strategy/internal/application"
 	"flip7_strategy/internal/domain"
 	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/testutil"
 )
 
 func TestGameStateSerialization(t *testing.T) {
@@ -18,14 +25,15 @@ func TestGameStateSerialization(t *testing.T) {
 	players := []*domain.Player{p1, p2}
 	game := domain.NewGame(players)
 
-	deck := domain.NewDeck()
-	dealer := p1
-	game.CurrentRound = domain.NewRound(players, dealer, deck)
-	game.DealerIndex = 0
-
 	// Modify state to ensure it's captured
 	p1.TotalScore = 50
+	p1.StartNewRound()
 	p1.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 5})
+
+	deck := domain.NewDeck()
+	dealer := p1
+	game.CurrentRound = testutil.NewRound(players, dealer, deck)
+	game.DealerIndex = 0
 	game.CurrentRound.ActivePlayers = []*domain.Player{p1, p2} // Both active
 	game.CurrentRound.CurrentTurnIndex = 1                     // Set to test serialization
 
@@ -180,8 +188,8 @@ func TestSaveStateAndLoadState(t *testing.T) {
 		if err == nil {
 			t.Errorf("LoadState should reject completed game")
 		}
-		if !strings.Contains(err.Error(), "already completed") {
-			t.Errorf("Error should mention game is completed, got: %v", err)
+		if !errors.Is(err, domain.ErrGameCompleted) {
+			t.Errorf("Expected ErrGameCompleted, got: %v", err)
 		}
 	})
 
@@ -212,8 +220,8 @@ func TestSaveStateAndLoadState(t *testing.T) {
 		if err == nil {
 			t.Errorf("LoadState should reject game with ended round")
 		}
-		if !strings.Contains(err.Error(), "already ended") {
-			t.Errorf("Error should mention round is ended, got: %v", err)
+		if !errors.Is(err, domain.ErrRoundEnded) {
+			t.Errorf("Expected ErrRoundEnded, got: %v", err)
 		}
 	})
 
@@ -260,3 +268,416 @@ func TestSaveStateAndLoadState(t *testing.T) {
 		}
 	})
 }
+
+func TestLoadState_ClampsCorruptedDealerIndex(t *testing.T) {
+	p1 := domain.NewPlayer("P1", &strategy.ProbabilisticStrategy{})
+	p2 := domain.NewPlayer("P2", &strategy.ProbabilisticStrategy{})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+
+	reader := bufio.NewReader(strings.NewReader(""))
+	service := application.NewManualGameService(reader, nil)
+	service.Game = game
+
+	for _, idx := range []int{5, -1} {
+		game.DealerIndex = idx
+		saveCode, err := service.SaveState()
+		if err != nil {
+			t.Fatalf("SaveState failed: %v", err)
+		}
+
+		newService := application.NewManualGameService(reader, nil)
+		if err := newService.LoadState(saveCode); err != nil {
+			t.Fatalf("LoadState failed: %v", err)
+		}
+		if newService.Game.DealerIndex != 0 {
+			t.Errorf("DealerIndex %d: expected clamp to 0, got %d", idx, newService.Game.DealerIndex)
+		}
+	}
+}
+
+func TestLoadState_RejectsSaveWithoutPlayers(t *testing.T) {
+	reader := bufio.NewReader(strings.NewReader(""))
+	service := application.NewManualGameService(reader, nil)
+	service.Game = domain.NewGame([]*domain.Player{})
+
+	saveCode, err := service.SaveState()
+	if err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+
+	if err := application.NewManualGameService(reader, nil).LoadState(saveCode); err == nil {
+		t.Errorf("Expected LoadState to reject a save without players")
+	}
+}
+
+// The fixtures in testdata/save_codes hold the same mid-round state (Alice, user-controlled,
+// 41 points with a 7 in hand; Bob 27 points; second round of a game) in each save format.
+func TestLoadState_SupportedSaveVersions(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("testdata", "save_codes", "*.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("No save code fixtures found")
+	}
+
+	for _, file := range files {
+		t.Run(filepath.Base(file), func(t *testing.T) {
+			code, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			service := application.NewManualGameService(bufio.NewReader(strings.NewReader("")), nil)
+			if err := service.LoadState(strings.TrimSpace(string(code))); err != nil {
+				t.Fatalf("LoadState failed: %v", err)
+			}
+
+			g := service.Game
+			if len(g.Players) != 2 {
+				t.Fatalf("Expected 2 players, got %d", len(g.Players))
+			}
+			alice, bob := g.Players[0], g.Players[1]
+			if alice.Name != "Alice" || alice.TotalScore != 41 || bob.Name != "Bob" || bob.TotalScore != 27 {
+				t.Errorf("Expected Alice 41 and Bob 27, got %s %d and %s %d", alice.Name, alice.TotalScore, bob.Name, bob.TotalScore)
+			}
+			if alice.Strategy != nil || bob.Strategy == nil {
+				t.Errorf("Expected only Alice to be user-controlled")
+			}
+			if _, ok := alice.CurrentHand.NumberCards[7]; !ok {
+				t.Errorf("Expected Alice to hold a 7")
+			}
+			// Every version, v1 included through its migration, lets a player holding cards stay.
+			if !alice.CurrentHand.CanStay() {
+				t.Errorf("Expected Alice, holding a 7, to be allowed to stay")
+			}
+			if g.CurrentRound == nil || g.CurrentRound.Dealer != alice {
+				t.Errorf("Expected the round in progress to be restored with Alice as dealer")
+			}
+			if service.GameID != "game_fixture" {
+				t.Errorf("Expected GameID game_fixture, got %s", service.GameID)
+			}
+
+			// v1 codes predate the score history, so it starts empty for every player.
+			wantHistory := map[string][]int{alice.ID.String(): {}, bob.ID.String(): {}}
+			if !strings.HasPrefix(filepath.Base(file), "v1_") {
+				wantHistory = map[string][]int{alice.ID.String(): {18, 41}, bob.ID.String(): {27, 27}}
+			}
+			if !reflect.DeepEqual(service.ScoreHistory, wantHistory) {
+				t.Errorf("Expected score history %v, got %v", wantHistory, service.ScoreHistory)
+			}
+
+			// Card allowances are tracked from v3 on; older rounds in progress are not checked.
+			allowance, tracked := g.CurrentRound.CardAllowances[alice.ID.String()]
+			if base := filepath.Base(file); !strings.HasPrefix(base, "v1_") && !strings.HasPrefix(base, "v2_") {
+				if !tracked || allowance.Turns != 1 {
+					t.Errorf("Expected Alice's turn to be tracked, got %+v", g.CurrentRound.CardAllowances)
+				}
+			} else if g.CurrentRound.CardAllowances != nil {
+				t.Errorf("Expected no card allowances, got %+v", g.CurrentRound.CardAllowances)
+			}
+
+			// App seats are kept from v4 on: Bob is the app's Adaptive there.
+			wantSeat := application.SeatPhysical
+			if strings.HasPrefix(filepath.Base(file), "v4_") {
+				wantSeat = application.SeatAI
+			}
+			if got := service.Seat(bob); got != wantSeat {
+				t.Errorf("Expected Bob to play %s, got %s", wantSeat, got)
+			}
+
+			// Re-saving writes the current format.
+			resaved, err := service.SaveState()
+			if err != nil {
+				t.Fatalf("SaveState failed: %v", err)
+			}
+			if version := saveVersion(t, resaved); version != 4 {
+				t.Errorf("Expected re-saved code to be version 4, got %d", version)
+			}
+		})
+	}
+}
+
+func TestLoadState_RejectsNewerSaveVersion(t *testing.T) {
+	// A future format may change field types; the version must be checked before decoding them.
+	future := base64.StdEncoding.EncodeToString([]byte(`{"version": 99, "game": "reshaped"}`))
+
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader("")), nil)
+	err := service.LoadState(future)
+	if err == nil {
+		t.Fatal("Expected LoadState to reject a save code from a newer version")
+	}
+	if !strings.Contains(err.Error(), "newer version") {
+		t.Errorf("Expected a newer version error, got: %v", err)
+	}
+}
+
+func TestManualMode_RecordsScoreHistoryPerCompletedRound(t *testing.T) {
+	input := strings.Join([]string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me deals first
+		"",    // Default winning score
+		"F",   // Initial deal: Me is dealt Freeze
+		"2",   // ...and freezes Bot
+		"5",   // Me hits
+		"S",   // Me stays; round 1 ends
+		"3",   // Round 2 initial deal, then input runs out
+	}, "\n") + "\n"
+
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.Run()
+
+	me, bot := service.Game.Players[0], service.Game.Players[1]
+	want := map[string][]int{me.ID.String(): {5}, bot.ID.String(): {0}}
+	if !reflect.DeepEqual(service.ScoreHistory, want) {
+		t.Errorf("Expected only the completed round in the history %v, got %v", want, service.ScoreHistory)
+	}
+}
+
+func saveVersion(t *testing.T, code string) int {
+	t.Helper()
+	data, err := base64.StdEncoding.DecodeString(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		t.Fatal(err)
+	}
+	return header.Version
+}
+
+func TestManualMode_SaveDuringTurnResumesSamePlayer(t *testing.T) {
+	input := strings.Join([]string{
+		"",      // No resume
+		"3",     // Players
+		"Bob",   // Player 2 name
+		"Carol", // Player 3 name
+		"1",     // Me deals first
+		"",      // Default winning score
+		"5",     // Initial deal: Me
+		"6",     // Bob
+		"7",     // Carol
+		"8",     // Me hits; input ends at the start of Bob's turn
+	}, "\n") + "\n"
+
+	first := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	first.Run()
+	bob := first.Game.Players[1].ID.String()
+
+	// This is the code the SAVE command would print at Bob's prompt
+	// (before running out of input marked the game as completed).
+	first.Game.IsCompleted = false
+	code, err := first.SaveState()
+	if err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+
+	// A code whose turn index is off by one (as when it was taken between turns) still resumes with Bob.
+	decoded, err := base64.StdEncoding.DecodeString(code)
+	if err != nil {
+		t.Fatalf("Failed to decode save code: %v", err)
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal(decoded, &raw); err != nil {
+		t.Fatalf("Failed to parse save code: %v", err)
+	}
+	raw["game"].(map[string]interface{})["current_round"].(map[string]interface{})["current_turn_index"] = 2
+	staleJSON, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatalf("Failed to encode save code: %v", err)
+	}
+	staleCode := base64.StdEncoding.EncodeToString(staleJSON)
+
+	for name, code := range map[string]string{"as saved": code, "stale turn index": staleCode} {
+		t.Run(name, func(t *testing.T) {
+			logger := &recordingLogger{}
+			resumed := application.NewManualGameService(bufio.NewReader(strings.NewReader(code+"\n")), logger)
+			resumed.Run()
+
+			for _, e := range logger.events {
+				if e.eventType != "TurnStart" {
+					continue
+				}
+				if e.playerID != bob {
+					t.Errorf("Expected the first turn after resuming to be Bob's, got player %s", e.playerID)
+				}
+				return
+			}
+			t.Error("Expected a turn to start after resuming")
+		})
+	}
+}
+
+// roundInProgressSave returns the decoded JSON of a save made mid-round: three players,
+// the first two holding a card each and a Second Chance with the third.
+func roundInProgressSave(t *testing.T) map[string]any {
+	t.Helper()
+	players := []*domain.Player{
+		domain.NewPlayer("P1", &strategy.ProbabilisticStrategy{}),
+		domain.NewPlayer("P2", &strategy.ProbabilisticStrategy{}),
+		domain.NewPlayer("P3", nil),
+	}
+	game := domain.NewGame(players)
+	game.Deck = fullDeckStartingWith(
+		domain.Card{Type: domain.CardTypeNumber, Value: 5},
+		domain.Card{Type: domain.CardTypeNumber, Value: 7},
+		domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance},
+	)
+	game.CurrentRound = domain.NewRound(players, players[0], game.Deck)
+	game.RoundCount = 1
+	for _, p := range game.CurrentRound.ActivePlayers {
+		card, err := game.Deck.Draw()
+		if err != nil {
+			t.Fatalf("Draw failed: %v", err)
+		}
+		p.CurrentHand.AddCard(card)
+	}
+
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader("")), nil)
+	service.Game = game
+	code, err := service.SaveState()
+	if err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+	data, err := base64.StdEncoding.DecodeString(code)
+	if err != nil {
+		t.Fatalf("Save code is not base64: %v", err)
+	}
+	var save map[string]any
+	if err := json.Unmarshal(data, &save); err != nil {
+		t.Fatalf("Save code is not JSON: %v", err)
+	}
+	return save
+}
+
+func TestLoadState_RejectsCorruptedSaves(t *testing.T) {
+	game := func(save map[string]any) map[string]any { return save["game"].(map[string]any) }
+	round := func(save map[string]any) map[string]any { return game(save)["current_round"].(map[string]any) }
+	players := func(save map[string]any) []any { return game(save)["players"].([]any) }
+	player := func(save map[string]any, i int) map[string]any { return players(save)[i].(map[string]any) }
+	hand := func(save map[string]any, i int) map[string]any {
+		return player(save, i)["current_hand"].(map[string]any)
+	}
+	deck := func(save map[string]any) map[string]any { return game(save)["deck"].(map[string]any) }
+	stranger := map[string]any{"id": "00000000-0000-0000-0000-000000000001", "name": "Stranger"}
+	number := func(v int) map[string]any { return map[string]any{"type": "number", "value": v} }
+
+	tests := []struct {
+		name    string
+		corrupt func(save map[string]any)
+		want    string
+	}{
+		{"negative round count", func(s map[string]any) { game(s)["round_count"] = -1 }, "negative round count"},
+		{"duplicate player ID", func(s map[string]any) { player(s, 1)["id"] = player(s, 0)["id"] }, "used twice"},
+		{"missing player", func(s map[string]any) { players(s)[2] = nil }, "player 3 is missing"},
+		{"too many players", func(s map[string]any) {
+			all := players(s)
+			for i := 0; i < 500; i++ {
+				all = append(all, map[string]any{"id": fmt.Sprintf("00000000-0000-0000-0000-%012d", i), "name": "Extra"})
+			}
+			game(s)["players"] = all
+		}, "503 players"},
+		{"unknown round player", func(s map[string]any) { round(s)["players"].([]any)[1] = stranger }, "round player"},
+		{"unknown active player", func(s map[string]any) { round(s)["active_players"] = []any{stranger} }, "active player"},
+		{"unknown dealer", func(s map[string]any) { round(s)["dealer"] = stranger }, "round dealer"},
+		{"missing dealer", func(s map[string]any) { round(s)["dealer"] = nil }, "round dealer is missing"},
+		{"unknown winner", func(s map[string]any) { game(s)["winners"] = []any{stranger} }, "winner"},
+		{"negative turn index", func(s map[string]any) { round(s)["current_turn_index"] = -1 }, "turn index -1"},
+		{"turn index past the active players", func(s map[string]any) { round(s)["current_turn_index"] = 9 }, "turn index 9"},
+		{"initial deal past its order", func(s map[string]any) {
+			s["initial_deal"] = map[string]any{"order": []any{player(s, 0)["id"]}, "next": 4}
+		}, "initial deal position 4"},
+		{"initial deal to a stranger", func(s map[string]any) {
+			s["initial_deal"] = map[string]any{"order": []any{stranger["id"]}, "next": 0}
+		}, "initial deal"},
+		{"negative remaining count", func(s map[string]any) { deck(s)["remaining_counts"].(map[string]any)["12"] = -3 }, "-3 copies of 12"},
+		{"remaining count above the composition", func(s map[string]any) {
+			deck(s)["remaining_counts"].(map[string]any)["3"] = 40
+		}, "40 copies of 3"},
+		{"huge deck", func(s map[string]any) {
+			cards := deck(s)["cards"].([]any)
+			for i := 0; i < 1000; i++ {
+				cards = append(cards, number(12))
+			}
+			deck(s)["cards"] = cards
+		}, "more than the whole deck"},
+		{"extra copies in the discard pile", func(s map[string]any) {
+			game(s)["discard_pile"] = []any{number(0), number(0)}
+		}, "3 copies of 0"},
+		{"unknown card in the deck", func(s map[string]any) { deck(s)["cards"].([]any)[0] = number(13) }, "unknown card"},
+		{"players without hands during a round", func(s map[string]any) {
+			for i := range players(s) {
+				player(s, i)["current_hand"] = nil
+			}
+		}, "has no hand during a round"},
+		{"round player without a hand", func(s map[string]any) {
+			round(s)["players"].([]any)[0].(map[string]any)["current_hand"] = nil
+		}, "round player"},
+		{"active player without a hand", func(s map[string]any) {
+			round(s)["active_players"].([]any)[0].(map[string]any)["current_hand"] = nil
+		}, "active player"},
+		{"hand number set without its cards", func(s map[string]any) { hand(s, 0)["number_cards"] = nil }, "does not match"},
+		{"number card out of range in a hand", func(s map[string]any) {
+			hand(s, 1)["raw_number_cards"] = []any{-4}
+			hand(s, 1)["number_cards"] = map[string]any{"-4": map[string]any{}}
+		}, "unknown number card -4"},
+		{"app seat for a stranger", func(s map[string]any) {
+			s["app_seats"] = map[string]any{stranger["id"].(string): map[string]any{"kind": "ai", "strategy": "Adaptive"}}
+		}, "app seat"},
+		{"app seat of an unknown kind", func(s map[string]any) {
+			s["app_seats"] = map[string]any{player(s, 1)["id"].(string): map[string]any{"kind": "robot"}}
+		}, `unknown kind "robot"`},
+		{"app seat with an unknown strategy", func(s map[string]any) {
+			s["app_seats"] = map[string]any{player(s, 1)["id"].(string): map[string]any{"kind": "ai", "strategy": "Nonexistent"}}
+		}, "cannot seat"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			save := roundInProgressSave(t)
+			tt.corrupt(save)
+			data, err := json.Marshal(save)
+			if err != nil {
+				t.Fatalf("Marshal failed: %v", err)
+			}
+			code := base64.StdEncoding.EncodeToString(data)
+
+			defer func() {
+				if r := recover(); r != nil {
+					t.Fatalf("LoadState panicked: %v", r)
+				}
+			}()
+			service := application.NewManualGameService(bufio.NewReader(strings.NewReader("")), nil)
+			err = service.LoadState(code)
+			if err == nil {
+				t.Fatalf("Expected LoadState to reject the save")
+			}
+			if !strings.Contains(err.Error(), "invalid save code") || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected an invalid save code error mentioning %q, got: %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestLoadState_AcceptsRoundInProgress(t *testing.T) {
+	data, err := json.Marshal(roundInProgressSave(t))
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader("")), nil)
+	if err := service.LoadState(base64.StdEncoding.EncodeToString(data)); err != nil {
+		t.Fatalf("LoadState failed: %v", err)
+	}
+}
+
+func TestLoadState_RejectsOversizedSave(t *testing.T) {
+	code := base64.StdEncoding.EncodeToString([]byte(`{"version":3,"padding":"` + strings.Repeat("x", 2<<20) + `"}`))
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader("")), nil)
+	if err := service.LoadState(code); err == nil || !strings.Contains(err.Error(), "too large") {
+		t.Errorf("Expected an oversized save to be rejected, got: %v", err)
+	}
+}
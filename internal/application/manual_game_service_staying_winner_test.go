@@ -0,0 +1,29 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+)
+
+func TestManualMode_StayingWinnerBanner(t *testing.T) {
+	// A game to 20: Me is dealt 5 and Bot 12, Me hits a 3, then Bot hits a 9 and reaches 21 by
+	// staying now. Only Me's next turn has the banner.
+	input := strings.Join([]string{"", "2", "Bot", "1", "20", "5", "12", "3", "9"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	banner := "⚠ Bot reaches 21 by staying now — consider Flip Three on Bot (a Freeze would bank it)\n"
+	if got := strings.Count(out.String(), banner); got != 1 {
+		t.Errorf("Expected the banner %q once, got %d times:\n%s", banner, got, out.String())
+	}
+	turn := out.String()[strings.LastIndex(out.String(), ">>> Turn: Me"):]
+	if !strings.Contains(turn, banner) {
+		t.Errorf("Expected the banner on Me's second turn:\n%s", turn)
+	}
+}
@@ -16,7 +16,7 @@ func TestFormatCandidateOption(t *testing.T) {
 	candidate := domain.NewPlayer("Candidate", nil)
 	candidate.TotalScore = 150
 	candidate.CurrentHand = &domain.PlayerHand{
-		RawNumberCards: []domain.NumberValue{5, 8},
+		RawNumberCards: []domain.NumberValue{8, 5},
 	}
 
 	t.Run("Suggested", func(t *testing.T) {
@@ -32,6 +32,9 @@ func TestFormatCandidateOption(t *testing.T) {
 		if !strings.Contains(output, "Score: 150") {
 			t.Errorf("Expected output to contain score, got: %s", output)
 		}
+		if !strings.Contains(output, "Hand: [5, 8]") {
+			t.Errorf("Expected output to contain the hand in ascending order, got: %s", output)
+		}
 	})
 
 	t.Run("NotSuggested", func(t *testing.T) {
@@ -138,7 +141,11 @@ func TestPromptForTargetSuggestionLogic(t *testing.T) {
 		// The service should still work and AdaptiveStrategy should handle nil deck
 		player1 := service.Game.Players[0]
 		player1.CurrentHand = &domain.PlayerHand{
-			RawNumberCards: []domain.NumberValue{3, 7},
+			RawNumberCards: []domain.NumberValue{7, 3},
+			ModifierCards: []domain.Card{
+				{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2},
+				{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4},
+			},
 		}
 
 		// FormatCandidateOption should work even without CurrentRound
@@ -146,7 +153,8 @@ func TestPromptForTargetSuggestionLogic(t *testing.T) {
 		if !strings.Contains(output, "Player1") {
 			t.Errorf("Expected output to contain player name, got: %s", output)
 		}
-		if !strings.Contains(output, "[3, 7]") {
+		// Numbers ascending, then modifiers in table order with their symbols
+		if !strings.Contains(output, "Hand: [3, 7, +4, x2]") {
 			t.Errorf("Expected output to contain hand, got: %s", output)
 		}
 	})
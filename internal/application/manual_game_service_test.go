@@ -1,8 +1,13 @@
 package application_test
 
 import (
+	"bufio"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
 
+	"flip7_strategy/internal/application"
 	"flip7_strategy/internal/domain"
 )
 
@@ -43,7 +48,7 @@ func TestCanPlayerStay(t *testing.T) {
 				h.AddCard(domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2})
 				return h
 			}(),
-			expected: false,
+			expected: true,
 		},
 		{
 			name: "Only Second Chance (no other actions)",
@@ -52,7 +57,7 @@ func TestCanPlayerStay(t *testing.T) {
 				h.AddCard(domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance})
 				return h
 			}(),
-			expected: false,
+			expected: true,
 		},
 		{
 			name: "Second Chance + Other Action",
@@ -81,8 +86,28 @@ func TestCanPlayerStay(t *testing.T) {
 				h.AddCard(domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze})
 				return h
 			}(),
+			expected: true,
+		},
+		{
+			name: "Passed Second Chance without flipping",
+			hand: func() *domain.PlayerHand {
+				h := domain.NewPlayerHand()
+				// Receiving a passed card does not count as flipping.
+				h.ActionCards = append(h.ActionCards, domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance})
+				return h
+			}(),
 			expected: false,
 		},
+		{
+			name: "Flipped a card that left the hand",
+			hand: func() *domain.PlayerHand {
+				h := domain.NewPlayerHand()
+				// e.g. a drawn Second Chance that was passed on to another player
+				h.HasDrawnThisRound = true
+				return h
+			}(),
+			expected: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -93,3 +118,346 @@ func TestCanPlayerStay(t *testing.T) {
 		})
 	}
 }
+
+// recordingLogger keeps every logged event for inspection.
+type recordingLogger struct {
+	events []recordedEvent
+}
+
+type recordedEvent struct {
+	playerID  string
+	eventType string
+	details   map[string]interface{}
+}
+
+func (l *recordingLogger) Log(gameID, roundID, playerID, eventType string, details map[string]interface{}) {
+	l.events = append(l.events, recordedEvent{playerID: playerID, eventType: eventType, details: details})
+}
+
+func (l *recordingLogger) Close() {}
+
+func TestManualMode_TurnEndDuration(t *testing.T) {
+	// Each clock reading is 1.5s after the previous one, so a completed turn
+	// (one reading when the prompt is shown, one when the turn ends) lasts 1500ms.
+	current := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time {
+		current = current.Add(1500 * time.Millisecond)
+		return current
+	}
+
+	input := strings.Join([]string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me starts
+		"",    // Default winning score
+		"3",   // Initial deal: Me (no TurnEnd)
+		"4",   // Initial deal: Bot (no TurnEnd)
+		"5",   // Me hits
+		"U",   // Bot's turn is undone -> back to Me's turn (no TurnEnd)
+		"7",   // Me hits
+		"W",   // Bot asks for the what-if table (same turn continues)
+		"8",   // Bot hits
+	}, "\n") + "\n"
+
+	logger := &recordingLogger{}
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), logger)
+	service.Clock = clock
+	service.Run()
+
+	me := service.Game.Players[0].ID.String()
+	bot := service.Game.Players[1].ID.String()
+
+	var turnEnds []recordedEvent
+	for _, e := range logger.events {
+		if e.eventType == "TurnEnd" {
+			turnEnds = append(turnEnds, e)
+		}
+	}
+
+	expected := []struct {
+		playerID string
+		action   string
+	}{
+		{me, "hit"},
+		{me, "hit"},
+		{bot, "hit"},
+	}
+	if len(turnEnds) != len(expected) {
+		t.Fatalf("Expected %d TurnEnd events, got %d", len(expected), len(turnEnds))
+	}
+	for i, want := range expected {
+		got := turnEnds[i]
+		if got.playerID != want.playerID {
+			t.Errorf("TurnEnd %d: expected player %s, got %s", i, want.playerID, got.playerID)
+		}
+		if got.details["action"] != want.action {
+			t.Errorf("TurnEnd %d: expected action %s, got %v", i, want.action, got.details["action"])
+		}
+		if got.details["duration_ms"] != int64(1500) {
+			t.Errorf("TurnEnd %d: expected duration_ms 1500, got %v", i, got.details["duration_ms"])
+		}
+	}
+}
+
+func TestManualMode_InitialDealResolvesActions(t *testing.T) {
+	input := strings.Join([]string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me deals first
+		"",    // Default winning score
+		"F",   // Initial deal: Me is dealt Freeze
+		"2",   // ...and freezes Bot before Bot is dealt
+		"5",   // Me hits (Bot is skipped in the deal and in turns)
+		"S",   // Me stays
+	}, "\n") + "\n"
+
+	logger := &recordingLogger{}
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), logger)
+	service.Run()
+
+	me := service.Game.Players[0]
+	bot := service.Game.Players[1]
+	if me.TotalScore != 5 {
+		t.Errorf("Expected Me to have 5 points, got %d", me.TotalScore)
+	}
+	if bot.TotalScore != 0 {
+		t.Errorf("Expected frozen Bot to have 0 points, got %d", bot.TotalScore)
+	}
+
+	var dealt []recordedEvent
+	for _, e := range logger.events {
+		if e.eventType == "InitialDeal" {
+			dealt = append(dealt, e)
+		}
+		if e.eventType == "CardPlayed" && e.details["card"] == "freeze" {
+			t.Errorf("Expected the dealt Freeze to be logged as InitialDeal, not CardPlayed")
+		}
+	}
+	if len(dealt) != 1 {
+		t.Fatalf("Expected 1 InitialDeal event in round 1, got %d", len(dealt))
+	}
+	if dealt[0].playerID != me.ID.String() || dealt[0].details["card"] != "freeze" {
+		t.Errorf("Expected Me to be dealt freeze, got %v for %s", dealt[0].details["card"], dealt[0].playerID)
+	}
+}
+
+func TestManualMode_ConservesCards(t *testing.T) {
+	input := strings.Join([]string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me deals first
+		"",    // Default winning score
+		"C",   // Initial deal: Me is dealt Second Chance
+		"C",   // Bot is dealt Second Chance
+		"C",   // Me draws a third one: everyone has one, so it is discarded
+		"S",   // Bot stays
+		"5",   // Me hits 5
+		"5",   // Me hits 5 again: Second Chance absorbs it
+		"F",   // Me draws Freeze...
+		"1",   // ...and freezes themselves, banking 5; the round ends
+	}, "\n") + "\n"
+
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.Run()
+
+	if me := service.Game.Players[0]; me.TotalScore != 5 {
+		t.Errorf("Expected Me to have 5 points, got %d", me.TotalScore)
+	}
+	if err := service.Game.ValidateConservation(); err != nil {
+		t.Errorf("Expected cards to be conserved, got: %v", err)
+	}
+}
+
+func TestManualMode_DuplicateNames(t *testing.T) {
+	input := strings.Join([]string{
+		"",    // No resume
+		"4",   // Players
+		"Tom", // Player 2 name
+		"tom", // Player 3 name: Tom is taken
+		"Me",  // Player 4 name: Me is taken
+		"1",   // Me deals first
+		"",    // Default winning score
+	}, "\n") + "\n" // The input ends at Me's initial card
+	var out strings.Builder
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.Out = &out
+	service.Run()
+
+	if service.Game == nil {
+		t.Fatalf("Expected the game to be set up\n%s", out.String())
+	}
+	want := []string{"Me", "Tom", "tom (2)", "Me (2)"}
+	for i, p := range service.Game.Players {
+		if p.Name != want[i] {
+			t.Errorf("Expected player %d to be named %q, got %q", i+1, want[i], p.Name)
+		}
+	}
+	if !strings.Contains(out.String(), "tom is already playing. This player is tom (2).") {
+		t.Errorf("Expected the renaming to be reported\n%s", out.String())
+	}
+}
+
+func TestManualMode_DiscardsResolvedActions(t *testing.T) {
+	input := strings.Join([]string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me deals first
+		"",    // Default winning score
+		"2",   // Initial deal: Me
+		"3",   // Initial deal: Bot
+		"T",   // Me draws Flip Three...
+		"1",   // ...on themselves
+		"4",   // Forced draws: the Freeze waits until the Flip Three is over
+		"F",
+		"6",
+		"2", // The queued Freeze goes on Bot, banking 3
+	}, "\n") + "\n"
+
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.Run()
+
+	if bot := service.Game.Players[1]; bot.TotalScore != 3 {
+		t.Errorf("Expected the queued Freeze to bank Bot's 3, got %d", bot.TotalScore)
+	}
+	// The actions are discarded as they are resolved, ahead of the hands collected when the
+	// input ends: kept in Me's hand, they would come after Me's numbers.
+	want := []domain.Card{
+		{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze},
+		{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree},
+	}
+	want = append(want, numbers(2, 4, 6, 3)...)
+	if !reflect.DeepEqual(service.Game.DiscardPile, want) {
+		t.Errorf("Expected the discard pile %v, got %v", want, service.Game.DiscardPile)
+	}
+	if err := service.Game.ValidateConservation(); err != nil {
+		t.Errorf("Expected cards to be conserved, got: %v", err)
+	}
+}
+
+func TestManualMode_FlipThreeIntoOpponentFlip7(t *testing.T) {
+	input := strings.Join([]string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me deals first
+		"",    // Default winning score
+		"1",   // Initial deal: Me
+		"2",   // Initial deal: Bot
+		"3",   // Me hits
+		"4",   // Bot hits
+		"5",   // Me hits
+		"6",   // Bot hits
+		"7",   // Me hits
+		"8",   // Bot hits
+		"T",   // Me draws Flip Three...
+		"2",   // ...on Bot
+		"9",   // Forced draws: Bot completes Flip 7 on the third one
+		"10",
+		"11",
+	}, "\n") + "\n"
+
+	logger := &recordingLogger{}
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), logger)
+	service.Run()
+
+	me := service.Game.Players[0]
+	bot := service.Game.Players[1]
+	if bot.TotalScore != 65 {
+		t.Errorf("Expected Bot to bank 50 + 15 for Flip 7, got %d", bot.TotalScore)
+	}
+	if me.TotalScore != 0 {
+		t.Errorf("Expected Me to bank nothing in a round ended by Bot's Flip 7, got %d", me.TotalScore)
+	}
+
+	// Both hands and the Flip Three card, once each
+	discards := make(map[string]int)
+	for _, c := range service.Game.DiscardPile {
+		discards[c.String()]++
+	}
+	for _, card := range []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "10", "11", "flip_three"} {
+		if discards[card] != 1 {
+			t.Errorf("Expected %s in the discard pile once, got %d", card, discards[card])
+		}
+	}
+	if len(service.Game.DiscardPile) != 12 {
+		t.Errorf("Expected 12 discarded cards, got %d", len(service.Game.DiscardPile))
+	}
+
+	var tail []string
+	var resolved recordedEvent
+	for i, e := range logger.events {
+		if e.eventType == "CardPlayed" && e.details["card"] == "flip_three" {
+			for _, rest := range logger.events[i:] {
+				if rest.eventType == "RoundStart" {
+					break
+				}
+				tail = append(tail, rest.eventType)
+				if rest.eventType == "ActionResolved" {
+					resolved = rest
+				}
+			}
+		}
+	}
+	want := "CardPlayed ActionTarget FlipThreeProgress CardPlayed FlipThreeProgress CardPlayed FlipThreeProgress CardPlayed Flip7 ActionResolved DecisionAnalysis TurnEnd RoundSummary"
+	if got := strings.Join(tail, " "); got != want {
+		t.Errorf("Expected events %q after the Flip Three, got %q", want, got)
+	}
+	if resolved.playerID != me.ID.String() || resolved.details["target"] != "Bot" ||
+		resolved.details["outcome"] != "flip7" || resolved.details["backfired"] != true {
+		t.Errorf("Expected Me's Flip Three to be logged as backfired on Bot, got %s %v", resolved.playerID, resolved.details)
+	}
+}
+
+func TestManualMode_FlipThreeShowsTargetRiskBeforeEachDraw(t *testing.T) {
+	input := strings.Join([]string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me deals first
+		"",    // Default winning score
+		"3",   // Initial deal: Me
+		"5",   // Initial deal: Bot
+		"T",   // Me draws Flip Three...
+		"2",   // ...on Bot
+		"C",   // Forced draws: Second Chance,
+		"5",   // a duplicate it absorbs,
+		"7",   // and a safe card
+	}, "\n") + "\n"
+
+	logger := &recordingLogger{}
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), logger)
+	var out strings.Builder
+	service.Out = &out
+	service.Run()
+
+	// 91 cards are left before the first draw, 4 of them 5s; Second Chance covers the second
+	// draw; the absorbed 5 and the Second Chance leave 3 5s in 89 cards before the third.
+	want := []string{
+		"Bot before card 1/3: [5] | Score: 5 | Bust Rate: 4.40%",
+		"Bot before card 2/3: [5, SC] | Score: 5 | Bust Rate: 0.00%",
+		"Bot before card 3/3: [5] | Score: 5 | Bust Rate: 3.37%",
+	}
+	var got []string
+	for _, line := range strings.Split(out.String(), "\n") {
+		if strings.HasPrefix(line, "Bot before card") {
+			got = append(got, line)
+		}
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Expected the risk lines\n%s\ngot\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+
+	var rates []interface{}
+	for _, e := range logger.events {
+		if e.eventType == "FlipThreeProgress" {
+			rates = append(rates, e.details["bust_rate"])
+		}
+	}
+	if len(rates) != 3 || rates[0] != 4.0/91 || rates[1] != 0.0 || rates[2] != 3.0/89 {
+		t.Errorf("Expected FlipThreeProgress events with bust rates 4/91, 0 and 3/89, got %v", rates)
+	}
+}
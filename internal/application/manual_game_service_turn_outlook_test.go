@@ -0,0 +1,48 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+func TestManualMode_TurnOutlook(t *testing.T) {
+	tests := []struct {
+		name  string
+		model domain.Strategy
+		input []string
+		want  string
+	}{
+		{
+			// Me is dealt 5 and Bot 7; with Bot assumed to stay, Me gets one more turn, alone.
+			"Opponent model", &MockStrategy{DecideResult: domain.TurnChoiceStay},
+			[]string{"", "2", "Bot", "1", "", "5", "7", "S"},
+			"\n~1.0 more turns expected; 0% chance the round ends before your next turn\n",
+		},
+		{
+			// Bot deals: Bot is dealt 7 and Me 5, then Bot stays and leaves Me alone.
+			"Last player in the round", nil,
+			[]string{"", "2", "Bot", "2", "", "7", "5", "S", "S"},
+			"\nUnique numbers: 1/7 — safe values remaining: 0,1,2,3,4,6,7,8,9,10,11,12 (73 cards), unsafe: 5 (4 cards)\n" +
+				"You are the last player in the round: you get as many more turns as you choose\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := strings.Join(tt.input, "\n") + "\n"
+			service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+			service.TurnOutlookModel = tt.model
+			var out strings.Builder
+			service.Out = &out
+
+			service.Run()
+
+			if !strings.Contains(out.String(), tt.want) {
+				t.Errorf("Expected %q in the output:\n%s", tt.want, out.String())
+			}
+		})
+	}
+}
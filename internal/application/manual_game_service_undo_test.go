@@ -2,6 +2,7 @@ package application_test
 
 import (
 	"bufio"
+	"fmt"
 	"strings"
 	"testing"
 
@@ -20,40 +21,28 @@ func (m *MockLogger) Close() {
 func TestManualModeUndoRedo(t *testing.T) {
 	// Scenario:
 	// 1. Start Game (2 players, Me starts)
-	// 2. Play 5
-	// 3. Play 6 -> Score 11
-	// 4. Undo -> Back to Score 5 (Hand: [5])
-	// 5. Play 7 -> Score 12 (Hand: [5, 7])
-	// 6. Stay
-	// 7. Player 2 stays (auto/placeholder logic doesn't run input, but setupPlayers asks for 2 players.
-	//    Wait, Player 2 is AUTO strategy?
-	//    In setupPlayers, I set Player 2 to ProbabilisticStrategy.
-	//    But ManualGameService playRound iterates through all players.
-	//    If Player 2 is AI, does Manual Mode ask for input for AI?
-	//    Let's check code.
-	//    playRound gets next player.
-	//    "Input (0-12...)"
-	//    It asks input for EVERYONE because Manual Mode assumes User controls everything (hotseat).
-	//    The logic `if p.Strategy == nil` is only used for "User Controlled IDs" in save state meta.
-	//    But game loop asks input for EVERYONE.
-	//    So I need to provide input for Player 2 as well.
+	// 2. Initial deal: Me 5, Bot 0
+	// 3. Me plays 6 -> Score 11
+	// 4. Undo (on Bot's turn) -> Back to Me's turn with Score 5 (Hand: [5])
+	// 5. Me plays 7 -> Score 12 (Hand: [5, 7])
+	// 6. Bot stays, Me stays
+	//
+	// Manual Mode asks for input for EVERYONE (hotseat), including players with a strategy,
+	// so the input covers Bot as well.
 
 	// Input sequence:
 	// "" (Save code - empty)
 	// "2" (Num players)
-	// "MyName" (Player 2 name - waiting for input? "Enter name for Player 2: ")
+	// "Bot" (Player 2 name)
 	// "1" (Start player - Me)
+	// "" (Winning score - default 200)
 	// --- Round Starts ---
-	// Me Turn:
-	// "5"
-	// "6"
-	// "U" (Undo 6)
-	// "7"
-	// "S" (Stay)
-	// Player 2 Turn:
-	// "S" (Stay - Wait, must hit on first turn)
-	// "10"
-	// "S"
+	// Initial deal: "5" (Me), "0" (Bot)
+	// Me Turn: "6"
+	// Bot Turn: "U" (Undo Me's 6)
+	// Me Turn: "7"
+	// Bot Turn: "S"
+	// Me Turn: "S"
 	// --- Round Ends ---
 	// Check results.
 
@@ -61,13 +50,13 @@ func TestManualModeUndoRedo(t *testing.T) {
 2
 Bot
 1
+
 5
 0
 6
 U
 7
 S
-0
 S
 `
 	// Clean up input string to ensure newlines are correct
@@ -102,31 +91,23 @@ S
 
 func TestManualModeRedo(t *testing.T) {
 	// Scenario:
-	// 1. Me Play 5
-	// 2. Bot Play 0
-	// 3. Me Play 6
-	// 4. Undo (Back to Me Play 6) -> Actually Undo undoes ONE step.
-	//    History:
-	//    - Start
-	//    - Me 5
-	//    - Bot 0
-	//    - Me 6
-	//    Undo -> Bot 0. (Me Hand [5]). Current Player Me.
-	//    Redo -> Me 6. (Me Hand [5, 6]).
-	// 5. Me Stay.
-	// 6. Bot Stay.
+	// 1. Initial deal: Me 5, Bot 0
+	// 2. Me plays 6
+	// 3. Undo (on Bot's turn) -> Me's turn again, Hand [5]
+	// 4. Redo -> Bot's turn, Me's Hand [5, 6]
+	// 5. Bot stays, Me stays.
 
 	input := `
 2
 Bot
 1
+
 5
 0
 6
 U
 R
 S
-0
 S
 `
 	// Clean up input string to ensure newlines are correct
@@ -142,3 +123,98 @@ S
 		t.Errorf("Expected Me to have 11 points (Redo restored 6), got %d", me.TotalScore)
 	}
 }
+
+func TestManualModeUndoDuringInitialDeal(t *testing.T) {
+	// Initial deal: Me 5, then Undo at Bot's deal prompt -> Me is dealt again (6).
+	input := `
+2
+Bot
+1
+
+5
+U
+6
+3
+S
+S
+`
+	input = "\n" + strings.TrimSpace(input) + "\n"
+
+	reader := bufio.NewReader(strings.NewReader(input))
+	service := application.NewManualGameService(reader, &MockLogger{})
+	service.Run()
+
+	me := service.Game.Players[0]
+	bot := service.Game.Players[1]
+	if me.TotalScore != 6 {
+		t.Errorf("Expected Me to have 6 points (undone 5 replaced by 6), got %d", me.TotalScore)
+	}
+	if bot.TotalScore != 3 {
+		t.Errorf("Expected Bot to have 3 points, got %d", bot.TotalScore)
+	}
+}
+
+func TestManualModeHistCommandDoesNotConsumeInput(t *testing.T) {
+	// HIST at the deal prompt and at a turn prompt only reports the history.
+	input := `
+2
+Bot
+1
+
+5
+HIST
+3
+HIST
+S
+S
+`
+	input = "\n" + strings.TrimSpace(input) + "\n"
+
+	reader := bufio.NewReader(strings.NewReader(input))
+	service := application.NewManualGameService(reader, &MockLogger{})
+	service.Run()
+
+	me := service.Game.Players[0]
+	bot := service.Game.Players[1]
+	if me.TotalScore != 5 || bot.TotalScore != 3 {
+		t.Errorf("Expected Me 5 and Bot 3, got %d and %d", me.TotalScore, bot.TotalScore)
+	}
+	// The input ends at the start of round 2, which Undo does not go back past.
+	if !service.History.AtRoundStart() || service.History.UndoSteps() != 0 || service.History.Len() < 2 {
+		t.Errorf("Expected the history to stop at the start of round 2, got %d undo steps for %d states",
+			service.History.UndoSteps(), service.History.Len())
+	}
+}
+
+func TestManualModeUndoStopsAtRoundStart(t *testing.T) {
+	// Round 1 (to 10 points): Me is dealt 5 and Bot 6, both stay. Round 2 is dealt by Bot: Undo at
+	// its first prompt is refused, Undo after Bot's 7 returns to the start of round 2 and is refused
+	// there again. Bot is then dealt 7 and Me 8, both stay and both pass 10.
+	input := strings.Join([]string{"", "2", "Bot", "1", "10",
+		"5", "6", "S", "S",
+		"U", "7", "U", "U", "7", "8", "S", "S"}, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	var out strings.Builder
+	service.Out = &out
+
+	service.Run()
+
+	if n := strings.Count(out.String(), "Cannot undo past the start of this round"); n != 2 {
+		t.Errorf("Expected Undo to be refused twice at the start of round 2, got %d times:\n%s", n, out.String())
+	}
+	game := service.Game
+	if !game.IsCompleted || game.RoundCount != 2 {
+		t.Fatalf("Expected the game to end after round 2, got round %d (completed: %v)", game.RoundCount, game.IsCompleted)
+	}
+	me, bot := game.Players[0], game.Players[1]
+	if me.TotalScore != 13 || bot.TotalScore != 13 {
+		t.Errorf("Expected Me and Bot to score 5+8 and 6+7, got %d and %d", me.TotalScore, bot.TotalScore)
+	}
+	// Every hand was collected once: 5 and 6 from round 1, 7 and 8 from round 2.
+	if got := fmt.Sprint(game.DiscardPile); got != "[5 6 8 7]" {
+		t.Errorf("Expected the discard pile to hold [5 6 8 7], got %s", got)
+	}
+	if err := game.ValidateConservation(); err != nil {
+		t.Errorf("Expected every card to be in the deck or the discard pile once, got %v", err)
+	}
+}
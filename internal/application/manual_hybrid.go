@@ -0,0 +1,262 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// SeatKind is who plays a seat at a hybrid Manual Mode table (see ManualGameService.Hybrid).
+type SeatKind string
+
+const (
+	SeatPhysical SeatKind = "physical" // A player at the table: the user enters the cards they draw
+	SeatAI       SeatKind = "ai"       // The app's AI decides and draws from the tracked deck
+	SeatAppHuman SeatKind = "human"    // A person decides in the app, which draws from the tracked deck
+)
+
+// AppSeat is how a save code keeps a seat the app plays: its kind (SeatAI or SeatAppHuman) and,
+// for an AI seat, the registry name of its strategy (see strategy.New).
+type AppSeat struct {
+	Kind     SeatKind `json:"kind"`
+	Strategy string   `json:"strategy,omitempty"`
+}
+
+// defaultSeatAI is the strategy of an AI seat when none is named at its prompt.
+const defaultSeatAI = "Adaptive"
+
+// setupSeats asks who plays each of players, for a hybrid game, and fills AppSeats. An
+// invalid answer seats a player at the table. It returns false if the input ended.
+func (s *ManualGameService) setupSeats(players []*domain.Player) bool {
+	s.AppSeats = make(map[string]domain.Strategy)
+	for _, p := range players {
+		s.ask(console.MsgSeatPrompt, console.Args{"name": p.Name})
+		answer, ok := s.readAnswer()
+		if !ok {
+			return false
+		}
+		switch answer {
+		case "", "1":
+			continue
+		case "2":
+			s.ask(console.MsgSeatStrategyPrompt, console.Args{"name": p.Name, "default": defaultSeatAI})
+			name, ok := s.readLine()
+			if !ok {
+				return false
+			}
+			if name == "" {
+				name = defaultSeatAI
+			}
+			strat, err := strategy.New(name)
+			if err != nil {
+				s.say(console.MsgInvalidSeatStrategy, console.Args{"err": err, "default": defaultSeatAI})
+				strat, _ = strategy.New(defaultSeatAI)
+			}
+			s.AppSeats[p.ID.String()] = strat
+		case "3":
+			s.AppSeats[p.ID.String()] = s.newAppHuman()
+		default:
+			s.say(console.MsgInvalidSeat, console.Args{"name": p.Name})
+		}
+	}
+	return true
+}
+
+// newAppHuman returns the strategy of a person deciding in the app, who answers at the
+// service's prompts.
+func (s *ManualGameService) newAppHuman() *console.HumanStrategy {
+	human := console.NewHumanStrategyWithIO(s.Reader, s.out())
+	human.Messages = s.Messages
+	return human
+}
+
+// appSeat returns the strategy deciding for p if the app plays p's seat.
+func (s *ManualGameService) appSeat(p *domain.Player) (domain.Strategy, bool) {
+	strat, ok := s.AppSeats[p.ID.String()]
+	return strat, ok
+}
+
+// Seat returns who plays p's seat.
+func (s *ManualGameService) Seat(p *domain.Player) SeatKind {
+	strat, ok := s.appSeat(p)
+	if !ok {
+		return SeatPhysical
+	}
+	return appSeatKind(strat)
+}
+
+// appSeatKind returns who plays a seat the app plays with strat.
+func appSeatKind(strat domain.Strategy) SeatKind {
+	if _, human := strat.(*console.HumanStrategy); human {
+		return SeatAppHuman
+	}
+	return SeatAI
+}
+
+// savedSeats returns AppSeats as a save code keeps them.
+func (s *ManualGameService) savedSeats() map[string]AppSeat {
+	if len(s.AppSeats) == 0 {
+		return nil
+	}
+	seats := make(map[string]AppSeat, len(s.AppSeats))
+	for id, strat := range s.AppSeats {
+		seat := AppSeat{Kind: appSeatKind(strat)}
+		if seat.Kind == SeatAI {
+			seat.Strategy = strat.Name()
+		}
+		seats[id] = seat
+	}
+	return seats
+}
+
+// restoreSeats returns the AppSeats of g, loaded with seats from a save code. A seat the app
+// already plays the same way keeps its strategy, so an undo does not reset what it has counted;
+// any other AI seat gets a new instance of its strategy, and fails to load if the strategy is
+// unknown.
+func (s *ManualGameService) restoreSeats(g *domain.Game, seats map[string]AppSeat) (map[string]domain.Strategy, error) {
+	if len(seats) == 0 {
+		return nil, nil
+	}
+	restored := make(map[string]domain.Strategy, len(seats))
+	for _, p := range g.Players {
+		id := p.ID.String()
+		seat, ok := seats[id]
+		if !ok {
+			continue
+		}
+		if strat, ok := s.AppSeats[id]; ok && appSeatKind(strat) == seat.Kind && (seat.Kind != SeatAI || strat.Name() == seat.Strategy) {
+			restored[id] = strat
+			continue
+		}
+		if seat.Kind == SeatAppHuman {
+			restored[id] = s.newAppHuman()
+			continue
+		}
+		strat, err := strategy.New(seat.Strategy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid save code: cannot seat %s: %w", p.Name, err)
+		}
+		restored[id] = strat
+	}
+	return restored, nil
+}
+
+// playAppTurn plays the turn of p, whose seat the app plays: strat may play a held action
+// first, then decides to hit or stay, and a hit is drawn from the tracked deck and processed
+// like a card entered for a player at the table. It returns the turn's action ("hit", "stay"
+// or "play") and false if the round cannot continue (the deck ran out or there is no round).
+func (s *ManualGameService) playAppTurn(p *domain.Player, strat domain.Strategy) (string, bool) {
+	round := s.Game.CurrentRound
+	s.prepareAppSeat(strat)
+	if held := p.CurrentHand.HeldActions(); s.Game.HoldableActions && len(held) > 0 {
+		if holder, ok := strat.(domain.ActionHolder); ok {
+			chosen := holder.PlayHeldAction(domain.HeldActionContext{
+				Deck:         round.Deck,
+				Hand:         p.CurrentHand,
+				PlayerScore:  p.TotalScore,
+				OtherPlayers: round.Players,
+				Held:         held,
+			})
+			if chosen != nil && s.playHeldCard(p, chosen.ActionType) {
+				return "play", true
+			}
+		}
+	}
+
+	choice := strat.Decide(round.Deck, p.CurrentHand, p.TotalScore, round.Players)
+	if choice == domain.TurnChoiceStay && !p.CurrentHand.CanStay() {
+		choice = domain.TurnChoiceHit // The same rule as for the other players
+	}
+	if choice == domain.TurnChoiceStay {
+		s.say(console.MsgAppStays, console.Args{"name": p.Name})
+		s.stay(p)
+		return "stay", true
+	}
+
+	card, err := s.drawForApp(p)
+	if err != nil {
+		if errors.Is(err, domain.ErrDeckEmpty) {
+			s.exhaustRound()
+		} else {
+			s.say(console.MsgErrorEndingRound, console.Args{"err": err})
+		}
+		return "hit", false
+	}
+	s.processCard(p, card)
+	s.warnInconsistencies()
+	return "hit", true
+}
+
+// prepareAppSeat shows strat, which plays a seat for the app, the game's winning score and the
+// tracked deck, with the discard pile to be reshuffled after it, before it decides.
+func (s *ManualGameService) prepareAppSeat(strat domain.Strategy) {
+	var deck domain.DeckView
+	if round := s.Game.CurrentRound; round != nil && round.Deck != nil {
+		deck = domain.NewReshuffleView(round.Deck, s.Game.DiscardPile)
+	}
+	s.prepareAdvisor(strat, deck)
+}
+
+// drawForApp draws the next card of the tracked deck for p, whose seat the app plays. The card
+// is taken out through the DeckTracker like a card entered for a player at the table, so an
+// empty deck is rebuilt from the discard pile first, and the DeckTracker's errors are returned.
+func (s *ManualGameService) drawForApp(p *domain.Player) (domain.Card, error) {
+	card, err := s.nextDeckCard()
+	if err == nil {
+		err = s.removeCardFromDeck(card)
+	}
+	if err != nil {
+		return domain.Card{}, err
+	}
+	s.say(console.MsgAppDraws, console.Args{"name": p.Name})
+	return card, nil
+}
+
+// nextDeckCard returns the card the tracked deck deals next: its top card, or a random card of
+// the discard pile when the deck is empty and is about to be rebuilt from it.
+func (s *ManualGameService) nextDeckCard() (domain.Card, error) {
+	round := s.Game.CurrentRound
+	switch {
+	case round == nil || round.Deck == nil:
+		return domain.Card{}, fmt.Errorf("%w: no deck to draw from", domain.ErrNoActiveRound)
+	case round.Deck.Remaining() > 0:
+		return round.Deck.Cards[0], nil
+	case len(s.Game.DiscardPile) > 0:
+		return s.Game.DiscardPile[rand.Intn(len(s.Game.DiscardPile))], nil
+	}
+	return domain.Card{}, fmt.Errorf("%w and discard pile is empty", domain.ErrDeckEmpty)
+}
+
+// stay banks p's hand and takes p out of the round.
+func (s *ManualGameService) stay(p *domain.Player) {
+	p.CurrentHand.Status = domain.HandStatusStayed
+	score := s.bankHand(p)
+
+	s.publish(domain.PlayerStayed{Player: p, Banked: score})
+
+	s.Game.CurrentRound.RemoveActivePlayer(p)
+}
+
+// chooseTarget picks actor's target for an action among candidates: the app's strategy chooses
+// for an app-controlled actor, and the user is prompted for a player at the table. It returns
+// nil when there is no candidate, and for an invalid choice or the end of input at the prompt.
+func (s *ManualGameService) chooseTarget(actionType domain.ActionType, candidates []*domain.Player, actor *domain.Player) *domain.Player {
+	strat, ok := s.appSeat(actor)
+	if !ok || len(candidates) == 0 {
+		return s.promptForTarget(actionType, candidates, actor)
+	}
+	s.prepareAppSeat(strat)
+	selector := &strategyTargetSelector{
+		strategy: strat,
+		warn: func(format string, a ...interface{}) {
+			fmt.Fprintf(s.out(), format, a...)
+		},
+	}
+	target := selector.SelectTarget(actionType, candidates, actor)
+	s.say(console.MsgAppTarget, console.Args{"name": actor.Name, "target": target.Name})
+	return target
+}
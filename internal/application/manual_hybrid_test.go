@@ -0,0 +1,257 @@
+package application
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// firstNumberStrategy hits until it holds a number card, then stays, and targets the player
+// named Target.
+type firstNumberStrategy struct {
+	Target string
+}
+
+func (s *firstNumberStrategy) Name() string { return "FirstNumber" }
+func (s *firstNumberStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, score int, others []*domain.Player) domain.TurnChoice {
+	if len(hand.NumberCards) > 0 {
+		return domain.TurnChoiceStay
+	}
+	return domain.TurnChoiceHit
+}
+func (s *firstNumberStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	for _, c := range candidates {
+		if c.Name == s.Target {
+			return c
+		}
+	}
+	return nil
+}
+
+// deckStartingWith returns a standard deck whose top cards are top, in that order.
+func deckStartingWith(t *testing.T, top ...domain.Card) *domain.Deck {
+	t.Helper()
+	rest := domain.StandardDeckCards()
+	for _, card := range top {
+		found := false
+		for i, c := range rest {
+			if sameCard(c, card) {
+				rest = append(rest[:i], rest[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("No copy of %s left for the deck", card)
+		}
+	}
+	return domain.NewDeckInOrder(append(top, rest...))
+}
+
+func TestManualMode_HybridRound(t *testing.T) {
+	number := func(v int) domain.Card { return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)} }
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+
+	// Me plays at the table, Ann in the app and Bot is the app's AI. Me is dealt Flip Three
+	// (entered) and aims it at Ann, who draws 1, 2 and 4 from the deck, then is dealt 6. Bot is
+	// dealt Freeze and freezes Me. Ann hits once, drawing 7, and stays; Bot hits for 8 and stays.
+	me := domain.NewPlayer("Me", nil)
+	ann := domain.NewPlayer("Ann", nil)
+	bot := domain.NewPlayer("Bot", nil)
+	players := []*domain.Player{me, ann, bot}
+
+	input := strings.Join([]string{
+		"T", // Me's initial card
+		"2", // Flip Three on Ann
+		"h", // Ann hits
+		"s", // Ann stays
+	}, "\n") + "\n"
+	reader := bufio.NewReader(strings.NewReader(input))
+	var out strings.Builder
+	svc := NewManualGameServiceWithOutput(reader, nil, &out)
+	human := console.NewHumanStrategyWithIO(reader, &out)
+	svc.AppSeats = map[string]domain.Strategy{
+		ann.ID.String(): human,
+		bot.ID.String(): &firstNumberStrategy{Target: "Me"},
+	}
+	svc.Game = domain.NewGame(players)
+	svc.Game.WinningScore = 10
+	svc.Game.Deck = deckStartingWith(t, number(1), number(2), number(4), number(6), freeze, number(7), number(8))
+	svc.PushState()
+
+	svc.gameLoop()
+
+	if !svc.Game.IsCompleted || svc.Game.RoundCount != 1 {
+		t.Fatalf("Expected the game to end with the first round, got round %d (completed: %v)\n%s", svc.Game.RoundCount, svc.Game.IsCompleted, out.String())
+	}
+	for _, tt := range []struct {
+		player *domain.Player
+		seat   SeatKind
+		total  int
+	}{
+		{me, SeatPhysical, 0},
+		{ann, SeatAppHuman, 20},
+		{bot, SeatAI, 8},
+	} {
+		if got := svc.Seat(tt.player); got != tt.seat {
+			t.Errorf("Expected %s to play %s, got %s", tt.player.Name, tt.seat, got)
+		}
+		if tt.player.TotalScore != tt.total {
+			t.Errorf("Expected %s to bank %d, got %d\n%s", tt.player.Name, tt.total, tt.player.TotalScore, out.String())
+		}
+	}
+	if len(svc.Game.Winners) != 1 || svc.Game.Winners[0] != ann {
+		t.Errorf("Expected Ann to win, got %v", getPlayerNames(svc.Game.Winners))
+	}
+	if err := svc.Game.Invariants(); err != nil {
+		t.Errorf("Expected every card to be accounted for: %v", err)
+	}
+	for _, want := range []string{"The app draws for Ann from the deck.", "Bot targets Me.", "Ann stays."} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected the output to say %q\n%s", want, out.String())
+		}
+	}
+}
+
+func TestManualMode_HybridSetupSeats(t *testing.T) {
+	input := strings.Join([]string{
+		"",             // No resume
+		"4",            // Players
+		"Ann",          // Player 2 name
+		"Bot",          // Player 3 name
+		"Cat",          // Player 4 name
+		"1",            // Me at the table
+		"3",            // Ann in the app
+		"2",            // Bot is the app's AI...
+		"Heuristic-20", // ...playing Heuristic-20
+		"9",            // An invalid seat: Cat at the table
+		"1",            // Me deals first
+		"",             // Default winning score
+	}, "\n") + "\n" // The input ends at Me's initial card
+	var out strings.Builder
+	svc := NewManualGameServiceWithOutput(bufio.NewReader(strings.NewReader(input)), nil, &out)
+	svc.Hybrid = true
+	svc.Run()
+
+	if svc.Game == nil {
+		t.Fatalf("Expected the game to be set up\n%s", out.String())
+	}
+	want := []SeatKind{SeatPhysical, SeatAppHuman, SeatAI, SeatPhysical}
+	for i, p := range svc.Game.Players {
+		if got := svc.Seat(p); got != want[i] {
+			t.Errorf("Expected %s to play %s, got %s", p.Name, want[i], got)
+		}
+	}
+	if strat, _ := svc.appSeat(svc.Game.Players[2]); strat == nil || strat.Name() != "Heuristic-20" {
+		t.Errorf("Expected Bot to play Heuristic-20, got %v", strat)
+	}
+	if !strings.Contains(out.String(), "Invalid choice. Cat plays at the table.") {
+		t.Errorf("Expected the invalid seat to be reported\n%s", out.String())
+	}
+}
+
+// reachTargetStrategy hits until its total with the hand reaches the winning score it was
+// given, then stays: without SetWinningScore it aims for domain.WinningThreshold. It keeps the
+// deck it is shown.
+type reachTargetStrategy struct {
+	winningScore int
+	deck         domain.DeckView
+}
+
+func (s *reachTargetStrategy) Name() string                 { return "ReachTarget" }
+func (s *reachTargetStrategy) SetWinningScore(score int)    { s.winningScore = score }
+func (s *reachTargetStrategy) SetDeck(deck domain.DeckView) { s.deck = deck }
+func (s *reachTargetStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, score int, others []*domain.Player) domain.TurnChoice {
+	target := s.winningScore
+	if target == 0 {
+		target = domain.WinningThreshold
+	}
+	if score+domain.NewScoreCalculator().Total(hand) >= target {
+		return domain.TurnChoiceStay
+	}
+	return domain.TurnChoiceHit
+}
+func (s *reachTargetStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	return candidates[0]
+}
+
+func TestManualMode_HybridAppSeatFollowsTargetScore(t *testing.T) {
+	number := func(v int) domain.Card { return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)} }
+
+	// Me plays at the table and stays on 5. Bot is dealt 6 and draws 7: at 13 it has reached
+	// the table's 10 points, so it stays and wins instead of hitting on toward 200.
+	me := domain.NewPlayer("Me", nil)
+	bot := domain.NewPlayer("Bot", nil)
+	input := strings.Join([]string{
+		"5", // Me's initial card
+		"s", // Me stays
+	}, "\n") + "\n"
+	var out strings.Builder
+	svc := NewManualGameServiceWithOutput(bufio.NewReader(strings.NewReader(input)), nil, &out)
+	strat := &reachTargetStrategy{}
+	svc.AppSeats = map[string]domain.Strategy{bot.ID.String(): strat}
+	svc.Game = domain.NewGame([]*domain.Player{me, bot})
+	svc.Game.WinningScore = 10
+	svc.Game.Deck = deckStartingWith(t, number(6), number(7), number(8), number(9))
+	svc.PushState()
+
+	svc.gameLoop()
+
+	if bot.TotalScore != 13 {
+		t.Errorf("Expected Bot to stay on 13, got %d\n%s", bot.TotalScore, out.String())
+	}
+	if len(svc.Game.Winners) != 1 || svc.Game.Winners[0] != bot {
+		t.Errorf("Expected Bot to win, got %v", getPlayerNames(svc.Game.Winners))
+	}
+	if strat.deck == nil {
+		t.Error("Expected Bot's strategy to be shown the tracked deck")
+	}
+}
+
+func TestManualMode_HybridSeatsRoundTrip(t *testing.T) {
+	me := domain.NewPlayer("Me", nil)
+	ann := domain.NewPlayer("Ann", nil)
+	bot := domain.NewPlayer("Bot", nil)
+	players := []*domain.Player{me, ann, bot}
+
+	svc := NewManualGameServiceWithOutput(bufio.NewReader(strings.NewReader("")), nil, &strings.Builder{})
+	heuristic, err := strategy.New("Heuristic-20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc.AppSeats = map[string]domain.Strategy{
+		ann.ID.String(): svc.newAppHuman(),
+		bot.ID.String(): heuristic,
+	}
+	svc.Game = domain.NewGame(players)
+	code, err := svc.SaveState()
+	if err != nil {
+		t.Fatalf("SaveState failed: %v", err)
+	}
+
+	resumed := NewManualGameServiceWithOutput(bufio.NewReader(strings.NewReader("")), nil, &strings.Builder{})
+	if err := resumed.LoadState(code); err != nil {
+		t.Fatalf("LoadState failed: %v", err)
+	}
+	want := []SeatKind{SeatPhysical, SeatAppHuman, SeatAI}
+	for i, p := range resumed.Game.Players {
+		if got := resumed.Seat(p); got != want[i] {
+			t.Errorf("Expected %s to play %s after resuming, got %s", p.Name, want[i], got)
+		}
+	}
+	if strat, _ := resumed.appSeat(resumed.Game.Players[2]); strat == nil || strat.Name() != "Heuristic-20" {
+		t.Errorf("Expected Bot to play Heuristic-20 after resuming, got %v", strat)
+	}
+
+	// An undo keeps the strategies already playing the seats.
+	if err := svc.LoadState(code); err != nil {
+		t.Fatalf("LoadState failed: %v", err)
+	}
+	if strat, _ := svc.appSeat(bot); strat != heuristic {
+		t.Errorf("Expected Bot to keep its strategy, got %v", strat)
+	}
+}
@@ -0,0 +1,85 @@
+package application
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// maxInputRetries is how many failed reads in a row a prompt tolerates before the game is abandoned.
+const maxInputRetries = 3
+
+// errInputClosed is returned by prompts that could not read any more input.
+var errInputClosed = errors.New("input closed")
+
+// InputPort is where Manual Mode reads the user's answers from.
+type InputPort interface {
+	// ReadLine returns the next line of input without what terminals add to it (see
+	// cleanInput), or false once no more input can be read.
+	ReadLine() (string, bool)
+}
+
+// ReaderInput is the InputPort reading lines from Reader. A failed read is retried up to
+// maxInputRetries times in a row; the end of input is final.
+type ReaderInput struct {
+	Reader *bufio.Reader
+}
+
+// ReadLine implements InputPort.
+func (in ReaderInput) ReadLine() (string, bool) {
+	for attempt := 1; ; attempt++ {
+		input, err := in.Reader.ReadString('\n')
+		if err == nil {
+			return cleanInput(input), true
+		}
+		if !errors.Is(err, io.EOF) && attempt < maxInputRetries {
+			continue
+		}
+		return "", false
+	}
+}
+
+// cleanInput trims what terminals and editors add to a line: surrounding spaces, the \r of a
+// CRLF line ending included, and UTF-8 byte order marks. Names and file paths keep their
+// full-width characters.
+func cleanInput(input string) string {
+	return strings.TrimSpace(strings.ReplaceAll(input, "\uFEFF", ""))
+}
+
+// input returns the InputPort answers are read from.
+func (s *ManualGameService) input() InputPort {
+	if s.Input == nil {
+		return ReaderInput{Reader: s.Reader}
+	}
+	return s.Input
+}
+
+// readLine reads one line of input from the InputPort. Once no more input can be read, it
+// prints "Error reading input. Exiting game." (MsgInputClosed), marks the game as completed
+// (when there is one) and returns false.
+func (s *ManualGameService) readLine() (string, bool) {
+	input, ok := s.input().ReadLine()
+	if !ok {
+		s.say(console.MsgInputClosed, nil)
+		if s.Game != nil {
+			s.Game.End(domain.GameEndReasonAborted)
+		}
+	}
+	return input, ok
+}
+
+// readAnswer is readLine for answers that are not free text (cards, commands, numbers and y/n):
+// full-width characters are read as ASCII (see domain.NormalizeToken), so "５" is 5 and "Ｓ" is S.
+func (s *ManualGameService) readAnswer() (string, bool) {
+	input, ok := s.readLine()
+	return domain.NormalizeToken(input), ok
+}
+
+// parseInput reads a card typed at a prompt (see domain.ParseCardToken).
+func (s *ManualGameService) parseInput(input string) (domain.Card, error) {
+	return domain.ParseCardToken(input)
+}
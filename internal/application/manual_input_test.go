@@ -0,0 +1,85 @@
+package application_test
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+)
+
+// flakyReader fails the first failures reads, then reads from r.
+type flakyReader struct {
+	r        io.Reader
+	failures int
+}
+
+func (f *flakyReader) Read(p []byte) (int, error) {
+	if f.failures > 0 {
+		f.failures--
+		return 0, errors.New("temporary failure")
+	}
+	return f.r.Read(p)
+}
+
+func TestReaderInput_ReadLine(t *testing.T) {
+	in := application.ReaderInput{Reader: bufio.NewReader(strings.NewReader("5\r\n\uFEFF Alice \n  \nlast"))}
+	for _, want := range []string{"5", "Alice", ""} {
+		got, ok := in.ReadLine()
+		if !ok || got != want {
+			t.Errorf("Expected (%q, true), got (%q, %v)", want, got, ok)
+		}
+	}
+	// A last line without a line ending is the end of input, as at the prompts.
+	if got, ok := in.ReadLine(); ok {
+		t.Errorf("Expected the end of input, got %q", got)
+	}
+}
+
+func TestReaderInput_Retries(t *testing.T) {
+	tests := []struct {
+		failures int
+		wantOK   bool
+	}{
+		{0, true},
+		{2, true},  // Retried until the third attempt succeeds
+		{3, false}, // Every attempt failed
+	}
+	for _, tt := range tests {
+		in := application.ReaderInput{Reader: bufio.NewReader(&flakyReader{r: strings.NewReader("S\n"), failures: tt.failures})}
+		got, ok := in.ReadLine()
+		if ok != tt.wantOK || (ok && got != "S") {
+			t.Errorf("%d failures: expected ok=%v, got (%q, %v)", tt.failures, tt.wantOK, got, ok)
+		}
+	}
+}
+
+// scriptedInput is an InputPort answering from a list of lines.
+type scriptedInput []string
+
+func (s *scriptedInput) ReadLine() (string, bool) {
+	if len(*s) == 0 {
+		return "", false
+	}
+	line := (*s)[0]
+	*s = (*s)[1:]
+	return line, true
+}
+
+func TestManualMode_InputPort(t *testing.T) {
+	// No Reader at all: the answers come from the InputPort. Me is dealt +10, then Bot a
+	// Freeze aimed at Me, who banks 10.
+	input := scriptedInput{"", "2", "Bot", "1", "", "+10", "F", "1"}
+	service := application.NewManualGameServiceWithOutput(nil, nil, io.Discard)
+	service.Input = &input
+	service.Run()
+
+	if me := service.Game.Players[0]; me.TotalScore != 10 {
+		t.Errorf("Expected Me to bank 10, got %d", me.TotalScore)
+	}
+	if !service.Game.IsCompleted {
+		t.Error("Expected the game to end with the input")
+	}
+}
@@ -0,0 +1,105 @@
+package application
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// ManualScript is a Manual Mode session written down to be played back, e.g. to check that a
+// real game still scores the same. A script file has one answer per line, as typed at the
+// prompts, with some help for keeping it readable:
+//
+//   - "#" starts a comment, on a line of its own or after an answer
+//   - blank lines are skipped, so "<enter>" stands for pressing Enter on an empty answer
+//   - "EXPECT score <player> <value>" asks for the player's total score once the script ends
+type ManualScript struct {
+	// Input is the answers, one per line, ready for ManualGameService.Reader.
+	Input   string
+	Expects []ScoreExpectation
+}
+
+// ScoreExpectation is an EXPECT score directive of a ManualScript.
+type ScoreExpectation struct {
+	Line   int // Line of the directive in the script, for error messages
+	Player string
+	Score  int
+}
+
+// scriptEnter is the script line for an empty answer.
+const scriptEnter = "<enter>"
+
+// ParseManualScript reads a script. It fails on a malformed EXPECT directive.
+func ParseManualScript(r io.Reader) (*ManualScript, error) {
+	script := &ManualScript{}
+	var input strings.Builder
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case strings.EqualFold(line, scriptEnter):
+			line = ""
+		case strings.HasPrefix(strings.ToUpper(line), "EXPECT "):
+			expect, err := parseScoreExpectation(line, number)
+			if err != nil {
+				return nil, err
+			}
+			script.Expects = append(script.Expects, expect)
+			continue
+		}
+		input.WriteString(line)
+		input.WriteString("\n")
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	script.Input = input.String()
+	return script, nil
+}
+
+// parseScoreExpectation parses "EXPECT score <player> <value>". The player's name may contain spaces.
+func parseScoreExpectation(line string, number int) (ScoreExpectation, error) {
+	fields := strings.Fields(line)
+	if len(fields) < 4 || !strings.EqualFold(fields[1], "score") {
+		return ScoreExpectation{}, fmt.Errorf("line %d: expected \"EXPECT score <player> <value>\", got %q", number, line)
+	}
+	score, err := strconv.Atoi(fields[len(fields)-1])
+	if err != nil {
+		return ScoreExpectation{}, fmt.Errorf("line %d: invalid score %q", number, fields[len(fields)-1])
+	}
+	return ScoreExpectation{Line: number, Player: strings.Join(fields[2:len(fields)-1], " "), Score: score}, nil
+}
+
+// Check compares the expected scores with the total scores in game. It returns one message per
+// expectation that is not met, or none when the game scored as expected.
+func (s *ManualScript) Check(game *domain.Game) []string {
+	var failures []string
+	for _, expect := range s.Expects {
+		var player *domain.Player
+		if game != nil {
+			for _, p := range game.Players {
+				if p.Name == expect.Player {
+					player = p
+					break
+				}
+			}
+		}
+		switch {
+		case player == nil:
+			failures = append(failures, fmt.Sprintf("line %d: no player named %q", expect.Line, expect.Player))
+		case player.TotalScore != expect.Score:
+			failures = append(failures, fmt.Sprintf("line %d: expected %s to score %d, got %d", expect.Line, expect.Player, expect.Score, player.TotalScore))
+		}
+	}
+	return failures
+}
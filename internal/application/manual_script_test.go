@@ -0,0 +1,100 @@
+package application_test
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+func TestParseManualScript(t *testing.T) {
+	text := strings.Join([]string{
+		"# Setup",
+		"<enter>   # No resume",
+		"",
+		"  2",
+		"Alice (Cautious)",
+		"<ENTER>",
+		"EXPECT score Alice (Cautious) 42",
+		"expect SCORE Me -3 # Case does not matter",
+		"S",
+	}, "\n")
+
+	script, err := application.ParseManualScript(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if want := "\n2\nAlice (Cautious)\n\nS\n"; script.Input != want {
+		t.Errorf("Expected input %q, got %q", want, script.Input)
+	}
+	want := []application.ScoreExpectation{
+		{Line: 7, Player: "Alice (Cautious)", Score: 42},
+		{Line: 8, Player: "Me", Score: -3},
+	}
+	if !reflect.DeepEqual(script.Expects, want) {
+		t.Errorf("Expected %+v, got %+v", want, script.Expects)
+	}
+}
+
+func TestParseManualScript_MalformedExpect(t *testing.T) {
+	for _, line := range []string{"EXPECT score Me", "EXPECT total Me 3", "EXPECT score Me three"} {
+		if _, err := application.ParseManualScript(strings.NewReader("2\n" + line + "\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+			t.Errorf("%q: expected an error on line 2, got %v", line, err)
+		}
+	}
+}
+
+func TestManualScript_Check(t *testing.T) {
+	me := domain.NewPlayer("Me", nil)
+	me.TotalScore = 13
+	game := domain.NewGame([]*domain.Player{me})
+	script := &application.ManualScript{Expects: []application.ScoreExpectation{
+		{Line: 3, Player: "Me", Score: 13},
+		{Line: 4, Player: "Me", Score: 20},
+		{Line: 5, Player: "Bob", Score: 0},
+	}}
+
+	want := []string{
+		"line 4: expected Me to score 20, got 13",
+		`line 5: no player named "Bob"`,
+	}
+	if got := script.Check(game); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
+
+func TestManualScript_ExampleScripts(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join("testdata", "manual_scripts", "*.txt"))
+	if err != nil || len(paths) == 0 {
+		t.Fatalf("Expected example scripts, got %v (%v)", paths, err)
+	}
+	for _, path := range paths {
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			f, err := os.Open(path)
+			if err != nil {
+				t.Fatalf("Failed to open script: %v", err)
+			}
+			defer f.Close()
+			script, err := application.ParseManualScript(f)
+			if err != nil {
+				t.Fatalf("Parse failed: %v", err)
+			}
+			if len(script.Expects) == 0 {
+				t.Fatalf("Expected the script to check scores")
+			}
+
+			service := application.NewManualGameServiceWithOutput(bufio.NewReader(strings.NewReader(script.Input)), nil, io.Discard)
+			service.Run()
+
+			if failures := script.Check(service.Game); len(failures) > 0 {
+				t.Errorf("Expectations not met:\n%s", strings.Join(failures, "\n"))
+			}
+		})
+	}
+}
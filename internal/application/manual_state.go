@@ -0,0 +1,447 @@
+package application
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// saveFormatVersion is the save code format written by SaveState.
+// Bump it and register a migration in saveMigrations whenever the serialized state changes shape.
+const saveFormatVersion = 4
+
+// gameStateWrapper wraps the game state with metadata for serialization.
+type gameStateWrapper struct {
+	Version           int          `json:"version"` // Save format version; absent (0) in codes from before versioning, which are v1
+	Game              *domain.Game `json:"game"`
+	UserControlledIDs []string     `json:"user_controlled_ids"` // IDs of players with nil strategy
+	GameID            string       `json:"game_id"`             // GameID for logging continuity
+	// InitialDeal is set while the round's initial deal is in progress (nil once turns have started).
+	InitialDeal *initialDealProgress `json:"initial_deal,omitempty"`
+	// ScoreHistory maps player IDs to their total score after each completed round (added in v2).
+	ScoreHistory map[string][]int `json:"score_history"`
+	// Stats are the counts for the end-of-game screen. Codes from before they were saved have
+	// none, so the counts restart from the resumed state.
+	Stats *domain.GameStats `json:"stats,omitempty"`
+	// CurrentPlayerID is the player whose turn prompt was showing when the code was made
+	// (empty between turns). On load it takes precedence over CurrentTurnIndex.
+	CurrentPlayerID string `json:"current_player_id,omitempty"`
+	// Strategies maps AI player IDs to the registry name of their strategy (interactive saves only;
+	// manual mode gives every AI player a ProbabilisticStrategy).
+	Strategies map[string]string `json:"strategies,omitempty"`
+	// Match is the series the game belongs to; absent for a single game.
+	Match *Match `json:"match,omitempty"`
+	// AppSeats maps the IDs of the players whose seat the app plays at a hybrid table to who
+	// plays it (added in v4).
+	AppSeats map[string]AppSeat `json:"app_seats,omitempty"`
+}
+
+// saveMigrations upgrades a decoded save from the version it is keyed by to the next one.
+var saveMigrations = map[int]func(w *gameStateWrapper){
+	1: migrateSaveV1ToV2,
+	2: migrateSaveV2ToV3,
+	3: migrateSaveV3ToV4,
+}
+
+// migrateSaveV1ToV2 adds the per-round score history. Rounds played before the
+// migration are unknown, so every player starts with an empty history. v1 hands do not
+// record whether their player has drawn this round either: one that holds cards, or is no
+// longer active, has, so it may stay.
+func migrateSaveV1ToV2(w *gameStateWrapper) {
+	w.ScoreHistory = make(map[string][]int)
+	if w.Game == nil {
+		return
+	}
+	for _, p := range w.Game.Players {
+		if p == nil {
+			continue // Rejected by validateSave
+		}
+		w.ScoreHistory[p.ID.String()] = []int{}
+		h := p.CurrentHand
+		if h == nil {
+			continue
+		}
+		holdsCards := len(h.RawNumberCards)+len(h.ModifierCards)+len(h.ActionCards) > 0
+		if holdsCards || h.Status != domain.HandStatusActive {
+			h.HasDrawnThisRound = true
+		}
+	}
+}
+
+// migrateSaveV2ToV3 accounts for the card allowances added to rounds. The turns taken in the
+// round in progress are unknown, so it does not track them and the card count is checked
+// again from the next round.
+func migrateSaveV2ToV3(w *gameStateWrapper) {
+	if w.Game != nil && w.Game.CurrentRound != nil {
+		w.Game.CurrentRound.CardAllowances = nil
+	}
+}
+
+// migrateSaveV3ToV4 accounts for the app seats added to save codes. Codes from before have
+// none: a hybrid game resumed from one has every player at the table, as it always had.
+func migrateSaveV3ToV4(w *gameStateWrapper) {
+	w.AppSeats = nil
+}
+
+// StatePersister turns the state of a Manual Mode game into a save code and back. The undo
+// history keeps its steps as save codes too.
+type StatePersister interface {
+	Save(state ManualState) (string, error)
+	// Load reads a code made by Save, with every player relinked (see RelinkPointers). It
+	// fails if the game cannot be resumed: it is over, or the code is corrupted.
+	Load(code string) (ManualState, error)
+}
+
+// ManualState is what a save code keeps of a Manual Mode game.
+type ManualState struct {
+	Game         *domain.Game
+	GameID       string
+	ScoreHistory map[string][]int
+	Stats        *domain.GameStats
+	// TurnPlayerID is the player whose turn prompt was showing (empty between turns). Load
+	// points the round's turn index at them.
+	TurnPlayerID string
+	// Match is the series the game belongs to, nil for a single game.
+	Match *Match
+	// AppSeats maps the IDs of the players whose seat the app plays to who plays it.
+	AppSeats    map[string]AppSeat
+	initialDeal *initialDealProgress // Set while the round's initial deal is in progress
+}
+
+// SaveCodec is the StatePersister of Manual Mode: its codes are base64-encoded JSON in the
+// format of saveFormatVersion, and it reads the codes of every earlier version.
+type SaveCodec struct {
+	// OnDealerReset, if set, is told when a code points the dealer outside the table, before
+	// the first player is made dealer instead.
+	OnDealerReset func(g *domain.Game)
+}
+
+// Save implements StatePersister.
+func (c SaveCodec) Save(state ManualState) (string, error) {
+	// Collect IDs of user-controlled players (those with nil strategy)
+	var userControlledIDs []string
+	for _, p := range state.Game.Players {
+		if p.Strategy == nil {
+			userControlledIDs = append(userControlledIDs, p.ID.String())
+		}
+	}
+
+	wrapper := gameStateWrapper{
+		Version:           saveFormatVersion,
+		Game:              state.Game,
+		UserControlledIDs: userControlledIDs,
+		GameID:            state.GameID,
+		InitialDeal:       state.initialDeal,
+		ScoreHistory:      state.ScoreHistory,
+		Stats:             state.Stats,
+		CurrentPlayerID:   state.TurnPlayerID,
+		Match:             state.Match,
+		AppSeats:          state.AppSeats,
+	}
+
+	data, err := json.Marshal(wrapper)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// Load implements StatePersister.
+func (c SaveCodec) Load(code string) (ManualState, error) {
+	decoded, err := base64.StdEncoding.DecodeString(code)
+	if err != nil {
+		return ManualState{}, fmt.Errorf("invalid code: %w", err)
+	}
+
+	wrapper, err := decodeSave(decoded)
+	if err != nil {
+		return ManualState{}, err
+	}
+
+	// Validate that the game state is not nil
+	if wrapper.Game == nil {
+		return ManualState{}, fmt.Errorf("invalid save code: game state is missing")
+	}
+
+	// Validate that the loaded game is resumable
+	if wrapper.Game.IsCompleted {
+		return ManualState{}, fmt.Errorf("cannot resume the loaded game: %w", domain.ErrGameCompleted)
+	}
+	if wrapper.Game.CurrentRound != nil && wrapper.Game.CurrentRound.IsEnded {
+		return ManualState{}, fmt.Errorf("cannot resume the loaded game: current %w", domain.ErrRoundEnded)
+	}
+
+	if len(wrapper.Game.Players) == 0 {
+		return ManualState{}, fmt.Errorf("invalid save code: no players")
+	}
+	// A hand-edited or corrupted save may point the dealer outside the table.
+	if wrapper.Game.DealerIndex < 0 || wrapper.Game.DealerIndex >= len(wrapper.Game.Players) {
+		if c.OnDealerReset != nil {
+			c.OnDealerReset(wrapper.Game)
+		}
+		wrapper.Game.DealerIndex = 0
+	}
+	if err := validateSave(wrapper); err != nil {
+		return ManualState{}, fmt.Errorf("invalid save code: %w", err)
+	}
+
+	relinkPointers(wrapper.Game, wrapper.UserControlledIDs)
+	if wrapper.CurrentPlayerID != "" && wrapper.Game.CurrentRound != nil {
+		resumeTurnOf(wrapper.Game.CurrentRound, wrapper.CurrentPlayerID)
+	}
+	return ManualState{
+		Game:         wrapper.Game,
+		GameID:       wrapper.GameID,
+		ScoreHistory: wrapper.ScoreHistory,
+		Stats:        wrapper.Stats,
+		TurnPlayerID: wrapper.CurrentPlayerID,
+		Match:        wrapper.Match,
+		AppSeats:     wrapper.AppSeats,
+		initialDeal:  wrapper.InitialDeal,
+	}, nil
+}
+
+// persister returns the Persister, or the SaveCodec reporting a reset dealer.
+func (s *ManualGameService) persister() StatePersister {
+	if s.Persister != nil {
+		return s.Persister
+	}
+	return SaveCodec{OnDealerReset: s.dealerReset}
+}
+
+// dealerReset warns that a loaded code pointed the dealer outside the table of g.
+func (s *ManualGameService) dealerReset(g *domain.Game) {
+	s.say(console.MsgDealerOutOfRange, console.Args{
+		"index": g.DealerIndex,
+		"count": len(g.Players),
+		"name":  g.Players[0].Name,
+	})
+}
+
+// SaveState serializes the current game state to a base64 string.
+func (s *ManualGameService) SaveState() (string, error) {
+	return s.persister().Save(ManualState{
+		Game:         s.Game,
+		GameID:       s.GameID,
+		ScoreHistory: s.ScoreHistory,
+		Stats:        s.Stats,
+		TurnPlayerID: s.turnPlayerID,
+		Match:        s.Match,
+		AppSeats:     s.savedSeats(),
+		initialDeal:  s.initialDeal,
+	})
+}
+
+// LoadState deserializes the game state from a base64 string.
+func (s *ManualGameService) LoadState(encoded string) error {
+	state, err := s.persister().Load(encoded)
+	if err != nil {
+		return err
+	}
+	seats, err := s.restoreSeats(state.Game, state.AppSeats)
+	if err != nil {
+		return err
+	}
+	s.Game = state.Game
+	s.AppSeats = seats
+	s.GameID = state.GameID // Restore GameID for logging continuity
+	s.initialDeal = state.initialDeal
+	s.ScoreHistory = state.ScoreHistory
+	s.Stats = state.Stats
+	s.turnPlayerID = ""
+	// An undo restores the series as it was, which during a game is as it is. Copying it into
+	// the series being played keeps the one its MatchService records the results in.
+	if s.Match != nil && state.Match != nil {
+		*s.Match = *state.Match
+	} else {
+		s.Match = state.Match
+	}
+	return nil
+}
+
+// resumeTurnOf points the turn index at the given player, so a code copied during a turn
+// resumes with that same player. A player no longer in the round leaves the index as saved.
+func resumeTurnOf(round *domain.Round, playerID string) {
+	for i, p := range round.ActivePlayers {
+		if p.ID.String() == playerID {
+			round.CurrentTurnIndex = i
+			return
+		}
+	}
+}
+
+// decodeSave parses a decoded save code of any supported version and migrates it to saveFormatVersion.
+func decodeSave(data []byte) (*gameStateWrapper, error) {
+	if len(data) > maxSaveCodeSize {
+		return nil, fmt.Errorf("invalid save code: %d bytes is too large for a game state", len(data))
+	}
+	// Read the version on its own first: a newer format may not fit the current wrapper at all.
+	var header struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return nil, fmt.Errorf("failed to parse game state: %w", err)
+	}
+	version := header.Version
+	if version == 0 {
+		version = 1 // Codes written before versioning
+	}
+	if version < 0 {
+		return nil, fmt.Errorf("invalid save code: unknown format version %d", header.Version)
+	}
+	if version > saveFormatVersion {
+		return nil, fmt.Errorf("save code from a newer version (format v%d, this build reads up to v%d)", version, saveFormatVersion)
+	}
+
+	var wrapper gameStateWrapper
+	if err := json.Unmarshal(data, &wrapper); err != nil {
+		return nil, fmt.Errorf("failed to parse game state: %w", err)
+	}
+	for ; version < saveFormatVersion; version++ {
+		saveMigrations[version](&wrapper)
+	}
+	wrapper.Version = saveFormatVersion
+	return &wrapper, nil
+}
+
+// printSaveCode shows the save code of the current state (the SAVE command).
+func (s *ManualGameService) printSaveCode() {
+	code, err := s.SaveState()
+	if err != nil {
+		s.say(console.MsgSaveCodeFailed, console.Args{"err": err})
+		return
+	}
+	s.say(console.MsgSaveCode, console.Args{"code": code})
+}
+
+// PushState captures the current game state and pushes it to history.
+func (s *ManualGameService) PushState() {
+	s.pushHistory(s.History.Push)
+}
+
+// pushRoundStart pushes the state a new round starts from (see HistoryPhaseRoundStart).
+func (s *ManualGameService) pushRoundStart() {
+	s.pushHistory(s.History.PushRoundStart)
+}
+
+func (s *ManualGameService) pushHistory(push func(GameMemento)) {
+	if s.Game == nil {
+		return
+	}
+	state, err := s.SaveState()
+	if err != nil {
+		s.say(console.MsgHistoryPushFailed, console.Args{"err": err})
+		return
+	}
+	push(GameMemento(state))
+}
+
+// printHistory tells the user how many undo and redo steps are available.
+func (s *ManualGameService) printHistory() {
+	s.say(console.MsgHistory, console.Args{
+		"undo": s.History.UndoSteps(),
+		"redo": s.History.RedoSteps(),
+		"kept": s.History.Len(),
+		"max":  s.History.maxLen(),
+	})
+}
+
+// Undo reverts the game state to the previous memento.
+func (s *ManualGameService) Undo() {
+	if s.History.AtRoundStart() {
+		s.say(console.MsgCannotUndoPastRound, nil)
+		return
+	}
+	memento, ok := s.History.Undo()
+	if !ok {
+		s.say(console.MsgCannotUndo, nil)
+		return
+	}
+	if err := s.LoadState(string(memento)); err != nil {
+		s.say(console.MsgUndoFailed, console.Args{"err": err})
+		// Try to recover state index if loading fails
+		s.History.Redo()
+	} else {
+		s.say(console.MsgUndone, nil)
+	}
+}
+
+// Redo advances the game state to the next memento.
+func (s *ManualGameService) Redo() {
+	memento, ok := s.History.Redo()
+	if !ok {
+		s.say(console.MsgCannotRedo, nil)
+		return
+	}
+	if err := s.LoadState(string(memento)); err != nil {
+		s.say(console.MsgRedoFailed, console.Args{"err": err})
+		// Try to recover state index if loading fails
+		s.History.Undo()
+	} else {
+		s.say(console.MsgRedone, nil)
+	}
+}
+
+// RelinkPointers restores pointer relationships after deserialization.
+// It ensures that all references to players point to the same instances and restores strategies.
+func (s *ManualGameService) RelinkPointers(g *domain.Game, userControlledIDs []string) {
+	relinkPointers(g, userControlledIDs)
+}
+
+// relinkPointers implements RelinkPointers for every mode that loads a gameStateWrapper.
+// User-controlled players get a nil strategy and AI players a ProbabilisticStrategy.
+func relinkPointers(g *domain.Game, userControlledIDs []string) {
+	// Create a set of user-controlled player IDs for quick lookup
+	userControlledSet := make(map[string]bool)
+	for _, id := range userControlledIDs {
+		userControlledSet[id] = true
+	}
+
+	playerMap := make(map[string]*domain.Player)
+	for _, p := range g.Players {
+		playerMap[p.ID.String()] = p
+		// Restore strategies based on user control
+		if userControlledSet[p.ID.String()] {
+			p.Strategy = nil
+		} else {
+			// Default to ProbabilisticStrategy for AI players in manual mode
+			p.Strategy = &strategy.ProbabilisticStrategy{}
+		}
+	}
+
+	if g.CurrentRound != nil {
+		// Relink Round Players
+		for i, p := range g.CurrentRound.Players {
+			if existing, ok := playerMap[p.ID.String()]; ok {
+				g.CurrentRound.Players[i] = existing
+			}
+		}
+		// Relink Active Players
+		for i, p := range g.CurrentRound.ActivePlayers {
+			if existing, ok := playerMap[p.ID.String()]; ok {
+				g.CurrentRound.ActivePlayers[i] = existing
+			}
+		}
+		// Relink Dealer
+		if g.CurrentRound.Dealer != nil {
+			if existing, ok := playerMap[g.CurrentRound.Dealer.ID.String()]; ok {
+				g.CurrentRound.Dealer = existing
+			}
+		}
+		// Relink Deck
+		if g.Deck != nil {
+			g.CurrentRound.Deck = g.Deck
+		}
+	}
+
+	// Relink Winners
+	for i, p := range g.Winners {
+		if existing, ok := playerMap[p.ID.String()]; ok {
+			g.Winners[i] = existing
+		}
+	}
+}
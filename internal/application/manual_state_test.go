@@ -0,0 +1,168 @@
+package application_test
+
+import (
+	"errors"
+	"testing"
+	"testing/quick"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/testutil"
+)
+
+// codecGame returns a game of Me (user-controlled) and Bot in its first round, Bot to play.
+func codecGame(t *testing.T) *domain.Game {
+	t.Helper()
+	me := domain.NewPlayer("Me", nil)
+	bot := domain.NewPlayer("Bot", &strategy.ProbabilisticStrategy{})
+	g := domain.NewGame([]*domain.Player{me, bot})
+	g.Deck = domain.NewDeck()
+	g.RoundCount = 1
+	g.CurrentRound = domain.NewRound(g.Players, me, g.Deck)
+	seven := domain.Card{Type: domain.CardTypeNumber, Value: 7}
+	if err := (application.TableDeckTracker{}).Remove(g, seven); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	me.CurrentHand.AddCard(seven)
+	me.TotalScore = 42
+	return g
+}
+
+func TestSaveCodec_RoundTrip(t *testing.T) {
+	g := codecGame(t)
+	bot := g.Players[1]
+	state := application.ManualState{
+		Game:         g,
+		GameID:       "game-1",
+		ScoreHistory: map[string][]int{g.Players[0].ID.String(): {42}},
+		Stats:        domain.NewGameStats(),
+		TurnPlayerID: bot.ID.String(),
+		Match:        application.NewMatch("match-1", 3, 1),
+	}
+	state.Match.Record(domain.NewGame(g.Players))
+
+	code, err := application.SaveCodec{}.Save(state)
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	loaded, err := application.SaveCodec{}.Load(code)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+
+	if loaded.GameID != "game-1" || loaded.TurnPlayerID != bot.ID.String() || loaded.Stats == nil {
+		t.Errorf("Expected the game ID, turn player and stats back, got %+v", loaded)
+	}
+	if m := loaded.Match; m == nil || m.ID != "match-1" || m.Played != 1 || m.Points[g.Players[0].ID.String()] != 42 || m.FirstDealer != 1 {
+		t.Errorf("Expected the series back, got %+v", loaded.Match)
+	}
+	if got := loaded.ScoreHistory[g.Players[0].ID.String()]; len(got) != 1 || got[0] != 42 {
+		t.Errorf("Expected the score history back, got %v", loaded.ScoreHistory)
+	}
+	lg := loaded.Game
+	if lg.Players[0].TotalScore != 42 || len(lg.Players[0].CurrentHand.NumberCards) != 1 {
+		t.Errorf("Expected Me's score and hand back, got %d and %v", lg.Players[0].TotalScore, lg.Players[0].CurrentHand.NumberCards)
+	}
+	if lg.Players[0].Strategy != nil || lg.Players[1].Strategy == nil {
+		t.Error("Expected Me to stay user-controlled and Bot to get a strategy")
+	}
+	round := lg.CurrentRound
+	if round.ActivePlayers[0] != lg.Players[0] || round.Dealer != lg.Players[0] || round.Deck != lg.Deck {
+		t.Error("Expected the round to point at the game's players and deck")
+	}
+	if round.CurrentTurnIndex != 1 {
+		t.Errorf("Expected the turn to resume with Bot, got index %d", round.CurrentTurnIndex)
+	}
+}
+
+func TestSaveCodec_DealerReset(t *testing.T) {
+	g := codecGame(t)
+	g.DealerIndex = 5
+	code, err := application.SaveCodec{}.Save(application.ManualState{Game: g})
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	reported := -1
+	codec := application.SaveCodec{OnDealerReset: func(g *domain.Game) { reported = g.DealerIndex }}
+	loaded, err := codec.Load(code)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if reported != 5 || loaded.Game.DealerIndex != 0 {
+		t.Errorf("Expected dealer 5 to be reported and reset to 0, got %d reported and %d", reported, loaded.Game.DealerIndex)
+	}
+}
+
+func TestSaveCodec_RejectsFinishedGame(t *testing.T) {
+	g := codecGame(t)
+	g.End(domain.GameEndReasonWinner)
+	code, err := application.SaveCodec{}.Save(application.ManualState{Game: g})
+	if err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if _, err := (application.SaveCodec{}).Load(code); !errors.Is(err, domain.ErrGameCompleted) {
+		t.Errorf("Expected ErrGameCompleted, got %v", err)
+	}
+}
+
+// countingPersister is a StatePersister counting the codes it makes and reads.
+type countingPersister struct {
+	application.SaveCodec
+	saves, loads int
+}
+
+func (c *countingPersister) Save(state application.ManualState) (string, error) {
+	c.saves++
+	return c.SaveCodec.Save(state)
+}
+
+func (c *countingPersister) Load(code string) (application.ManualState, error) {
+	c.loads++
+	return c.SaveCodec.Load(code)
+}
+
+func TestManualMode_Persister(t *testing.T) {
+	persister := &countingPersister{}
+	service := application.NewManualGameService(nil, nil)
+	service.Persister = persister
+	service.Game = codecGame(t)
+
+	service.PushState()
+	service.Game.Players[0].TotalScore = 99
+	service.PushState()
+	service.Undo()
+
+	if persister.saves != 2 || persister.loads != 1 {
+		t.Errorf("Expected 2 saves and 1 load through the persister, got %d and %d", persister.saves, persister.loads)
+	}
+	if got := service.Game.Players[0].TotalScore; got != 42 {
+		t.Errorf("Expected Undo to restore a total of 42, got %d", got)
+	}
+}
+
+func TestProperty_SaveLoadSaveIsFixedPoint(t *testing.T) {
+	property := func(g testutil.Game) bool {
+		saved := &application.ManualGameService{Game: g.Game, GameID: "game-1"}
+		code, err := saved.SaveState()
+		if err != nil {
+			t.Logf("%v: %v", g, err)
+			return false
+		}
+		loaded := &application.ManualGameService{}
+		if err := loaded.LoadState(code); err != nil {
+			t.Logf("%v: %v", g, err)
+			return false
+		}
+		again, err := loaded.SaveState()
+		if err != nil || again != code {
+			t.Logf("%v: the reloaded game saves differently (%v)", g, err)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, &quick.Config{MaxCount: 200}); err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,517 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// initialDealProgress tracks the initial deal of a round: before regular turns begin,
+// every active player is dealt one card in dealer order.
+type initialDealProgress struct {
+	Order []string `json:"order"` // Player IDs in dealing order
+	Next  int      `json:"next"`  // Index in Order of the next player to be dealt
+}
+
+// turnCommandKind is what a line typed at a turn or deal prompt asks for.
+type turnCommandKind int
+
+const (
+	turnCard    turnCommandKind = iota // Anything else: the card drawn
+	turnUndo                           // U, UNDO or <
+	turnRedo                           // R, REDO or >
+	turnHistory                        // HIST
+	turnCheck                          // CHECK
+	turnSave                           // SAVE
+	turnEmpty                          // EMPTY: the cards on the table ran out
+	turnStay                           // S
+	turnWhatIf                         // W
+	turnTable                          // P or TABLE
+	turnMemory                         // MEM: switch the memory model of the bust rate
+	turnPlay                           // PLAY <action>: play a held action
+)
+
+// turnCommand is a parsed line of input at a turn or deal prompt.
+type turnCommand struct {
+	Kind turnCommandKind
+	Arg  string // The action named after PLAY, e.g. "F"
+}
+
+// parseTurnCommand reads the command of a line typed at a turn or deal prompt, ignoring case.
+// Any line that is not a command is a turnCard, left to parseInput.
+func parseTurnCommand(input string) turnCommand {
+	if fields := strings.Fields(input); len(fields) > 0 && strings.EqualFold(fields[0], "PLAY") {
+		return turnCommand{Kind: turnPlay, Arg: strings.Join(fields[1:], " ")}
+	}
+	switch strings.ToUpper(input) {
+	case "U", "UNDO", "<":
+		return turnCommand{Kind: turnUndo}
+	case "R", "REDO", ">":
+		return turnCommand{Kind: turnRedo}
+	case "HIST":
+		return turnCommand{Kind: turnHistory}
+	case "CHECK":
+		return turnCommand{Kind: turnCheck}
+	case "SAVE":
+		return turnCommand{Kind: turnSave}
+	case "EMPTY":
+		return turnCommand{Kind: turnEmpty}
+	case "S":
+		return turnCommand{Kind: turnStay}
+	case "W":
+		return turnCommand{Kind: turnWhatIf}
+	case "P", "TABLE":
+		return turnCommand{Kind: turnTable}
+	case "MEM":
+		return turnCommand{Kind: turnMemory}
+	}
+	return turnCommand{Kind: turnCard}
+}
+
+// gameLoop plays rounds until the game is over, then shows and records how it ended.
+func (s *ManualGameService) gameLoop() {
+	for !s.Game.IsCompleted {
+		s.Game.RoundCount++
+		s.playRound()
+		// A round cut short by end of input is not recorded. The summary reads the hands,
+		// so it comes before they are discarded.
+		if s.Game.CurrentRound != nil && s.Game.CurrentRound.IsEnded {
+			s.printRoundSummary()
+			s.recordScoreHistory()
+		}
+		// Collect cards from players' hands to discard pile at end of round
+		if s.Game.CurrentRound != nil { // Could be nil on first iteration or error
+			s.Game.DiscardHands()
+		}
+		if s.Game.CurrentRound != nil && s.Game.CurrentRound.EndReason == domain.RoundEndReasonExhausted {
+			s.say(console.MsgGameExhausted, nil)
+			s.Game.EndExhausted()
+			break
+		}
+
+		// Rotate dealer for next round
+		s.Game.NextDealer()
+
+		// Check for winners
+		winners := s.Game.DetermineWinners()
+		if len(winners) > 0 {
+			s.Game.Winners = winners
+			s.Game.End(domain.GameEndReasonWinner)
+		}
+		// Update deck reference for the next round
+		// If a reshuffle happened during PlayRound, s.Game.CurrentRound.Deck points to the new deck.
+		if s.Game.CurrentRound != nil && s.Game.CurrentRound.Deck != nil {
+			s.Game.Deck = s.Game.CurrentRound.Deck
+		}
+	}
+	s.printWinner()
+	s.exportGame()
+	s.printShadowSummary()
+	s.updateProfiles()
+
+	s.publish(domain.GameEnded{Game: s.Game})
+}
+
+// playRound plays the current round, starting it unless a resumed one is in progress.
+func (s *ManualGameService) playRound() {
+	// Initialize new round only if not resuming
+	if s.Game.CurrentRound == nil || s.Game.CurrentRound.IsEnded {
+		if s.Game.Deck == nil {
+			s.Game.Deck = domain.NewDeck()
+		}
+		dealer := s.Game.Players[s.Game.DealerIndex]
+		s.Game.CurrentRound = domain.NewRound(s.Game.Players, dealer, s.Game.Deck)
+		s.Game.CurrentRound.TrackCardAllowances()
+		s.Game.CurrentRound.DiscardsBefore = len(s.Game.DiscardPile)
+		s.warnedAnomalies = nil
+		s.say(console.MsgNewRound, console.Args{"dealer": dealer.Name})
+
+		s.publish(domain.RoundStarted{Round: s.Game.RoundCount, Dealer: dealer})
+
+		// Every active player is dealt one card, starting with the dealer, before regular turns.
+		s.initialDeal = &initialDealProgress{Order: getPlayerIDs(s.Game.CurrentRound.ActivePlayers)}
+
+		// The round-start state is the undo boundary: gameLoop has already collected the previous
+		// round's hands and passed the deal on, so that round cannot be undone into.
+		s.pushRoundStart()
+	} else {
+		s.say(console.MsgResumingRound, nil)
+	}
+
+	for !s.Game.CurrentRound.IsEnded {
+		// Label for restarting turn loop if undo/redo happens
+	StartOfTurn:
+		s.pendingHit = nil
+		if s.initialDeal != nil {
+			if !s.dealInitialCard() {
+				return
+			}
+			continue
+		}
+
+		// Robustness check for resumed states: a turn that leaves nobody in play already ends the round.
+		if s.endRoundIfNoneInPlay() {
+			break
+		}
+
+		// Ensure index is valid
+		if s.Game.CurrentRound.CurrentTurnIndex >= len(s.Game.CurrentRound.ActivePlayers) {
+			s.Game.CurrentRound.CurrentTurnIndex = 0
+		}
+
+		currentPlayer := s.Game.CurrentRound.ActivePlayers[s.Game.CurrentRound.CurrentTurnIndex]
+
+		// Skip players who are not active (busted, stayed, frozen)
+		if currentPlayer.CurrentHand.Status != domain.HandStatusActive {
+			// Fix: Remove inactive player from ActivePlayers list to prevent infinite loops
+			// and ensure the round can end (RoundEndReasonNoActivePlayers).
+			s.Game.CurrentRound.RemoveActivePlayer(currentPlayer)
+			continue
+		}
+
+		// Save Code is hidden by default. Use 'SAVE' command to view.
+		// A code made during this turn resumes with this player, whatever the turn index says.
+		s.turnPlayerID = currentPlayer.ID.String()
+
+		s.Game.CurrentRound.RecordTurn(currentPlayer)
+		s.say(console.MsgTurnHeader, console.Args{"name": currentPlayer.Name, "score": currentPlayer.TotalScore})
+
+		calc := domain.NewScoreCalculator()
+		score := calc.Compute(currentPlayer.CurrentHand)
+
+		s.publish(domain.TurnStarted{Player: currentPlayer, HandScore: score.Total})
+
+		// Show current hand score before input
+		s.printHand(currentPlayer.CurrentHand, score.Total)
+		if held := currentPlayer.CurrentHand.HeldActions(); len(held) > 0 {
+			labels := make([]string, len(held))
+			for i, c := range held {
+				labels[i] = c.String()
+			}
+			s.say(console.MsgHeldActions, console.Args{"cards": strings.Join(labels, ", ")})
+		}
+
+		// The app plays its seats without advice: their turn is over before the input loop.
+		appStrategy, app := s.appSeat(currentPlayer)
+		var analysis turnAnalysis
+		if !app {
+			analysis = s.analyzeState(currentPlayer)
+			s.pendingHit = &analysis
+		}
+
+		// Input loop for this turn (single action)
+		turnEnded := false
+		playerRemoved := false
+		shouldRestartTurn := false
+		turnAction := ""
+		// Undo/Redo restarts the turn (and this timer), so time spent there is not counted.
+		turnStartedAt := s.now()
+
+		if app {
+			var ok bool
+			if turnAction, ok = s.playAppTurn(currentPlayer, appStrategy); !ok {
+				return
+			}
+			playerRemoved = !s.Game.CurrentRound.ContainsActive(currentPlayer.ID)
+			turnEnded = true
+		}
+
+	TurnInput:
+		for !turnEnded {
+			s.ask(console.MsgTurnPrompt, nil)
+			input, ok := s.readAnswer()
+			if !ok {
+				return
+			}
+
+			cmd := parseTurnCommand(input)
+			switch cmd.Kind {
+			case turnUndo:
+				s.Undo()
+				shouldRestartTurn = true
+				break TurnInput
+			case turnRedo:
+				s.Redo()
+				shouldRestartTurn = true
+				break TurnInput
+			case turnHistory:
+				s.printHistory()
+				continue
+			case turnCheck:
+				s.printAudit()
+				continue
+			case turnPlay:
+				if s.playHeldAction(currentPlayer, cmd.Arg) {
+					playerRemoved = !s.Game.CurrentRound.ContainsActive(currentPlayer.ID)
+					turnEnded = true
+					turnAction = "play"
+				}
+				continue
+			case turnTable:
+				fmt.Fprint(s.out(), s.Messages.TableStatus(s.Game, currentPlayer))
+				continue
+			case turnMemory:
+				s.switchMemory(currentPlayer)
+				continue
+			case turnSave:
+				s.printSaveCode()
+				continue
+			case turnWhatIf: // Read-only analysis
+				s.printWhatIf(currentPlayer)
+				continue
+			case turnEmpty:
+				// The cards on the table ran out, even if the tracked deck has some left
+				s.exhaustRound()
+				return
+			}
+
+			if cmd.Kind == turnStay {
+				// Validation: Cannot stay on first turn (empty hand) unless special conditions met
+				if !currentPlayer.CurrentHand.CanStay() {
+					s.say(console.MsgCannotStay, nil)
+					continue
+				}
+
+				s.stay(currentPlayer)
+				playerRemoved = true
+				turnEnded = true
+				turnAction = "stay"
+			} else {
+				// Parse card or action
+				card, err := s.parseInput(input)
+				if err != nil {
+					s.say(console.MsgInvalidInput, console.Args{"err": err})
+					continue
+				}
+
+				// Remove card from deck (tracking)
+				if err := s.removeCardFromDeck(card); err != nil {
+					if errors.Is(err, domain.ErrNoActiveRound) {
+						s.say(console.MsgErrorEndingRound, console.Args{"err": err})
+						return
+					}
+					if errors.Is(err, domain.ErrDeckEmpty) {
+						s.exhaustRound()
+						return
+					}
+					s.say(console.MsgErrorTryAgain, console.Args{"err": err})
+					continue
+				}
+
+				// Process card
+				s.processCard(currentPlayer, card)
+				s.warnInconsistencies()
+
+				// Check if player was removed (Freeze only)
+				// processCard calls RemoveActivePlayer only for Freeze actions.
+				// Note: Flip7 ends the round without removing the player.
+				// Note: Busted players are NOT removed from ActivePlayers; only their status changes.
+				// We need to check if currentPlayer is still in ActivePlayers.
+				if !s.Game.CurrentRound.ContainsActive(currentPlayer.ID) {
+					playerRemoved = true
+				}
+
+				// Turn always ends after one action (Hit) or Action card
+				turnEnded = true
+				turnAction = "hit"
+			}
+		}
+
+		if shouldRestartTurn {
+			goto StartOfTurn
+		}
+		s.turnPlayerID = ""
+		s.pendingHit = nil
+		if turnAction != "play" { // A held action that ended the turn left no hit/stay to compare
+			s.recordShadows(currentPlayer, "", analysis.shadows, turnAction)
+		}
+
+		if currentPlayer.Strategy == nil && !app {
+			// User-controlled turns keep the advice next to the choice for review after the game
+			s.publish(DecisionAnalyzed{
+				Player:    currentPlayer,
+				BustRate:  analysis.bustRate,
+				HandScore: analysis.handScore,
+				EVIfHit:   analysis.evIfHit,
+				Suggested: analysis.suggested,
+				Chosen:    turnAction,
+			})
+		}
+		s.publish(domain.TurnEnded{Player: currentPlayer, Action: turnAction, Duration: s.now().Sub(turnStartedAt)})
+
+		// Check if round ended during this loop (Flip 7 or all stayed)
+		if s.Game.CurrentRound.IsEnded {
+			// Do NOT push state here: an ended round cannot be resumed. The next push is the
+			// round-start state of the next round, which Undo does not go back past.
+			break
+		}
+
+		// Update Turn Index
+		if !playerRemoved {
+			s.Game.CurrentRound.CurrentTurnIndex++
+		}
+		// If player removed (Freeze action), the next player slides into the current index, so we don't increment.
+		// Busted players remain in ActivePlayers but are skipped via the status check at the start of the loop.
+
+		// The last player to bust or stay ends the round here, before a push: a state with nobody
+		// left in play would end the round again as soon as Undo or Redo loaded it.
+		if s.endRoundIfNoneInPlay() {
+			break
+		}
+
+		// Push state if action successful and round not ended
+		// We push AFTER updating the turn index so that the saved state points to the NEXT player's turn.
+		// This ensures that when we Undo, we return to the start of the turn that was just completed (or rather,
+		// we return to the state where the previous player has finished, and it is now the current player's turn).
+		s.PushState()
+	}
+}
+
+// exhaustRound ends the current round when no card can be drawn: the tracked deck and discard
+// pile are empty, or the user typed EMPTY because the cards on the table ran out (e.g. some were
+// lost or miscounted). The hands still in play are handled by Game.ExhaustionRule, and the game
+// ends with the round (see gameLoop).
+func (s *ManualGameService) exhaustRound() {
+	s.say(console.MsgDeckExhausted, nil)
+	rule := s.Game.RoundExhaustionRule()
+	hands := s.Game.CurrentRound.Exhaust(rule)
+	s.initialDeal = nil
+	for _, h := range hands {
+		if rule == domain.ExhaustionDiscardHands {
+			s.say(console.MsgExhaustedHandDiscarded, console.Args{"name": h.Player.Name})
+		} else {
+			s.say(console.MsgBanked, console.Args{"name": h.Player.Name, "points": h.Banked, "total": h.Player.TotalScore})
+		}
+	}
+
+	s.publish(domain.RoundExhausted{Rule: rule, Hands: hands})
+}
+
+// endRoundIfNoneInPlay ends the current round if none of its players is still active,
+// and reports whether it did.
+func (s *ManualGameService) endRoundIfNoneInPlay() bool {
+	round := s.Game.CurrentRound
+	for _, p := range round.ActivePlayers {
+		if p.CurrentHand.Status == domain.HandStatusActive {
+			return false
+		}
+	}
+	round.End(domain.RoundEndReasonNoActivePlayers)
+	return true
+}
+
+// dealInitialCard deals the initial card to the next player in the deal order.
+// The card goes through processCard like any other draw, so actions are resolved
+// (and a Flip Three may end the round) during the deal.
+// Undo/Redo and SAVE work here as in regular turns; every dealt card is an undo point.
+// It returns false if the round cannot continue (input closed or no active round).
+func (s *ManualGameService) dealInitialCard() bool {
+	s.skipInactiveInDeal()
+	if s.initialDeal == nil {
+		// Deal complete: turns start from this state, unless the deal left nobody in play
+		if !s.endRoundIfNoneInPlay() {
+			s.PushState()
+		}
+		return true
+	}
+
+	p := s.findPlayer(s.initialDeal.Order[s.initialDeal.Next])
+	if _, app := s.appSeat(p); app {
+		card, err := s.drawForApp(p)
+		switch {
+		case errors.Is(err, domain.ErrDeckEmpty):
+			s.exhaustRound()
+			return true
+		case err != nil:
+			s.say(console.MsgErrorEndingRound, console.Args{"err": err})
+			return false
+		}
+		return s.dealCard(p, card)
+	}
+
+	for {
+		s.ask(console.MsgInitialCardPrompt, console.Args{"name": p.Name})
+		input, ok := s.readAnswer()
+		if !ok {
+			return false
+		}
+
+		// Staying, PLAY and the analysis commands have no place in the deal: they are read as cards.
+		switch parseTurnCommand(input).Kind {
+		case turnUndo:
+			s.Undo()
+			return true
+		case turnRedo:
+			s.Redo()
+			return true
+		case turnHistory:
+			s.printHistory()
+			continue
+		case turnCheck:
+			s.printAudit()
+			continue
+		case turnSave:
+			s.printSaveCode()
+			continue
+		case turnEmpty:
+			s.exhaustRound()
+			return true
+		}
+
+		card, err := s.parseInput(input)
+		if err != nil {
+			s.say(console.MsgInvalidInput, console.Args{"err": err})
+			continue
+		}
+		if err := s.removeCardFromDeck(card); err != nil {
+			if errors.Is(err, domain.ErrNoActiveRound) {
+				s.say(console.MsgErrorEndingRound, console.Args{"err": err})
+				return false
+			}
+			if errors.Is(err, domain.ErrDeckEmpty) {
+				s.exhaustRound()
+				return true
+			}
+			s.say(console.MsgErrorTryAgain, console.Args{"err": err})
+			continue
+		}
+
+		return s.dealCard(p, card)
+	}
+}
+
+// dealCard processes card, dealt to p, and moves the deal on to the next player.
+func (s *ManualGameService) dealCard(p *domain.Player, card domain.Card) bool {
+	s.initialDeal.Next++
+	s.processDrawnCard(p, card, domain.DrawInitialDeal)
+	s.warnInconsistencies()
+
+	if s.Game.CurrentRound.IsEnded {
+		// Flip 7 during a Flip Three: the deal ends with the round.
+		s.initialDeal = nil
+		return true
+	}
+
+	s.skipInactiveInDeal()
+	s.PushState()
+	return true
+}
+
+// skipInactiveInDeal advances the deal past players who are no longer active
+// (e.g. frozen or busted by an action during the deal) and ends the deal after the last player.
+func (s *ManualGameService) skipInactiveInDeal() {
+	deal := s.initialDeal
+	if deal == nil {
+		return
+	}
+	for deal.Next < len(deal.Order) {
+		if s.Game.CurrentRound.DealsInitialCardTo(s.findPlayer(deal.Order[deal.Next])) {
+			return
+		}
+		deal.Next++
+	}
+	s.initialDeal = nil
+}
@@ -0,0 +1,39 @@
+package application
+
+import "testing"
+
+func TestParseTurnCommand(t *testing.T) {
+	tests := []struct {
+		input string
+		want  turnCommand
+	}{
+		{"U", turnCommand{Kind: turnUndo}},
+		{"undo", turnCommand{Kind: turnUndo}},
+		{"<", turnCommand{Kind: turnUndo}},
+		{"r", turnCommand{Kind: turnRedo}},
+		{"REDO", turnCommand{Kind: turnRedo}},
+		{">", turnCommand{Kind: turnRedo}},
+		{"hist", turnCommand{Kind: turnHistory}},
+		{"Check", turnCommand{Kind: turnCheck}},
+		{"save", turnCommand{Kind: turnSave}},
+		{"EMPTY", turnCommand{Kind: turnEmpty}},
+		{"s", turnCommand{Kind: turnStay}},
+		{"w", turnCommand{Kind: turnWhatIf}},
+		{"P", turnCommand{Kind: turnTable}},
+		{"table", turnCommand{Kind: turnTable}},
+		{"PLAY F", turnCommand{Kind: turnPlay, Arg: "F"}},
+		{"play  flip three", turnCommand{Kind: turnPlay, Arg: "flip three"}},
+		{"PLAY", turnCommand{Kind: turnPlay}},
+		{"5", turnCommand{Kind: turnCard}},
+		{"+10", turnCommand{Kind: turnCard}},
+		{"F", turnCommand{Kind: turnCard}},
+		{"SC", turnCommand{Kind: turnCard}},
+		{"PLAYER", turnCommand{Kind: turnCard}},
+		{"", turnCommand{Kind: turnCard}},
+	}
+	for _, tt := range tests {
+		if got := parseTurnCommand(tt.input); got != tt.want {
+			t.Errorf("parseTurnCommand(%q) = %+v, want %+v", tt.input, got, tt.want)
+		}
+	}
+}
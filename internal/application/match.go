@@ -0,0 +1,226 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// Match is the state of a best-of-N series: games played back to back by the same players,
+// each from zero points. It carries over what the games do not: the games won and the points
+// scored by every player, and whose turn it is to deal first. Manual Mode keeps it in the save
+// codes, so a series can be resumed at any game.
+type Match struct {
+	ID     string `json:"id"`      // Logged with the MatchStart and MatchEnd events, and with every GameInMatch
+	BestOf int    `json:"best_of"` // Most games the series lasts
+	Played int    `json:"played"`  // Games finished so far
+	// Wins maps player IDs to the games they won; every winner of a shared win gets one.
+	Wins map[string]int `json:"wins"`
+	// Points maps player IDs to the sum of their final scores, which breaks ties on wins.
+	Points map[string]int `json:"points"`
+	// FirstDealer is the seat dealing the first round of game one; each game after passes
+	// the first deal to the next seat.
+	FirstDealer int `json:"first_dealer"`
+}
+
+// NewMatch returns a best-of-bestOf series whose first game is dealt first by seat firstDealer.
+func NewMatch(id string, bestOf, firstDealer int) *Match {
+	return &Match{
+		ID:          id,
+		BestOf:      bestOf,
+		Wins:        make(map[string]int),
+		Points:      make(map[string]int),
+		FirstDealer: firstDealer,
+	}
+}
+
+// Dealer returns the seat dealing the first round of the next game at a table of n players.
+func (m *Match) Dealer(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return (m.FirstDealer + m.Played) % n
+}
+
+// Record adds the result of the finished game g to the series.
+func (m *Match) Record(g *domain.Game) {
+	m.Played++
+	for _, w := range g.Winners {
+		m.Wins[w.ID.String()]++
+	}
+	for _, p := range g.Players {
+		m.Points[p.ID.String()] += p.TotalScore
+	}
+}
+
+// Over reports whether the series is decided: every game has been played, or the leader has
+// more wins than anyone else could reach in the games left.
+func (m *Match) Over() bool {
+	if m.Played >= m.BestOf {
+		return true
+	}
+	var first, second int
+	for _, wins := range m.Wins {
+		switch {
+		case wins > first:
+			first, second = wins, first
+		case wins > second:
+			second = wins
+		}
+	}
+	return first > second+m.BestOf-m.Played
+}
+
+// MatchStanding is a player's record in a series.
+type MatchStanding struct {
+	Player *domain.Player
+	Wins   int
+	Points int
+}
+
+// Standings ranks players by games won, then by points, keeping seat order between equals.
+func (m *Match) Standings(players []*domain.Player) []MatchStanding {
+	standings := make([]MatchStanding, len(players))
+	for i, p := range players {
+		id := p.ID.String()
+		standings[i] = MatchStanding{Player: p, Wins: m.Wins[id], Points: m.Points[id]}
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		if standings[i].Wins != standings[j].Wins {
+			return standings[i].Wins > standings[j].Wins
+		}
+		return standings[i].Points > standings[j].Points
+	})
+	return standings
+}
+
+// Winners returns the players leading the series: the most wins, then the most points.
+// Only players level on both share the lead.
+func (m *Match) Winners(players []*domain.Player) []*domain.Player {
+	standings := m.Standings(players)
+	var winners []*domain.Player
+	for _, s := range standings {
+		if s.Wins != standings[0].Wins || s.Points != standings[0].Points {
+			break
+		}
+		winners = append(winners, s.Player)
+	}
+	return winners
+}
+
+// NextGame returns the next game of the series after previous: the same players and rules,
+// every score back to zero, a fresh deck, and the first deal passed to the next seat.
+func (m *Match) NextGame(previous *domain.Game) *domain.Game {
+	for _, p := range previous.Players {
+		p.TotalScore = 0
+		p.CurrentHand = nil
+	}
+	g := domain.NewGame(previous.Players)
+	g.WinningScore = previous.WinningScore
+	g.TeamWinningScore = previous.TeamWinningScore
+	g.ExhaustionRule = previous.ExhaustionRule
+	g.HoldableActions = previous.HoldableActions
+	g.DealerIndex = m.Dealer(len(g.Players))
+	return g
+}
+
+// MatchStarted is published when a series begins between Players.
+type MatchStarted struct {
+	Match   *Match
+	Players []*domain.Player
+}
+
+// MatchGameFinished is published once game Number of a series, logged as GameID, has been
+// recorded in the Match.
+type MatchGameFinished struct {
+	Match   *Match
+	GameID  string
+	Number  int
+	Winners []*domain.Player
+}
+
+// MatchEnded is published once a series is decided, with its Winners among Players.
+type MatchEnded struct {
+	Match   *Match
+	Players []*domain.Player
+	Winners []*domain.Player
+}
+
+func (MatchStarted) EventName() string      { return "MatchStarted" }
+func (MatchGameFinished) EventName() string { return "MatchGameFinished" }
+func (MatchEnded) EventName() string        { return "MatchEnded" }
+
+// MatchService plays the games of a Match one after the other, in any mode: PlayGame plays
+// each game the way the mode does. After every game it prints the series scoreboard, and once
+// the series is decided its winner.
+type MatchService struct {
+	Match *Match
+	// PlayGame plays game to its end. It returns the ID the game is logged under, and whether
+	// the game finished: a game cut short (e.g. by the end of the input) stops the series
+	// without being counted.
+	PlayGame func(game *domain.Game) (gameID string, finished bool)
+	// Out receives the scoreboards; nil means os.Stdout.
+	Out io.Writer
+	// Messages is the language of the scoreboards; nil is English.
+	Messages *console.Messages
+	// Events receives the MatchStarted, MatchGameFinished and MatchEnded events; a LoggerSink
+	// logs them as MatchStart, GameInMatch and MatchEnd.
+	Events domain.EventBus
+}
+
+// Start announces the start of the series between players.
+func (s *MatchService) Start(players []*domain.Player) {
+	s.Events.Publish(MatchStarted{Match: s.Match, Players: players})
+}
+
+// Run plays game, which may be a game of the series already in progress, then the next games
+// until the series is decided. It returns the series winners, or nil if a game was cut short.
+func (s *MatchService) Run(game *domain.Game) []*domain.Player {
+	for {
+		number := s.Match.Played + 1
+		s.say(console.MsgMatchGame, console.Args{"game": number, "best_of": s.Match.BestOf})
+		gameID, finished := s.PlayGame(game)
+		if !finished {
+			s.say(console.MsgMatchStopped, console.Args{"game": number, "best_of": s.Match.BestOf})
+			return nil
+		}
+		s.Match.Record(game)
+		s.Events.Publish(MatchGameFinished{Match: s.Match, GameID: gameID, Number: number, Winners: game.Winners})
+		fmt.Fprint(s.out(), s.Messages.MatchScoreboard(number, s.Match.BestOf, s.standings(game.Players)))
+		if s.Match.Over() {
+			break
+		}
+		game = s.Match.NextGame(game)
+	}
+
+	winners := s.Match.Winners(game.Players)
+	s.Events.Publish(MatchEnded{Match: s.Match, Players: game.Players, Winners: winners})
+	s.say(console.MsgMatchWinner, console.Args{"names": strings.Join(getPlayerNames(winners), ", ")})
+	return winners
+}
+
+// standings returns the console rows of the scoreboard of players.
+func (s *MatchService) standings(players []*domain.Player) []console.MatchStanding {
+	standings := s.Match.Standings(players)
+	rows := make([]console.MatchStanding, len(standings))
+	for i, st := range standings {
+		rows[i] = console.MatchStanding{Name: st.Player.Name, Wins: st.Wins, Points: st.Points}
+	}
+	return rows
+}
+
+func (s *MatchService) out() io.Writer {
+	if s.Out == nil {
+		return os.Stdout
+	}
+	return s.Out
+}
+
+func (s *MatchService) say(id console.MessageID, args console.Args) {
+	fmt.Fprintln(s.out(), s.Messages.Format(id, args))
+}
@@ -0,0 +1,139 @@
+package application_test
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+func TestMatchService_AutomaticSeries(t *testing.T) {
+	p1 := domain.NewPlayer("P1", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	p2 := domain.NewPlayer("P2", &MockStrategy{DecideResult: domain.TurnChoiceStay})
+	first := domain.NewGame([]*domain.Player{p1, p2})
+	first.WinningScore = 1 // Every game is over after its first round
+
+	// Both players stay on their dealt card, so the higher one wins the game: the dealer's
+	// opponent, then the dealer, then the dealer's opponent again.
+	decks := [][]domain.Card{numbers(3, 9), numbers(4, 10), numbers(2, 8)}
+	var dealers []string
+	var out strings.Builder
+	series := &application.MatchService{
+		Match: application.NewMatch("match-1", 3, 0),
+		PlayGame: func(game *domain.Game) (string, bool) {
+			if game != first && (p1.TotalScore != 0 || p2.TotalScore != 0) {
+				t.Errorf("Expected game %d to start from zero, got %d and %d", len(dealers)+1, p1.TotalScore, p2.TotalScore)
+			}
+			dealers = append(dealers, game.Players[game.DealerIndex].Name)
+			svc := application.NewGameService(game)
+			svc.Silent = true
+			svc.UseFixedDeck(decks[len(dealers)-1])
+			svc.RunGame()
+			return game.ID.String(), game.IsCompleted
+		},
+		Out: &out,
+	}
+	series.Start(first.Players)
+	winners := series.Run(first)
+
+	if len(winners) != 1 || winners[0] != p2 {
+		t.Errorf("Expected P2 to win the series, got %v\n%s", playerNames(winners), out.String())
+	}
+	if got := strings.Join(dealers, ","); got != "P1,P2,P1" {
+		t.Errorf("Expected the first deal to rotate P1,P2,P1, got %s", got)
+	}
+	m := series.Match
+	if m.Played != 3 || m.Wins[p2.ID.String()] != 2 || m.Wins[p1.ID.String()] != 1 {
+		t.Errorf("Expected 3 games, 2 won by P2 and 1 by P1, got %+v", m)
+	}
+	if m.Points[p1.ID.String()] != 3+10+2 || m.Points[p2.ID.String()] != 9+4+8 {
+		t.Errorf("Expected the final scores added up, got %v", m.Points)
+	}
+	for _, want := range []string{"Series after game 3 (best of 3)", " - P2: 2 win(s), 21 points in all", "Series winner(s): P2"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected %q in the output\n%s", want, out.String())
+		}
+	}
+}
+
+func TestMatch_OverAndTiebreak(t *testing.T) {
+	a := domain.NewPlayer("A", nil)
+	b := domain.NewPlayer("B", nil)
+	players := []*domain.Player{a, b}
+	finish := func(m *application.Match, scoreA, scoreB int, winners ...*domain.Player) {
+		g := domain.NewGame(players)
+		a.TotalScore, b.TotalScore = scoreA, scoreB
+		g.Winners = winners
+		m.Record(g)
+	}
+
+	// A best of 3 is decided once a player has won twice.
+	clinched := application.NewMatch("m", 3, 1)
+	finish(clinched, 210, 150, a)
+	if clinched.Over() {
+		t.Error("Expected one win of three not to decide the series")
+	}
+	finish(clinched, 205, 190, a)
+	if !clinched.Over() {
+		t.Error("Expected two wins of three to decide the series")
+	}
+	if next := clinched.NextGame(domain.NewGame(players)); next.DealerIndex != 1 {
+		t.Errorf("Expected the third game to be dealt by seat 1 again, got %d", next.DealerIndex)
+	}
+
+	// A shared win counts for both; level on wins, the most points takes the series.
+	level := application.NewMatch("m", 3, 0)
+	finish(level, 200, 200, a, b)
+	finish(level, 150, 240, b)
+	finish(level, 220, 110, a)
+	if !level.Over() || level.Wins[a.ID.String()] != 2 || level.Wins[b.ID.String()] != 2 {
+		t.Fatalf("Expected a series over at two wins each, got %+v", level)
+	}
+	if winners := level.Winners(players); len(winners) != 1 || winners[0] != a {
+		t.Errorf("Expected A to win on points (570 to 550), got %v", playerNames(winners))
+	}
+	level.Points[b.ID.String()] = level.Points[a.ID.String()]
+	if winners := level.Winners(players); len(winners) != 2 {
+		t.Errorf("Expected players level on wins and points to share the series, got %v", playerNames(winners))
+	}
+}
+
+func TestManualMode_MatchSeries(t *testing.T) {
+	input := strings.Join([]string{
+		"",                 // No resume
+		"2",                // Players
+		"Bot",              // Player 2 name
+		"1",                // Me deals first
+		"1",                // Winning score: every game is over after its first round
+		"5", "9", "S", "S", // Game 1: Bot wins
+		"4", "10", "S", "S", // Game 2, dealt by Bot: Me wins
+	}, "\n") + "\n" // The input ends in game 3
+	logger := &recordingLogger{}
+	var out strings.Builder
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), logger)
+	service.Out = &out
+	service.MatchGames = 3
+	service.Run()
+
+	m := service.Match
+	if m == nil || m.Played != 2 {
+		t.Fatalf("Expected two games of the series to be played, got %+v\n%s", m, out.String())
+	}
+	if !strings.HasPrefix(service.GameID, m.ID) || !strings.HasSuffix(service.GameID, "_game3") {
+		t.Errorf("Expected game 3 to be logged under the match, got %q", service.GameID)
+	}
+	for _, want := range []string{"--- New Round! Dealer: Bot ---", "Series after game 2 (best of 3)", "The series stopped during game 3 (best of 3)."} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected %q in the output\n%s", want, out.String())
+		}
+	}
+	counts := make(map[string]int)
+	for _, e := range logger.events {
+		counts[e.eventType]++
+	}
+	if counts["MatchStart"] != 1 || counts["GameStart"] != 3 || counts["GameInMatch"] != 2 || counts["MatchEnd"] != 0 {
+		t.Errorf("Expected a MatchStart, 3 GameStarts and 2 GameInMatch events, got %v", counts)
+	}
+}
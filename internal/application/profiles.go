@@ -0,0 +1,250 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// profileStoreVersion is the current format of the profiles file.
+const profileStoreVersion = 1
+
+// PlayerProfile is the lifetime record of one person over the Manual Mode games they played.
+type PlayerProfile struct {
+	Name         string    `json:"name"`
+	GamesPlayed  int       `json:"games_played"`
+	Wins         int       `json:"wins"`          // Games won, shared wins included
+	PointsScored int       `json:"points_scored"` // Sum of the final total scores
+	RoundsPlayed int       `json:"rounds_played"`
+	Busts        int       `json:"busts"`
+	Flip7s       int       `json:"flip7s"`
+	LastPlayed   time.Time `json:"last_played"`
+}
+
+// WinRate returns the share of games won, 0 before the first game.
+func (p *PlayerProfile) WinRate() float64 {
+	if p.GamesPlayed == 0 {
+		return 0
+	}
+	return float64(p.Wins) / float64(p.GamesPlayed)
+}
+
+// AverageScore returns the average final total score, 0 before the first game.
+func (p *PlayerProfile) AverageScore() float64 {
+	if p.GamesPlayed == 0 {
+		return 0
+	}
+	return float64(p.PointsScored) / float64(p.GamesPlayed)
+}
+
+// BustRate returns the share of rounds played that ended in a bust, 0 before the first round.
+func (p *PlayerProfile) BustRate() float64 {
+	if p.RoundsPlayed == 0 {
+		return 0
+	}
+	return float64(p.Busts) / float64(p.RoundsPlayed)
+}
+
+// ProfileStore keeps the PlayerProfiles of a group of people in a JSON file, so Manual Mode can
+// add up their stats over many game nights. Names are matched ignoring case and surrounding
+// spaces, so "alice " at the prompt is Alice's profile.
+type ProfileStore struct {
+	Path     string           `json:"-"` // File the store is loaded from and saved to
+	Version  int              `json:"version"`
+	Profiles []*PlayerProfile `json:"profiles"`
+}
+
+// DefaultProfilesPath returns where profiles are kept unless configured otherwise:
+// ~/.flip7/profiles.json.
+func DefaultProfilesPath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".flip7", "profiles.json"), nil
+}
+
+// LoadProfileStore reads the profiles kept at path. A missing file is an empty store, which
+// Save creates along with its directory.
+func LoadProfileStore(path string) (*ProfileStore, error) {
+	store := &ProfileStore{Path: path, Version: profileStoreVersion}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, store); err != nil {
+		return nil, fmt.Errorf("invalid profiles file %s: %w", path, err)
+	}
+	if store.Version != profileStoreVersion {
+		return nil, fmt.Errorf("unsupported profiles version %d in %s (this build reads version %d)", store.Version, path, profileStoreVersion)
+	}
+	return store, nil
+}
+
+// Save writes the store to Path atomically, creating its directory if needed.
+func (s *ProfileStore) Save() error {
+	s.Version = profileStoreVersion
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.Path), 0755); err != nil {
+		return err
+	}
+	return writeFileAtomic(s.Path, append(data, '\n'))
+}
+
+// Find returns the profile named name, ignoring case and surrounding spaces, or nil.
+func (s *ProfileStore) Find(name string) *PlayerProfile {
+	name = strings.TrimSpace(name)
+	for _, p := range s.Profiles {
+		if strings.EqualFold(p.Name, name) {
+			return p
+		}
+	}
+	return nil
+}
+
+// Add creates a profile named name, trimmed of surrounding spaces, and returns it. If a
+// profile matches the name already, that one is returned instead.
+func (s *ProfileStore) Add(name string) *PlayerProfile {
+	if p := s.Find(name); p != nil {
+		return p
+	}
+	p := &PlayerProfile{Name: strings.TrimSpace(name)}
+	s.Profiles = append(s.Profiles, p)
+	return p
+}
+
+// RecordGame adds a finished game to the profiles of its players, matched by name; players
+// without a profile are left out. stats gives the busts and Flip 7s, and may be nil. It
+// returns the profiles it updated, in seating order.
+func (s *ProfileStore) RecordGame(game *domain.Game, stats *domain.GameStats, at time.Time) []*PlayerProfile {
+	winners := make(map[*domain.Player]bool, len(game.Winners))
+	for _, w := range game.Winners {
+		winners[w] = true
+	}
+	var updated []*PlayerProfile
+	seen := make(map[*PlayerProfile]bool)
+	for _, p := range game.Players {
+		profile := s.Find(p.Name)
+		if profile == nil || seen[profile] {
+			continue // No profile, or two seats under one name: only the first one counts
+		}
+		seen[profile] = true
+
+		profile.GamesPlayed++
+		if winners[p] {
+			profile.Wins++
+		}
+		profile.PointsScored += p.TotalScore
+		profile.RoundsPlayed += game.RoundCount
+		if stats != nil {
+			if st, ok := stats.Players[p.ID.String()]; ok {
+				profile.Busts += st.Busts
+				profile.Flip7s += st.Flip7s
+			}
+		}
+		profile.LastPlayed = at
+		updated = append(updated, profile)
+	}
+	return updated
+}
+
+// Leaderboard returns the profiles by wins, then win rate, then average score, then name.
+func (s *ProfileStore) Leaderboard() []*PlayerProfile {
+	profiles := append([]*PlayerProfile(nil), s.Profiles...)
+	sort.SliceStable(profiles, func(i, j int) bool {
+		a, b := profiles[i], profiles[j]
+		switch {
+		case a.Wins != b.Wins:
+			return a.Wins > b.Wins
+		case a.WinRate() != b.WinRate():
+			return a.WinRate() > b.WinRate()
+		case a.AverageScore() != b.AverageScore():
+			return a.AverageScore() > b.AverageScore()
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+	return profiles
+}
+
+// LeaderboardTable shows the Leaderboard with each profile's lifetime stats.
+func (s *ProfileStore) LeaderboardTable() *console.Table {
+	table := console.NewTable()
+	table.AddHeader("Player", "Games", "Wins", "Win Rate", "Avg Score", "Bust Rate", "Flip 7s", "Last Played")
+	for _, p := range s.Leaderboard() {
+		last := "-"
+		if !p.LastPlayed.IsZero() {
+			last = p.LastPlayed.Format("2006-01-02")
+		}
+		table.AddRow(p.Name, p.GamesPlayed, p.Wins,
+			fmt.Sprintf("%.1f%%", p.WinRate()*100),
+			fmt.Sprintf("%.1f", p.AverageScore()),
+			fmt.Sprintf("%.1f%%", p.BustRate()*100),
+			p.Flip7s, last)
+	}
+	return table
+}
+
+// matchProfile looks name up in the Profiles of Manual Mode and returns the name to seat the
+// player under: the profile's own spelling when there is one. Otherwise it offers to create a
+// profile, saved at once; declined, the player is seated as typed and not tracked. It returns
+// false if the input ended.
+func (s *ManualGameService) matchProfile(name string) (string, bool) {
+	if profile := s.Profiles.Find(name); profile != nil {
+		s.say(console.MsgProfileFound, console.Args{"name": profile.Name, "games": profile.GamesPlayed, "wins": profile.Wins})
+		return profile.Name, true
+	}
+	s.ask(console.MsgCreateProfilePrompt, console.Args{"name": name})
+	answer, ok := s.readAnswer()
+	if !ok {
+		return "", false
+	}
+	if answer = strings.ToLower(answer); answer != "y" && answer != "yes" {
+		return name, true
+	}
+	profile := s.Profiles.Add(name)
+	if err := s.Profiles.Save(); err != nil {
+		s.say(console.MsgProfilesSaveFailed, console.Args{"path": s.Profiles.Path, "err": err})
+	} else {
+		s.say(console.MsgProfileCreated, console.Args{"name": profile.Name})
+	}
+	return profile.Name, true
+}
+
+// updateProfiles adds the game that just ended to the Profiles of its players and saves them.
+// A game stopped before it finished is not counted.
+func (s *ManualGameService) updateProfiles() {
+	if s.Profiles == nil {
+		return
+	}
+	if s.Game.EndReason == domain.GameEndReasonAborted {
+		s.say(console.MsgProfilesNotUpdated, nil)
+		return
+	}
+	updated := s.Profiles.RecordGame(s.Game, s.Stats, s.now())
+	if len(updated) == 0 {
+		return
+	}
+	if err := s.Profiles.Save(); err != nil {
+		s.say(console.MsgProfilesSaveFailed, console.Args{"path": s.Profiles.Path, "err": err})
+		return
+	}
+	names := make([]string, len(updated))
+	for i, p := range updated {
+		names[i] = p.Name
+	}
+	s.say(console.MsgProfilesUpdated, console.Args{"names": strings.Join(names, ", ")})
+}
@@ -0,0 +1,152 @@
+package application_test
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+)
+
+func TestProfileStore_FindIgnoresCaseAndSurroundingSpaces(t *testing.T) {
+	store := &application.ProfileStore{}
+	alice := store.Add("  Alice ")
+	if alice.Name != "Alice" {
+		t.Errorf("Expected the name to be trimmed, got %q", alice.Name)
+	}
+	for _, name := range []string{"Alice", "alice", "ALICE", "alice  ", "\tAlice"} {
+		if got := store.Find(name); got != alice {
+			t.Errorf("Find(%q) = %v, want Alice's profile", name, got)
+		}
+	}
+	for _, name := range []string{"Alicia", "Al ice", ""} {
+		if got := store.Find(name); got != nil {
+			t.Errorf("Find(%q) = %q, want no profile", name, got.Name)
+		}
+	}
+	if again := store.Add("aLiCe "); again != alice || len(store.Profiles) != 1 {
+		t.Errorf("Expected Add to return the existing profile, got %d profiles", len(store.Profiles))
+	}
+}
+
+func TestProfileStore_SaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "profiles.json")
+	store, err := application.LoadProfileStore(path)
+	if err != nil || len(store.Profiles) != 0 {
+		t.Fatalf("Expected a missing file to load as an empty store, got %v, %v", store, err)
+	}
+	store.Add("Alice").Wins = 3
+	store.Add("Bob")
+	if err := store.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := application.LoadProfileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alice := loaded.Find("alice"); alice == nil || alice.Wins != 3 || loaded.Find("bob") == nil {
+		t.Errorf("Expected Alice (3 wins) and Bob to be loaded, got %+v", loaded.Profiles)
+	}
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("Expected only the profiles file to be left, got %d files", len(entries))
+	}
+
+	if err := os.WriteFile(path, []byte("{not json"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := application.LoadProfileStore(path); err == nil {
+		t.Error("Expected an error for a corrupt profiles file")
+	}
+}
+
+// playProfileGame plays a scripted Manual Mode game with the profiles kept at path.
+func playProfileGame(t *testing.T, path string, answers ...string) string {
+	t.Helper()
+	store, err := application.LoadProfileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input := strings.Join(answers, "\n") + "\n"
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), nil)
+	service.Profiles = store
+	var out strings.Builder
+	service.Out = &out
+	service.Run()
+	return out.String()
+}
+
+func TestManualMode_ProfilesAddUpGames(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.json")
+
+	// Game 1, to 10 points: the user types "alice " and creates both profiles. Alice is dealt 5
+	// and hits 6, Bob is dealt 7 and busts on another 7, and Alice stays on 11 and wins.
+	out := playProfileGame(t, path, "", "2", "alice ", "y", "Bob", "y", "1", "10", "5", "7", "6", "7", "S")
+	for _, want := range []string{"Created a profile for alice.", "Created a profile for Bob.", "Profiles updated: alice, Bob."} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Game 1: expected %q in the output:\n%s", want, out)
+		}
+	}
+
+	// Game 2: "ALICE" and "bob" find the profiles. Bob deals: Bob is dealt 12 and Alice 3,
+	// Bob stays on 12 and wins when Alice busts on another 3.
+	out = playProfileGame(t, path, "", "2", "ALICE", "bob", "2", "10", "12", "3", "S", "3")
+	for _, want := range []string{"Welcome back, alice! 1 games played, 1 won.", "Welcome back, Bob! 1 games played, 0 won."} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Game 2: expected %q in the output:\n%s", want, out)
+		}
+	}
+
+	// A third game that runs out of input counts for nobody.
+	out = playProfileGame(t, path, "", "2", "Alice", "Bob", "1", "10", "5")
+	if !strings.Contains(out, "The game did not finish, so the profiles are not updated.") {
+		t.Errorf("Game 3: expected the profiles not to be updated:\n%s", out)
+	}
+
+	store, err := application.LoadProfileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(store.Profiles) != 2 {
+		t.Fatalf("Expected 2 profiles, got %+v", store.Profiles)
+	}
+	for _, want := range []application.PlayerProfile{
+		{Name: "alice", GamesPlayed: 2, Wins: 1, PointsScored: 11, RoundsPlayed: 2, Busts: 1},
+		{Name: "Bob", GamesPlayed: 2, Wins: 1, PointsScored: 12, RoundsPlayed: 2, Busts: 1},
+	} {
+		got := store.Find(want.Name)
+		if got == nil {
+			t.Errorf("Expected a profile for %s", want.Name)
+			continue
+		}
+		if got.LastPlayed.IsZero() {
+			t.Errorf("Expected %s's last game to be dated", want.Name)
+		}
+		got.LastPlayed = want.LastPlayed
+		if *got != want {
+			t.Errorf("Expected %+v, got %+v", want, *got)
+		}
+	}
+	if got := store.Find("Bob").BustRate(); got != 0.5 {
+		t.Errorf("Expected Bob to bust in half his rounds, got %v", got)
+	}
+}
+
+func TestManualMode_DeclinedProfileIsNotTracked(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "profiles.json")
+
+	out := playProfileGame(t, path, "", "2", "", "Carol", "n", "1", "10", "5", "7", "6", "7", "S")
+
+	if !strings.Contains(out, "No profile named Carol. Create one? (y/N): ") {
+		t.Errorf("Expected to be offered a profile for Carol:\n%s", out)
+	}
+	if strings.Contains(out, "Profiles updated") {
+		t.Errorf("Expected no profile to be updated:\n%s", out)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected no profiles file to be written, got %v", err)
+	}
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// progressUpdates is about how many times a run reports its progress.
+const progressUpdates = 100
+
+// progressTracker counts the finished games of a run and reports them to SimulationService.Progress.
+// gameDone is safe to call from several goroutines: the count is atomic and reports are
+// serialized, so the callback sees done increase monotonically and always sees done == total last.
+type progressTracker struct {
+	// warnings is shared by the GameServices of the run's games (see SimulationService.Warnings).
+	warnings *StrategyWarnings
+	report   func(done, total int)
+	total    int
+	every    int
+	done     atomic.Int64
+	mu       sync.Mutex
+	reported int
+}
+
+// startProgress starts tracking a run of total games.
+func (s *SimulationService) startProgress(total int) *progressTracker {
+	every := total / progressUpdates
+	if every < 1 {
+		every = 1
+	}
+	warnings := s.Warnings
+	if warnings == nil {
+		warnings = NewStrategyWarnings(nil)
+	}
+	return &progressTracker{warnings: warnings, report: s.Progress, total: total, every: every}
+}
+
+// gameDone records a finished game and reports every few games and at the end.
+func (p *progressTracker) gameDone() {
+	if p.report == nil {
+		return
+	}
+	done := int(p.done.Add(1))
+	if done%p.every != 0 && done != p.total {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// A later game may have been reported first by another goroutine.
+	if done > p.reported {
+		p.reported = done
+		p.report(done, p.total)
+	}
+}
@@ -0,0 +1,362 @@
+package application
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+	"flip7_strategy/internal/infrastructure/logging"
+)
+
+// Divergence is a point where a logged outcome disagrees with what the rules produce.
+type Divergence struct {
+	Round    int    // RoundID of the record (0 for GameStart)
+	Turn     int    // TurnStart events seen in the round so far; 0 is the initial deal
+	Player   string // Player name, empty for game-level records
+	Event    string // Event type of the record, e.g. "Stay"
+	Field    string // What was compared, e.g. "banked_score"
+	Recorded string
+	Expected string
+}
+
+func (d Divergence) String() string {
+	who := d.Player
+	if who == "" {
+		who = "game"
+	}
+	return fmt.Sprintf("round %d, turn %d: %s %s %s: logged %s, rules give %s",
+		d.Round, d.Turn, who, d.Event, d.Field, d.Recorded, d.Expected)
+}
+
+// ReplayReport is the result of replaying one logged game.
+type ReplayReport struct {
+	GameID      string
+	Rounds      int // Rounds started in the log
+	Records     int // Records of this game that were replayed
+	Divergences []Divergence
+}
+
+// Consistent reports whether the log agrees with the rules everywhere.
+func (r *ReplayReport) Consistent() bool {
+	return len(r.Divergences) == 0
+}
+
+// ReplayGame re-applies the logged events of one game through the domain rules and reports
+// every recorded outcome the rules disagree with: banked and total scores on Stay, Frozen and
+// Flip7, busts that should or should not have happened, and the final scores in GameEnd.
+// An empty gameID selects the only game in the log.
+//
+// After a divergence the replay adopts the logged total, so a single bad entry is reported
+// once instead of at every later score. Moves taken back with Undo in manual mode are still
+// in the log and will show up as divergences.
+func ReplayGame(records []logging.LogRecord, gameID string) (*ReplayReport, error) {
+	if gameID == "" {
+		ids := loggedGameIDs(records)
+		if len(ids) != 1 {
+			return nil, fmt.Errorf("log contains %d games; choose one of %v", len(ids), ids)
+		}
+		gameID = ids[0]
+	}
+
+	replay := &gameReplay{
+		report:  &ReplayReport{GameID: gameID},
+		players: make(map[string]*domain.Player),
+		byName:  make(map[string]*domain.Player),
+	}
+	for _, r := range records {
+		if r.GameID != gameID {
+			continue
+		}
+		if !replay.started && r.EventType != "GameStart" {
+			continue
+		}
+		if err := replay.apply(r); err != nil {
+			return nil, err
+		}
+		replay.report.Records++
+	}
+	if !replay.started {
+		return nil, fmt.Errorf("game %s has no GameStart record in the log", gameID)
+	}
+	replay.checkPending(nil)
+	return replay.report, nil
+}
+
+func loggedGameIDs(records []logging.LogRecord) []string {
+	seen := make(map[string]bool)
+	var ids []string
+	for _, r := range records {
+		if r.EventType == "GameStart" && !seen[r.GameID] {
+			seen[r.GameID] = true
+			ids = append(ids, r.GameID)
+		}
+	}
+	return ids
+}
+
+// gameReplay maps logged events onto domain players and hands.
+type gameReplay struct {
+	report  *ReplayReport
+	started bool
+	players map[string]*domain.Player // Logged player ID -> player
+	byName  map[string]*domain.Player
+	round   int
+	turn    int
+
+	// A card that busts a hand or completes a Flip 7 must be followed by the matching record.
+	pending *pendingOutcome
+}
+
+type pendingOutcome struct {
+	player *domain.Player
+	event  string // "Bust" or "Flip7"
+	banked int    // Flip7 only
+	round  int
+	turn   int
+}
+
+func (g *gameReplay) apply(r logging.LogRecord) error {
+	g.checkPending(&r)
+
+	switch r.EventType {
+	case "GameStart":
+		names := detailStrings(r.Details, "players")
+		ids := detailStrings(r.Details, "player_ids")
+		if len(names) == 0 || len(names) != len(ids) {
+			return fmt.Errorf("GameStart of %s lists %d players and %d player IDs", r.GameID, len(names), len(ids))
+		}
+		for i, id := range ids {
+			p := domain.NewPlayer(names[i], nil)
+			p.StartNewRound()
+			g.players[id] = p
+			g.byName[names[i]] = p
+		}
+		g.started = true
+	case "RoundStart":
+		round, err := strconv.Atoi(r.RoundID)
+		if err != nil {
+			return fmt.Errorf("invalid round ID %q: %w", r.RoundID, err)
+		}
+		g.round = round
+		g.turn = 0
+		g.report.Rounds++
+		for _, p := range g.players {
+			p.StartNewRound()
+		}
+	case "TurnStart":
+		g.turn++
+		p, err := g.player(r)
+		if err != nil {
+			return err
+		}
+		g.compare(p, r, "score", p.TotalScore)
+	case "InitialDeal", "CardPlayed":
+		p, err := g.player(r)
+		if err != nil {
+			return err
+		}
+		card, err := domain.ParseCard(detailString(r.Details, "card"))
+		if err != nil {
+			return fmt.Errorf("round %d, %s: %w", g.round, r.EventType, err)
+		}
+		g.playCard(p, card, r.EventType)
+	case "ActionTarget":
+		// Freeze shows up as its own Frozen record; only a passed Second Chance changes a hand here.
+		if detailString(r.Details, "action") == string(domain.ActionGiveSecondChance) {
+			if target, ok := g.byName[detailString(r.Details, "target")]; ok {
+				target.CurrentHand.ReceiveSecondChance(domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance})
+			}
+		}
+	case "Stay", "Frozen":
+		p, err := g.player(r)
+		if err != nil {
+			return err
+		}
+		if p.CurrentHand.Status != domain.HandStatusActive {
+			g.diverge(p, r.EventType, "status", "active", string(p.CurrentHand.Status))
+		}
+		if r.EventType == "Stay" {
+			p.CurrentHand.Status = domain.HandStatusStayed
+		} else {
+			p.CurrentHand.Status = domain.HandStatusFrozen
+		}
+		g.compareBank(p, r, p.BankCurrentHand())
+	case "Bust":
+		p, err := g.player(r)
+		if err != nil {
+			return err
+		}
+		if g.pending != nil && g.pending.player == p && g.pending.event == "Bust" {
+			g.pending = nil
+			return nil
+		}
+		g.diverge(p, r.EventType, "outcome", "bust", "no bust with "+console.FormatHand(p.CurrentHand))
+		p.CurrentHand.Status = domain.HandStatusBusted
+	case "Flip7":
+		p, err := g.player(r)
+		if err != nil {
+			return err
+		}
+		if g.pending != nil && g.pending.player == p && g.pending.event == "Flip7" {
+			banked := g.pending.banked
+			g.pending = nil
+			g.compareBank(p, r, banked)
+			return nil
+		}
+		g.diverge(p, r.EventType, "outcome", "flip 7", "no flip 7 with "+console.FormatHand(p.CurrentHand))
+		p.CurrentHand.Status = domain.HandStatusStayed
+		if total, ok := detailInt(r.Details, "total_score"); ok {
+			p.TotalScore = total
+		}
+	case "GameEnd":
+		scores, _ := r.Details["scores"].(map[string]interface{})
+		names := make([]string, 0, len(scores))
+		for name := range scores {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			p, ok := g.byName[name]
+			if !ok {
+				g.diverge(nil, r.EventType, "scores", name, "no such player")
+				continue
+			}
+			if final, ok := toInt(scores[name]); ok && final != p.TotalScore {
+				g.diverge(p, r.EventType, "final_score", strconv.Itoa(final), strconv.Itoa(p.TotalScore))
+			}
+		}
+	}
+	return nil
+}
+
+// playCard applies a drawn card the way manual mode does. Action effects are logged as their
+// own records, so a Second Chance only needs to be placed in the hand, and Freeze and Flip
+// Three, which are discarded once resolved, not even that.
+func (g *gameReplay) playCard(p *domain.Player, card domain.Card, eventType string) {
+	hand := p.CurrentHand
+	if hand.Status != domain.HandStatusActive {
+		g.diverge(p, eventType, "card", card.String(), "no draw: hand is "+string(hand.Status))
+		return
+	}
+	hand.HasDrawnThisRound = true
+
+	if card.Type == domain.CardTypeAction {
+		// A second Second Chance is passed on (ActionTarget) or discarded, never kept.
+		if card.ActionType == domain.ActionSecondChance && !hand.HasSecondChance() {
+			hand.ActionCards = append(hand.ActionCards, card)
+		}
+		return
+	}
+
+	busted, flip7, _ := hand.AddCard(card)
+	switch {
+	case busted:
+		hand.Status = domain.HandStatusBusted
+		g.pending = &pendingOutcome{player: p, event: "Bust", round: g.round, turn: g.turn}
+	case flip7:
+		hand.Status = domain.HandStatusStayed
+		g.pending = &pendingOutcome{player: p, event: "Flip7", banked: p.BankCurrentHand(), round: g.round, turn: g.turn}
+	}
+}
+
+// checkPending reports a bust or Flip 7 that the rules produced but the log did not record
+// right after the card. next is the record being applied, or nil at the end of the log.
+func (g *gameReplay) checkPending(next *logging.LogRecord) {
+	if g.pending == nil {
+		return
+	}
+	if next != nil && next.EventType == g.pending.event && g.players[next.PlayerID] == g.pending.player {
+		return
+	}
+	g.report.Divergences = append(g.report.Divergences, Divergence{
+		Round:    g.pending.round,
+		Turn:     g.pending.turn,
+		Player:   g.pending.player.Name,
+		Event:    g.pending.event,
+		Field:    "outcome",
+		Recorded: "missing",
+		Expected: g.pending.event,
+	})
+	g.pending = nil
+}
+
+// compareBank checks banked_score and total_score against the rules, then adopts the logged total.
+func (g *gameReplay) compareBank(p *domain.Player, r logging.LogRecord, banked int) {
+	if recorded, ok := detailInt(r.Details, "banked_score"); ok && recorded != banked {
+		g.diverge(p, r.EventType, "banked_score", strconv.Itoa(recorded), strconv.Itoa(banked))
+	}
+	g.compare(p, r, "total_score", p.TotalScore)
+	if total, ok := detailInt(r.Details, "total_score"); ok {
+		p.TotalScore = total
+	}
+}
+
+func (g *gameReplay) compare(p *domain.Player, r logging.LogRecord, field string, expected int) {
+	if recorded, ok := detailInt(r.Details, field); ok && recorded != expected {
+		g.diverge(p, r.EventType, field, strconv.Itoa(recorded), strconv.Itoa(expected))
+	}
+}
+
+func (g *gameReplay) diverge(p *domain.Player, event, field, recorded, expected string) {
+	name := ""
+	if p != nil {
+		name = p.Name
+	}
+	g.report.Divergences = append(g.report.Divergences, Divergence{
+		Round:    g.round,
+		Turn:     g.turn,
+		Player:   name,
+		Event:    event,
+		Field:    field,
+		Recorded: recorded,
+		Expected: expected,
+	})
+}
+
+func (g *gameReplay) player(r logging.LogRecord) (*domain.Player, error) {
+	p, ok := g.players[r.PlayerID]
+	if !ok {
+		return nil, fmt.Errorf("round %d, %s: unknown player ID %q", g.round, r.EventType, r.PlayerID)
+	}
+	return p, nil
+}
+
+func detailString(details map[string]interface{}, key string) string {
+	if v, ok := details[key].(string); ok {
+		return v
+	}
+	return ""
+}
+
+func detailInt(details map[string]interface{}, key string) (int, bool) {
+	return toInt(details[key])
+}
+
+// toInt accepts numbers decoded from JSON (float64) as well as ints from in-memory events.
+func toInt(v interface{}) (int, bool) {
+	switch n := v.(type) {
+	case float64:
+		return int(n), true
+	case int:
+		return n, true
+	}
+	return 0, false
+}
+
+func detailStrings(details map[string]interface{}, key string) []string {
+	switch values := details[key].(type) {
+	case []string:
+		return values
+	case []interface{}:
+		result := make([]string, 0, len(values))
+		for _, v := range values {
+			if s, ok := v.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package application_test
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/infrastructure/logging"
+)
+
+func readLogFile(t *testing.T, path string) []logging.LogRecord {
+	t.Helper()
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Failed to open log: %v", err)
+	}
+	defer file.Close()
+
+	records, err := logging.ReadLogRecords(file, nil)
+	if err != nil {
+		t.Fatalf("Failed to read log: %v", err)
+	}
+	return records
+}
+
+func TestReplayGame_FlagsLoggedDiscrepancy(t *testing.T) {
+	records := readLogFile(t, filepath.Join("testdata", "replay", "divergent_game.csv"))
+
+	report, err := application.ReplayGame(records, "game_fixture")
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+
+	// Round 2, Alice's third turn: 12 + plus_4 banks 16, but the log says 18 (total 30 instead of 28).
+	// The replay adopts the logged total afterwards, so GameEnd is not flagged again.
+	expected := []application.Divergence{
+		{Round: 2, Turn: 3, Player: "Alice", Event: "Stay", Field: "banked_score", Recorded: "18", Expected: "16"},
+		{Round: 2, Turn: 3, Player: "Alice", Event: "Stay", Field: "total_score", Recorded: "30", Expected: "28"},
+	}
+	if !reflect.DeepEqual(report.Divergences, expected) {
+		t.Errorf("Expected divergences %+v, got %+v", expected, report.Divergences)
+	}
+	if report.Rounds != 2 {
+		t.Errorf("Expected 2 rounds, got %d", report.Rounds)
+	}
+}
+
+func TestReplayGame_ManualModeLogIsConsistent(t *testing.T) {
+	input := strings.Join([]string{
+		"",    // No resume
+		"2",   // Players
+		"Bot", // Player 2 name
+		"1",   // Me deals first
+		"",    // Default winning score
+		"C",   // Initial deal: Me is dealt Second Chance
+		"C",   // Bot is dealt Second Chance
+		"C",   // Me draws a third one, which is discarded
+		"8",   // Bot hits 8
+		"5",   // Me hits 5
+		"8",   // Bot hits 8 again: Second Chance absorbs it
+		"5",   // Me hits 5 again: Second Chance absorbs it
+		"8",   // Bot busts on a third 8
+		"F",   // Me draws Freeze...
+		"1",   // ...and freezes themselves, banking 5; the round ends
+		"12",  // Round 2 initial deal: Bot is dealt 12
+		"12",  // Me is dealt 12
+		"S",   // Bot stays with 12
+		"12",  // Me busts
+	}, "\n") + "\n"
+
+	logPath := filepath.Join(t.TempDir(), "game.csv")
+	logger, err := logging.NewCSVLogger(logPath)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	service := application.NewManualGameService(bufio.NewReader(strings.NewReader(input)), logger)
+	service.Run()
+	logger.Close()
+
+	report, err := application.ReplayGame(readLogFile(t, logPath), "")
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if !report.Consistent() {
+		t.Errorf("Expected a log written by manual mode to replay cleanly, got %v", report.Divergences)
+	}
+	// Round 3 starts before the input runs out
+	if report.Rounds != 3 {
+		t.Errorf("Expected 3 rounds, got %d", report.Rounds)
+	}
+}
+
+func TestReplayGame_UnknownGame(t *testing.T) {
+	records := readLogFile(t, filepath.Join("testdata", "replay", "divergent_game.csv"))
+
+	if _, err := application.ReplayGame(records, "game_missing"); err == nil {
+		t.Error("Expected an error for a game that is not in the log")
+	}
+}
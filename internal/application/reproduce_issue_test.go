@@ -21,31 +21,30 @@ func TestReproduceDeckExhaustion(t *testing.T) {
 	// "2" (Num players)
 	// "Player2" (Name of P2)
 	// "1" (Start player Me)
+	// "" (Default winning score)
 	//
 	// Round 1 (Me is Dealer/First):
-	// "4" (Me)
-	// "4" (Me)
-	// "4" (Me)
-	// "4" (Me) -> All 4s gone.
-	// "S" (Me Stay)
-	// "S" (Player 2 Stay) -> Round 1 Ends.
+	// "4" (Initial deal: Me)
+	// "4" (Initial deal: P2)
+	// "4" (Me hits -> Bust)
+	// "4" (P2 hits -> Bust) -> All 4s gone, Round 1 Ends.
 	//
 	// Round 2 (Player 2 is Dealer/First):
-	// "4" (Try to draw 4. Should fail if deck persists.)
+	// "4" (Initial deal for P2: try to draw 4. Should fail if deck persists.)
 	// "5" (Fallback)
 	inputLines := []string{
 		"",        // No resume
 		"2",       // 2 players
 		"Player2", // P2 Name
 		"1",       // Start with Me
+		"",        // Default winning score
 		// Round 1
-		"4", "4", "4", "4", // Me takes all 4s
-		"S", // Me Stay
-		"5", // P2 draws 5 (Valid hit)
-		"S", // P2 Stay
+		"4", "4", // Initial deal
+		"4", "4", // Both hit a duplicate 4 and bust
 		// Round 2
-		"4", // P2 tries 4.
-		"6", // P2 tries 6 (Fallback).
+		"4", // P2 is dealt 4 (rejected).
+		"5", // P2 is dealt 5 (Fallback).
+		"6", // Me is dealt 6
 		"S", // P2 Stay
 		"S", // Me Stay
 	}
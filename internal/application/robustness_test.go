@@ -0,0 +1,170 @@
+package application_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/testutil"
+)
+
+// faultInjector is a TurnInterceptor that breaks the game in ways normal play rarely or never
+// does: it empties the deck and the discard pile before a Flip Three card is drawn, shuffles
+// the deck before decisions and busts an action's target between choosing and resolving it.
+// It checks the game's invariants before every decision.
+type faultInjector struct {
+	rng    *rand.Rand
+	stash  []domain.Card // Cards taken out to empty the deck and discard pile
+	points map[application.InterceptPoint]int
+	err    error // The first broken invariant
+}
+
+func (f *faultInjector) Intercept(game *domain.Game, at application.Interception) {
+	f.points[at.Point]++
+	switch at.Point {
+	case application.InterceptBeforeDecision:
+		if f.err == nil {
+			if err := game.Invariants(); err != nil {
+				f.err = fmt.Errorf("round %d, before %s decides: %w", game.RoundCount, at.Player.Name, err)
+			}
+		}
+		if f.rng.Intn(10) == 0 {
+			cards := at.Round.Deck.Cards
+			f.rng.Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+		}
+	case application.InterceptBeforeDraw:
+		if at.Source == domain.DrawFlipThree && f.rng.Intn(20) == 0 {
+			f.stash = append(f.stash, at.Round.Deck.Cards...)
+			f.stash = append(f.stash, game.DiscardPile...)
+			at.Round.Deck.Cards = nil
+			game.DiscardPile = nil
+		}
+	case application.InterceptTargetChosen:
+		if f.rng.Intn(8) == 0 && at.Player.CurrentHand.Status == domain.HandStatusActive {
+			at.Player.CurrentHand.Status = domain.HandStatusBusted
+			at.Round.RemoveActivePlayer(at.Player)
+		}
+	}
+}
+
+// restore puts the stashed cards back on the discard pile, where the invariants can count them.
+func (f *faultInjector) restore(game *domain.Game) {
+	game.DiscardPile = append(game.DiscardPile, f.stash...)
+	f.stash = nil
+}
+
+// erraticStrategy wraps a real strategy but sometimes answers with a choice that is neither hit
+// nor stay, no target, or a target that was not a candidate.
+type erraticStrategy struct {
+	domain.Strategy
+	rng      *rand.Rand
+	stranger *domain.Player
+}
+
+func (s *erraticStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, score int, others []*domain.Player) domain.TurnChoice {
+	if s.rng.Intn(10) == 0 {
+		return domain.TurnChoice("dance")
+	}
+	return s.Strategy.Decide(deck, hand, score, others)
+}
+
+func (s *erraticStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	switch s.rng.Intn(6) {
+	case 0:
+		return nil
+	case 1:
+		return s.stranger
+	}
+	return s.Strategy.ChooseTarget(action, candidates, self)
+}
+
+func TestGameService_SurvivesInjectedFaults(t *testing.T) {
+	stranger := domain.NewPlayer("Stranger", strategy.NewCautiousStrategy())
+	stranger.StartNewRound()
+	points := make(map[application.InterceptPoint]int)
+	exhausted := 0
+	for seed := int64(0); seed < 200; seed++ {
+		rng := rand.New(rand.NewSource(seed))
+		players := []*domain.Player{
+			domain.NewPlayer("Cautious", strategy.NewCautiousStrategy()),
+			domain.NewPlayer("Aggressive", &erraticStrategy{Strategy: strategy.NewAggressiveStrategy(), rng: rng, stranger: stranger}),
+			domain.NewPlayer("Adaptive", strategy.NewAdaptiveStrategy()),
+		}
+		if seed%2 == 1 {
+			players = append(players, domain.NewPlayer("Probabilistic", &erraticStrategy{Strategy: strategy.NewProbabilisticStrategy(), rng: rng, stranger: stranger}))
+		}
+		game := domain.NewGame(players)
+		injector := &faultInjector{rng: rng, points: points}
+		svc := application.NewGameService(game)
+		svc.Silent = true
+		svc.MaxRounds = 30
+		svc.Interceptor = injector
+
+		svc.RunGame()
+
+		if injector.err != nil {
+			t.Fatalf("Seed %d: %v", seed, injector.err)
+		}
+		if !game.IsCompleted {
+			t.Fatalf("Seed %d: expected the game to end, it stopped after round %d", seed, game.RoundCount)
+		}
+		if game.EndReason == domain.GameEndReasonExhausted {
+			exhausted++
+		}
+		injector.restore(game)
+		if err := game.Invariants(); err != nil {
+			t.Fatalf("Seed %d: game ended %q: %v", seed, game.EndReason, err)
+		}
+	}
+
+	for _, point := range []application.InterceptPoint{
+		application.InterceptBeforeDecision, application.InterceptAfterDecision,
+		application.InterceptBeforeDraw, application.InterceptAfterDraw, application.InterceptTargetChosen,
+	} {
+		if points[point] == 0 {
+			t.Errorf("Expected the interceptor to be called %s", point)
+		}
+	}
+	if exhausted == 0 {
+		t.Errorf("Expected some games to end with an emptied deck")
+	}
+}
+
+func TestGameService_ResumesRandomStates(t *testing.T) {
+	// Any legal mid-round state, e.g. one loaded from a save code, plays on to the end of the
+	// game without breaking an invariant.
+	for seed := int64(0); seed < 200; seed++ {
+		rng := rand.New(rand.NewSource(seed))
+		game := testutil.RandomGame(rng)
+		for i, p := range game.Players {
+			if i%2 == 0 {
+				p.Strategy = strategy.NewAdaptiveStrategy()
+			} else {
+				p.Strategy = &erraticStrategy{Strategy: strategy.NewProbabilisticStrategy(), rng: rng}
+			}
+		}
+		injector := &faultInjector{rng: rng, points: make(map[application.InterceptPoint]int)}
+		svc := application.NewGameService(game)
+		svc.Silent = true
+		svc.MaxRounds = game.RoundCount + 30
+		svc.Interceptor = injector
+
+		if err := svc.ResumeGame(); err != nil {
+			t.Fatalf("Seed %d: %v", seed, err)
+		}
+
+		if injector.err != nil {
+			t.Fatalf("Seed %d: %v", seed, injector.err)
+		}
+		if !game.IsCompleted {
+			t.Fatalf("Seed %d: expected the game to end, it stopped after round %d", seed, game.RoundCount)
+		}
+		injector.restore(game)
+		if err := game.Invariants(); err != nil {
+			t.Fatalf("Seed %d: game ended %q: %v", seed, game.EndReason, err)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package application
+
+import (
+	"fmt"
+
+	"flip7_strategy/internal/domain"
+)
+
+// maxTablePlayers is the most players a game can seat: far more than any real table, but it
+// bounds what a save code can ask the loader to build.
+const maxTablePlayers = 50
+
+// maxSaveCodeSize bounds a decoded save code. A real one is a few kilobytes; anything near
+// this size is not a game state.
+const maxSaveCodeSize = 1 << 20
+
+// validateSave checks the structure of a decoded save before any of it is used: every index
+// must be in range, every player reference must resolve to one of Game.Players, and the cards
+// must fit in the standard deck. While a round is in progress every player must hold a hand.
+// Save codes are user input, so a corrupted or hand-edited one
+// must fail to load instead of panicking later in the game.
+func validateSave(w *gameStateWrapper) error {
+	g := w.Game
+	if len(g.Players) > maxTablePlayers {
+		return fmt.Errorf("%d players (at most %d can play)", len(g.Players), maxTablePlayers)
+	}
+	if g.RoundCount < 0 {
+		return fmt.Errorf("negative round count %d", g.RoundCount)
+	}
+	if g.WinningScore < 0 {
+		return fmt.Errorf("negative winning score %d", g.WinningScore)
+	}
+	if g.DealerIndex < 0 || g.DealerIndex >= len(g.Players) {
+		return fmt.Errorf("dealer index %d is outside the %d players", g.DealerIndex, len(g.Players))
+	}
+
+	copies := standardCopies()
+	players := make(map[string]bool, len(g.Players))
+	for i, p := range g.Players {
+		if p == nil {
+			return fmt.Errorf("player %d is missing", i+1)
+		}
+		id := p.ID.String()
+		if players[id] {
+			return fmt.Errorf("player ID %s is used twice", id)
+		}
+		players[id] = true
+		if g.CurrentRound != nil && p.CurrentHand == nil {
+			return fmt.Errorf("%s has no hand during a round", p.Name)
+		}
+		if err := validateHand(p.CurrentHand, copies); err != nil {
+			return fmt.Errorf("%s: %w", p.Name, err)
+		}
+	}
+	resolve := func(what string, p *domain.Player) error {
+		if p == nil {
+			return fmt.Errorf("%s is missing", what)
+		}
+		if !players[p.ID.String()] {
+			return fmt.Errorf("%s %s is not one of the players", what, p.ID)
+		}
+		return nil
+	}
+	// resolveWithHand is resolve for a player of the round in progress, who must hold a hand.
+	resolveWithHand := func(what string, p *domain.Player) error {
+		if err := resolve(what, p); err != nil {
+			return err
+		}
+		if p.CurrentHand == nil {
+			return fmt.Errorf("%s %s has no hand", what, p.Name)
+		}
+		return nil
+	}
+
+	if r := g.CurrentRound; r != nil {
+		if err := resolve("round dealer", r.Dealer); err != nil {
+			return err
+		}
+		for _, p := range r.Players {
+			if err := resolveWithHand("round player", p); err != nil {
+				return err
+			}
+		}
+		for _, p := range r.ActivePlayers {
+			if err := resolveWithHand("active player", p); err != nil {
+				return err
+			}
+		}
+		if r.CurrentTurnIndex < 0 || r.CurrentTurnIndex > len(r.ActivePlayers) {
+			return fmt.Errorf("turn index %d is outside the %d active players", r.CurrentTurnIndex, len(r.ActivePlayers))
+		}
+	}
+	for _, p := range g.Winners {
+		if err := resolve("winner", p); err != nil {
+			return err
+		}
+	}
+	if d := w.InitialDeal; d != nil {
+		for _, id := range d.Order {
+			if !players[id] {
+				return fmt.Errorf("player %s in the initial deal is not one of the players", id)
+			}
+		}
+		if d.Next < 0 || d.Next > len(d.Order) {
+			return fmt.Errorf("initial deal position %d is outside the %d players being dealt", d.Next, len(d.Order))
+		}
+	}
+	for id, seat := range w.AppSeats {
+		if !players[id] {
+			return fmt.Errorf("app seat %s is not one of the players", id)
+		}
+		switch {
+		case seat.Kind == SeatAI && seat.Strategy == "":
+			return fmt.Errorf("app seat %s names no strategy", id)
+		case seat.Kind != SeatAI && seat.Kind != SeatAppHuman:
+			return fmt.Errorf("app seat %s has unknown kind %q", id, seat.Kind)
+		}
+	}
+	return validateSaveCards(g, copies)
+}
+
+// validateHand checks that a hand holds only real cards and that its set of numbers matches them.
+func validateHand(h *domain.PlayerHand, copies map[domain.Card]int) error {
+	if h == nil {
+		return nil // Before the first round (validateSave requires one during a round)
+	}
+	numbers := make(map[domain.NumberValue]bool, len(h.RawNumberCards))
+	for _, v := range h.RawNumberCards {
+		if copies[domain.Card{Type: domain.CardTypeNumber, Value: v}] == 0 {
+			return fmt.Errorf("unknown number card %d", v)
+		}
+		numbers[v] = true
+	}
+	if len(h.NumberCards) != len(numbers) {
+		return fmt.Errorf("hand number set %v does not match its cards %v", h.NumberCards, h.RawNumberCards)
+	}
+	for v := range h.NumberCards {
+		if !numbers[v] {
+			return fmt.Errorf("hand number set %v does not match its cards %v", h.NumberCards, h.RawNumberCards)
+		}
+	}
+	for _, c := range h.ModifierCards {
+		if c.Type != domain.CardTypeModifier {
+			return fmt.Errorf("%s is among the modifier cards", c)
+		}
+		if copies[c] == 0 {
+			return fmt.Errorf("unknown card %+v", c)
+		}
+	}
+	for _, c := range h.ActionCards {
+		if c.Type != domain.CardTypeAction {
+			return fmt.Errorf("%s is among the action cards", c)
+		}
+		if copies[c] == 0 {
+			return fmt.Errorf("unknown card %+v", c)
+		}
+	}
+	return nil
+}
+
+// standardCopies counts the copies of each card in the standard deck.
+func standardCopies() map[domain.Card]int {
+	copies := make(map[domain.Card]int)
+	for _, c := range domain.StandardDeckCards() {
+		copies[c]++
+	}
+	return copies
+}
+
+// validateSaveCards checks that the deck, the discard pile and the hands together hold no
+// more copies of any card than the standard deck has, and that the deck's remaining number
+// counts are within the same bounds.
+func validateSaveCards(g *domain.Game, copies map[domain.Card]int) error {
+	counts := make(map[domain.Card]int)
+	add := func(where string, cards []domain.Card) error {
+		if len(cards) > len(domain.StandardDeckCards()) { // Stops a padded deck before counting it
+			return fmt.Errorf("%s has %d cards, more than the whole deck", where, len(cards))
+		}
+		for _, c := range cards {
+			if copies[c] == 0 {
+				return fmt.Errorf("unknown card %+v in the %s", c, where)
+			}
+			counts[c]++
+		}
+		return nil
+	}
+
+	if g.Deck != nil {
+		if err := add("deck", g.Deck.Cards); err != nil {
+			return err
+		}
+		for v, n := range g.Deck.RemainingCounts {
+			limit := copies[domain.Card{Type: domain.CardTypeNumber, Value: v}]
+			if limit == 0 {
+				return fmt.Errorf("deck counts unknown number %d", v)
+			}
+			if n < 0 || n > limit {
+				return fmt.Errorf("deck counts %d copies of %d (the deck has %d)", n, v, limit)
+			}
+		}
+	}
+	if err := add("discard pile", g.DiscardPile); err != nil {
+		return err
+	}
+	for _, p := range g.Players {
+		h := p.CurrentHand
+		if h == nil {
+			continue
+		}
+		for _, v := range h.RawNumberCards {
+			counts[domain.Card{Type: domain.CardTypeNumber, Value: v}]++
+		}
+		for _, c := range h.ModifierCards {
+			counts[c]++
+		}
+		for _, c := range h.ActionCards {
+			counts[c]++
+		}
+	}
+	for c, n := range counts {
+		if n > copies[c] {
+			return fmt.Errorf("%d copies of %s in play (the deck has %d)", n, c, copies[c])
+		}
+	}
+	return nil
+}
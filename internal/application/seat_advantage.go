@@ -0,0 +1,154 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+	"flip7_strategy/internal/stats"
+)
+
+// seatAdvantageStrategy is the strategy played in every seat of the seat advantage analysis.
+const seatAdvantageStrategy = "Adaptive"
+
+// SeatStats is the record of one position at the table across games.
+type SeatStats struct {
+	Games  int
+	Wins   float64 // A game won by k players on equal scores counts 1/k
+	scores []float64
+}
+
+func (st *SeatStats) record(score int, win float64) {
+	st.Games++
+	st.Wins += win
+	st.scores = append(st.scores, float64(score))
+}
+
+// WinRate returns the share of games won from this position.
+func (st SeatStats) WinRate() float64 {
+	if st.Games == 0 {
+		return 0
+	}
+	return st.Wins / float64(st.Games)
+}
+
+// AvgScore returns the average final score from this position.
+func (st SeatStats) AvgScore() float64 {
+	if st.Games == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range st.scores {
+		sum += v
+	}
+	return sum / float64(st.Games)
+}
+
+// SeatAdvantageResult is the outcome of RunSeatAdvantageAnalysis.
+type SeatAdvantageResult struct {
+	Strategy string // Played in every seat
+	Games    int
+	// BySeat holds Seat1..SeatN by seat index.
+	BySeat []SeatStats
+	// ByTurnOrder holds the seats by their place in the turn order of the first round:
+	// [0] is the seat that dealt first (and so flips first), [1] the next one, and so on.
+	ByTurnOrder []SeatStats
+	// FirstDealer counts the games each seat dealt first, by seat index.
+	FirstDealer []int
+}
+
+// RunSeatAdvantageAnalysis measures how much the seat and the first deal are worth. It plays
+// n games of playerCount players who all play the same strategy, so any difference between
+// them comes from their position. Players are Seat1..SeatN, and the first dealer rotates
+// through the seats (game i is dealt first by seat i mod playerCount).
+// It prints the win rate and average score by seat and by place in the first round's turn
+// order, with their 95% confidence margins.
+func (s *SimulationService) RunSeatAdvantageAnalysis(n int, playerCount int) (SeatAdvantageResult, error) {
+	if playerCount < 2 {
+		return SeatAdvantageResult{}, errors.New("a seat advantage analysis needs at least 2 players")
+	}
+	fmt.Printf("Running Seat Advantage Analysis (%d games, %d %s players)...\n", n, playerCount, seatAdvantageStrategy)
+
+	result := playSeatAdvantage(n, playerCount, s.startProgress(n))
+
+	fmt.Println("\n--- By Seat ---")
+	s.printTable(seatTable(result.BySeat, "Seat", func(i int) string { return fmt.Sprintf("Seat%d", i+1) }, result.FirstDealer))
+	fmt.Println("\n--- By Turn Order (first round) ---")
+	s.printTable(seatTable(result.ByTurnOrder, "Order", turnOrderLabel, nil))
+	return result, nil
+}
+
+// playSeatAdvantage plays the games of RunSeatAdvantageAnalysis.
+func playSeatAdvantage(n int, playerCount int, progress *progressTracker) SeatAdvantageResult {
+	result := SeatAdvantageResult{
+		Strategy:    seatAdvantageStrategy,
+		Games:       n,
+		BySeat:      make([]SeatStats, playerCount),
+		ByTurnOrder: make([]SeatStats, playerCount),
+		FirstDealer: make([]int, playerCount),
+	}
+	for i := 0; i < n; i++ {
+		players := make([]*domain.Player, playerCount)
+		for seat := range players {
+			strat, _ := strategy.New(seatAdvantageStrategy) // A registered name
+			players[seat] = domain.NewPlayer(fmt.Sprintf("Seat%d", seat+1), strat)
+		}
+		dealer := i % playerCount
+
+		game := domain.NewGame(players)
+		game.DealerIndex = dealer
+		svc := NewGameService(game)
+		svc.Silent = true
+		svc.Warnings = progress.warnings
+		svc.RunGame()
+		progress.gameDone()
+
+		result.FirstDealer[dealer]++
+		for seat, p := range players {
+			win := 0.0
+			if containsPlayer(game.Winners, p) {
+				win = 1.0 / float64(len(game.Winners))
+			}
+			result.BySeat[seat].record(p.TotalScore, win)
+			result.ByTurnOrder[(seat-dealer+playerCount)%playerCount].record(p.TotalScore, win)
+		}
+	}
+	return result
+}
+
+// turnOrderLabel names the i-th place in the turn order, e.g. "1 (dealer)" and "2".
+func turnOrderLabel(i int) string {
+	if i == 0 {
+		return "1 (dealer)"
+	}
+	return fmt.Sprint(i + 1)
+}
+
+// seatTable lists positions in order with their win rate and average score, each with its
+// 95% confidence margin. With firstDealer set it also shows how often each dealt first.
+func seatTable(positions []SeatStats, column string, label func(i int) string, firstDealer []int) *console.Table {
+	table := console.NewTable()
+	header := []string{column, "Games", "Wins", "Win Rate", "±", "Avg Score", "±"}
+	if firstDealer != nil {
+		header = append(header, "Dealt First")
+	}
+	table.AddHeader(header...)
+	for i, st := range positions {
+		row := []interface{}{
+			label(i),
+			st.Games,
+			fmt.Sprintf("%.2f", st.Wins),
+			fmt.Sprintf("%.2f%%", st.WinRate()*100),
+			fmt.Sprintf("%.2f%%", stats.WinRateMargin(st.Wins, st.Games, stats.Z95)*100),
+			fmt.Sprintf("%.1f", st.AvgScore()),
+			fmt.Sprintf("%.1f", stats.MeanMargin(st.scores, stats.Z95)),
+		}
+		if firstDealer != nil {
+			row = append(row, firstDealer[i])
+		}
+		table.AddRow(row...)
+	}
+	return table
+}
@@ -0,0 +1,154 @@
+package application
+
+import (
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// shadowDecision is what one shadow advisor would have chosen at one of the user's decisions.
+type shadowDecision struct {
+	round  int
+	player string            // User-controlled player who decided
+	action domain.ActionType // Action whose target was chosen; empty for a hit/stay choice
+	shadow string            // The advisor's choice: "hit", "stay" or a target's name
+	chosen string            // The user's choice, in the same form
+}
+
+// shadowRecord tallies one advisor's choices against the user's.
+type shadowRecord struct {
+	decisions int
+	diverged  []shadowDecision
+}
+
+// prepareAdvisor shows an advisor (a shadow or a suggestion advisor) the game's winning score
+// and the current deck, as the seat it advises would see them.
+func (s *ManualGameService) prepareAdvisor(advisor domain.Strategy, deck domain.DeckView) {
+	if ws, ok := advisor.(domain.WinningScoreAware); ok {
+		ws.SetWinningScore(s.Game.TargetScore())
+	}
+	if ds, ok := advisor.(domain.DeckAware); ok && deck != nil {
+		ds.SetDeck(deck)
+	}
+}
+
+// shadowTurn returns whether each shadow advisor would hit or stay with p's hand, in the order
+// of ShadowAdvisors. It returns nil for a player the user does not control.
+func (s *ManualGameService) shadowTurn(p *domain.Player, deck domain.DeckView) []string {
+	if p.Strategy != nil || len(s.ShadowAdvisors) == 0 {
+		return nil
+	}
+	choices := make([]string, len(s.ShadowAdvisors))
+	for i, advisor := range s.ShadowAdvisors {
+		s.prepareAdvisor(advisor, deck)
+		choices[i] = string(advisor.Decide(deck, p.CurrentHand, p.TotalScore, s.getOpponents(p)))
+	}
+	return choices
+}
+
+// shadowTarget returns the name of the candidate each shadow advisor would target with action,
+// in the order of ShadowAdvisors. It returns nil for an actor the user does not control.
+func (s *ManualGameService) shadowTarget(action domain.ActionType, candidates []*domain.Player, actor *domain.Player, deck domain.DeckView) []string {
+	if actor.Strategy != nil || len(s.ShadowAdvisors) == 0 {
+		return nil
+	}
+	choices := make([]string, len(s.ShadowAdvisors))
+	for i, advisor := range s.ShadowAdvisors {
+		s.prepareAdvisor(advisor, deck)
+		if target := advisor.ChooseTarget(action, candidates, actor); target != nil {
+			choices[i] = target.Name
+		}
+	}
+	return choices
+}
+
+// recordShadows compares the shadow advisors' choices with the one p made, logging each as a
+// ShadowDecision event and adding it to the summary printed when the game ends.
+// action is empty for a hit/stay choice. A decision taken back with Undo stays counted.
+func (s *ManualGameService) recordShadows(p *domain.Player, action domain.ActionType, shadows []string, chosen string) {
+	decision := "turn"
+	if action != "" {
+		decision = string(action)
+	}
+	for i, shadow := range shadows {
+		advisor := s.ShadowAdvisors[i].Name()
+		agreed := shadow == chosen
+		s.publish(ShadowDecided{Player: p, Advisor: advisor, Decision: decision, Shadow: shadow, Chosen: chosen, Agreed: agreed})
+
+		if s.shadowRecords == nil {
+			s.shadowRecords = make(map[string]*shadowRecord)
+		}
+		rec := s.shadowRecords[advisor]
+		if rec == nil {
+			rec = &shadowRecord{}
+			s.shadowRecords[advisor] = rec
+		}
+		rec.decisions++
+		if !agreed {
+			rec.diverged = append(rec.diverged, shadowDecision{
+				round:  s.Game.RoundCount,
+				player: p.Name,
+				action: action,
+				shadow: shadow,
+				chosen: chosen,
+			})
+		}
+	}
+}
+
+// printShadowSummary prints how often each shadow advisor agreed with the user and every
+// decision where they diverged. Advisors sharing a name share one tally.
+func (s *ManualGameService) printShadowSummary() {
+	if len(s.ShadowAdvisors) == 0 {
+		return
+	}
+	s.say(console.MsgShadowHeader, nil)
+	printed := make(map[string]bool, len(s.ShadowAdvisors))
+	for _, a := range s.ShadowAdvisors {
+		advisor := a.Name()
+		if printed[advisor] {
+			continue
+		}
+		printed[advisor] = true
+
+		rec := s.shadowRecords[advisor]
+		if rec == nil || rec.decisions == 0 {
+			s.say(console.MsgShadowNoDecisions, console.Args{"advisor": advisor})
+			continue
+		}
+		agreed := rec.decisions - len(rec.diverged)
+		s.say(console.MsgShadowAgreement, console.Args{
+			"advisor":   advisor,
+			"agreed":    agreed,
+			"decisions": rec.decisions,
+			"rate":      float64(agreed) / float64(rec.decisions) * 100,
+		})
+		for _, d := range rec.diverged {
+			if d.action == "" {
+				s.say(console.MsgShadowTurnDiverged, console.Args{
+					"round":   d.round,
+					"name":    d.player,
+					"advisor": advisor,
+					"shadow":  s.moveName(domain.TurnChoice(d.shadow)),
+					"chosen":  s.moveName(domain.TurnChoice(d.chosen)),
+				})
+				continue
+			}
+			s.say(console.MsgShadowTargetDiverged, console.Args{
+				"round":   d.round,
+				"name":    d.player,
+				"action":  string(d.action),
+				"advisor": advisor,
+				"shadow":  d.shadow,
+				"chosen":  d.chosen,
+			})
+		}
+	}
+}
+
+// moveName returns the word for a hit/stay choice in the language of the messages.
+func (s *ManualGameService) moveName(choice domain.TurnChoice) string {
+	if choice == domain.TurnChoiceStay {
+		return s.Messages.Format(console.MsgMoveStay, nil)
+	}
+	return s.Messages.Format(console.MsgMoveHit, nil)
+}
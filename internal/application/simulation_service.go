@@ -3,22 +3,86 @@ package application
 import (
 	"flip7_strategy/internal/domain"
 	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+	"flip7_strategy/internal/stats"
 	"fmt"
+	"os"
 	"sort"
+	"strconv"
+	"strings"
 )
 
-const MinDeckSizeBeforeReshuffle = 10
-
-type SimulationService struct{}
+type SimulationService struct {
+	CSV bool // Print result tables as CSV (e.g. to paste into a spreadsheet) instead of aligned text
+	// Progress, if set, is called about every 1% of a run's games with the number of games
+	// finished so far, and once more when the last one finishes. Calls never overlap.
+	Progress func(done, total int)
+	// CheckpointPath, if set, is where the optimization sweeps save their progress after each
+	// configuration, so an interrupted sweep can be resumed (see DefaultSweepCheckpoint).
+	CheckpointPath string
+	// Warnings receives the warnings about strategies that break the rules, shared by the games
+	// of every run; nil means a StrategyWarnings per run, writing to os.Stderr.
+	Warnings *StrategyWarnings
+}
 
 func NewSimulationService() *SimulationService {
 	return &SimulationService{}
 }
 
+// printTable writes a result table to stdout in the configured format.
+func (s *SimulationService) printTable(table *console.Table) {
+	if s.CSV {
+		if err := table.RenderCSV(os.Stdout); err != nil {
+			fmt.Printf("Failed to write CSV: %v\n", err)
+		}
+		return
+	}
+	table.Render(os.Stdout)
+}
+
+// winsTable builds the common "Strategy | Wins | Win Rate | ±" table, sorted by wins.
+// "±" is the 95% confidence margin of the win rate.
+func winsTable(wins map[string]float64, games int) *console.Table {
+	table := console.NewTable()
+	table.AddHeader("Strategy", "Wins", "Win Rate", "±")
+	// Rows are added by name so that strategies with equal wins keep a stable order.
+	names := make([]string, 0, len(wins))
+	for name := range wins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		count := wins[name]
+		table.AddRow(name, fmt.Sprintf("%.2f", count), fmt.Sprintf("%.2f%%", count/float64(games)*100),
+			fmt.Sprintf("%.2f%%", stats.WinRateMargin(count, games, stats.Z95)*100))
+	}
+	table.SortBy(1, true)
+	return table
+}
+
 func (s *SimulationService) RunMonteCarlo(n int) {
 	fmt.Printf("Running %d games (Counting Mode)...\n", n)
 
+	result := s.playMonteCarloLineup(n, domain.WinningThreshold, s.startProgress(n))
+
+	fmt.Println("\n--- Simulation Results ---")
+	s.printTable(winsTable(result.Wins, n))
+
+	fmt.Println("\n--- Action Card Usage ---")
+	fmt.Printf("Freeze targets (self / opponent with the highest score / other), Flip Threes aimed at a bust risk above %.0f%%, and Second Chances passed on.\n", HighRiskFlipThreeTarget*100)
+	s.printTable(actionStatsTable(result.ActionStats))
+}
+
+// MonteCarloResult is the outcome of a run of the Monte Carlo lineup, keyed by strategy name.
+type MonteCarloResult struct {
+	Wins        map[string]float64 // A game won by k players on equal scores counts 1/k
+	ActionStats map[string]*ActionStats
+}
+
+// playMonteCarloLineup plays n games of the Monte Carlo lineup to winningScore.
+func (s *SimulationService) playMonteCarloLineup(n int, winningScore int, progress *progressTracker) MonteCarloResult {
 	wins := make(map[string]float64)
+	actions := newActionCollector()
 
 	// Define strategies to test
 	// We need to create fresh players for each game to reset state,
@@ -26,19 +90,23 @@ func (s *SimulationService) RunMonteCarlo(n int) {
 
 	for i := 0; i < n; i++ {
 		// Create players
-		p1 := domain.NewPlayer("Alice (Cautious)", &strategy.CautiousStrategy{})
+		p1 := domain.NewPlayer("Alice (Cautious)", strategy.NewCautiousStrategy())
 		p2 := domain.NewPlayer("Bob (Aggressive)", strategy.NewAggressiveStrategy())
-		p3 := domain.NewPlayer("Charlie (Probabilistic)", &strategy.ProbabilisticStrategy{})
+		p3 := domain.NewPlayer("Charlie (Probabilistic)", strategy.NewProbabilisticStrategy())
 		p4 := domain.NewPlayer("Dave (Heuristic)", strategy.NewHeuristicStrategy(strategy.DefaultHeuristicThreshold))
-		p5 := domain.NewPlayer("Eve (ExpectedValue)", &strategy.ExpectedValueStrategy{})
+		p5 := domain.NewPlayer("Eve (ExpectedValue)", strategy.NewExpectedValueStrategy())
 		p6 := domain.NewPlayer("Frank (Adaptive)", strategy.NewAdaptiveStrategy())
 
 		players := []*domain.Player{p1, p2, p3, p4, p5, p6}
 		game := domain.NewGame(players)
+		game.WinningScore = winningScore
 
 		svc := NewGameService(game)
 		svc.Silent = true // Run silently
+		svc.Warnings = progress.warnings
+		svc.Events.Subscribe(actions)
 		svc.RunGame()
+		progress.gameDone()
 
 		if len(game.Winners) > 0 {
 			points := 1.0 / float64(len(game.Winners))
@@ -48,134 +116,289 @@ func (s *SimulationService) RunMonteCarlo(n int) {
 		}
 	}
 
-	fmt.Println("\n--- Simulation Results ---")
-	for name, count := range wins {
-		percentage := count / float64(n) * 100
-		fmt.Printf("%s: %.2f wins (%.2f%%)\n", name, count, percentage)
+	return MonteCarloResult{Wins: wins, ActionStats: actions.stats}
+}
+
+// RunThresholdSensitivity reruns the Monte Carlo lineup at each winning score
+// and reports how each strategy's win rate shifts between the first and last threshold.
+func (s *SimulationService) RunThresholdSensitivity(n int, thresholds []int) {
+	fmt.Printf("Running Threshold Sensitivity (%d games per threshold)...\n", n)
+	if len(thresholds) == 0 {
+		fmt.Println("No thresholds given.")
+		return
+	}
+
+	winRates := make([]map[string]float64, len(thresholds))
+	nameSet := make(map[string]bool)
+	progress := s.startProgress(n * len(thresholds))
+	for i, threshold := range thresholds {
+		wins := s.playMonteCarloLineup(n, threshold, progress).Wins
+		winRates[i] = make(map[string]float64)
+		for name, count := range wins {
+			winRates[i][name] = count / float64(n) * 100
+			nameSet[name] = true
+		}
 	}
+
+	var names []string
+	for name := range nameSet {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	table := console.NewTable()
+	header := []string{"Strategy"}
+	for _, threshold := range thresholds {
+		header = append(header, strconv.Itoa(threshold))
+	}
+	table.AddHeader(append(header, "Shift")...)
+
+	for _, name := range names {
+		row := []interface{}{name}
+		for i := range thresholds {
+			row = append(row, fmt.Sprintf("%.2f%%", winRates[i][name]))
+		}
+		shift := winRates[len(thresholds)-1][name] - winRates[0][name]
+		table.AddRow(append(row, fmt.Sprintf("%+.2f", shift))...)
+	}
+
+	fmt.Println()
+	s.printTable(table)
 }
 
 func (s *SimulationService) RunHeuristicOptimization(gamesPerThreshold int) {
 	fmt.Printf("Running Heuristic Optimization (%d games per threshold)...\n", gamesPerThreshold)
-	fmt.Println("Threshold | Win Rate")
-	fmt.Println("----------|----------")
 
-	type Result struct {
-		Threshold int
-		WinRate   float64
+	var thresholds []int
+	for threshold := 15; threshold <= 50; threshold++ {
+		thresholds = append(thresholds, threshold)
 	}
-	var results []Result
-
-	for threshold := 15; threshold <= 35; threshold++ {
-		wins := 0.0
-		for i := 0; i < gamesPerThreshold; i++ {
-			p1 := domain.NewPlayer("Alice", &strategy.CautiousStrategy{})
-			p2 := domain.NewPlayer("Bob", strategy.NewAggressiveStrategy())
-			p3 := domain.NewPlayer("Charlie", &strategy.ProbabilisticStrategy{})
-			p4 := domain.NewPlayer("Dave", strategy.NewHeuristicStrategy(threshold))
+	results := s.runSweep(SweepHeuristic, thresholds, gamesPerThreshold, "Dave", func(threshold int) []*domain.Player {
+		return []*domain.Player{
+			domain.NewPlayer("Alice", strategy.NewCautiousStrategy()),
+			domain.NewPlayer("Bob", strategy.NewAggressiveStrategy()),
+			domain.NewPlayer("Charlie", strategy.NewProbabilisticStrategy()),
+			domain.NewPlayer("Dave", strategy.NewHeuristicStrategy(threshold)),
+		}
+	})
+	s.printSweep("Threshold", results)
+}
 
-			players := []*domain.Player{p1, p2, p3, p4}
-			game := domain.NewGame(players)
+// RunAdaptiveOptimization sweeps the Adaptive strategy's threat threshold against a fixed
+// lineup and reports the threshold with the best win rate.
+func (s *SimulationService) RunAdaptiveOptimization(gamesPerThreshold int) {
+	fmt.Printf("Running Adaptive Optimization (%d games per threat threshold)...\n", gamesPerThreshold)
 
-			svc := NewGameService(game)
-			svc.Silent = true
-			svc.RunGame()
-
-			for _, winner := range game.Winners {
-				if winner.Name == "Dave" {
-					wins += 1.0 / float64(len(game.Winners))
-				}
-			}
+	var thresholds []int
+	for threshold := 120; threshold <= domain.WinningThreshold; threshold += 10 {
+		thresholds = append(thresholds, threshold)
+	}
+	results := s.runSweep(SweepAdaptive, thresholds, gamesPerThreshold, "Dave", func(threshold int) []*domain.Player {
+		config := strategy.DefaultAdaptiveConfig()
+		config.ThreatScoreThreshold = threshold
+		return []*domain.Player{
+			domain.NewPlayer("Alice", strategy.NewCautiousStrategy()),
+			domain.NewPlayer("Bob", strategy.NewAggressiveStrategy()),
+			domain.NewPlayer("Charlie", strategy.NewProbabilisticStrategy()),
+			domain.NewPlayer("Dave", strategy.NewAdaptiveStrategyWithConfig(config)),
 		}
-		winRate := (wins / float64(gamesPerThreshold)) * 100
-		fmt.Printf("%9d | %7.2f%%\n", threshold, winRate)
-		results = append(results, Result{Threshold: threshold, WinRate: winRate})
+	})
+	s.printSweep("Threat Threshold", results)
+}
+
+// printSweep prints the win rate and deck seed of every configuration, then the best one.
+func (s *SimulationService) printSweep(label string, results []SweepResult) {
+	table := console.NewTable()
+	table.AddHeader(label, "Win Rate", "Seed")
+	for _, res := range results {
+		table.AddRow(res.Config, fmt.Sprintf("%.2f%%", res.WinRate()), res.Seed)
 	}
+	s.printTable(table)
 
 	// Find best
-	bestThreshold := 0
+	best := SweepResult{}
 	maxWinRate := -1.0
 	for _, res := range results {
-		if res.WinRate > maxWinRate {
-			maxWinRate = res.WinRate
-			bestThreshold = res.Threshold
+		if res.WinRate() > maxWinRate {
+			maxWinRate = res.WinRate()
+			best = res
 		}
 	}
-	fmt.Printf("\nBest Threshold: %d (Win Rate: %.2f%%)\n", bestThreshold, maxWinRate)
+	fmt.Printf("\nBest %s: %d (Win Rate: %.2f%%)\n", label, best.Config, maxWinRate)
 }
 
-func (s *SimulationService) RunSinglePlayerOptimization(n int) {
-	fmt.Printf("Running Single Player Optimization (%d games per strategy)...\n", n)
-	fmt.Println("Strategy | Avg Rounds | Median Rounds")
-	fmt.Println("---------|------------|--------------")
+// SinglePlayerMaxRounds caps every solo game, so a strategy that never reaches the
+// winning score ends the game instead of hanging the run.
+const SinglePlayerMaxRounds = 100
+
+// SinglePlayerResult summarizes the solo games of one strategy.
+type SinglePlayerResult struct {
+	Strategy  string
+	Games     int
+	Completed int // Games that reached the winning score within the round cap
+
+	// Rounds needed to reach the winning score, over completed games only
+	AvgRounds float64
+	RoundsP10 float64
+	RoundsP50 float64
+	RoundsP90 float64
+
+	BustsPerGame      float64
+	AvgPointsPerRound float64 // Points banked per round played, over all games
+}
+
+// CompletionRate is the fraction of games that reached the winning score.
+func (r SinglePlayerResult) CompletionRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return float64(r.Completed) / float64(r.Games)
+}
+
+// RunSinglePlayerOptimization plays n solo games per strategy and reports how fast each reaches
+// the winning score. Games are capped at SinglePlayerMaxRounds rounds.
+func (s *SimulationService) RunSinglePlayerOptimization(n int) []SinglePlayerResult {
+	fmt.Printf("Running Single Player Optimization (%d games per strategy, at most %d rounds each)...\n", n, SinglePlayerMaxRounds)
 
 	strategies := []struct {
 		Name  string
 		Strat domain.Strategy
 	}{
-		{"Cautious", &strategy.CautiousStrategy{}},
+		{"Cautious", strategy.NewCautiousStrategy()},
 		{"Aggressive", strategy.NewAggressiveStrategy()},
-		{"Probabilistic", &strategy.ProbabilisticStrategy{}},
+		{"Probabilistic", strategy.NewProbabilisticStrategy()},
 		{"Heuristic-27", strategy.NewHeuristicStrategy(27)},
-		{"ExpectedValue", &strategy.ExpectedValueStrategy{}},
+		{"ExpectedValue", strategy.NewExpectedValueStrategy()},
 		{"Adaptive", strategy.NewAdaptiveStrategy()},
 	}
 
-	for _, strat := range strategies {
-		var rounds []int
-		for i := 0; i < n; i++ {
-			p := domain.NewPlayer("Player", strat.Strat)
-			players := []*domain.Player{p}
-			game := domain.NewGame(players)
-			svc := NewGameService(game)
-			svc.Silent = true
-			svc.RunGame()
+	table := console.NewTable()
+	table.AddHeader("Strategy", "Completed", "Avg Rounds", "P10", "P50", "P90", "Busts/Game", "Points/Round")
 
-			// Check if player reached 200 points
-			if p.TotalScore >= 200 {
-				rounds = append(rounds, game.RoundCount)
-			}
-		}
+	var results []SinglePlayerResult
+	progress := s.startProgress(len(strategies) * n)
+	for _, strat := range strategies {
+		r := playSinglePlayer(strat.Name, strat.Strat, n, SinglePlayerMaxRounds, progress)
+		results = append(results, r)
 
-		if len(rounds) == 0 {
-			fmt.Printf("%-15s | N/A | N/A\n", strat.Name)
+		completed := fmt.Sprintf("%.2f%%", r.CompletionRate()*100)
+		if r.Completed == 0 {
+			table.AddRow(r.Strategy, completed, "N/A", "N/A", "N/A", "N/A",
+				fmt.Sprintf("%.2f", r.BustsPerGame), fmt.Sprintf("%.2f", r.AvgPointsPerRound))
 			continue
 		}
+		table.AddRow(r.Strategy, completed, fmt.Sprintf("%.2f", r.AvgRounds),
+			fmt.Sprintf("%.1f", r.RoundsP10), fmt.Sprintf("%.1f", r.RoundsP50), fmt.Sprintf("%.1f", r.RoundsP90),
+			fmt.Sprintf("%.2f", r.BustsPerGame), fmt.Sprintf("%.2f", r.AvgPointsPerRound))
+	}
+	s.printTable(table)
+	return results
+}
 
-		sum := 0
-		for _, r := range rounds {
-			sum += r
-		}
-		avg := float64(sum) / float64(len(rounds))
-
-		sort.Ints(rounds)
-		var median float64
-		if len(rounds)%2 == 0 {
-			median = float64(rounds[len(rounds)/2-1]+rounds[len(rounds)/2]) / 2.0
-		} else {
-			median = float64(rounds[len(rounds)/2])
+// PlaySinglePlayer plays n solo games of strat, each capped at maxRounds rounds (0 means no cap).
+func PlaySinglePlayer(name string, strat domain.Strategy, n int, maxRounds int) SinglePlayerResult {
+	return playSinglePlayer(name, strat, n, maxRounds, &progressTracker{})
+}
+
+func playSinglePlayer(name string, strat domain.Strategy, n int, maxRounds int, progress *progressTracker) SinglePlayerResult {
+	result := SinglePlayerResult{Strategy: name, Games: n}
+
+	var rounds []int
+	busts, totalRounds, totalPoints := 0, 0, 0
+	for i := 0; i < n; i++ {
+		p := domain.NewPlayer("Player", strat)
+		game := domain.NewGame([]*domain.Player{p})
+		svc := NewGameService(game)
+		svc.Silent = true
+		svc.Warnings = progress.warnings
+		svc.MaxRounds = maxRounds
+		svc.Events.Subscribe(domain.EventSinkFunc(func(e domain.Event) {
+			if _, ok := e.(domain.PlayerBusted); ok {
+				busts++
+			}
+		}))
+		svc.RunGame()
+		progress.gameDone()
+
+		totalRounds += game.RoundCount
+		totalPoints += p.TotalScore
+		// A capped game ends without reaching the winning score
+		if p.TotalScore >= game.TargetScore() {
+			rounds = append(rounds, game.RoundCount)
 		}
+	}
+
+	result.Completed = len(rounds)
+	if n > 0 {
+		result.BustsPerGame = float64(busts) / float64(n)
+	}
+	if totalRounds > 0 {
+		result.AvgPointsPerRound = float64(totalPoints) / float64(totalRounds)
+	}
+	if len(rounds) == 0 {
+		return result
+	}
 
-		fmt.Printf("%-15s | %10.2f | %13.2f\n", strat.Name, avg, median)
+	sum := 0
+	for _, r := range rounds {
+		sum += r
+	}
+	result.AvgRounds = float64(sum) / float64(len(rounds))
+
+	sort.Ints(rounds)
+	result.RoundsP10 = percentile(rounds, 0.10)
+	result.RoundsP50 = percentile(rounds, 0.50)
+	result.RoundsP90 = percentile(rounds, 0.90)
+	return result
+}
+
+// percentile interpolates linearly between the closest ranks of sorted values (p in [0, 1]).
+// The 50th percentile of an even number of values is the mean of the middle two.
+func percentile(sorted []int, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := p * float64(len(sorted)-1)
+	lower := int(pos)
+	if lower+1 >= len(sorted) {
+		return float64(sorted[len(sorted)-1])
 	}
+	frac := pos - float64(lower)
+	return float64(sorted[lower]) + frac*float64(sorted[lower+1]-sorted[lower])
 }
 
+// RunMultiplayerEvaluation plays n games at each table size from 1 to 5 players, the seats
+// rotating through a pool of strategies, and prints each size's standings. The pool holds
+// Probabilistic twice, with a fixed threshold and with one scaled to the table (see
+// strategy.TableScaling), and every table size reports which of the two won more of its
+// games, then the sizes where scaling helps.
 func (s *SimulationService) RunMultiplayerEvaluation(n int) {
 	fmt.Printf("Running Multiplayer Evaluation (%d games per player count)...\n", n)
 
+	fixed := strategy.NewProbabilisticStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.70))
+	scaled := strategy.NewScaledProbabilisticStrategy()
+	scaled.TargetSelector = strategy.NewRiskBasedTargetSelector(0.70)
+
 	// Strategies pool
 	strats := []domain.Strategy{
-		&strategy.CautiousStrategy{},
+		strategy.NewCautiousStrategy(),
 		strategy.NewAggressiveStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.65)),
-		strategy.NewProbabilisticStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.70)),
+		fixed,
+		scaled,
 		strategy.NewHeuristicStrategyWithSelector(27, strategy.NewRiskBasedTargetSelector(0.65)),
 		strategy.NewExpectedValueStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.80)),
 		strategy.NewAdaptiveStrategy(),
+		strategy.NewScoreThresholdSwitchingStrategy(150,
+			strategy.NewCautiousStrategy(),
+			strategy.NewAggressiveStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.65))),
 	}
 
-	for playerCount := 1; playerCount <= 5; playerCount++ {
+	const maxPlayers = 5
+	progress := s.startProgress(maxPlayers * n)
+	var comparisons []scalingComparison
+	for playerCount := 1; playerCount <= maxPlayers; playerCount++ {
 		fmt.Printf("\n--- %d Players ---\n", playerCount)
-		wins := make(map[string]float64)
+		standings := newStandingsAggregator()
 
 		for i := 0; i < n; i++ {
 			var players []*domain.Player
@@ -191,35 +414,203 @@ func (s *SimulationService) RunMultiplayerEvaluation(n int) {
 			game := domain.NewGame(players)
 			svc := NewGameService(game)
 			svc.Silent = true
+			svc.Warnings = progress.warnings
 			svc.RunGame()
+			progress.gameDone()
+
+			// Aggregate by strategy name, not player name (which includes the seat)
+			results := make([]finalStanding, 0, len(game.Players))
+			for _, p := range game.Players {
+				results = append(results, finalStanding{
+					Strategy: p.Strategy.Name(),
+					Score:    p.TotalScore,
+					Winner:   containsPlayer(game.Winners, p),
+				})
+			}
+			standings.Record(results, len(game.Winners))
+		}
 
-			if len(game.Winners) > 0 {
-				points := 1.0 / float64(len(game.Winners))
-				for _, winner := range game.Winners {
-					// We want to aggregate by strategy name, not player name
-					// Player name includes strategy name, so we can parse or just use strategy name directly if we had access.
-					// But we constructed the player with the strategy.
-					// Let's just use the strategy name from the winner's strategy instance.
-					wins[winner.Strategy.Name()] += points
-				}
+		s.printTable(standings.Table(playerCount))
+		if c, ok := compareScaling(playerCount, standings, fixed.Name(), scaled.Name()); ok {
+			fmt.Printf("Table scaling: %s won %.2f%% of its games, %s %.2f%% (%+.2f pts)\n",
+				scaled.Name(), c.Scaled*100, fixed.Name(), c.Fixed*100, (c.Scaled-c.Fixed)*100)
+			comparisons = append(comparisons, c)
+		}
+	}
+
+	var helps []string
+	for _, c := range comparisons {
+		if c.Helps() {
+			helps = append(helps, strconv.Itoa(c.Players))
+		}
+	}
+	if len(helps) == 0 {
+		fmt.Println("\nTable scaling does not help Probabilistic at any table size.")
+		return
+	}
+	fmt.Printf("\nTable scaling helps Probabilistic at %s players.\n", strings.Join(helps, ", "))
+}
+
+// scalingComparison is how Probabilistic fared with its threshold scaled to the table against
+// the fixed threshold, at one table size.
+type scalingComparison struct {
+	Players int
+	Fixed   float64 // Share of its games the fixed variant won (ties split)
+	Scaled  float64 // Share of its games the scaled variant won (ties split)
+}
+
+// Helps reports whether the scaled variant won more of its games.
+func (c scalingComparison) Helps() bool {
+	return c.Scaled > c.Fixed
+}
+
+// compareScaling compares the win rates of the strategies named fixed and scaled in the
+// standings of a table of players. It returns false unless both played.
+func compareScaling(players int, standings *standingsAggregator, fixed, scaled string) (scalingComparison, bool) {
+	f, s := standings.Strategies[fixed], standings.Strategies[scaled]
+	if f == nil || s == nil || f.Games == 0 || s.Games == 0 {
+		return scalingComparison{}, false
+	}
+	return scalingComparison{
+		Players: players,
+		Fixed:   f.Wins / float64(f.Games),
+		Scaled:  s.Wins / float64(s.Games),
+	}, true
+}
+
+func containsPlayer(players []*domain.Player, target *domain.Player) bool {
+	for _, p := range players {
+		if p == target {
+			return true
+		}
+	}
+	return false
+}
+
+// finalStanding is one player's result at the end of a game.
+type finalStanding struct {
+	Strategy string
+	Score    int
+	Winner   bool
+}
+
+// strategyStandings accumulates the results of one strategy across games.
+type strategyStandings struct {
+	Games      int
+	Wins       float64     // Tie-split, like the other evaluations
+	Placements map[int]int // Rank -> number of games finished at that rank
+	RankSum    int
+	ScoreSum   int
+	DeficitSum int // Points behind the top score of each game
+}
+
+func (st *strategyStandings) AvgPlacement() float64 {
+	return float64(st.RankSum) / float64(st.Games)
+}
+
+func (st *strategyStandings) AvgScore() float64 {
+	return float64(st.ScoreSum) / float64(st.Games)
+}
+
+func (st *strategyStandings) AvgDeficit() float64 {
+	return float64(st.DeficitSum) / float64(st.Games)
+}
+
+// standingsAggregator collects final scores and placements per strategy.
+type standingsAggregator struct {
+	Strategies map[string]*strategyStandings
+	games      int
+}
+
+func newStandingsAggregator() *standingsAggregator {
+	return &standingsAggregator{Strategies: make(map[string]*strategyStandings)}
+}
+
+// Record adds the final standings of one game. Players are ranked by score;
+// tied players share the better rank (e.g. 1, 1, 3). winnerCount splits the win
+// between tied winners.
+func (a *standingsAggregator) Record(results []finalStanding, winnerCount int) {
+	a.games++
+
+	top := 0
+	for i, r := range results {
+		if i == 0 || r.Score > top {
+			top = r.Score
+		}
+	}
+
+	for _, r := range results {
+		rank := 1
+		for _, other := range results {
+			if other.Score > r.Score {
+				rank++
 			}
 		}
 
-		for name, count := range wins {
-			percentage := count / float64(n) * 100
-			fmt.Printf("%s: %.2f wins (%.2f%%)\n", name, count, percentage)
+		st, ok := a.Strategies[r.Strategy]
+		if !ok {
+			st = &strategyStandings{Placements: make(map[int]int)}
+			a.Strategies[r.Strategy] = st
+		}
+		st.Games++
+		st.Placements[rank]++
+		st.RankSum += rank
+		st.ScoreSum += r.Score
+		st.DeficitSum += top - r.Score
+		if r.Winner && winnerCount > 0 {
+			st.Wins += 1.0 / float64(winnerCount)
 		}
 	}
 }
 
+// Table returns one row per strategy, sorted by win rate, with a placement
+// histogram column for each rank 1..playerCount.
+func (a *standingsAggregator) Table(playerCount int) *console.Table {
+	table := console.NewTable()
+	header := []string{"Strategy", "Wins", "Win Rate", "Avg Place", "Avg Score", "Avg Deficit"}
+	for rank := 1; rank <= playerCount; rank++ {
+		header = append(header, fmt.Sprintf("#%d", rank))
+	}
+	table.AddHeader(header...)
+
+	names := make([]string, 0, len(a.Strategies))
+	for name := range a.Strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		st := a.Strategies[name]
+		row := []interface{}{
+			name,
+			fmt.Sprintf("%.2f", st.Wins),
+			fmt.Sprintf("%.2f%%", st.Wins/float64(a.games)*100),
+			fmt.Sprintf("%.2f", st.AvgPlacement()),
+			fmt.Sprintf("%.1f", st.AvgScore()),
+			fmt.Sprintf("%.1f", st.AvgDeficit()),
+		}
+		for rank := 1; rank <= playerCount; rank++ {
+			row = append(row, st.Placements[rank])
+		}
+		table.AddRow(row...)
+	}
+	table.SortBy(1, true)
+	return table
+}
+
 func (s *SimulationService) RunStrategyCombinationEvaluation(n int) {
 	fmt.Printf("Running Strategy Combination Evaluation (%d games per pair)...\n", n)
 
+	table := console.NewTable()
+	// "±" is the 95% confidence margin of both win rates; p is the chance of a split at least
+	// this uneven between equally strong strategies, and "*" marks p < 0.05.
+	table.AddHeader("Strategy A", "Strategy B", "A Wins", "A Win Rate", "B Wins", "B Win Rate", "±", "p", "Sig")
+
 	strategies := []struct {
 		Name  string
 		Strat domain.Strategy
 	}{
-		{"Cautious", &strategy.CautiousStrategy{}},
+		{"Cautious", strategy.NewCautiousStrategy()},
 		{"Aggressive", strategy.NewAggressiveStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.65))},
 		{"Probabilistic", strategy.NewProbabilisticStrategyWithSelector(strategy.NewRiskBasedTargetSelector(0.70))},
 		{"Heuristic-27", strategy.NewHeuristicStrategyWithSelector(27, strategy.NewRiskBasedTargetSelector(0.65))},
@@ -227,12 +618,12 @@ func (s *SimulationService) RunStrategyCombinationEvaluation(n int) {
 		{"Adaptive", strategy.NewAdaptiveStrategy()},
 	}
 
+	progress := s.startProgress(len(strategies) * (len(strategies) - 1) / 2 * n)
 	for i := 0; i < len(strategies); i++ {
 		for j := i + 1; j < len(strategies); j++ {
 			s1 := strategies[i]
 			s2 := strategies[j]
 
-			fmt.Printf("\n--- %s vs %s ---\n", s1.Name, s2.Name)
 			wins := make(map[string]float64)
 
 			for k := 0; k < n; k++ {
@@ -244,7 +635,9 @@ func (s *SimulationService) RunStrategyCombinationEvaluation(n int) {
 				game := domain.NewGame(players)
 				svc := NewGameService(game)
 				svc.Silent = true
+				svc.Warnings = progress.warnings
 				svc.RunGame()
+				progress.gameDone()
 
 				if len(game.Winners) > 0 {
 					points := 1.0 / float64(len(game.Winners))
@@ -254,16 +647,27 @@ func (s *SimulationService) RunStrategyCombinationEvaluation(n int) {
 				}
 			}
 
-			// Print results for this pair
 			count1 := wins[s1.Name]
 			pct1 := count1 / float64(n) * 100
 			count2 := wins[s2.Name]
 			pct2 := count2 / float64(n) * 100
 
-			fmt.Printf("%s: %.2f wins (%.2f%%)\n", s1.Name, count1, pct1)
-			fmt.Printf("%s: %.2f wins (%.2f%%)\n", s2.Name, count2, pct2)
+			p := stats.TwoSidedPValue(count1, n)
+			significance := ""
+			if p < 0.05 {
+				significance = "*"
+			}
+
+			table.AddRow(s1.Name, s2.Name,
+				fmt.Sprintf("%.2f", count1), fmt.Sprintf("%.2f%%", pct1),
+				fmt.Sprintf("%.2f", count2), fmt.Sprintf("%.2f%%", pct2),
+				fmt.Sprintf("%.2f%%", stats.WinRateMargin(count1, n, stats.Z95)*100),
+				fmt.Sprintf("%.3f", p), significance)
 		}
 	}
+
+	fmt.Println()
+	s.printTable(table)
 }
 
 func (s *SimulationService) RunTargetSelectionSimulation(n int) {
@@ -277,6 +681,9 @@ func (s *SimulationService) RunTargetSelectionSimulation(n int) {
 	// Define thresholds
 	thresholds := []float64{0.5, 0.65, 0.7, 0.8, 0.9}
 
+	const batches = 4 // Expected Value, Probabilistic, Heuristic, Aggressive
+	progress := s.startProgress(batches * n)
+
 	// Helper to run a batch
 	runBatch := func(batchName string, targetStrategies []StrategyConfig) {
 		fmt.Printf("\n--- Batch: %s ---\n", batchName)
@@ -290,14 +697,16 @@ func (s *SimulationService) RunTargetSelectionSimulation(n int) {
 			}
 			// Add some standard opponents to fill the table and provide a baseline
 			// 5 target strategies + 3 standard = 8 players
-			players = append(players, domain.NewPlayer("Standard-Cautious", &strategy.CautiousStrategy{}))
+			players = append(players, domain.NewPlayer("Standard-Cautious", strategy.NewCautiousStrategy()))
 			players = append(players, domain.NewPlayer("Standard-Aggressive", strategy.NewAggressiveStrategy()))
-			players = append(players, domain.NewPlayer("Standard-Probabilistic", &strategy.ProbabilisticStrategy{}))
+			players = append(players, domain.NewPlayer("Standard-Probabilistic", strategy.NewProbabilisticStrategy()))
 
 			game := domain.NewGame(players)
 			svc := NewGameService(game)
 			svc.Silent = true
+			svc.Warnings = progress.warnings
 			svc.RunGame()
+			progress.gameDone()
 
 			if len(game.Winners) > 0 {
 				points := 1.0 / float64(len(game.Winners))
@@ -307,23 +716,7 @@ func (s *SimulationService) RunTargetSelectionSimulation(n int) {
 			}
 		}
 
-		// Sort and print results for this batch
-		type Result struct {
-			Name string
-			Wins float64
-		}
-		var results []Result
-		for name, count := range wins {
-			results = append(results, Result{Name: name, Wins: count})
-		}
-		sort.Slice(results, func(i, j int) bool {
-			return results[i].Wins > results[j].Wins
-		})
-
-		for _, res := range results {
-			percentage := res.Wins / float64(n) * 100
-			fmt.Printf("%-25s: %.2f wins (%.2f%%)\n", res.Name, res.Wins, percentage)
-		}
+		s.printTable(winsTable(wins, n))
 	}
 
 	// 1. Expected Value Batch
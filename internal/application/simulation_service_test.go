@@ -0,0 +1,609 @@
+package application
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+)
+
+func TestStandingsAggregator_RiggedOutcomes(t *testing.T) {
+	a := newStandingsAggregator()
+
+	// Game 1: A wins outright, B close second, C far behind
+	a.Record([]finalStanding{
+		{Strategy: "A", Score: 210, Winner: true},
+		{Strategy: "B", Score: 200},
+		{Strategy: "C", Score: 50},
+	}, 1)
+	// Game 2: A and B tie for the win, C last
+	a.Record([]finalStanding{
+		{Strategy: "A", Score: 205, Winner: true},
+		{Strategy: "B", Score: 205, Winner: true},
+		{Strategy: "C", Score: 100},
+	}, 2)
+	// Game 3: C wins, A last, B second
+	a.Record([]finalStanding{
+		{Strategy: "A", Score: 20},
+		{Strategy: "B", Score: 190},
+		{Strategy: "C", Score: 220, Winner: true},
+	}, 1)
+
+	tests := []struct {
+		strategy     string
+		wins         float64
+		avgPlacement float64
+		avgScore     float64
+		avgDeficit   float64
+		placements   map[int]int
+	}{
+		{"A", 1.5, 5.0 / 3.0, 435.0 / 3.0, 200.0 / 3.0, map[int]int{1: 2, 3: 1}},
+		{"B", 0.5, 5.0 / 3.0, 595.0 / 3.0, 40.0 / 3.0, map[int]int{1: 1, 2: 2}},
+		{"C", 1.0, 7.0 / 3.0, 370.0 / 3.0, 265.0 / 3.0, map[int]int{1: 1, 3: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			st, ok := a.Strategies[tt.strategy]
+			if !ok {
+				t.Fatalf("Expected standings for %s", tt.strategy)
+			}
+			if st.Games != 3 {
+				t.Errorf("Expected 3 games, got %d", st.Games)
+			}
+			if math.Abs(st.Wins-tt.wins) > 1e-9 {
+				t.Errorf("Expected %.2f wins, got %.2f", tt.wins, st.Wins)
+			}
+			if math.Abs(st.AvgPlacement()-tt.avgPlacement) > 1e-9 {
+				t.Errorf("Expected avg placement %.3f, got %.3f", tt.avgPlacement, st.AvgPlacement())
+			}
+			if math.Abs(st.AvgScore()-tt.avgScore) > 1e-9 {
+				t.Errorf("Expected avg score %.3f, got %.3f", tt.avgScore, st.AvgScore())
+			}
+			if math.Abs(st.AvgDeficit()-tt.avgDeficit) > 1e-9 {
+				t.Errorf("Expected avg deficit %.3f, got %.3f", tt.avgDeficit, st.AvgDeficit())
+			}
+			for rank := 1; rank <= 3; rank++ {
+				if st.Placements[rank] != tt.placements[rank] {
+					t.Errorf("Expected %d finishes at rank %d, got %d", tt.placements[rank], rank, st.Placements[rank])
+				}
+			}
+		})
+	}
+}
+
+func TestStandingsAggregator_TiesShareRank(t *testing.T) {
+	a := newStandingsAggregator()
+	a.Record([]finalStanding{
+		{Strategy: "A", Score: 100},
+		{Strategy: "B", Score: 100},
+		{Strategy: "C", Score: 90},
+	}, 0)
+
+	expected := map[string]int{"A": 1, "B": 1, "C": 3}
+	for name, rank := range expected {
+		if got := a.Strategies[name].RankSum; got != rank {
+			t.Errorf("Expected %s at rank %d, got %d", name, rank, got)
+		}
+	}
+	// No winner recorded (e.g. aborted game): nobody gets a win
+	for name, st := range a.Strategies {
+		if st.Wins != 0 {
+			t.Errorf("Expected no wins for %s, got %.2f", name, st.Wins)
+		}
+	}
+}
+
+func TestCompareScaling(t *testing.T) {
+	a := newStandingsAggregator()
+	// Scaled plays three games and wins two, one of them shared; Fixed plays two and wins one.
+	a.Record([]finalStanding{{Strategy: "Scaled", Score: 210, Winner: true}, {Strategy: "Fixed", Score: 150}}, 1)
+	a.Record([]finalStanding{{Strategy: "Scaled", Score: 205, Winner: true}, {Strategy: "Other", Score: 205, Winner: true}}, 2)
+	a.Record([]finalStanding{{Strategy: "Scaled", Score: 90}, {Strategy: "Fixed", Score: 200, Winner: true}}, 1)
+
+	c, ok := compareScaling(2, a, "Fixed", "Scaled")
+	if !ok {
+		t.Fatal("Expected both variants to be compared")
+	}
+	if c.Players != 2 || c.Scaled != 0.5 || c.Fixed != 0.5 || c.Helps() {
+		t.Errorf("Expected 1.5 of 3 against 1 of 2, an even match, got %+v", c)
+	}
+	if _, ok := compareScaling(2, a, "Fixed", "Missing"); ok {
+		t.Error("Expected no comparison without the scaled variant")
+	}
+}
+
+// alwaysStayStrategy stays as soon as it is asked, banking only the initial deal.
+type alwaysStayStrategy struct{}
+
+func (alwaysStayStrategy) Name() string { return "AlwaysStay" }
+func (alwaysStayStrategy) Decide(domain.DeckView, *domain.PlayerHand, int, []*domain.Player) domain.TurnChoice {
+	return domain.TurnChoiceStay
+}
+func (alwaysStayStrategy) ChooseTarget(_ domain.ActionType, candidates []*domain.Player, _ *domain.Player) *domain.Player {
+	return candidates[0]
+}
+
+func TestPlaySinglePlayer_RoundCapEndsNeverFinishingGames(t *testing.T) {
+	// Staying on the initial card cannot reach 200 points in 5 rounds.
+	result := PlaySinglePlayer("AlwaysStay", alwaysStayStrategy{}, 4, 5)
+
+	if result.Games != 4 {
+		t.Errorf("Expected 4 games, got %d", result.Games)
+	}
+	if result.Completed != 0 || result.CompletionRate() != 0 {
+		t.Errorf("Expected no completed games, got %d (rate %.2f)", result.Completed, result.CompletionRate())
+	}
+	if result.AvgRounds != 0 || result.RoundsP50 != 0 {
+		t.Errorf("Expected no round statistics without completed games, got %+v", result)
+	}
+	// Every game ran the full 5 rounds without reaching 200 points.
+	if result.AvgPointsPerRound >= 40 {
+		t.Errorf("Expected fewer than 40 points per round, got %.2f", result.AvgPointsPerRound)
+	}
+}
+
+func TestPlaySinglePlayer_ReportsCompletedGames(t *testing.T) {
+	result := PlaySinglePlayer("Aggressive", strategy.NewAggressiveStrategy(), 20, SinglePlayerMaxRounds)
+
+	if result.CompletionRate() != 1 {
+		t.Fatalf("Expected every game to finish within %d rounds, got %.2f", SinglePlayerMaxRounds, result.CompletionRate())
+	}
+	if !(result.RoundsP10 <= result.RoundsP50 && result.RoundsP50 <= result.RoundsP90) {
+		t.Errorf("Expected ordered percentiles, got P10 %.1f, P50 %.1f, P90 %.1f", result.RoundsP10, result.RoundsP50, result.RoundsP90)
+	}
+	if result.AvgPointsPerRound <= 0 {
+		t.Errorf("Expected points to be banked, got %.2f per round", result.AvgPointsPerRound)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	values := []int{1, 2, 3, 4}
+	tests := []struct {
+		p    float64
+		want float64
+	}{
+		{0, 1},
+		{0.5, 2.5}, // Median of an even count
+		{0.9, 3.7},
+		{1, 4},
+	}
+	for _, tt := range tests {
+		if got := percentile(values, tt.p); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("percentile(%v, %.2f): expected %.2f, got %.2f", values, tt.p, tt.want, got)
+		}
+	}
+	if got := percentile(nil, 0.5); got != 0 {
+		t.Errorf("Expected 0 for no values, got %.2f", got)
+	}
+}
+
+// recordProgress returns a Progress callback that records its calls, and a check that they
+// counted up to total without ever going back.
+func recordProgress(t *testing.T) (func(done, total int), func(total int)) {
+	var calls [][2]int
+	record := func(done, total int) {
+		calls = append(calls, [2]int{done, total})
+	}
+	check := func(total int) {
+		t.Helper()
+		if len(calls) == 0 {
+			t.Fatal("Expected progress to be reported")
+		}
+		for i, c := range calls {
+			if c[1] != total {
+				t.Errorf("Call %d: expected total %d, got %d", i, total, c[1])
+			}
+			if i > 0 && c[0] <= calls[i-1][0] {
+				t.Errorf("Call %d: done went from %d to %d", i, calls[i-1][0], c[0])
+			}
+		}
+		if last := calls[len(calls)-1]; last[0] != total {
+			t.Errorf("Expected the last call to report %d done, got %d", total, last[0])
+		}
+	}
+	return record, check
+}
+
+func TestSimulationService_ReportsProgress(t *testing.T) {
+	record, check := recordProgress(t)
+	s := NewSimulationService()
+	s.Progress = record
+
+	s.RunMonteCarlo(20)
+	check(20)
+}
+
+func TestProgressTracker_ConcurrentGames(t *testing.T) {
+	record, check := recordProgress(t)
+	s := NewSimulationService()
+	s.Progress = record
+
+	const workers, gamesEach = 8, 250
+	progress := s.startProgress(workers * gamesEach)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < gamesEach; i++ {
+				progress.gameDone()
+			}
+		}()
+	}
+	wg.Wait()
+	check(workers * gamesEach)
+}
+
+func TestRunSweep_ResumesInterruptedCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	configs := []int{10, 15, 20, 25}
+	const games = 2
+	lineup := func(threshold int) []*domain.Player {
+		return []*domain.Player{
+			domain.NewPlayer("Alice", alwaysStayStrategy{}),
+			domain.NewPlayer("Dave", strategy.NewHeuristicStrategy(threshold)),
+		}
+	}
+
+	// Interrupt the sweep during its last configuration
+	s := NewSimulationService()
+	s.CheckpointPath = path
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("Expected the sweep to be interrupted")
+			}
+		}()
+		s.runSweep(SweepHeuristic, configs, games, "Dave", func(threshold int) []*domain.Player {
+			if threshold == 25 {
+				panic("interrupted")
+			}
+			return lineup(threshold)
+		})
+	}()
+
+	// Keep only the first half of the configurations
+	checkpoint, err := LoadSweepCheckpoint(path)
+	if err != nil {
+		t.Fatalf("LoadSweepCheckpoint failed: %v", err)
+	}
+	if len(checkpoint.Results) != 3 {
+		t.Fatalf("Expected 3 configurations checkpointed before the interruption, got %d", len(checkpoint.Results))
+	}
+	checkpoint.Results = checkpoint.Results[:2]
+	if err := checkpoint.save(path); err != nil {
+		t.Fatalf("Failed to truncate checkpoint: %v", err)
+	}
+
+	played := make(map[int]int)
+	results := s.runSweep(SweepHeuristic, configs, games, "Dave", func(threshold int) []*domain.Player {
+		played[threshold]++
+		return lineup(threshold)
+	})
+
+	if len(played) != 2 || played[20] != games || played[25] != games {
+		t.Errorf("Expected only thresholds 20 and 25 to be played, got %v", played)
+	}
+	if len(results) != len(configs) {
+		t.Fatalf("Expected %d results, got %d", len(configs), len(results))
+	}
+	for i, res := range results {
+		if res.Config != configs[i] || res.Games != games || res.Seed != checkpoint.Seed+int64(i) {
+			t.Errorf("Result %d: expected threshold %d with %d games and seed %d, got %+v", i, configs[i], games, checkpoint.Seed+int64(i), res)
+		}
+	}
+	for i, saved := range checkpoint.Results {
+		if results[i] != saved {
+			t.Errorf("Expected the checkpointed result %+v to be kept, got %+v", saved, results[i])
+		}
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("Expected the checkpoint to be removed once the sweep completes, got %v", err)
+	}
+}
+
+func TestLoadSweepCheckpoint_RejectsOtherVersions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	if err := os.WriteFile(path, []byte(`{"version": 99, "sweep": "heuristic"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSweepCheckpoint(path); err == nil {
+		t.Error("Expected a checkpoint from another format version to be rejected")
+	}
+}
+
+// selfFreezeStrategy stays whenever it may and freezes itself whenever it draws a Freeze.
+type selfFreezeStrategy struct{ alwaysStayStrategy }
+
+func (selfFreezeStrategy) Name() string { return "SelfFreeze" }
+func (selfFreezeStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	if action == domain.ActionFreeze {
+		return self
+	}
+	return candidates[0]
+}
+
+func TestActionCollector_RecordsSelfFreezes(t *testing.T) {
+	// Round 1: P1 is dealt a Freeze and freezes themselves, P2 banks 5.
+	// Round 2: P2 banks 3 and wins, P1 is dealt the second Freeze.
+	p1 := domain.NewPlayer("P1", selfFreezeStrategy{})
+	p2 := domain.NewPlayer("P2", alwaysStayStrategy{})
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.WinningScore = 8
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	game.Deck = domain.NewDeckInOrder([]domain.Card{
+		freeze, {Type: domain.CardTypeNumber, Value: 5},
+		{Type: domain.CardTypeNumber, Value: 3}, freeze,
+	})
+
+	actions := newActionCollector()
+	svc := NewGameService(game)
+	svc.Silent = true
+	svc.Events.Subscribe(actions)
+	svc.RunGame()
+
+	st := actions.stats["SelfFreeze"]
+	if st == nil {
+		t.Fatalf("Expected action stats for SelfFreeze, got %v", actions.stats)
+	}
+	if st.Freezes != 2 || st.FreezeSelf != 2 || st.FreezeLeader != 0 || st.FreezeOther != 0 {
+		t.Errorf("Expected 2 freezes, all on self, got %+v", st)
+	}
+	if got := share(st.FreezeSelf, st.Freezes); got != "100.0%" {
+		t.Errorf("Expected 100.0%% self-freezes, got %s", got)
+	}
+	if _, ok := actions.stats["AlwaysStay"]; ok {
+		t.Errorf("Expected no action stats for a strategy that drew no action card")
+	}
+}
+
+func TestPlayCountingValue_PairsGames(t *testing.T) {
+	// A strategy that never looks at the deck plays the same games in both conditions.
+	newStrategy := func() domain.Strategy { return alwaysStayStrategy{} }
+	result := playCountingValue("AlwaysStay", newStrategy, 8, 42, &progressTracker{})
+
+	if result.Games != 8 {
+		t.Errorf("Expected 8 games, got %d", result.Games)
+	}
+	if result.CountingWins != result.AmnesiacWins || result.Delta() != 0 || result.Margin != 0 {
+		t.Errorf("Expected identical conditions, got %+v", result)
+	}
+}
+
+func TestCountingValueTable_SortsByDelta(t *testing.T) {
+	table := countingValueTable([]CountingValueResult{
+		{Strategy: "Small", Games: 100, CountingWins: 30, AmnesiacWins: 28, Margin: 0.031},
+		{Strategy: "Large", Games: 100, CountingWins: 35, AmnesiacWins: 25, Margin: 0.042},
+	})
+	var out strings.Builder
+	if err := table.RenderCSV(&out); err != nil {
+		t.Fatal(err)
+	}
+	expected := "Strategy,Counting,Amnesiac,Delta,±\nLarge,35.00%,25.00%,+10.00,4.20\nSmall,30.00%,28.00%,+2.00,3.10\n"
+	if out.String() != expected {
+		t.Errorf("Expected:\n%s\ngot:\n%s", expected, out.String())
+	}
+}
+
+func TestPlayLineup_RotatesSeatsEvenly(t *testing.T) {
+	lineup := []string{"Cautious", "Aggressive", "Adaptive", "ExpectedValue"}
+	const rotations = 3
+	s := NewSimulationService()
+
+	result, err := s.playLineup(rotations*len(lineup), lineup, 2, s.startProgress(rotations*len(lineup)))
+	if err != nil {
+		t.Fatalf("playLineup: %v", err)
+	}
+
+	for _, name := range lineup {
+		for seat := 1; seat <= len(lineup); seat++ {
+			if got := result.seats[name][seat]; got != rotations {
+				t.Errorf("%s: expected %d games in seat %d, got %d", name, rotations, seat, got)
+			}
+		}
+		if st := result.standings.Strategies[name]; st == nil || st.Games != rotations*len(lineup) {
+			t.Errorf("%s: expected standings for %d games, got %+v", name, rotations*len(lineup), st)
+		}
+	}
+
+	if _, err := s.playLineup(1, []string{"Cautious", "Nobody"}, 1, s.startProgress(1)); err == nil {
+		t.Error("Expected an unknown strategy to be rejected")
+	}
+}
+
+func TestPlaySeatAdvantage_RotatesFirstDealer(t *testing.T) {
+	const playerCount, rotations = 3, 4
+	result := playSeatAdvantage(rotations*playerCount, playerCount, &progressTracker{})
+
+	total := 0.0
+	for seat, st := range result.BySeat {
+		if result.FirstDealer[seat] != rotations {
+			t.Errorf("Seat%d: expected to deal first %d times, got %d", seat+1, rotations, result.FirstDealer[seat])
+		}
+		if st.Games != rotations*playerCount {
+			t.Errorf("Seat%d: expected %d games, got %d", seat+1, rotations*playerCount, st.Games)
+		}
+		total += st.WinRate()
+	}
+	// Every game has a winner (shared wins are split), so the win rates add up to 100%.
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("Expected the seat win rates to sum to 100%%, got %.4f%%", total*100)
+	}
+	orderTotal := 0.0
+	for _, st := range result.ByTurnOrder {
+		orderTotal += st.WinRate()
+	}
+	if math.Abs(orderTotal-1) > 1e-9 {
+		t.Errorf("Expected the turn order win rates to sum to 100%%, got %.4f%%", orderTotal*100)
+	}
+}
+
+func TestRunSeatAdvantageAnalysis_NeedsTwoPlayers(t *testing.T) {
+	if _, err := NewSimulationService().RunSeatAdvantageAnalysis(10, 1); err == nil {
+		t.Error("Expected an error for a single player")
+	}
+}
+
+func TestPlayTeamPair_TeamsWinTogether(t *testing.T) {
+	const games = 20
+	result := playTeamPair("Cautious", "Adaptive", games, &progressTracker{})
+
+	if result.Games != games {
+		t.Errorf("Expected %d games, got %d", games, result.Games)
+	}
+	// Every game is won by one team (or shared by both), so the wins add up to the games.
+	if total := result.WinsA + result.WinsB; math.Abs(total-games) > 1e-9 {
+		t.Errorf("Expected the team wins to add up to %d, got %.2f", games, total)
+	}
+	// A game ends only once a team has reached the combined target.
+	if result.CombinedA+result.CombinedB < games*domain.TeamWinningThreshold {
+		t.Errorf("Expected at least %d combined points per game, got %+v", domain.TeamWinningThreshold, result)
+	}
+}
+
+func TestSubsets(t *testing.T) {
+	got := subsets([]string{"A", "B", "C", "D"}, 3)
+	want := [][]string{{"A", "B", "C"}, {"A", "B", "D"}, {"A", "C", "D"}, {"B", "C", "D"}}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d subsets, got %v", len(want), got)
+	}
+	for i := range want {
+		for j := range want[i] {
+			if got[i][j] != want[i][j] {
+				t.Errorf("Subset %d: expected %v, got %v", i, want[i], got[i])
+				break
+			}
+		}
+	}
+}
+
+func TestRunBestResponseTraining_StopsAtMaxIters(t *testing.T) {
+	const players, maxIters = 3, 4
+	result, err := NewSimulationService().RunBestResponseTraining(players, 2, maxIters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Converging takes at least one sweep per seat, so this run may stop either way, but never late.
+	if len(result.Trace) > maxIters {
+		t.Errorf("Expected at most %d iterations, got %d", maxIters, len(result.Trace))
+	}
+	if !result.Converged && len(result.Trace) != maxIters {
+		t.Errorf("Expected an unconverged run to use all %d iterations, got %d", maxIters, len(result.Trace))
+	}
+	if len(result.Thresholds) != players {
+		t.Fatalf("Expected %d thresholds, got %v", players, result.Thresholds)
+	}
+	inGrid := func(threshold float64) bool {
+		for _, g := range BestResponseGrid {
+			if g == threshold {
+				return true
+			}
+		}
+		return false
+	}
+	for _, threshold := range result.Thresholds {
+		if !inGrid(threshold) {
+			t.Errorf("Expected every threshold to be on the grid, got %v", result.Thresholds)
+		}
+	}
+	for i, step := range result.Trace {
+		if step.Iteration != i+1 || step.Seat != i%players {
+			t.Errorf("Step %d: expected iteration %d by seat %d, got %+v", i, i+1, i%players, step)
+		}
+		if step.Improved() && step.WinRateTo-step.WinRateFrom <= BestResponseEpsilon {
+			t.Errorf("Step %d: moved without beating epsilon: %+v", i, step)
+		}
+	}
+}
+
+func TestRunBestResponseTraining_ConvergesWhenNoSeatImproves(t *testing.T) {
+	// However noisy one game per threshold is, the run ends either converged, with the last
+	// sweep of every seat keeping its threshold, or at maxIters.
+	result, err := NewSimulationService().RunBestResponseTraining(2, 1, 50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Converged {
+		last := result.Trace[len(result.Trace)-2:]
+		for _, step := range last {
+			if step.Improved() {
+				t.Errorf("Expected the last sweep of every seat to keep its threshold, got %+v", last)
+			}
+		}
+	} else if len(result.Trace) != 50 {
+		t.Errorf("Expected an unconverged run to use all 50 iterations, got %d", len(result.Trace))
+	}
+}
+
+func TestRunBestResponseTraining_NeedsTwoPlayers(t *testing.T) {
+	if _, err := NewSimulationService().RunBestResponseTraining(1, 10, 5); err == nil {
+		t.Error("Expected an error for a single player")
+	}
+}
+
+func TestRunHoldableActionsComparison_PlaysBothConditions(t *testing.T) {
+	result := NewSimulationService().RunHoldableActionsComparison(8)
+
+	if result.Games != 8 {
+		t.Errorf("Expected 8 games, got %d", result.Games)
+	}
+	for _, wins := range []float64{result.HoldingWins, result.ImmediateWins} {
+		if wins < 0 || wins > 8 {
+			t.Errorf("Expected between 0 and 8 wins in each condition, got %+v", result)
+		}
+	}
+	if result.HoldingHeldPlays == 0 {
+		t.Errorf("Expected Adaptive to play held action cards while holding, got %+v", result)
+	}
+	if result.ImmediateHeldPlays != 0 {
+		t.Errorf("Expected Adaptive to hold no action cards when resolving them at once, got %+v", result)
+	}
+}
+
+// alwaysHitStrategy hits until it busts, so it only ever banks a Flip 7 or a frozen hand.
+type alwaysHitStrategy struct{}
+
+func (alwaysHitStrategy) Name() string { return "AlwaysHit" }
+func (alwaysHitStrategy) Decide(domain.DeckView, *domain.PlayerHand, int, []*domain.Player) domain.TurnChoice {
+	return domain.TurnChoiceHit
+}
+func (alwaysHitStrategy) ChooseTarget(_ domain.ActionType, candidates []*domain.Player, _ *domain.Player) *domain.Player {
+	return candidates[0]
+}
+
+func TestRunExploitabilitySearch_FindsExploiterOfWeakStrategy(t *testing.T) {
+	result, err := NewSimulationService().searchExploiters("AlwaysHit", func() domain.Strategy { return alwaysHitStrategy{} }, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := len(ExploiterHeuristicThresholds) + len(BestResponseGrid); len(result.Candidates) != want {
+		t.Fatalf("Expected %d candidates, got %d", want, len(result.Candidates))
+	}
+	for _, c := range result.Candidates {
+		if c.Games != 4 {
+			t.Errorf("Expected 4 games for %s, got %d", c.Name, c.Games)
+		}
+		if c.WinRate() > result.Best.WinRate() {
+			t.Errorf("Expected %s (%.2f%%) to be the best, but %s won %.2f%%", result.Best.Name, result.Best.WinRate(), c.Name, c.WinRate())
+		}
+	}
+	if result.Best.WinRate() <= 90 {
+		t.Errorf("Expected an exploiter winning over 90%% of games against AlwaysHit, best was %s at %.2f%%", result.Best.Name, result.Best.WinRate())
+	}
+	if got, want := result.Exploitability(), result.Best.WinRate()-50; got != want {
+		t.Errorf("Expected an exploitability of %.2f, got %.2f", want, got)
+	}
+}
+
+func TestRunExploitabilitySearch_RejectsUnknownStrategy(t *testing.T) {
+	if _, err := NewSimulationService().RunExploitabilitySearch("NoSuchStrategy", 4); err == nil {
+		t.Error("Expected an error for an unregistered strategy")
+	}
+}
@@ -0,0 +1,38 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// StrategyWarnings tells strategy authors about strategies that break the rules. Each warning
+// is written once per strategy, however many games share the StrategyWarnings: a simulation
+// plays thousands of games with the same strategies and warns about each one once.
+// It is safe to share between the games of parallel tables.
+type StrategyWarnings struct {
+	out    io.Writer
+	mu     sync.Mutex
+	warned map[string]bool
+}
+
+// NewStrategyWarnings returns a StrategyWarnings writing to out; nil means os.Stderr.
+func NewStrategyWarnings(out io.Writer) *StrategyWarnings {
+	if out == nil {
+		out = os.Stderr
+	}
+	return &StrategyWarnings{out: out, warned: make(map[string]bool)}
+}
+
+// warnOnce writes the warning unless it was already written for key, which names the
+// strategy and the kind of warning.
+func (w *StrategyWarnings) warnOnce(key, format string, a ...interface{}) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.warned[key] {
+		return
+	}
+	w.warned[key] = true
+	fmt.Fprintf(w.out, format, a...)
+}
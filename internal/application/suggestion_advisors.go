@@ -0,0 +1,101 @@
+package application
+
+import (
+	"fmt"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// MaxSuggestionAdvisors is the most strategies the Manual Mode suggestion panel asks.
+const MaxSuggestionAdvisors = 4
+
+// suggestionAdvisors returns the strategies to ask for suggestions: SuggestionAdvisors up to
+// MaxSuggestionAdvisors, or a fresh Adaptive strategy when there are none.
+func (s *ManualGameService) suggestionAdvisors() []domain.Strategy {
+	if len(s.SuggestionAdvisors) == 0 {
+		return []domain.Strategy{strategy.NewAdaptiveStrategy()}
+	}
+	if len(s.SuggestionAdvisors) > MaxSuggestionAdvisors {
+		return s.SuggestionAdvisors[:MaxSuggestionAdvisors]
+	}
+	return s.SuggestionAdvisors
+}
+
+// suggestMove asks every suggestion advisor whether p should hit or stay, prints the suggestion
+// and returns it. A single advisor's choice is printed as the suggested move; several are
+// printed as a panel, and the suggestion is the majority's choice (the first advisor's when
+// there is no majority).
+func (s *ManualGameService) suggestMove(p *domain.Player, deck *domain.Deck) domain.TurnChoice {
+	advisors := s.suggestionAdvisors()
+	choices := make([]string, len(advisors))
+	for i, advisor := range advisors {
+		s.prepareAdvisor(advisor, deck)
+		choices[i] = string(advisor.Decide(deck, p.CurrentHand, p.TotalScore, s.getOpponents(p)))
+	}
+	if len(advisors) == 1 {
+		choice := domain.TurnChoice(choices[0])
+		s.say(console.MsgSuggestedMove, console.Args{"move": s.moveName(choice)})
+		return choice
+	}
+
+	majority, votes := majorityChoice(choices)
+	suggestions := make([]console.AdvisorSuggestion, len(advisors))
+	for i, advisor := range advisors {
+		suggestions[i] = console.AdvisorSuggestion{Advisor: advisor.Name(), Choice: s.moveName(domain.TurnChoice(choices[i]))}
+	}
+	fmt.Fprintln(s.out(), s.Messages.SuggestionPanel(suggestions, s.moveName(domain.TurnChoice(majority)), votes))
+	if votes*2 > len(choices) {
+		return domain.TurnChoice(majority)
+	}
+	return domain.TurnChoice(choices[0])
+}
+
+// suggestTarget asks every suggestion advisor which of candidates actor should target with
+// action. It returns the suggested target, the majority's (the first advisor's when there is
+// no majority), and each advisor's choice for the target list.
+func (s *ManualGameService) suggestTarget(action domain.ActionType, candidates []*domain.Player, actor *domain.Player, deck domain.DeckView) (*domain.Player, []console.AdvisorSuggestion) {
+	advisors := s.suggestionAdvisors()
+	targets := make([]*domain.Player, len(advisors))
+	names := make([]string, len(advisors))
+	suggestions := make([]console.AdvisorSuggestion, len(advisors))
+	for i, advisor := range advisors {
+		s.prepareAdvisor(advisor, deck)
+		targets[i] = advisor.ChooseTarget(action, candidates, actor)
+		if targets[i] != nil {
+			names[i] = targets[i].ID.String()
+			suggestions[i] = console.AdvisorSuggestion{Advisor: advisor.Name(), Choice: targets[i].Name}
+		} else {
+			suggestions[i] = console.AdvisorSuggestion{Advisor: advisor.Name()}
+		}
+	}
+	suggested := targets[0]
+	if majority, votes := majorityChoice(names); votes*2 > len(names) {
+		for _, t := range targets {
+			if t != nil && t.ID.String() == majority {
+				suggested = t
+				break
+			}
+		}
+	}
+	return suggested, suggestions
+}
+
+// majorityChoice returns the most frequent of choices and how many times it occurs. Of equally
+// frequent choices, the one that occurs first wins. Empty choices are not counted.
+func majorityChoice(choices []string) (string, int) {
+	counts := make(map[string]int)
+	for _, c := range choices {
+		if c != "" {
+			counts[c]++
+		}
+	}
+	best, votes := "", 0
+	for _, c := range choices {
+		if counts[c] > votes {
+			best, votes = c, counts[c]
+		}
+	}
+	return best, votes
+}
@@ -0,0 +1,201 @@
+package application
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	"flip7_strategy/internal/domain"
+)
+
+// DefaultSweepCheckpoint is where the optimization sweeps keep their progress by default.
+const DefaultSweepCheckpoint = ".flip7_opt_checkpoint.json"
+
+// sweepCheckpointVersion is the current checkpoint format.
+const sweepCheckpointVersion = 1
+
+// Sweep names recorded in checkpoints.
+const (
+	SweepHeuristic = "heuristic"
+	SweepAdaptive  = "adaptive"
+)
+
+// SweepResult is the outcome of one configuration of a sweep.
+type SweepResult struct {
+	Config int     `json:"config"`
+	Seed   int64   `json:"seed"` // Seeds the shuffles of this configuration's decks
+	Games  int     `json:"games"`
+	Wins   float64 `json:"wins"` // A game won by k players on equal scores counts 1/k
+}
+
+// WinRate returns the share of games won, in percent.
+func (r SweepResult) WinRate() float64 {
+	if r.Games == 0 {
+		return 0
+	}
+	return r.Wins / float64(r.Games) * 100
+}
+
+// SweepCheckpoint is the progress of a sweep, written after every configuration so an
+// interrupted run can be resumed without replaying the configurations already done.
+type SweepCheckpoint struct {
+	Version        int           `json:"version"`
+	Sweep          string        `json:"sweep"`
+	GamesPerConfig int           `json:"games_per_config"`
+	Seed           int64         `json:"seed"` // Base seed; configuration i uses Seed+i
+	Configs        []int         `json:"configs"`
+	Results        []SweepResult `json:"results"` // Completed configurations
+}
+
+// Done reports whether every configuration has a result.
+func (c *SweepCheckpoint) Done() bool {
+	return len(c.Results) >= len(c.Configs)
+}
+
+// LoadSweepCheckpoint reads a checkpoint written by a sweep.
+// It returns an error wrapping os.ErrNotExist when there is none.
+func LoadSweepCheckpoint(path string) (*SweepCheckpoint, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var c SweepCheckpoint
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("invalid checkpoint: %w", err)
+	}
+	if c.Version != sweepCheckpointVersion {
+		return nil, fmt.Errorf("unsupported checkpoint version %d (this build reads version %d)", c.Version, sweepCheckpointVersion)
+	}
+	return &c, nil
+}
+
+// save writes the checkpoint atomically: a crash leaves either the old file or the new one.
+func (c *SweepCheckpoint) save(path string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data)
+}
+
+// writeFileAtomic writes data to path through a temporary file in the same directory that
+// replaces path once complete, so a crash leaves either the old file or the new one.
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op once renamed
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// matches reports whether the checkpoint belongs to the given sweep run.
+func (c *SweepCheckpoint) matches(sweep string, configs []int, gamesPerConfig int) bool {
+	if c.Sweep != sweep || c.GamesPerConfig != gamesPerConfig || len(c.Configs) != len(configs) {
+		return false
+	}
+	for i := range configs {
+		if c.Configs[i] != configs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// runSweep plays gamesPerConfig games of lineup(config) for every configuration and
+// returns the results in configuration order. The player named target is the one measured.
+// With CheckpointPath set, progress is saved after each configuration, a matching unfinished
+// checkpoint is resumed, and the checkpoint is removed once the sweep completes.
+func (s *SimulationService) runSweep(sweep string, configs []int, gamesPerConfig int, target string, lineup func(config int) []*domain.Player) []SweepResult {
+	checkpoint := &SweepCheckpoint{
+		Version:        sweepCheckpointVersion,
+		Sweep:          sweep,
+		GamesPerConfig: gamesPerConfig,
+		Seed:           time.Now().UnixNano(),
+		Configs:        configs,
+	}
+	if s.CheckpointPath != "" {
+		saved, err := LoadSweepCheckpoint(s.CheckpointPath)
+		switch {
+		case err == nil && saved.matches(sweep, configs, gamesPerConfig) && !saved.Done():
+			checkpoint = saved
+			fmt.Printf("Resuming from %s: %d of %d configurations already done.\n", s.CheckpointPath, len(saved.Results), len(configs))
+		case err != nil && !errors.Is(err, os.ErrNotExist):
+			fmt.Printf("Ignoring checkpoint %s: %v\n", s.CheckpointPath, err)
+		}
+	}
+
+	done := make(map[int]SweepResult, len(checkpoint.Results))
+	for _, r := range checkpoint.Results {
+		done[r.Config] = r
+	}
+
+	progress := s.startProgress((len(configs) - len(done)) * gamesPerConfig)
+	for i, config := range configs {
+		if _, ok := done[config]; ok {
+			continue
+		}
+
+		result := SweepResult{Config: config, Seed: checkpoint.Seed + int64(i), Games: gamesPerConfig}
+		rng := rand.New(rand.NewSource(result.Seed))
+		for g := 0; g < gamesPerConfig; g++ {
+			game := domain.NewGame(lineup(config))
+			game.Deck = shuffledDeck(domain.StandardDeckCards(), rng)
+
+			svc := NewGameService(game)
+			svc.Silent = true
+			svc.Warnings = progress.warnings
+			svc.DeckFactory = func(cards []domain.Card) *domain.Deck { return shuffledDeck(cards, rng) }
+			svc.RunGame()
+			progress.gameDone()
+
+			for _, winner := range game.Winners {
+				if winner.Name == target {
+					result.Wins += 1.0 / float64(len(game.Winners))
+				}
+			}
+		}
+
+		done[config] = result
+		checkpoint.Results = append(checkpoint.Results, result)
+		if s.CheckpointPath != "" {
+			if err := checkpoint.save(s.CheckpointPath); err != nil {
+				fmt.Printf("Failed to write checkpoint: %v\n", err)
+			}
+		}
+	}
+
+	if s.CheckpointPath != "" {
+		os.Remove(s.CheckpointPath)
+	}
+
+	results := make([]SweepResult, len(configs))
+	for i, config := range configs {
+		results[i] = done[config]
+	}
+	return results
+}
+
+// shuffledDeck returns a deck of the given cards in an order drawn from rng.
+func shuffledDeck(cards []domain.Card, rng *rand.Rand) *domain.Deck {
+	shuffled := append([]domain.Card(nil), cards...)
+	rng.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return domain.NewDeckInOrder(shuffled)
+}
@@ -0,0 +1,100 @@
+package application
+
+import (
+	"fmt"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+	"flip7_strategy/internal/infrastructure/console"
+	"flip7_strategy/internal/stats"
+)
+
+// TeamPairResult is the record of one pairing of RunTeamEvaluation.
+type TeamPairResult struct {
+	TeamA, TeamB string // Strategy played by both partners of each team
+	Games        int
+	// WinsA and WinsB count the games each team won; a game won by both teams on equal
+	// combined scores counts 1/2 to each.
+	WinsA, WinsB float64
+	// CombinedA and CombinedB sum each team's final combined score over the games.
+	CombinedA, CombinedB int
+}
+
+// RunTeamEvaluation plays n games of the team play variant for every pair of registered
+// strategies: two partners playing one strategy against two playing the other, to
+// domain.TeamWinningThreshold combined points. Partners never Freeze or Flip Three each other and
+// pass a Second Chance to each other first (see domain.TeamTargets). The teams sit alternately
+// (A, B, A, B) and the first deal rotates through the seats.
+// It prints each pairing's win rates with their 95% confidence margin and p-value, like the
+// Strategy Combination Evaluation.
+func (s *SimulationService) RunTeamEvaluation(n int) []TeamPairResult {
+	names := strategy.Names()
+	fmt.Printf("Running Team Evaluation (%d games per pair, teams of 2 to %d combined points)...\n", n, domain.TeamWinningThreshold)
+
+	table := console.NewTable()
+	table.AddHeader("Team A", "Team B", "A Wins", "A Win Rate", "B Wins", "B Win Rate", "±", "p", "Sig", "Avg A", "Avg B")
+
+	progress := s.startProgress(len(names) * (len(names) - 1) / 2 * n)
+	var results []TeamPairResult
+	for i := 0; i < len(names); i++ {
+		for j := i + 1; j < len(names); j++ {
+			r := playTeamPair(names[i], names[j], n, progress)
+			results = append(results, r)
+
+			p := stats.TwoSidedPValue(r.WinsA, n)
+			significance := ""
+			if p < 0.05 {
+				significance = "*"
+			}
+			table.AddRow(r.TeamA, r.TeamB,
+				fmt.Sprintf("%.2f", r.WinsA), fmt.Sprintf("%.2f%%", r.WinsA/float64(n)*100),
+				fmt.Sprintf("%.2f", r.WinsB), fmt.Sprintf("%.2f%%", r.WinsB/float64(n)*100),
+				fmt.Sprintf("%.2f%%", stats.WinRateMargin(r.WinsA, n, stats.Z95)*100),
+				fmt.Sprintf("%.3f", p), significance,
+				fmt.Sprintf("%.1f", float64(r.CombinedA)/float64(n)),
+				fmt.Sprintf("%.1f", float64(r.CombinedB)/float64(n)))
+		}
+	}
+
+	fmt.Println()
+	s.printTable(table)
+	return results
+}
+
+// playTeamPair plays the n team games of RunTeamEvaluation between strategies a and b.
+// a and b must be registered strategy names.
+func playTeamPair(a, b string, n int, progress *progressTracker) TeamPairResult {
+	result := TeamPairResult{TeamA: a, TeamB: b, Games: n}
+	seats := []struct{ team, strategy string }{{"A", a}, {"B", b}, {"A", a}, {"B", b}}
+	for i := 0; i < n; i++ {
+		players := make([]*domain.Player, len(seats))
+		for k, seat := range seats {
+			strat, _ := strategy.New(seat.strategy) // A registered name
+			players[k] = domain.NewPlayer(fmt.Sprintf("%s (%s%d)", seat.strategy, seat.team, k/2+1), strat)
+			players[k].Team = seat.team
+		}
+
+		game := domain.NewGame(players)
+		game.DealerIndex = i % len(seats)
+		svc := NewGameService(game)
+		svc.Silent = true
+		svc.Warnings = progress.warnings
+		svc.RunGame()
+		progress.gameDone()
+
+		winning := make(map[string]bool)
+		for _, w := range game.Winners {
+			winning[w.Team] = true
+		}
+		if winning["A"] {
+			result.WinsA += 1 / float64(len(winning))
+		}
+		if winning["B"] {
+			result.WinsB += 1 / float64(len(winning))
+		}
+		scores := game.TeamScores()
+		result.CombinedA += scores["A"]
+		result.CombinedB += scores["B"]
+	}
+	return result
+}
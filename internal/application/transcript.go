@@ -0,0 +1,254 @@
+package application
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// Transcript is a game written down by hand at the table, in the line-oriented format read by
+// ParseTranscript:
+//
+//	# Comments start with '#'. Blank lines are ignored.
+//	players: Me, Alice, Bob     # Seat order; the first player deals round 1
+//	target: 200                 # Optional winning score (default 200)
+//	R1: Me 7 3 +4 S / Alice 9 9(bust) / Bob F->Alice
+//	R1: Bob 12 S                # A round may continue on more lines
+//	R2: ...
+//
+// A round line holds segments separated by '/', each a player name followed by that player's
+// moves. Moves are applied in the order they are written, so a player may have several
+// segments in one round. A move is a card token (see domain.ParseCardToken), "S" for a stay,
+// "F->Name" or "T->Name" for a Freeze or Flip Three and its target, and "C->Name" for a
+// Second Chance passed on; a bare "C" is kept (or discarded when nobody can take it).
+// Text in parentheses is a note and is ignored.
+type Transcript struct {
+	Players []string
+	Target  int // Winning score; 0 for domain.WinningThreshold
+	Rounds  []TranscriptRound
+}
+
+// TranscriptRound is the moves of one round, in written order.
+type TranscriptRound struct {
+	Number int
+	Line   int // Line the round starts on
+	Moves  []TranscriptMove
+}
+
+// TranscriptMove is one entry of a round.
+type TranscriptMove struct {
+	Line   int    // Line of the transcript the move was written on
+	Player string // Declared name of the player who moved
+	Token  string // The move as written, e.g. "F->Alice"
+	Stay   bool
+	Card   domain.Card // The card drawn unless Stay
+	Target string      // Declared name of the target of a Freeze, Flip Three or passed Second Chance
+}
+
+func (m TranscriptMove) String() string {
+	return m.Player + " " + m.Token
+}
+
+// ParseTranscript reads a transcript (see Transcript). It checks the syntax only: that every
+// name is a declared player, every move is well formed and rounds are numbered in order.
+// Whether the moves are legal is left to ImportTranscript.
+func ParseTranscript(r io.Reader) (*Transcript, error) {
+	t := &Transcript{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if i := strings.Index(text, "#"); i >= 0 {
+			text = text[:i]
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(text, ":")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected \"players:\", \"target:\" or a round such as \"R1:\"", line)
+		}
+		key = strings.TrimSpace(key)
+		value = strings.TrimSpace(value)
+
+		switch {
+		case strings.EqualFold(key, "players"):
+			if t.Players != nil {
+				return nil, fmt.Errorf("line %d: players are already declared", line)
+			}
+			players, err := parseTranscriptPlayers(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			t.Players = players
+		case strings.EqualFold(key, "target"):
+			target, err := strconv.Atoi(value)
+			if err != nil || target <= 0 {
+				return nil, fmt.Errorf("line %d: invalid target %q", line, value)
+			}
+			t.Target = target
+		case len(key) > 1 && (key[0] == 'R' || key[0] == 'r'):
+			number, err := strconv.Atoi(key[1:])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid round %q", line, key)
+			}
+			if t.Players == nil {
+				return nil, fmt.Errorf("line %d: players must be declared before the first round", line)
+			}
+			if err := t.addRoundLine(number, value, line); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		default:
+			return nil, fmt.Errorf("line %d: unknown entry %q", line, key)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if t.Players == nil {
+		return nil, fmt.Errorf("no players declared")
+	}
+	return t, nil
+}
+
+func parseTranscriptPlayers(value string) ([]string, error) {
+	var players []string
+	for _, name := range strings.Split(value, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("empty player name")
+		}
+		for _, other := range players {
+			if strings.EqualFold(other, name) {
+				return nil, fmt.Errorf("player %q is declared twice", name)
+			}
+		}
+		players = append(players, name)
+	}
+	if len(players) < 2 {
+		return nil, fmt.Errorf("a game needs at least 2 players")
+	}
+	return players, nil
+}
+
+// addRoundLine appends the moves of a round line, starting the round unless the line continues it.
+func (t *Transcript) addRoundLine(number int, value string, line int) error {
+	last := len(t.Rounds)
+	switch {
+	case last > 0 && t.Rounds[last-1].Number == number:
+	case number == last+1:
+		t.Rounds = append(t.Rounds, TranscriptRound{Number: number, Line: line})
+	default:
+		return fmt.Errorf("round R%d out of order (expected R%d)", number, last+1)
+	}
+	round := &t.Rounds[len(t.Rounds)-1]
+
+	for _, segment := range strings.Split(stripNotes(value), "/") {
+		segment = strings.TrimSpace(segment)
+		if segment == "" {
+			continue
+		}
+		player, rest, err := t.segmentPlayer(segment)
+		if err != nil {
+			return err
+		}
+		tokens := strings.Fields(rest)
+		if len(tokens) == 0 {
+			return fmt.Errorf("no moves for %s", player)
+		}
+		for _, token := range tokens {
+			move, err := t.parseMove(token)
+			if err != nil {
+				return fmt.Errorf("%s %q: %w", player, token, err)
+			}
+			move.Line = line
+			move.Player = player
+			round.Moves = append(round.Moves, move)
+		}
+	}
+	return nil
+}
+
+// stripNotes removes the text in parentheses.
+func stripNotes(s string) string {
+	var b strings.Builder
+	depth := 0
+	for _, r := range s {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case depth == 0:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// segmentPlayer splits a segment into the declared player it starts with and their moves.
+// The longest matching name wins, so "Bob Jr 7" is not read as Bob drawing "Jr".
+func (t *Transcript) segmentPlayer(segment string) (string, string, error) {
+	best := ""
+	for _, name := range t.Players {
+		if len(name) <= len(best) || len(segment) < len(name) || !strings.EqualFold(segment[:len(name)], name) {
+			continue
+		}
+		if len(segment) == len(name) || segment[len(name)] == ' ' || segment[len(name)] == '\t' {
+			best = name
+		}
+	}
+	if best == "" {
+		name, _, _ := strings.Cut(segment, " ")
+		return "", "", fmt.Errorf("unknown player %q", name)
+	}
+	return best, segment[len(best):], nil
+}
+
+// parseMove reads one move token; Line and Player are set by the caller.
+func (t *Transcript) parseMove(token string) (TranscriptMove, error) {
+	move := TranscriptMove{Token: token}
+	if strings.EqualFold(token, "S") {
+		move.Stay = true
+		return move, nil
+	}
+
+	cardToken, target, passed := strings.Cut(token, "->")
+	card, err := domain.ParseCardToken(cardToken)
+	if err != nil {
+		return move, err
+	}
+	move.Card = card
+
+	needsTarget := card.Type == domain.CardTypeAction && card.ActionType != domain.ActionSecondChance
+	switch {
+	case needsTarget && !passed:
+		return move, fmt.Errorf("%s needs a target, e.g. %s->%s", card, strings.ToUpper(cardToken), t.Players[0])
+	case passed && card.Type != domain.CardTypeAction:
+		return move, fmt.Errorf("only Freeze, Flip Three and Second Chance take a target")
+	case passed:
+		name, ok := t.player(target)
+		if !ok {
+			return move, fmt.Errorf("unknown player %q", target)
+		}
+		move.Target = name
+	}
+	return move, nil
+}
+
+// player returns the declared spelling of name.
+func (t *Transcript) player(name string) (string, bool) {
+	for _, p := range t.Players {
+		if strings.EqualFold(p, strings.TrimSpace(name)) {
+			return p, true
+		}
+	}
+	return "", false
+}
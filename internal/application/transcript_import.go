@@ -0,0 +1,471 @@
+package application
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/logger"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+// TranscriptIssue is a move of a transcript that the rules do not allow, or a round the
+// transcript leaves unfinished.
+type TranscriptIssue struct {
+	Line    int
+	Round   int
+	Move    string // The move as written, e.g. "Alice 9"; empty for a round
+	Problem string
+}
+
+func (i TranscriptIssue) String() string {
+	if i.Move == "" {
+		return fmt.Sprintf("line %d (round %d): %s", i.Line, i.Round, i.Problem)
+	}
+	return fmt.Sprintf("line %d (round %d): %s: %s", i.Line, i.Round, i.Move, i.Problem)
+}
+
+// ImportReport is the result of importing a transcript.
+type ImportReport struct {
+	GameID string
+	Game   *domain.Game // The game as the legal moves left it
+	Rounds int          // Rounds played
+	Moves  int          // Moves applied
+	Issues []TranscriptIssue
+}
+
+// Valid reports whether every move of the transcript was legal and every round was finished.
+func (r *ImportReport) Valid() bool {
+	return len(r.Issues) == 0
+}
+
+// ImportTranscript plays a parsed transcript through the rules, starting from a full deck,
+// and logs it with l (if not nil) the way manual mode logs a game, so that ReplayGame and
+// evaluate_logs can read it. Player IDs are derived from gameID and the names, so importing
+// the same transcript again produces the same log.
+//
+// Every move is checked against the state of the game: a card must still have a copy in
+// the deck (the discard pile is reshuffled when only it has one, as in manual mode), only
+// players still in the round draw or stay, nobody stays before flipping a card, action
+// targets must be in play, Second Chance is kept or passed as the rules say, a Flip Three
+// target draws their three cards before anything else happens, and nothing happens after a
+// Flip 7. Illegal moves are reported and skipped. A round that ends with players still in
+// play is reported; their hands score nothing. Rounds after the game is won are ignored.
+func ImportTranscript(t *Transcript, gameID string, l logger.GameLogger) *ImportReport {
+	players := make([]*domain.Player, len(t.Players))
+	imp := &transcriptImport{
+		gameID: gameID,
+		logger: l,
+		byName: make(map[string]*domain.Player, len(t.Players)),
+	}
+	for i, name := range t.Players {
+		p := domain.NewPlayer(name, nil)
+		p.ID = uuid.NewSHA1(uuid.NameSpaceOID, []byte(gameID+"/"+name))
+		players[i] = p
+		imp.byName[name] = p
+	}
+	imp.game = domain.NewGame(players)
+	if t.Target > 0 {
+		imp.game.WinningScore = t.Target
+	}
+	imp.game.Deck = domain.NewDeckInOrder(domain.StandardDeckCards())
+	imp.report = &ImportReport{GameID: gameID, Game: imp.game}
+
+	imp.log("system", "GameStart", map[string]interface{}{
+		"num_players":   len(players),
+		"players":       getPlayerNames(players),
+		"player_ids":    getPlayerIDs(players),
+		"winning_score": imp.game.TargetScore(),
+	})
+	for _, r := range t.Rounds {
+		if imp.game.IsCompleted {
+			imp.issue(r.Line, "", fmt.Sprintf("the game was already won in round %d; the rest is ignored", imp.round))
+			break
+		}
+		imp.playRound(r)
+	}
+
+	scores := make(map[string]int, len(players))
+	for _, p := range players {
+		scores[p.Name] = p.TotalScore
+	}
+	imp.log("system", "GameEnd", map[string]interface{}{
+		"winners":    getPlayerNames(imp.game.Winners),
+		"winner_ids": getPlayerIDs(imp.game.Winners),
+		"scores":     scores,
+		"standings":  standingsDetails(imp.game),
+	})
+	return imp.report
+}
+
+// transcriptImport is the state of an import in progress.
+type transcriptImport struct {
+	game   *domain.Game
+	byName map[string]*domain.Player
+	gameID string
+	logger logger.GameLogger
+	report *ImportReport
+	round  int
+
+	dealt      map[*domain.Player]bool // Players who flipped their first card of the round
+	flipThrees []*flipThreeDraw        // Flip Threes being drawn, innermost last
+	played     []domain.Card           // Freeze and Flip Three cards not yet discarded: some Flip Three is still being drawn
+}
+
+// flipThreeDraw is a Flip Three whose target is still drawing.
+type flipThreeDraw struct {
+	actor  *domain.Player
+	target *domain.Player
+	left   int              // Cards the target still has to draw
+	queued []TranscriptMove // Freeze and Flip Three drawn by the target, resolved after the three cards
+	after  []TranscriptMove // Actions of an outer Flip Three waiting for this one to finish
+}
+
+func (imp *transcriptImport) playRound(r TranscriptRound) {
+	g := imp.game
+	imp.round = r.Number
+	imp.report.Rounds++
+	dealer := g.Players[g.DealerIndex]
+	g.CurrentRound = domain.NewRound(g.Players, dealer, g.Deck)
+	imp.dealt = make(map[*domain.Player]bool)
+	imp.log("system", "RoundStart", map[string]interface{}{
+		"dealer": dealer.Name,
+	})
+
+	last := r.Line
+	for _, m := range r.Moves {
+		last = m.Line
+		if err := imp.apply(m); err != nil {
+			imp.issue(m.Line, m.String(), err.Error())
+			continue
+		}
+		imp.report.Moves++
+	}
+
+	round := g.CurrentRound
+	if len(imp.flipThrees) > 0 {
+		imp.interruptFlipThree(last, "")
+	}
+	imp.discardPlayed()
+	if !round.IsEnded {
+		if len(round.ActivePlayers) > 0 {
+			imp.issue(last, "", fmt.Sprintf("round ends with %s still in play; their hands score nothing",
+				strings.Join(getPlayerNames(round.ActivePlayers), ", ")))
+		}
+		round.End(domain.RoundEndReasonNoActivePlayers)
+	}
+
+	g.DiscardHands()
+	g.Deck = round.Deck
+	g.NextDealer()
+	if winners := g.DetermineWinners(); len(winners) > 0 {
+		g.Winners = winners
+		g.End(domain.GameEndReasonWinner)
+	}
+}
+
+// apply plays one move, or returns why it is not legal without changing anything.
+func (imp *transcriptImport) apply(m TranscriptMove) error {
+	round := imp.game.CurrentRound
+	p := imp.byName[m.Player]
+	if round.IsEnded {
+		return fmt.Errorf("the round is already over")
+	}
+	if top := imp.drawingFlipThree(); top != nil && (top.target != p || m.Stay) {
+		imp.interruptFlipThree(m.Line, m.String())
+	}
+	hand := p.CurrentHand
+	if hand.Status != domain.HandStatusActive {
+		return fmt.Errorf("%s is out of the round (%s)", p.Name, hand.Status)
+	}
+
+	if m.Stay {
+		if !hand.CanStay() {
+			return fmt.Errorf("cannot stay before flipping a card")
+		}
+		hand.Status = domain.HandStatusStayed
+		banked := p.BankCurrentHand()
+		round.RemoveActivePlayer(p)
+		imp.logBanked(p, "Stay", banked)
+		imp.endIfEmpty()
+		return nil
+	}
+
+	if err := imp.checkAction(p, m); err != nil {
+		return err
+	}
+	if err := imp.draw(m.Card); err != nil {
+		return err
+	}
+	eventType := "CardPlayed"
+	if !imp.dealt[p] {
+		eventType = "InitialDeal"
+		imp.dealt[p] = true
+	}
+	imp.log(p.ID.String(), eventType, map[string]interface{}{
+		"card": m.Card.String(),
+	})
+	hand.HasDrawnThisRound = true
+
+	drawing := imp.drawingFlipThree()
+	if drawing != nil && drawing.target != p {
+		drawing = nil
+	}
+	switch {
+	case m.Card.Type != domain.CardTypeAction:
+		imp.addCard(p, m.Card)
+	case m.Card.ActionType == domain.ActionSecondChance:
+		imp.secondChance(p, m)
+	default:
+		imp.played = append(imp.played, m.Card)
+		if drawing != nil {
+			drawing.queued = append(drawing.queued, m)
+		} else if err := imp.resolve(m, nil); err != nil {
+			return err // Not reached: checkAction validated the target
+		}
+	}
+	if drawing != nil {
+		drawing.left--
+	}
+	imp.settleFlipThrees()
+	if len(imp.flipThrees) == 0 {
+		imp.discardPlayed()
+	}
+	imp.endIfEmpty()
+	return nil
+}
+
+// discardPlayed puts the Freeze and Flip Three cards played so far on the discard pile. They
+// are discarded once resolved, as in manual mode, never kept in a hand.
+func (imp *transcriptImport) discardPlayed() {
+	imp.game.DiscardPile = append(imp.game.DiscardPile, imp.played...)
+	imp.played = nil
+}
+
+// checkAction validates the target of an action card before it is drawn. The target of an
+// action drawn during a Flip Three is checked when the action is resolved.
+func (imp *transcriptImport) checkAction(p *domain.Player, m TranscriptMove) error {
+	if m.Card.Type != domain.CardTypeAction {
+		return nil
+	}
+	if m.Card.ActionType != domain.ActionSecondChance {
+		if top := imp.drawingFlipThree(); top != nil && top.target == p {
+			return nil
+		}
+		return imp.checkInPlay(imp.byName[m.Target])
+	}
+
+	takers := imp.secondChanceTakers(p)
+	switch {
+	case m.Target == "" && p.CurrentHand.HasSecondChance() && len(takers) > 0:
+		return fmt.Errorf("%s already holds a Second Chance and must pass this one, e.g. C->%s", p.Name, takers[0].Name)
+	case m.Target == "":
+		return nil
+	case !p.CurrentHand.HasSecondChance():
+		return fmt.Errorf("a first Second Chance is kept, not passed")
+	case !containsPlayer(takers, imp.byName[m.Target]):
+		return fmt.Errorf("%s cannot take a Second Chance (must be another player in play without one)", m.Target)
+	}
+	return nil
+}
+
+// secondChanceTakers returns the players p may pass a Second Chance to.
+func (imp *transcriptImport) secondChanceTakers(p *domain.Player) []*domain.Player {
+	var takers []*domain.Player
+	for _, other := range imp.game.CurrentRound.ActivePlayers {
+		if other != p && !other.CurrentHand.HasSecondChance() {
+			takers = append(takers, other)
+		}
+	}
+	return takers
+}
+
+func (imp *transcriptImport) checkInPlay(p *domain.Player) error {
+	if !imp.game.CurrentRound.ContainsActive(p.ID) {
+		return fmt.Errorf("%s is not in play", p.Name)
+	}
+	return nil
+}
+
+// draw takes card out of the deck, reshuffling the discard pile into it when only the
+// discard pile has a copy left.
+func (imp *transcriptImport) draw(card domain.Card) error {
+	g := imp.game
+	round := g.CurrentRound
+	if removeCard(round.Deck, card) {
+		return nil
+	}
+	copies := countCopies(g, card)
+	if copies.discard == 0 {
+		return copies.unavailableError(card)
+	}
+
+	imp.log("system", "Reshuffle", map[string]interface{}{
+		"discard_count": len(g.DiscardPile),
+	})
+	deck := domain.NewDeckInOrder(append(g.DiscardPile, round.Deck.Cards...))
+	round.Reshuffle(deck)
+	g.Deck = deck
+	g.DiscardPile = nil
+	removeCard(deck, card)
+	return nil
+}
+
+// addCard adds a number or modifier card to p's hand and resolves a bust or Flip 7.
+func (imp *transcriptImport) addCard(p *domain.Player, card domain.Card) {
+	round := imp.game.CurrentRound
+	busted, flip7, discarded := p.CurrentHand.AddCard(card)
+	imp.game.DiscardPile = append(imp.game.DiscardPile, discarded...)
+	switch {
+	case busted:
+		round.RemoveActivePlayer(p)
+		imp.log(p.ID.String(), "Bust", map[string]interface{}{
+			"hand": console.FormatHand(p.CurrentHand),
+		})
+	case flip7:
+		p.CurrentHand.Status = domain.HandStatusStayed
+		banked := p.BankCurrentHand()
+		round.RemoveActivePlayer(p)
+		round.End(domain.RoundEndReasonFlip7)
+		imp.logBanked(p, "Flip7", banked)
+	}
+}
+
+// secondChance keeps, passes or discards a Second Chance drawn by p, as checkAction allowed.
+func (imp *transcriptImport) secondChance(p *domain.Player, m TranscriptMove) {
+	switch {
+	case m.Target != "":
+		target := imp.byName[m.Target]
+		if !target.CurrentHand.ReceiveSecondChance(m.Card) {
+			imp.game.DiscardPile = append(imp.game.DiscardPile, m.Card)
+		}
+		imp.log(p.ID.String(), "ActionTarget", map[string]interface{}{
+			"action": string(domain.ActionGiveSecondChance),
+			"target": target.Name,
+		})
+	case p.CurrentHand.HasSecondChance():
+		imp.game.DiscardPile = append(imp.game.DiscardPile, m.Card) // Nobody can take it
+	default:
+		p.CurrentHand.ActionCards = append(p.CurrentHand.ActionCards, m.Card)
+	}
+}
+
+// resolve applies a Freeze or starts a Flip Three on the target of m. after is the actions
+// that must wait until a Flip Three started here is over.
+func (imp *transcriptImport) resolve(m TranscriptMove, after []TranscriptMove) error {
+	actor, target := imp.byName[m.Player], imp.byName[m.Target]
+	if err := imp.checkInPlay(target); err != nil {
+		return err
+	}
+	imp.log(actor.ID.String(), "ActionTarget", map[string]interface{}{
+		"action": string(m.Card.ActionType),
+		"target": target.Name,
+	})
+
+	switch m.Card.ActionType {
+	case domain.ActionFreeze:
+		target.CurrentHand.Status = domain.HandStatusFrozen
+		banked := target.BankCurrentHand()
+		imp.game.CurrentRound.RemoveActivePlayer(target)
+		imp.logBanked(target, "Frozen", banked)
+		imp.logActionResolved(actor, target, domain.ActionFreeze)
+	case domain.ActionFlipThree:
+		imp.flipThrees = append(imp.flipThrees, &flipThreeDraw{actor: actor, target: target, left: 3, after: after})
+	}
+	return nil
+}
+
+// drawingFlipThree returns the Flip Three whose target must draw next, if any.
+func (imp *transcriptImport) drawingFlipThree() *flipThreeDraw {
+	if len(imp.flipThrees) == 0 {
+		return nil
+	}
+	return imp.flipThrees[len(imp.flipThrees)-1]
+}
+
+// settleFlipThrees finishes the Flip Threes whose target has drawn three cards or is out of
+// the round, then resolves the actions queued during them (unless the target busted).
+func (imp *transcriptImport) settleFlipThrees() {
+	round := imp.game.CurrentRound
+	for len(imp.flipThrees) > 0 {
+		if round.IsEnded {
+			for i := len(imp.flipThrees) - 1; i >= 0; i-- {
+				imp.logActionResolved(imp.flipThrees[i].actor, imp.flipThrees[i].target, domain.ActionFlipThree)
+			}
+			imp.flipThrees = nil
+			return
+		}
+		top := imp.drawingFlipThree()
+		if top.left > 0 && top.target.CurrentHand.Status == domain.HandStatusActive {
+			return
+		}
+		imp.flipThrees = imp.flipThrees[:len(imp.flipThrees)-1]
+		imp.logActionResolved(top.actor, top.target, domain.ActionFlipThree)
+
+		actions := top.after
+		if top.target.CurrentHand.Status != domain.HandStatusBusted {
+			actions = append(append([]TranscriptMove{}, top.queued...), top.after...)
+		}
+		for i, m := range actions {
+			if err := imp.resolve(m, actions[i+1:]); err != nil {
+				imp.issue(m.Line, m.String(), err.Error())
+				continue
+			}
+			if m.Card.ActionType == domain.ActionFlipThree {
+				break // The remaining actions wait for this Flip Three
+			}
+		}
+	}
+}
+
+// interruptFlipThree reports the Flip Three whose target stopped drawing before their three
+// cards and drops it, along with every action waiting for it.
+func (imp *transcriptImport) interruptFlipThree(line int, move string) {
+	top := imp.drawingFlipThree()
+	imp.issue(line, move, fmt.Sprintf("%s's Flip Three on %s was interrupted after %d of 3 cards",
+		top.actor.Name, top.target.Name, 3-top.left))
+	imp.flipThrees = nil
+}
+
+// endIfEmpty ends the round once nobody is left in play.
+func (imp *transcriptImport) endIfEmpty() {
+	round := imp.game.CurrentRound
+	if !round.IsEnded && len(round.ActivePlayers) == 0 {
+		round.End(domain.RoundEndReasonNoActivePlayers)
+	}
+}
+
+func (imp *transcriptImport) issue(line int, move, problem string) {
+	imp.report.Issues = append(imp.report.Issues, TranscriptIssue{
+		Line:    line,
+		Round:   imp.round,
+		Move:    move,
+		Problem: problem,
+	})
+}
+
+func (imp *transcriptImport) logActionResolved(actor, target *domain.Player, action domain.ActionType) {
+	outcome, backfired := actionOutcome(imp.game.CurrentRound, actor, target)
+	imp.log(actor.ID.String(), "ActionResolved", map[string]interface{}{
+		"action":    string(action),
+		"target":    target.Name,
+		"outcome":   outcome,
+		"backfired": backfired,
+	})
+}
+
+func (imp *transcriptImport) logBanked(p *domain.Player, eventType string, banked int) {
+	imp.log(p.ID.String(), eventType, map[string]interface{}{
+		"banked_score": banked,
+		"total_score":  p.TotalScore,
+	})
+}
+
+func (imp *transcriptImport) log(playerID, eventType string, details map[string]interface{}) {
+	if imp.logger != nil {
+		imp.logger.Log(imp.gameID, strconv.Itoa(imp.round), playerID, eventType, details)
+	}
+}
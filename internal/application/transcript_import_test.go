@@ -0,0 +1,116 @@
+package application_test
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/infrastructure/logging"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func parseTranscript(t *testing.T, text string) *application.Transcript {
+	t.Helper()
+	transcript, err := application.ParseTranscript(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	return transcript
+}
+
+func TestImportTranscript_SampleGameGolden(t *testing.T) {
+	text, err := os.ReadFile(filepath.Join("testdata", "transcript", "sample_game.txt"))
+	if err != nil {
+		t.Fatalf("Failed to read transcript: %v", err)
+	}
+	transcript := parseTranscript(t, string(text))
+
+	logPath := filepath.Join(t.TempDir(), "imported.csv")
+	csvLogger, err := logging.NewCSVLogger(logPath)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	csvLogger.Now = func() time.Time { return time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC) }
+	report := application.ImportTranscript(transcript, "sample_game", csvLogger)
+	csvLogger.Close()
+
+	if !report.Valid() {
+		t.Fatalf("Expected a valid transcript, got issues %v", report.Issues)
+	}
+	if report.Rounds != 4 || len(report.Game.Winners) != 1 || report.Game.Winners[0].Name != "Bob" {
+		t.Errorf("Expected Bob to win after 4 rounds, got %d rounds and winners %v", report.Rounds, report.Game.Winners)
+	}
+
+	got, err := os.ReadFile(logPath)
+	if err != nil {
+		t.Fatalf("Failed to read log: %v", err)
+	}
+	golden := filepath.Join("testdata", "transcript", "sample_game.csv")
+	if *update {
+		if err := os.WriteFile(golden, got, 0644); err != nil {
+			t.Fatalf("Failed to update golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("Failed to read golden file: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("Imported log differs from %s (run with -update to accept):\n%s", golden, got)
+	}
+
+	// The imported log is a game log like any other: the rules agree with every recorded score.
+	replay, err := application.ReplayGame(readLogFile(t, logPath), "")
+	if err != nil {
+		t.Fatalf("Replay failed: %v", err)
+	}
+	if !replay.Consistent() {
+		t.Errorf("Expected the imported log to replay cleanly, got %v", replay.Divergences)
+	}
+}
+
+func TestImportTranscript_ReportsImpossibleMoves(t *testing.T) {
+	transcript := parseTranscript(t, strings.Join([]string{
+		"players: Me, Bob, Cy",
+		"R1: Me 1 / Bob 1 / Bob S / Cy 5 / Me 5 5 / Me 6",
+		"R1: Cy F->Me / Cy T->Bob / Bob 3 / Cy 4 S",
+		"R2: Bob C / Cy C / Me C->Bob / Bob C",
+		"R2: Me 0 1 2 3 4 6 7 / Cy 9",
+	}, "\n"))
+
+	report := application.ImportTranscript(transcript, "bad", nil)
+
+	var got []string
+	for _, issue := range report.Issues {
+		got = append(got, issue.String())
+	}
+	expected := []string{
+		"line 2 (round 1): Bob 1: card not found in deck: all 1 copies of 1 are already accounted for (deck: 0, discard: 0, hands: 1)",
+		"line 2 (round 1): Bob S: cannot stay before flipping a card",
+		"line 2 (round 1): Me 6: Me is out of the round (busted)",
+		"line 3 (round 1): Cy F->Me: Me is not in play",
+		"line 3 (round 1): Cy 4: Cy's Flip Three on Bob was interrupted after 1 of 3 cards",
+		"line 3 (round 1): round ends with Bob still in play; their hands score nothing",
+		"line 4 (round 2): Me C->Bob: a first Second Chance is kept, not passed",
+		"line 4 (round 2): Bob C: Bob already holds a Second Chance and must pass this one, e.g. C->Me",
+		"line 5 (round 2): Cy 9: the round is already over",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Expected issues:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(got, "\n"))
+	}
+
+	// The legal moves still count: Cy banks 9 in round 1, Me completes a Flip 7 in round 2
+	// after the discarded 1 is reshuffled back into the deck.
+	scores := map[string]int{}
+	for _, p := range report.Game.Players {
+		scores[p.Name] = p.TotalScore
+	}
+	if scores["Cy"] != 9 || scores["Me"] != 38 || scores["Bob"] != 0 {
+		t.Errorf("Expected Me 38, Bob 0, Cy 9, got %v", scores)
+	}
+}
@@ -0,0 +1,79 @@
+package application_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+func TestParseTranscript(t *testing.T) {
+	text := strings.Join([]string{
+		"# Friday game",
+		"players: Me, Bob, Bob Jr",
+		"target: 150",
+		"",
+		"R1: Me 7 +4 (nice) / Bob Jr 9 T->bob   # Junior hits Bob",
+		"R1: Bob 3 x2 C->Me / Me s",
+	}, "\n")
+
+	transcript, err := application.ParseTranscript(strings.NewReader(text))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(transcript.Players, []string{"Me", "Bob", "Bob Jr"}) || transcript.Target != 150 {
+		t.Fatalf("Expected players Me, Bob, Bob Jr to 150, got %v to %d", transcript.Players, transcript.Target)
+	}
+	if len(transcript.Rounds) != 1 || transcript.Rounds[0].Number != 1 || transcript.Rounds[0].Line != 5 {
+		t.Fatalf("Expected a single round 1 starting on line 5, got %+v", transcript.Rounds)
+	}
+
+	var got []string
+	for _, m := range transcript.Rounds[0].Moves {
+		got = append(got, m.String())
+	}
+	expected := []string{"Me 7", "Me +4", "Bob Jr 9", "Bob Jr T->bob", "Bob 3", "Bob x2", "Bob C->Me", "Me s"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected moves %q, got %q", expected, got)
+	}
+
+	moves := transcript.Rounds[0].Moves
+	flipThree := moves[3]
+	if flipThree.Card.ActionType != domain.ActionFlipThree || flipThree.Target != "Bob" || flipThree.Line != 5 {
+		t.Errorf("Expected Flip Three on Bob from line 5, got %+v", flipThree)
+	}
+	if pass := moves[6]; pass.Card.ActionType != domain.ActionSecondChance || pass.Target != "Me" || pass.Line != 6 {
+		t.Errorf("Expected Second Chance passed to Me on line 6, got %+v", pass)
+	}
+	if !moves[7].Stay {
+		t.Errorf("Expected a stay, got %+v", moves[7])
+	}
+}
+
+func TestParseTranscript_SyntaxErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"no players", "R1: Me 7", "line 1: players must be declared"},
+		{"unknown player", "players: Me, Bob\nR1: Al 7", "line 2: unknown player \"Al\""},
+		{"unknown card", "players: Me, Bob\nR1: Me 13", "line 2: Me \"13\": number out of range"},
+		{"action without target", "players: Me, Bob\nR1: Me F", "line 2: Me \"F\": freeze needs a target, e.g. F->Me"},
+		{"unknown target", "players: Me, Bob\nR1: Me T->Al", "line 2: Me \"T->Al\": unknown player \"Al\""},
+		{"target on a number", "players: Me, Bob\nR1: Me 7->Bob", "only Freeze, Flip Three and Second Chance take a target"},
+		{"rounds out of order", "players: Me, Bob\nR1: Me 7\nR3: Me 8", "line 3: round R3 out of order (expected R2)"},
+		{"single player", "players: Me", "a game needs at least 2 players"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := application.ParseTranscript(strings.NewReader(tt.text))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
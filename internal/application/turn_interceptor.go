@@ -0,0 +1,40 @@
+package application
+
+import "flip7_strategy/internal/domain"
+
+// InterceptPoint is where in a turn a TurnInterceptor is called.
+type InterceptPoint string
+
+const (
+	InterceptBeforeDecision InterceptPoint = "before_decision" // Before the player's strategy decides to hit or stay
+	InterceptAfterDecision  InterceptPoint = "after_decision"  // After it decided, before the choice is acted on
+	InterceptBeforeDraw     InterceptPoint = "before_draw"     // Before a card is drawn for the player
+	InterceptAfterDraw      InterceptPoint = "after_draw"      // After the card is drawn, before it takes effect
+	InterceptTargetChosen   InterceptPoint = "target_chosen"   // After an action's target (the player) is chosen, before it is resolved
+)
+
+// Interception tells a TurnInterceptor where play is.
+type Interception struct {
+	Point  InterceptPoint
+	Player *domain.Player    // The player deciding or drawing, or the action's target
+	Source domain.DrawSource // Why the card is drawn; empty unless Point is a draw
+	Choice domain.TurnChoice // The strategy's choice at InterceptAfterDecision
+	Card   *domain.Card      // The drawn card at InterceptAfterDraw
+	Round  *domain.Round     // The round in play
+}
+
+// TurnInterceptor is a seam for robustness tests: GameService.Interceptor is called at every
+// InterceptPoint and may change the game in any way, e.g. empty the deck, reorder it or take a
+// player out of the round, to check that the engine copes with states that are rare or
+// impossible in normal play. The service goes on with whatever the interceptor leaves.
+type TurnInterceptor interface {
+	Intercept(game *domain.Game, at Interception)
+}
+
+// intercept calls the interceptor, if any.
+func (s *GameService) intercept(at Interception) {
+	if s.Interceptor != nil {
+		at.Round = s.Game.CurrentRound
+		s.Interceptor.Intercept(s.Game, at)
+	}
+}
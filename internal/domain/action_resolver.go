@@ -50,8 +50,8 @@ func (sch *SecondChanceHandler) HandleSecondChance(
 		return SecondChanceResult{ShouldDiscard: true}
 	}
 
-	// Select a target to give the card to (candidates are already filtered)
-	target := selector.SelectTarget(ActionGiveSecondChance, candidates, p)
+	// Select a target to give the card to (candidates are already filtered; in teams a teammate takes it first)
+	target := selector.SelectTarget(ActionGiveSecondChance, TeamTargets(ActionGiveSecondChance, candidates, p), p)
 	if target == nil {
 		// If no target selected, discard the card
 		return SecondChanceResult{ShouldDiscard: true}
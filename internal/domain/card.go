@@ -1,10 +1,12 @@
 package domain
 
 import (
-	"errors"
 	"fmt"
 	"math/rand"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 )
 
 // CardType represents the category of a card.
@@ -36,6 +38,23 @@ func (m ModifierType) IsAdditive() bool {
 	return m == ModifierPlus2 || m == ModifierPlus4 || m == ModifierPlus6 || m == ModifierPlus8 || m == ModifierPlus10
 }
 
+// Points returns the points an additive modifier adds (0 for x2).
+func (m ModifierType) Points() int {
+	switch m {
+	case ModifierPlus2:
+		return 2
+	case ModifierPlus4:
+		return 4
+	case ModifierPlus6:
+		return 6
+	case ModifierPlus8:
+		return 8
+	case ModifierPlus10:
+		return 10
+	}
+	return 0
+}
+
 // ActionType represents the type of action card.
 type ActionType string
 
@@ -70,6 +89,88 @@ func (c Card) String() string {
 	}
 }
 
+// ParseCard is the inverse of Card.String: it reads "7", "plus_4", "multiply_2" or "freeze".
+func ParseCard(s string) (Card, error) {
+	if v, err := strconv.Atoi(s); err == nil {
+		if v < 0 || v > 12 {
+			return Card{}, fmt.Errorf("number card out of range: %d", v)
+		}
+		return Card{Type: CardTypeNumber, Value: NumberValue(v)}, nil
+	}
+	switch m := ModifierType(s); m {
+	case ModifierPlus2, ModifierPlus4, ModifierPlus6, ModifierPlus8, ModifierPlus10, ModifierX2:
+		return Card{Type: CardTypeModifier, ModifierType: m}, nil
+	}
+	switch a := ActionType(s); a {
+	case ActionFreeze, ActionFlipThree, ActionSecondChance:
+		return Card{Type: CardTypeAction, ActionType: a}, nil
+	}
+	return Card{}, fmt.Errorf("unknown card %q", s)
+}
+
+// ParseCardToken reads the short notation typed at the table: "0"-"12", "+2"-"+10",
+// "x2" (or "*2"), "F" (Freeze), "T" (Flip Three) and "C" (Second Chance), in any case.
+// The token is read through NormalizeToken, so "５", "＋４" or "×2" work too.
+func ParseCardToken(token string) (Card, error) {
+	token = strings.ToUpper(NormalizeToken(token))
+
+	// Modifiers
+	switch token {
+	case "+2":
+		return Card{Type: CardTypeModifier, ModifierType: ModifierPlus2}, nil
+	case "+4":
+		return Card{Type: CardTypeModifier, ModifierType: ModifierPlus4}, nil
+	case "+6":
+		return Card{Type: CardTypeModifier, ModifierType: ModifierPlus6}, nil
+	case "+8":
+		return Card{Type: CardTypeModifier, ModifierType: ModifierPlus8}, nil
+	case "+10":
+		return Card{Type: CardTypeModifier, ModifierType: ModifierPlus10}, nil
+	case "X2", "*2":
+		return Card{Type: CardTypeModifier, ModifierType: ModifierX2}, nil
+	}
+
+	// Actions
+	switch token {
+	case "F":
+		return Card{Type: CardTypeAction, ActionType: ActionFreeze}, nil
+	case "T":
+		return Card{Type: CardTypeAction, ActionType: ActionFlipThree}, nil
+	case "C":
+		return Card{Type: CardTypeAction, ActionType: ActionSecondChance}, nil
+	}
+
+	// Numbers ("+3" is a mistyped modifier, not a 3)
+	if val, err := strconv.Atoi(token); err == nil && !strings.HasPrefix(token, "+") {
+		if val >= 0 && val <= 12 {
+			return Card{Type: CardTypeNumber, Value: NumberValue(val)}, nil
+		}
+		return Card{}, fmt.Errorf("number out of range")
+	}
+
+	return Card{}, fmt.Errorf("unknown input")
+}
+
+// ParseDeckOrder reads a deck order written as card tokens (see ParseCardToken)
+// separated by commas or whitespace, e.g. "7,12,+4,F,3,x2,C". The first token is dealt first.
+func ParseDeckOrder(text string) ([]Card, error) {
+	tokens := strings.FieldsFunc(text, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	})
+	cards := make([]Card, 0, len(tokens))
+	for i, token := range tokens {
+		card, err := ParseCardToken(token)
+		if err != nil {
+			return nil, fmt.Errorf("card %d (%q): %w", i+1, token, err)
+		}
+		cards = append(cards, card)
+	}
+	if len(cards) == 0 {
+		return nil, fmt.Errorf("no cards in deck order")
+	}
+	return cards, nil
+}
+
 // Deck represents the deck of cards.
 type Deck struct {
 	Cards           []Card              `json:"cards"`
@@ -78,8 +179,14 @@ type Deck struct {
 
 // NewDeck creates a new shuffled deck.
 func NewDeck() *Deck {
+	d := NewDeckInOrder(StandardDeckCards())
+	d.Shuffle()
+	return d
+}
+
+// StandardDeckCards returns every card of a full Flip 7 deck, unshuffled.
+func StandardDeckCards() []Card {
 	cards := []Card{}
-	counts := make(map[NumberValue]int)
 
 	// Add Number cards: 0 (1 copy), 1 (1 copy), ..., 12 (12 copies)
 	// Wait, the rules say: "0-12 pts". Usually in these games, the count matches the number?
@@ -96,32 +203,24 @@ func NewDeck() *Deck {
 		}
 
 		val := NumberValue(i)
-		counts[val] = count
 		for j := 0; j < count; j++ {
 			cards = append(cards, Card{Type: CardTypeNumber, Value: val})
 		}
 	}
 
 	// Add Modifiers: 1x each
-	modifiers := []ModifierType{ModifierPlus2, ModifierPlus4, ModifierPlus6, ModifierPlus8, ModifierPlus10, ModifierX2}
-	for _, mod := range modifiers {
+	for _, mod := range AllModifierTypes {
 		cards = append(cards, Card{Type: CardTypeModifier, ModifierType: mod})
 	}
 
 	// Add Actions: 3x each
-	actions := []ActionType{ActionFreeze, ActionFlipThree, ActionSecondChance}
-	for _, act := range actions {
+	for _, act := range AllActionTypes {
 		for j := 0; j < 3; j++ {
 			cards = append(cards, Card{Type: CardTypeAction, ActionType: act})
 		}
 	}
 
-	d := &Deck{
-		Cards:           cards,
-		RemainingCounts: counts,
-	}
-	d.Shuffle()
-	return d
+	return cards
 }
 
 // Shuffle randomizes the deck order.
@@ -135,7 +234,7 @@ func (d *Deck) Shuffle() {
 // Draw removes the top card from the deck.
 func (d *Deck) Draw() (Card, error) {
 	if len(d.Cards) == 0 {
-		return Card{}, errors.New("deck is empty")
+		return Card{}, ErrDeckEmpty
 	}
 	card := d.Cards[0]
 	d.Cards = d.Cards[1:]
@@ -169,6 +268,14 @@ func (d *Deck) EstimateHitRisk(handNumbers map[NumberValue]struct{}, hasSecondCh
 
 // NewDeckFromCards creates a new deck from a list of cards (e.g., discard pile).
 func NewDeckFromCards(cards []Card) *Deck {
+	d := NewDeckInOrder(cards)
+	d.Shuffle()
+	return d
+}
+
+// NewDeckInOrder creates a deck that deals cards exactly in the given order (no shuffle).
+// It is meant for deterministic tests and replays.
+func NewDeckInOrder(cards []Card) *Deck {
 	counts := make(map[NumberValue]int)
 	for _, c := range cards {
 		if c.Type == CardTypeNumber {
@@ -176,80 +283,356 @@ func NewDeckFromCards(cards []Card) *Deck {
 		}
 	}
 
-	d := &Deck{
+	return &Deck{
 		Cards:           cards,
 		RemainingCounts: counts,
 	}
-	d.Shuffle()
-	return d
 }
 
+const (
+	// FlipThreeRiskTrials is the default number of Monte Carlo trials used by EstimateFlipThreeRisk.
+	FlipThreeRiskTrials = 1000
+	// FlipThreeExactMaxCards is the largest deck for which EstimateFlipThreeRisk enumerates every
+	// ordered draw exactly instead of sampling (15 cards -> 2730 ordered triples).
+	FlipThreeExactMaxCards = 15
+)
+
 // EstimateFlipThreeRisk calculates the probability of busting when drawing 3 cards.
-// It uses a Monte Carlo simulation.
+// It uses a Monte Carlo simulation with FlipThreeRiskTrials trials, or exact enumeration
+// when the deck has at most FlipThreeExactMaxCards cards.
 func (d *Deck) EstimateFlipThreeRisk(handNumbers map[NumberValue]struct{}, hasSecondChance bool) float64 {
-	if len(d.Cards) == 0 {
+	return d.EstimateFlipThreeRiskWithTrials(handNumbers, hasSecondChance, FlipThreeRiskTrials)
+}
+
+// EstimateFlipThreeRiskWithTrials is EstimateFlipThreeRisk with a configurable trial count.
+// trials <= 0 uses FlipThreeRiskTrials. Small decks are always enumerated exactly.
+func (d *Deck) EstimateFlipThreeRiskWithTrials(handNumbers map[NumberValue]struct{}, hasSecondChance bool, trials int) float64 {
+	if trials <= 0 {
+		trials = FlipThreeRiskTrials
+	}
+	return d.drawRisk(handNumbers, hasSecondChance, FlipThreeCardCount, trials)
+}
+
+// EstimateFlipThreeRiskWithDiscards is EstimateFlipThreeRiskWithTrials for a deck that may run
+// out during the three draws: the whole deck is drawn, then the rest come from discards, the
+// discard pile the game reshuffles into a new deck. With three cards left or more, or nothing
+// to reshuffle, it is EstimateFlipThreeRiskWithTrials. Every order is enumerated exactly when
+// discards has at most FlipThreeExactMaxCards cards; otherwise trials random draws are played.
+func (d *Deck) EstimateFlipThreeRiskWithDiscards(handNumbers map[NumberValue]struct{}, hasSecondChance bool, discards []Card, trials int) float64 {
+	fromDeck := len(d.Cards)
+	fromDiscards := FlipThreeCardCount - fromDeck
+	if fromDiscards <= 0 || len(discards) == 0 {
+		return d.EstimateFlipThreeRiskWithTrials(handNumbers, hasSecondChance, trials)
+	}
+	if trials <= 0 {
+		trials = FlipThreeRiskTrials
+	}
+	if fromDiscards > len(discards) {
+		fromDiscards = len(discards)
+	}
+
+	inHand := handNumberArray(handNumbers)
+	draws := make([]Card, fromDeck+fromDiscards)
+	busts, total := 0, 0
+	if len(discards) <= FlipThreeExactMaxCards {
+		walkDraws(d.Cards, draws[:fromDeck], func() {
+			walkDraws(discards, draws[fromDeck:], func() {
+				total++
+				if bustsOnDraws(draws, inHand, hasSecondChance) {
+					busts++
+				}
+			})
+		})
+		return float64(busts) / float64(total)
+	}
+
+	deckPerm, discardPerm := identityPerm(fromDeck), identityPerm(len(discards))
+	for i := 0; i < trials; i++ {
+		sampleDraws(d.Cards, deckPerm, draws[:fromDeck])
+		sampleDraws(discards, discardPerm, draws[fromDeck:])
+		if bustsOnDraws(draws, inHand, hasSecondChance) {
+			busts++
+		}
+	}
+	return float64(busts) / float64(trials)
+}
+
+const (
+	// MultiHitRiskTrials is the number of Monte Carlo trials used by EstimateMultiHitRisk.
+	MultiHitRiskTrials = 1000
+	// MultiHitExactMaxHits is the most hits EstimateMultiHitRisk enumerates exactly, on decks of
+	// at most FlipThreeExactMaxCards cards.
+	MultiHitExactMaxHits = 3
+)
+
+// EstimateMultiHitRisk calculates the probability of busting at some point within the next hits
+// draws, as when planning to take that many more cards and stay: each safe draw grows the hand,
+// and a Second Chance absorbs one duplicate (a Second Chance drawn meanwhile protects again). A
+// deck with fewer cards is drawn out. One hit is EstimateHitRisk; up to MultiHitExactMaxHits on
+// a deck of at most FlipThreeExactMaxCards cards are enumerated exactly, and the rest use a
+// Monte Carlo simulation with MultiHitRiskTrials trials.
+func (d *Deck) EstimateMultiHitRisk(handNumbers map[NumberValue]struct{}, hasSecondChance bool, hits int) float64 {
+	if hits <= 0 {
+		return 0
+	}
+	if hits == 1 {
+		return d.EstimateHitRisk(handNumbers, hasSecondChance)
+	}
+	return d.drawRisk(handNumbers, hasSecondChance, hits, MultiHitRiskTrials)
+}
+
+// drawRisk is the probability that drawing hits cards in a row (or the whole deck, if smaller)
+// busts a hand holding handNumbers. Up to MultiHitExactMaxHits draws from a deck of at most
+// FlipThreeExactMaxCards cards are enumerated exactly; otherwise trials random draws are played.
+func (d *Deck) drawRisk(handNumbers map[NumberValue]struct{}, hasSecondChance bool, hits, trials int) float64 {
+	deckSize := len(d.Cards)
+	if deckSize == 0 {
 		return 0
 	}
 
-	trials := 1000
-	busts := 0
+	drawCount := hits
+	if deckSize < drawCount {
+		drawCount = deckSize
+	}
 
+	inHand := handNumberArray(handNumbers)
+	draws := make([]Card, drawCount)
+	if deckSize <= FlipThreeExactMaxCards && drawCount <= MultiHitExactMaxHits {
+		return d.exactDrawRisk(inHand, hasSecondChance, draws)
+	}
+
+	// Scratch permutation buffer, reused across trials.
+	perm := identityPerm(deckSize)
+	busts := 0
 	for i := 0; i < trials; i++ {
-		// Clone deck state (simplified: just shuffle indices)
-		// Actually, we need to simulate drawing 3 cards from the current remaining cards.
-		// Since we don't want to modify the actual deck, we can just pick 3 random indices
-		// from the remaining cards. Note: drawing changes probabilities for subsequent draws.
-		// So we should shuffle a temporary slice of indices or just pick 3 unique indices.
-
-		// Optimization: If deck has < 3 cards, we just draw all of them.
-		// But for simulation, let's assume we can always draw (or deck reshuffles, but we only know current deck).
-		// If deck < 3, probability is deterministic based on those cards.
-		// Let's just simulate drawing up to 3 cards from the current deck.
-
-		deckSize := len(d.Cards)
-		drawCount := 3
-		if deckSize < 3 {
-			drawCount = deckSize
-		}
-
-		// Create a permutation of indices to simulate a shuffle
-		perm := Perm(deckSize)
-
-		// Simulation state
-		currentHand := make(map[NumberValue]struct{})
-		for k := range handNumbers {
-			currentHand[k] = struct{}{}
-		}
-		simHasSecondChance := hasSecondChance
-		busted := false
-
-		for j := 0; j < drawCount; j++ {
-			cardIdx := perm[j]
-			card := d.Cards[cardIdx]
-
-			if card.Type == CardTypeNumber {
-				if _, exists := currentHand[card.Value]; exists {
-					if simHasSecondChance {
-						simHasSecondChance = false
-						// Discard the duplicate and the second chance.
-						// The duplicate is NOT added to hand.
-					} else {
-						busted = true
-						break
-					}
-				} else {
-					currentHand[card.Value] = struct{}{}
+		sampleDraws(d.Cards, perm, draws)
+		if bustsOnDraws(draws, inHand, hasSecondChance) {
+			busts++
+		}
+	}
+
+	return float64(busts) / float64(trials)
+}
+
+// handNumberArray returns handNumbers as a fixed-size array: unlike a map, it keeps the
+// simulated hand allocation-free.
+func handNumberArray(handNumbers map[NumberValue]struct{}) [13]bool {
+	var inHand [13]bool
+	for v := range handNumbers {
+		if v >= 0 && int(v) < len(inHand) {
+			inHand[v] = true
+		}
+	}
+	return inHand
+}
+
+// identityPerm returns the permutation 0, 1, ..., n-1.
+func identityPerm(n int) []int {
+	perm := make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	return perm
+}
+
+// sampleDraws fills draws with a uniformly random ordered draw of distinct cards: a partial
+// Fisher-Yates shuffle of the first len(draws) positions of perm, a permutation of the indexes
+// of cards that is reused across calls.
+func sampleDraws(cards []Card, perm []int, draws []Card) {
+	for j := range draws {
+		k := j + GetRandomInt(len(cards)-j)
+		perm[j], perm[k] = perm[k], perm[j]
+		draws[j] = cards[perm[j]]
+	}
+}
+
+// exactDrawRisk enumerates every ordered draw of len(draws) distinct cards, using draws as
+// scratch space. Only used for small decks (see walkDraws).
+func (d *Deck) exactDrawRisk(inHand [13]bool, hasSecondChance bool, draws []Card) float64 {
+	busts, total := 0, 0
+	walkDraws(d.Cards, draws, func() {
+		total++
+		if bustsOnDraws(draws, inHand, hasSecondChance) {
+			busts++
+		}
+	})
+	return float64(busts) / float64(total)
+}
+
+// walkDraws calls visit once per ordered draw of len(draws) distinct cards of cards, with the
+// draw in draws. The used-card bitmask supports up to 64 cards.
+func walkDraws(cards []Card, draws []Card, visit func()) {
+	var walk func(depth int, used uint64)
+	walk = func(depth int, used uint64) {
+		if depth == len(draws) {
+			visit()
+			return
+		}
+		for i, card := range cards {
+			if used&(1<<uint(i)) != 0 {
+				continue
+			}
+			draws[depth] = card
+			walk(depth+1, used|1<<uint(i))
+		}
+	}
+	walk(0, 0)
+}
+
+// bustsOnDraws reports whether drawing the cards in order busts a hand holding the numbers in inHand.
+// inHand is passed by value, so the caller's copy is not modified.
+func bustsOnDraws(draws []Card, inHand [13]bool, hasSecondChance bool) bool {
+	for _, card := range draws {
+		if card.Type == CardTypeNumber {
+			if int(card.Value) < 0 || int(card.Value) >= len(inHand) {
+				continue
+			}
+			if inHand[card.Value] {
+				if hasSecondChance {
+					// Discard the duplicate and the second chance.
+					// The duplicate is NOT added to hand.
+					hasSecondChance = false
+					continue
 				}
-			} else if card.Type == CardTypeAction && card.ActionType == ActionSecondChance {
-				simHasSecondChance = true
+				return true
 			}
-			// Modifiers and other actions don't cause bust directly (FlipThree/Freeze are queued, not resolved in this risk calc)
+			inHand[card.Value] = true
+		} else if card.Type == CardTypeAction && card.ActionType == ActionSecondChance {
+			// Same rules as PlayerHand.AddCard: a new Second Chance protects again after one was used,
+			// and one drawn while holding another is passed on, so they never stack.
+			hasSecondChance = true
 		}
+		// Modifiers and other actions don't cause bust directly (FlipThree/Freeze are queued, not resolved in this risk calc)
+	}
+	return false
+}
 
-		if busted {
-			busts++
+// flipThreeHand is the part of a hand that decides the outcome of forced draws.
+type flipThreeHand struct {
+	inHand          [13]bool
+	unique          int
+	sum             int
+	multiplier      int
+	additive        int
+	hasSecondChance bool
+}
+
+// score is the hand's score with the Flip 7 bonus if flip7 (see ScoreCalculator).
+func (h flipThreeHand) score(flip7 bool) int {
+	total := h.sum*h.multiplier + h.additive
+	if flip7 {
+		total += 15
+	}
+	return total
+}
+
+// EstimateFlipThreeEV calculates the expected change in the score of a hand holding the given
+// numbers and modifier cards when it is forced to draw 3 cards: what it gains from the cards it
+// draws, or loses if it busts (its whole score). A Flip 7 stops the draws and earns the bonus.
+// Freeze and Flip Three drawn meanwhile are resolved later and do not count.
+//
+// Cards of a kind are interchangeable, so every ordered draw is enumerated exactly by kind,
+// weighted by how many of each kind are left. The estimate is deterministic: hands with the
+// same cards get the same value.
+func (d *Deck) EstimateFlipThreeEV(handNumbers map[NumberValue]struct{}, modifiers []Card, hasSecondChance bool) float64 {
+	if len(d.Cards) == 0 {
+		return 0
+	}
+
+	start := flipThreeHand{multiplier: 1, hasSecondChance: hasSecondChance}
+	for v := range handNumbers {
+		if v >= 0 && int(v) < len(start.inHand) {
+			start.inHand[v] = true
+			start.unique++
+			start.sum += int(v)
+		}
+	}
+	for _, m := range modifiers {
+		if m.ModifierType == ModifierX2 {
+			start.multiplier *= 2
+		}
+		start.additive += m.ModifierType.Points()
+	}
+	current := start.score(false)
+
+	// Kinds in order of first appearance, so the sum is always taken in the same order.
+	var kinds []Card
+	counts := make(map[Card]int)
+	for _, c := range d.Cards {
+		if counts[c] == 0 {
+			kinds = append(kinds, c)
 		}
+		counts[c]++
 	}
 
-	return float64(busts) / float64(trials)
+	drawCount := min(FlipThreeCardCount, len(d.Cards))
+	var walk func(h flipThreeHand, depth int, left int) float64
+	walk = func(h flipThreeHand, depth int, left int) float64 {
+		if depth == drawCount {
+			return float64(h.score(false) - current)
+		}
+		ev := 0.0
+		for _, card := range kinds {
+			n := counts[card]
+			if n == 0 {
+				continue
+			}
+			p := float64(n) / float64(left)
+
+			next := h
+			switch card.Type {
+			case CardTypeNumber:
+				switch {
+				case !next.inHand[card.Value]:
+					next.inHand[card.Value] = true
+					next.unique++
+					next.sum += int(card.Value)
+					if next.unique >= 7 {
+						ev += p * float64(next.score(true)-current)
+						continue
+					}
+				case next.hasSecondChance:
+					next.hasSecondChance = false // The duplicate and the Second Chance are discarded
+				default:
+					ev += p * float64(-current)
+					continue
+				}
+			case CardTypeModifier:
+				if card.ModifierType == ModifierX2 {
+					next.multiplier *= 2
+				}
+				next.additive += card.ModifierType.Points()
+			case CardTypeAction:
+				if card.ActionType == ActionSecondChance {
+					next.hasSecondChance = true
+				}
+			}
+
+			counts[card]--
+			ev += p * walk(next, depth+1, left-1)
+			counts[card]++
+		}
+		return ev
+	}
+	return walk(start, 0, len(d.Cards))
+}
+
+// NormalizeToken cleans up an answer typed or pasted at a prompt before it is parsed: it drops
+// UTF-8 byte order marks and the spaces around it (the \r of a CRLF line ending included), and
+// turns full-width characters ("５", "＋", "Ｓ", the ideographic space) and the multiplication sign
+// "×" into their ASCII counterparts. Case is kept.
+func NormalizeToken(token string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		switch {
+		case r == '\uFEFF':
+			return -1
+		case r >= '！' && r <= '～': // Full-width forms of ASCII '!' to '~'
+			return r - '！' + '!'
+		case r == '\u3000':
+			return ' '
+		case r == '×':
+			return 'x'
+		}
+		return r
+	}, token))
 }
@@ -1,6 +1,8 @@
 package domain_test
 
 import (
+	"errors"
+	"math"
 	"testing"
 
 	"flip7_strategy/internal/domain"
@@ -83,3 +85,403 @@ func TestEstimateFlipThreeRisk(t *testing.T) {
 		}
 	})
 }
+
+func TestEstimateFlipThreeRisk_ExactAndSampled(t *testing.T) {
+	number := func(v int) domain.Card {
+		return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+	}
+	plus2 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus2}
+	hand := map[domain.NumberValue]struct{}{5: {}}
+
+	t.Run("Small deck is enumerated exactly", func(t *testing.T) {
+		// Bust iff the 5 is among the first 3 of 4 cards: exactly 3/4.
+		deck := domain.NewDeckFromCards([]domain.Card{number(5), number(1), number(2), number(3)})
+		if risk := deck.EstimateFlipThreeRisk(hand, false); risk != 0.75 {
+			t.Errorf("Expected exactly 0.75, got %f", risk)
+		}
+		if risk := deck.EstimateFlipThreeRisk(hand, true); risk != 0 {
+			t.Errorf("Expected exactly 0 with Second Chance, got %f", risk)
+		}
+	})
+
+	t.Run("Guaranteed outcomes are exact", func(t *testing.T) {
+		bust := domain.NewDeckFromCards([]domain.Card{number(5), number(5), number(5)})
+		if risk := bust.EstimateFlipThreeRisk(hand, false); risk != 1.0 {
+			t.Errorf("Expected exactly 1.0, got %f", risk)
+		}
+		safe := domain.NewDeckFromCards([]domain.Card{number(1), number(2), number(3)})
+		if risk := safe.EstimateFlipThreeRisk(hand, false); risk != 0.0 {
+			t.Errorf("Expected exactly 0.0, got %f", risk)
+		}
+	})
+
+	t.Run("Newly drawn Second Chance protects again", func(t *testing.T) {
+		// Holding a Second Chance, two 5s are only survived if the new Second Chance arrives
+		// between them: drawn first it cannot stack (at most one in hand), drawn last it is too late.
+		secondChance := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}
+		deck := domain.NewDeckFromCards([]domain.Card{number(5), number(5), secondChance})
+		if risk := deck.EstimateFlipThreeRisk(hand, true); math.Abs(risk-2.0/3.0) > 1e-9 {
+			t.Errorf("Expected exactly 2/3, got %f", risk)
+		}
+	})
+
+	t.Run("Large deck is sampled", func(t *testing.T) {
+		// 4 fives and 16 modifiers: P(bust) = 1 - C(16,3)/C(20,3) = 1 - 560/1140
+		cards := []domain.Card{number(5), number(5), number(5), number(5)}
+		for i := 0; i < 16; i++ {
+			cards = append(cards, plus2)
+		}
+		deck := domain.NewDeckFromCards(cards)
+		expected := 1 - 560.0/1140.0
+
+		risk := deck.EstimateFlipThreeRiskWithTrials(hand, false, 20000)
+		if risk < expected-0.03 || risk > expected+0.03 {
+			t.Errorf("Expected risk ~%f, got %f", expected, risk)
+		}
+	})
+}
+
+func TestEstimateFlipThreeRiskWithDiscards(t *testing.T) {
+	number := func(v int) domain.Card {
+		return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+	}
+	repeat := func(c domain.Card, n int) []domain.Card {
+		cards := make([]domain.Card, n)
+		for i := range cards {
+			cards[i] = c
+		}
+		return cards
+	}
+	hand := map[domain.NumberValue]struct{}{5: {}, 7: {}}
+	safe := domain.NewDeckFromCards([]domain.Card{number(1), number(2)})
+
+	t.Run("Draws past the reshuffle come from the discards", func(t *testing.T) {
+		// Two safe cards are left, then the third is a 5 or a 7 from the reshuffled pile.
+		discards := append(repeat(number(5), 6), repeat(number(7), 6)...)
+		if risk := safe.EstimateFlipThreeRisk(hand, false); risk != 0 {
+			t.Fatalf("Expected the deck alone to look safe, got %f", risk)
+		}
+		if risk := safe.EstimateFlipThreeRiskWithDiscards(hand, false, discards, 0); risk != 1 {
+			t.Errorf("Expected exactly 1.0 with the reshuffle, got %f", risk)
+		}
+		if risk := safe.EstimateFlipThreeRiskWithDiscards(hand, true, discards, 0); risk != 0 {
+			t.Errorf("Expected exactly 0 with Second Chance, got %f", risk)
+		}
+
+		var view domain.DeckView = domain.NewReshuffleView(safe, discards)
+		if risk := view.EstimateFlipThreeRisk(hand, false); risk != 1 {
+			t.Errorf("Expected the view to see the reshuffle, got %f", risk)
+		}
+		if got := view.Remaining(); got != 2 {
+			t.Errorf("Expected the view to count the deck alone, got %d", got)
+		}
+	})
+
+	t.Run("Small discard pile is enumerated exactly", func(t *testing.T) {
+		// One card of the deck is left; two of the three discards are safe.
+		deck := domain.NewDeckFromCards([]domain.Card{number(1)})
+		discards := []domain.Card{number(5), number(2), number(3)}
+		if risk := deck.EstimateFlipThreeRiskWithDiscards(hand, false, discards, 0); math.Abs(risk-2.0/3.0) > 1e-9 {
+			t.Errorf("Expected exactly 2/3 (the 5 is among 2 of 3 cards), got %f", risk)
+		}
+		empty := domain.NewDeckFromCards(nil)
+		if risk := empty.EstimateFlipThreeRiskWithDiscards(hand, false, discards, 0); risk != 1 {
+			t.Errorf("Expected an empty deck to draw all three from the discards, got %f", risk)
+		}
+	})
+
+	t.Run("Large discard pile is sampled", func(t *testing.T) {
+		discards := append(repeat(number(5), 20), repeat(number(3), 20)...)
+		risk := safe.EstimateFlipThreeRiskWithDiscards(hand, false, discards, 20000)
+		if risk < 0.47 || risk > 0.53 {
+			t.Errorf("Expected risk ~0.5, got %f", risk)
+		}
+	})
+
+	t.Run("Deck of three cards or more ignores the discards", func(t *testing.T) {
+		deck := domain.NewDeckFromCards([]domain.Card{number(1), number(2), number(3)})
+		if risk := deck.EstimateFlipThreeRiskWithDiscards(hand, false, repeat(number(5), 10), 0); risk != 0 {
+			t.Errorf("Expected exactly 0, got %f", risk)
+		}
+	})
+}
+
+func TestEstimateMultiHitRisk(t *testing.T) {
+	number := func(v int) domain.Card {
+		return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+	}
+	hand := map[domain.NumberValue]struct{}{5: {}}
+
+	t.Run("Deck of duplicates busts on the first hit", func(t *testing.T) {
+		deck := domain.NewDeckFromCards([]domain.Card{number(5), number(5), number(5)})
+		for hits := 1; hits <= 3; hits++ {
+			if risk := deck.EstimateMultiHitRisk(hand, false, hits); risk != 1.0 {
+				t.Errorf("Expected exactly 1.0 within %d hits, got %f", hits, risk)
+			}
+		}
+	})
+
+	t.Run("Deck of safe cards never busts", func(t *testing.T) {
+		// Every number once (but the 5 in hand), every modifier, Freeze and Flip Three: nothing
+		// can duplicate, on the enumerated small deck and the sampled large one alike.
+		small := domain.NewDeckFromCards([]domain.Card{number(1), number(2), number(3), number(4)})
+		large := []domain.Card{}
+		for v := 0; v <= 12; v++ {
+			if v != 5 {
+				large = append(large, number(v))
+			}
+		}
+		for _, m := range domain.AllModifierTypes {
+			large = append(large, domain.Card{Type: domain.CardTypeModifier, ModifierType: m})
+		}
+		for _, a := range []domain.ActionType{domain.ActionFreeze, domain.ActionFlipThree} {
+			large = append(large, domain.Card{Type: domain.CardTypeAction, ActionType: a})
+		}
+		for name, deck := range map[string]*domain.Deck{"small": small, "large": domain.NewDeckFromCards(large)} {
+			for hits := 1; hits <= 6; hits++ {
+				if risk := deck.EstimateMultiHitRisk(hand, false, hits); risk != 0 {
+					t.Errorf("Expected exactly 0 within %d hits on the %s deck, got %f", hits, name, risk)
+				}
+			}
+		}
+	})
+
+	t.Run("Safe draws grow the hand", func(t *testing.T) {
+		// 1 and 1 with the 5 in hand: the first hit is safe only on a 1, which the second then
+		// duplicates. Bust within 1 hit: 1/3; within 2 hits: certain.
+		deck := domain.NewDeckFromCards([]domain.Card{number(5), number(1), number(1)})
+		if risk := deck.EstimateMultiHitRisk(hand, false, 1); math.Abs(risk-1.0/3.0) > 1e-9 {
+			t.Errorf("Expected exactly 1/3 within 1 hit, got %f", risk)
+		}
+		if risk := deck.EstimateMultiHitRisk(hand, false, 2); risk != 1.0 {
+			t.Errorf("Expected exactly 1.0 within 2 hits, got %f", risk)
+		}
+	})
+
+	t.Run("Second Chance absorbs one duplicate", func(t *testing.T) {
+		// Two 5s and a 7: the Second Chance takes the first 5, so only 5, 5 in the first two
+		// draws busts (1/3), and a deck drawn out always does.
+		deck := domain.NewDeckFromCards([]domain.Card{number(5), number(5), number(7)})
+		if risk := deck.EstimateMultiHitRisk(hand, true, 1); risk != 0 {
+			t.Errorf("Expected exactly 0 within 1 hit, got %f", risk)
+		}
+		if risk := deck.EstimateMultiHitRisk(hand, true, 2); math.Abs(risk-1.0/3.0) > 1e-9 {
+			t.Errorf("Expected exactly 1/3 within 2 hits, got %f", risk)
+		}
+		if risk := deck.EstimateMultiHitRisk(hand, true, 5); risk != 1.0 {
+			t.Errorf("Expected exactly 1.0 when the deck is drawn out, got %f", risk)
+		}
+	})
+
+	t.Run("Large deck is sampled", func(t *testing.T) {
+		// 4 fives and 16 modifiers, 4 hits: P(bust) = 1 - C(16,4)/C(20,4) = 1 - 1820/4845
+		plus2 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus2}
+		cards := []domain.Card{number(5), number(5), number(5), number(5)}
+		for i := 0; i < 16; i++ {
+			cards = append(cards, plus2)
+		}
+		deck := domain.NewDeckFromCards(cards)
+		expected := 1 - 1820.0/4845.0
+		if risk := deck.EstimateMultiHitRisk(hand, false, 4); risk < expected-0.06 || risk > expected+0.06 {
+			t.Errorf("Expected risk ~%f, got %f", expected, risk)
+		}
+	})
+
+	t.Run("No hits, no risk", func(t *testing.T) {
+		deck := domain.NewDeckFromCards([]domain.Card{number(5)})
+		if risk := deck.EstimateMultiHitRisk(hand, false, 0); risk != 0 {
+			t.Errorf("Expected 0 for no hits, got %f", risk)
+		}
+	})
+}
+
+func BenchmarkEstimateHitRisk(b *testing.B) {
+	deck := domain.NewDeck()
+	hand := map[domain.NumberValue]struct{}{3: {}, 7: {}, 9: {}, 11: {}, 12: {}}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		deck.EstimateHitRisk(hand, false)
+	}
+}
+
+func BenchmarkEstimateFlipThreeRisk(b *testing.B) {
+	deck := domain.NewDeck()
+	hand := map[domain.NumberValue]struct{}{3: {}, 7: {}, 9: {}, 11: {}, 12: {}}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		deck.EstimateFlipThreeRisk(hand, false)
+	}
+}
+
+func TestDeckDraw_EmptyDeckReturnsErrDeckEmpty(t *testing.T) {
+	deck := domain.NewDeckFromCards([]domain.Card{{Type: domain.CardTypeNumber, Value: 3}})
+
+	if _, err := deck.Draw(); err != nil {
+		t.Fatalf("Expected first draw to succeed, got %v", err)
+	}
+	if _, err := deck.Draw(); !errors.Is(err, domain.ErrDeckEmpty) {
+		t.Errorf("Expected ErrDeckEmpty, got %v", err)
+	}
+}
+
+func TestParseCard_RoundTripsEveryCard(t *testing.T) {
+	for _, card := range domain.StandardDeckCards() {
+		parsed, err := domain.ParseCard(card.String())
+		if err != nil {
+			t.Fatalf("Expected %s to parse, got %v", card, err)
+		}
+		if parsed != card {
+			t.Errorf("Expected %+v, got %+v", card, parsed)
+		}
+	}
+
+	for _, input := range []string{"13", "-1", "plus_3", "unknown", ""} {
+		if _, err := domain.ParseCard(input); err == nil {
+			t.Errorf("Expected %q to be rejected", input)
+		}
+	}
+}
+
+func TestParseCardToken_TerminalInput(t *testing.T) {
+	number := func(v int) domain.Card { return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)} }
+	tests := []struct {
+		input string
+		want  domain.Card
+	}{
+		{"5", number(5)},
+		{"5\r\n", number(5)},
+		{"\uFEFF7", number(7)},
+		{"５", number(5)},
+		{"１２", number(12)},
+		{"　０　", number(0)},
+		{"＋４", domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4}},
+		{"+10\r", domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus10}},
+		{"×2", domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}},
+		{"ｘ２", domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}},
+		{"＊2", domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}},
+		{"f", domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}},
+		{"Ｔ", domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}},
+		{"\uFEFFｃ\r", domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}},
+	}
+	for _, tt := range tests {
+		got, err := domain.ParseCardToken(tt.input)
+		if err != nil {
+			t.Errorf("ParseCardToken(%q): unexpected error %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseCardToken(%q): expected %+v, got %+v", tt.input, tt.want, got)
+		}
+	}
+
+	for _, input := range []string{"１３", "＋３", "\uFEFF", "\r\n", "Ｑ"} {
+		if _, err := domain.ParseCardToken(input); err == nil {
+			t.Errorf("Expected %q to be rejected", input)
+		}
+	}
+}
+
+func TestParseDeckOrder(t *testing.T) {
+	cards, err := domain.ParseDeckOrder("7, 12,+4\nf t c X2 *2 0")
+	if err != nil {
+		t.Fatalf("ParseDeckOrder failed: %v", err)
+	}
+	want := []domain.Card{
+		{Type: domain.CardTypeNumber, Value: 7},
+		{Type: domain.CardTypeNumber, Value: 12},
+		{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4},
+		{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze},
+		{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree},
+		{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance},
+		{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2},
+		{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2},
+		{Type: domain.CardTypeNumber, Value: 0},
+	}
+	if len(cards) != len(want) {
+		t.Fatalf("Expected %d cards, got %v", len(want), cards)
+	}
+	for i := range want {
+		if cards[i] != want[i] {
+			t.Errorf("Card %d: expected %+v, got %+v", i+1, want[i], cards[i])
+		}
+	}
+
+	for _, input := range []string{"", " , ", "7,13", "7,+3", "7,,Q"} {
+		if _, err := domain.ParseDeckOrder(input); err == nil {
+			t.Errorf("Expected %q to be rejected", input)
+		}
+	}
+}
+
+func TestDeck_DeckViewCounts(t *testing.T) {
+	deck := domain.NewDeckInOrder(domain.StandardDeckCards())
+	var view domain.DeckView = deck
+
+	if got := view.Remaining(); got != 94 {
+		t.Errorf("Expected 94 cards, got %d", got)
+	}
+	if got := view.RemainingNumberCounts()[12]; got != 12 {
+		t.Errorf("Expected 12 twelves, got %d", got)
+	}
+	if got := view.RemainingModifierCount(domain.ModifierX2); got != 1 {
+		t.Errorf("Expected 1 x2 modifier, got %d", got)
+	}
+	if got := view.RemainingActionCount(domain.ActionFreeze); got != 3 {
+		t.Errorf("Expected 3 Freeze cards, got %d", got)
+	}
+
+	// The returned counts are a copy.
+	view.RemainingNumberCounts()[12] = 0
+	if deck.RemainingCounts[12] != 12 {
+		t.Errorf("Expected the deck's counts to be unchanged, got %d", deck.RemainingCounts[12])
+	}
+}
+
+func TestEstimateFlipThreeEV(t *testing.T) {
+	number := func(v int) domain.Card {
+		return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+	}
+	x2 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}
+	plus2 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus2}
+	secondChance := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}
+	ten := map[domain.NumberValue]struct{}{10: {}}
+
+	tests := []struct {
+		name            string
+		deck            []domain.Card
+		hand            map[domain.NumberValue]struct{}
+		modifiers       []domain.Card
+		hasSecondChance bool
+		expected        float64
+	}{
+		// 10 + 5 + 6 doubled: 42, up from 10
+		{"x2 drawn doubles the whole hand", []domain.Card{x2, number(5), number(6)}, ten, nil, false, 32},
+		// Already doubled: 10x2 + 2 = 22 becomes (10+1+2)x2 + 2 + 2 = 30
+		{"x2 in hand doubles the new numbers", []domain.Card{number(1), number(2), plus2}, ten, []domain.Card{x2, plus2}, false, 8},
+		{"Certain bust loses the hand", []domain.Card{number(10), number(10), number(10)}, ten, nil, false, -10},
+		// The Second Chance arrives before the first 10 half of the time (+3), else the hand busts (-10)
+		{"Second Chance drawn in time", []domain.Card{secondChance, number(10), number(3)}, ten, nil, false, -3.5},
+		{"Second Chance in hand absorbs one duplicate", []domain.Card{number(10), number(3), number(4)}, ten, nil, true, 7},
+		// 1-6 score 21. A 7 first completes Flip 7 (28 + 15, the draws stop): +22 with probability 2/3;
+		// +2 first, then a 7: 28 + 2 + 15 = 45, +24.
+		{"Flip 7 ends the draws with the bonus", []domain.Card{number(7), number(7), plus2},
+			map[domain.NumberValue]struct{}{1: {}, 2: {}, 3: {}, 4: {}, 5: {}, 6: {}}, nil, false, 2.0/3*22 + 1.0/3*24},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deck := domain.NewDeckFromCards(tt.deck)
+			if ev := deck.EstimateFlipThreeEV(tt.hand, tt.modifiers, tt.hasSecondChance); math.Abs(ev-tt.expected) > 1e-9 {
+				t.Errorf("Expected %f, got %f", tt.expected, ev)
+			}
+		})
+	}
+
+	t.Run("Full deck is deterministic and rewards an empty hand", func(t *testing.T) {
+		deck := domain.NewDeck()
+		first := deck.EstimateFlipThreeEV(nil, nil, false)
+		if second := deck.EstimateFlipThreeEV(nil, nil, false); first != second || first <= 0 {
+			t.Errorf("Expected the same positive value twice, got %f and %f", first, second)
+		}
+	})
+}
@@ -0,0 +1,155 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// CardAllowance counts the occasions a player had to take cards in a round, bounding how many
+// cards can be attributed to them: one initial card, one per turn, three per Flip Three aimed at
+// them and each Second Chance passed to them.
+type CardAllowance struct {
+	Turns               int `json:"turns"`
+	FlipThrees          int `json:"flip_threes"`
+	SecondChancesPassed int `json:"second_chances_passed"`
+}
+
+// Max returns the most cards the allowance lets a player hold.
+func (a CardAllowance) Max() int {
+	return 1 + a.Turns + 3*a.FlipThrees + a.SecondChancesPassed
+}
+
+// TrackCardAllowances starts counting card allowances for the round (see CardAllowance).
+// Rounds that do not track them record nothing, and ConsistencyChecker skips the card count.
+func (r *Round) TrackCardAllowances() {
+	r.CardAllowances = make(map[string]*CardAllowance)
+}
+
+// RecordTurn counts a turn of p.
+func (r *Round) RecordTurn(p *Player) {
+	if a := r.allowance(p); a != nil {
+		a.Turns++
+	}
+}
+
+// RecordFlipThree counts a Flip Three aimed at p.
+func (r *Round) RecordFlipThree(p *Player) {
+	if a := r.allowance(p); a != nil {
+		a.FlipThrees++
+	}
+}
+
+// RecordSecondChancePassed counts a Second Chance passed to p.
+func (r *Round) RecordSecondChancePassed(p *Player) {
+	if a := r.allowance(p); a != nil {
+		a.SecondChancesPassed++
+	}
+}
+
+// allowance returns p's allowance, creating it on first use; nil if the round does not track them.
+func (r *Round) allowance(p *Player) *CardAllowance {
+	if r.CardAllowances == nil {
+		return nil
+	}
+	a, ok := r.CardAllowances[p.ID.String()]
+	if !ok {
+		a = &CardAllowance{}
+		r.CardAllowances[p.ID.String()] = a
+	}
+	return a
+}
+
+// Anomaly is a hand that the rules say cannot be in its current state,
+// usually because a card was entered for the wrong player or not at all.
+type Anomaly struct {
+	Player  *Player
+	Problem string
+}
+
+func (a Anomaly) String() string {
+	return a.Player.Name + " " + a.Problem
+}
+
+// ConsistencyChecker audits the hands of the current round against the invariants every
+// correctly recorded round keeps:
+//   - a hand holding the same number twice is busted, unless a Second Chance discarded the duplicate;
+//   - a hand holding 7 different numbers ended the round with Flip 7;
+//   - a hand holds no more cards than its CardAllowance (when the round tracks allowances).
+type ConsistencyChecker struct{}
+
+func NewConsistencyChecker() *ConsistencyChecker {
+	return &ConsistencyChecker{}
+}
+
+// Check returns the anomalies of the current round in seat order; nil if there is no round
+// or every hand is consistent.
+func (c *ConsistencyChecker) Check(g *Game) []Anomaly {
+	round := g.CurrentRound
+	if round == nil {
+		return nil
+	}
+
+	var anomalies []Anomaly
+	for _, p := range g.Players {
+		h := p.CurrentHand
+		if p.Dropped || h == nil {
+			continue
+		}
+
+		if dups := duplicateNumbers(h.RawNumberCards); len(dups) > 0 && h.Status != HandStatusBusted && !h.SecondChanceUsed {
+			anomalies = append(anomalies, Anomaly{Player: p, Problem: fmt.Sprintf("holds %s twice but is not busted", joinNumbers(dups))})
+		}
+
+		flip7 := round.IsEnded && round.EndReason == RoundEndReasonFlip7 && h.Status == HandStatusStayed
+		if uniqueNumbers(h.RawNumberCards) >= 7 && h.Status != HandStatusBusted && !flip7 {
+			anomalies = append(anomalies, Anomaly{Player: p, Problem: "holds 7 different numbers but did not get Flip 7"})
+		}
+
+		if round.CardAllowances == nil {
+			continue
+		}
+		allowance := CardAllowance{}
+		if a, ok := round.CardAllowances[p.ID.String()]; ok {
+			allowance = *a
+		}
+		if held := len(h.RawNumberCards) + len(h.ModifierCards) + len(h.ActionCards); held > allowance.Max() {
+			anomalies = append(anomalies, Anomaly{Player: p, Problem: fmt.Sprintf(
+				"holds %d cards but could have been dealt at most %d (1 initial, %d turns, %d Flip Three, %d passed Second Chance)",
+				held, allowance.Max(), allowance.Turns, allowance.FlipThrees, allowance.SecondChancesPassed)})
+		}
+	}
+	return anomalies
+}
+
+// duplicateNumbers returns the numbers that occur more than once, in ascending order.
+func duplicateNumbers(numbers []NumberValue) []NumberValue {
+	seen := make(map[NumberValue]int)
+	for _, v := range numbers {
+		seen[v]++
+	}
+	var dups []NumberValue
+	for v, n := range seen {
+		if n > 1 {
+			dups = append(dups, v)
+		}
+	}
+	sort.Slice(dups, func(i, j int) bool { return dups[i] < dups[j] })
+	return dups
+}
+
+func uniqueNumbers(numbers []NumberValue) int {
+	seen := make(map[NumberValue]struct{})
+	for _, v := range numbers {
+		seen[v] = struct{}{}
+	}
+	return len(seen)
+}
+
+func joinNumbers(numbers []NumberValue) string {
+	parts := make([]string, len(numbers))
+	for i, v := range numbers {
+		parts[i] = fmt.Sprint(int(v))
+	}
+	return strings.Join(parts, " and ")
+}
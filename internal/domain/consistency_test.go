@@ -0,0 +1,86 @@
+package domain_test
+
+import (
+	"reflect"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func numberCards(values ...int) []domain.NumberValue {
+	numbers := make([]domain.NumberValue, len(values))
+	for i, v := range values {
+		numbers[i] = domain.NumberValue(v)
+	}
+	return numbers
+}
+
+func TestConsistencyChecker(t *testing.T) {
+	// Hands are set up directly, as a mistyped entry would leave them, bypassing AddCard.
+	alice := domain.NewPlayer("Alice", nil)
+	bob := domain.NewPlayer("Bob", nil)
+	carol := domain.NewPlayer("Carol", nil)
+	dave := domain.NewPlayer("Dave", nil)
+	game := domain.NewGame([]*domain.Player{alice, bob, carol, dave})
+	game.CurrentRound = domain.NewRound(game.Players, alice, domain.NewDeck())
+	round := game.CurrentRound
+	round.TrackCardAllowances()
+
+	// Alice: a duplicate 5 but still active.
+	alice.CurrentHand.RawNumberCards = numberCards(5, 8, 5)
+	round.RecordTurn(alice)
+	round.RecordTurn(alice)
+
+	// Bob: 7 different numbers without Flip 7, more than 1 initial card and 3 turns allow.
+	bob.CurrentHand.RawNumberCards = numberCards(1, 2, 3, 4, 5, 6, 7)
+	for i := 0; i < 3; i++ {
+		round.RecordTurn(bob)
+	}
+
+	// Carol: 5 cards after 1 turn fit thanks to a Flip Three, and a busted hand may hold a duplicate.
+	carol.CurrentHand.RawNumberCards = numberCards(9, 10, 11, 12, 10)
+	carol.CurrentHand.Status = domain.HandStatusBusted
+	round.RecordTurn(carol)
+	round.RecordFlipThree(carol)
+
+	// Dave: a duplicate with a Second Chance discard recorded, and another Second Chance passed to Dave.
+	dave.CurrentHand.RawNumberCards = numberCards(4, 4)
+	dave.CurrentHand.SecondChanceUsed = true
+	dave.CurrentHand.ActionCards = []domain.Card{{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}}
+	round.RecordTurn(dave)
+	round.RecordSecondChancePassed(dave)
+
+	var got []string
+	for _, a := range domain.NewConsistencyChecker().Check(game) {
+		got = append(got, a.String())
+	}
+	expected := []string{
+		"Alice holds 5 twice but is not busted",
+		"Bob holds 7 different numbers but did not get Flip 7",
+		"Bob holds 7 cards but could have been dealt at most 4 (1 initial, 3 turns, 0 Flip Three, 0 passed Second Chance)",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected anomalies %q, got %q", expected, got)
+	}
+}
+
+func TestConsistencyChecker_Flip7AndUntrackedRounds(t *testing.T) {
+	alice := domain.NewPlayer("Alice", nil)
+	bob := domain.NewPlayer("Bob", nil)
+	game := domain.NewGame([]*domain.Player{alice, bob})
+	game.CurrentRound = domain.NewRound(game.Players, alice, domain.NewDeck())
+
+	// The round does not track allowances, so Alice's 7 cards after no turns are not counted.
+	alice.CurrentHand.RawNumberCards = numberCards(0, 1, 2, 3, 4, 5, 6)
+	alice.CurrentHand.Status = domain.HandStatusStayed
+	game.CurrentRound.End(domain.RoundEndReasonFlip7)
+
+	if anomalies := domain.NewConsistencyChecker().Check(game); len(anomalies) != 0 {
+		t.Errorf("Expected a Flip 7 to be consistent, got %v", anomalies)
+	}
+
+	game.CurrentRound.EndReason = domain.RoundEndReasonNoActivePlayers
+	if anomalies := domain.NewConsistencyChecker().Check(game); len(anomalies) != 1 || anomalies[0].Player != alice {
+		t.Errorf("Expected Alice's missed Flip 7 to be reported, got %v", anomalies)
+	}
+}
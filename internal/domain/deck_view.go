@@ -0,0 +1,105 @@
+package domain
+
+// DeckView is what a card-counting player can know about the draw pile: how many cards of
+// each kind are left, but not the order they will be drawn in.
+// Strategies receive a DeckView instead of *Deck so they cannot peek at upcoming cards.
+type DeckView interface {
+	// RemainingNumberCounts returns a copy of the count of each number card left; values with none left are omitted.
+	RemainingNumberCounts() map[NumberValue]int
+	RemainingModifierCount(m ModifierType) int
+	RemainingActionCount(a ActionType) int
+	// Remaining returns the number of cards left before the discard pile is reshuffled into a new deck.
+	Remaining() int
+
+	EstimateHitRisk(handNumbers map[NumberValue]struct{}, hasSecondChance bool) float64
+	EstimateFlipThreeRisk(handNumbers map[NumberValue]struct{}, hasSecondChance bool) float64
+	EstimateFlipThreeRiskWithTrials(handNumbers map[NumberValue]struct{}, hasSecondChance bool, trials int) float64
+	EstimateFlipThreeEV(handNumbers map[NumberValue]struct{}, modifiers []Card, hasSecondChance bool) float64
+	EstimateMultiHitRisk(handNumbers map[NumberValue]struct{}, hasSecondChance bool, hits int) float64
+}
+
+// DeckAware is implemented by strategies (and target selectors) that keep a view of the deck
+// for decisions outside Decide, such as choosing action targets.
+type DeckAware interface {
+	SetDeck(deck DeckView)
+}
+
+// ReshuffleAware is implemented by strategies that should know when the discard pile is
+// reshuffled into a new deck mid-round: deck replaces the one they were given, and what they
+// knew of where the other cards are (the discard pile or the hands) no longer holds.
+// Services call OnReshuffle once per reshuffle, before the next card is drawn.
+type ReshuffleAware interface {
+	OnReshuffle(deck DeckView)
+}
+
+// ReshuffleView is a DeckView of a deck together with the discard pile that is reshuffled into
+// a new deck once the deck runs out. Its Flip Three risks follow the draws past the reshuffle
+// (see Deck.EstimateFlipThreeRiskWithDiscards), so a target with too few cards left to draw
+// three is not taken for safe; everything else reads the deck alone.
+type ReshuffleView struct {
+	*Deck
+	Discards []Card
+}
+
+// NewReshuffleView returns the view of deck with discards to be reshuffled after it.
+func NewReshuffleView(deck *Deck, discards []Card) *ReshuffleView {
+	return &ReshuffleView{Deck: deck, Discards: discards}
+}
+
+// EstimateFlipThreeRisk implements DeckView.
+func (v *ReshuffleView) EstimateFlipThreeRisk(handNumbers map[NumberValue]struct{}, hasSecondChance bool) float64 {
+	return v.EstimateFlipThreeRiskWithTrials(handNumbers, hasSecondChance, FlipThreeRiskTrials)
+}
+
+// EstimateFlipThreeRiskWithTrials implements DeckView.
+func (v *ReshuffleView) EstimateFlipThreeRiskWithTrials(handNumbers map[NumberValue]struct{}, hasSecondChance bool, trials int) float64 {
+	return v.Deck.EstimateFlipThreeRiskWithDiscards(handNumbers, hasSecondChance, v.Discards, trials)
+}
+
+// LowDeckThreshold is the number of cards left at or below which players are warned that a
+// reshuffle is near. The reshuffled deck is the discard pile, so counting starts over.
+const LowDeckThreshold = 10
+
+// AllModifierTypes lists every modifier card type, one card of each per deck.
+var AllModifierTypes = []ModifierType{ModifierPlus2, ModifierPlus4, ModifierPlus6, ModifierPlus8, ModifierPlus10, ModifierX2}
+
+// AllActionTypes lists every action card type that is part of the deck.
+var AllActionTypes = []ActionType{ActionFreeze, ActionFlipThree, ActionSecondChance}
+
+// RemainingNumberCounts implements DeckView.
+func (d *Deck) RemainingNumberCounts() map[NumberValue]int {
+	counts := make(map[NumberValue]int, len(d.RemainingCounts))
+	for v, n := range d.RemainingCounts {
+		if n > 0 {
+			counts[v] = n
+		}
+	}
+	return counts
+}
+
+// RemainingModifierCount implements DeckView.
+func (d *Deck) RemainingModifierCount(m ModifierType) int {
+	count := 0
+	for _, c := range d.Cards {
+		if c.Type == CardTypeModifier && c.ModifierType == m {
+			count++
+		}
+	}
+	return count
+}
+
+// RemainingActionCount implements DeckView.
+func (d *Deck) RemainingActionCount(a ActionType) int {
+	count := 0
+	for _, c := range d.Cards {
+		if c.Type == CardTypeAction && c.ActionType == a {
+			count++
+		}
+	}
+	return count
+}
+
+// Remaining implements DeckView.
+func (d *Deck) Remaining() int {
+	return len(d.Cards)
+}
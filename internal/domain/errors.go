@@ -0,0 +1,24 @@
+package domain
+
+import "errors"
+
+// Sentinel errors for domain operations.
+// Callers should test for them with errors.Is; services may wrap them with more context.
+var (
+	// ErrDeckEmpty is returned when drawing from a deck with no cards left.
+	ErrDeckEmpty = errors.New("deck is empty")
+	// ErrCardNotInDeck is returned when a specific card is requested but is not in the deck
+	// (typically because every copy has already been drawn).
+	ErrCardNotInDeck = errors.New("card not found in deck")
+	// ErrNoActiveRound is returned when an operation needs a round (and its deck) but none is in progress.
+	ErrNoActiveRound = errors.New("no active round")
+	// ErrGameCompleted is returned when an operation requires a game that is still being played.
+	ErrGameCompleted = errors.New("game is already completed")
+	// ErrRoundEnded is returned when an operation requires a round that is still being played.
+	ErrRoundEnded = errors.New("round has already ended")
+	// ErrCardsNotConserved is returned when the deck, discard pile and hands do not add up to a full deck.
+	ErrCardsNotConserved = errors.New("cards are not conserved")
+	// ErrInvariantBroken is returned by Game.Invariants for a broken invariant other than
+	// card conservation.
+	ErrInvariantBroken = errors.New("game invariant broken")
+)
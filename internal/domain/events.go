@@ -0,0 +1,216 @@
+package domain
+
+import "time"
+
+// Event is something that happened during a game. Services publish events to an EventBus
+// so that consumers (console output, logs, statistics) need no hooks in the game logic.
+type Event interface {
+	EventName() string
+}
+
+// DrawSource tells how a card came to be drawn.
+type DrawSource string
+
+const (
+	DrawInitialDeal DrawSource = "initial_deal"
+	DrawHit         DrawSource = "hit"
+	DrawFlipThree   DrawSource = "flip_three" // One of the cards forced by a Flip Three
+)
+
+// GameStarted is published when a game begins, before its first round is dealt.
+type GameStarted struct {
+	Game *Game
+}
+
+// RoundStarted is published when a new round is dealt.
+type RoundStarted struct {
+	Round  int
+	Dealer *Player
+}
+
+// TurnStarted is published when a player's turn begins (Manual Mode), before they hit or stay.
+type TurnStarted struct {
+	Player    *Player
+	HandScore int
+}
+
+// TurnEnded is published when a player's turn is over (Manual Mode). Action is "hit", "stay"
+// or "play" (a held action that ended the turn); Duration is how long the turn took.
+type TurnEnded struct {
+	Player   *Player
+	Action   string
+	Duration time.Duration
+}
+
+// CardDrawn is published when a player draws a card, before its effect is applied.
+type CardDrawn struct {
+	Player *Player
+	Card   Card
+	Source DrawSource
+}
+
+// CardPlayed is published when an action card is resolved, before its effect is applied:
+// Freeze and Flip Three with the chosen target, Second Chance with the player who gets it
+// (the drawer if kept, another player if passed, nil if discarded).
+type CardPlayed struct {
+	Round    *Round
+	Discards []Card // The discard pile, reshuffled into a new deck once the round's deck runs out
+	Actor    *Player
+	Target   *Player
+	Action   ActionType
+}
+
+// SecondChancePassed is published when a drawn Second Chance goes to another player.
+type SecondChancePassed struct {
+	From *Player
+	To   *Player
+}
+
+// SecondChanceUsed is published when a player's Second Chance absorbs a duplicate number.
+type SecondChanceUsed struct {
+	Player *Player
+	Card   Card // The duplicate, discarded with the Second Chance
+}
+
+// ActionHeld is published when a Freeze or Flip Three is kept in the hand instead of played at
+// once, under the holdable-actions house rule (Game.HoldableActions).
+type ActionHeld struct {
+	Player *Player
+	Card   Card
+}
+
+// HeldActionPlayed is published when a Freeze or Flip Three kept in the hand under the
+// holdable-actions house rule is played, before its target is chosen.
+type HeldActionPlayed struct {
+	Player *Player
+	Card   Card
+}
+
+// ActionResolved is published once a Freeze or Flip Three has taken effect on its target.
+// Outcome is "flip7" when a Flip Three handed the target a Flip 7, otherwise the target's hand
+// status afterwards; an action that gave another player a Flip 7 has Backfired.
+type ActionResolved struct {
+	Actor     *Player
+	Target    *Player
+	Action    ActionType
+	Outcome   string
+	Backfired bool
+}
+
+// FlipThreeProgress is published before each card a Flip Three forces its target to draw
+// (Manual Mode): Card is its number (1 to 3), with the hand's score and bust risk before it.
+type FlipThreeProgress struct {
+	Player    *Player
+	Card      int
+	HandScore int
+	BustRate  float64
+}
+
+// PlayerBusted is published when a duplicate number ends a player's round.
+type PlayerBusted struct {
+	Player *Player
+	Card   Card // The duplicate
+	// PreHitBustRate is the bust risk the player was shown before the hit, if any (Manual Mode
+	// shows one before the turn's own draw).
+	PreHitBustRate *float64
+}
+
+// StayOverridden is published when a strategy chose to stay before flipping a card this round,
+// which the rules do not allow, so the player hits instead.
+type StayOverridden struct {
+	Player *Player
+}
+
+// PlayerStayed is published once a player who chose to stay has banked their hand.
+type PlayerStayed struct {
+	Player *Player
+	Banked int
+}
+
+// PlayerFrozen is published once a frozen player has banked their hand.
+type PlayerFrozen struct {
+	Player *Player
+	By     *Player // The player who played the Freeze
+	Banked int
+}
+
+// Flip7Achieved is published once a player with seven different numbers has banked their hand.
+type Flip7Achieved struct {
+	Player *Player
+	Banked int
+}
+
+// DeckReshuffled is published when the discard pile is reshuffled into a new deck.
+type DeckReshuffled struct {
+	Discarded int // Cards in the discard pile, now the deck
+}
+
+// RoundExhausted is published when a card must be drawn but the deck and the discard pile are
+// empty, once the hands still in play have been handled by Rule (see Round.Exhaust).
+type RoundExhausted struct {
+	Rule  ExhaustionRule
+	Hands []ExhaustedHand
+}
+
+// RoundEnded is published after every round while the hands are still on the table.
+type RoundEnded struct {
+	Round  *Round
+	Number int
+	Reason RoundEndReason
+}
+
+// GameEnded is published once the game is completed, with or without a winner.
+type GameEnded struct {
+	Game *Game
+}
+
+func (GameStarted) EventName() string        { return "GameStarted" }
+func (RoundStarted) EventName() string       { return "RoundStarted" }
+func (TurnStarted) EventName() string        { return "TurnStarted" }
+func (TurnEnded) EventName() string          { return "TurnEnded" }
+func (CardDrawn) EventName() string          { return "CardDrawn" }
+func (CardPlayed) EventName() string         { return "CardPlayed" }
+func (SecondChancePassed) EventName() string { return "SecondChancePassed" }
+func (SecondChanceUsed) EventName() string   { return "SecondChanceUsed" }
+func (ActionHeld) EventName() string         { return "ActionHeld" }
+func (HeldActionPlayed) EventName() string   { return "HeldActionPlayed" }
+func (ActionResolved) EventName() string     { return "ActionResolved" }
+func (FlipThreeProgress) EventName() string  { return "FlipThreeProgress" }
+func (PlayerBusted) EventName() string       { return "PlayerBusted" }
+func (StayOverridden) EventName() string     { return "StayOverridden" }
+func (PlayerStayed) EventName() string       { return "PlayerStayed" }
+func (PlayerFrozen) EventName() string       { return "PlayerFrozen" }
+func (Flip7Achieved) EventName() string      { return "Flip7Achieved" }
+func (DeckReshuffled) EventName() string     { return "DeckReshuffled" }
+func (RoundExhausted) EventName() string     { return "RoundExhausted" }
+func (RoundEnded) EventName() string         { return "RoundEnded" }
+func (GameEnded) EventName() string          { return "GameEnded" }
+
+// EventSink consumes published events.
+type EventSink interface {
+	Publish(e Event)
+}
+
+// EventSinkFunc adapts a function to EventSink.
+type EventSinkFunc func(e Event)
+
+// Publish calls f(e).
+func (f EventSinkFunc) Publish(e Event) { f(e) }
+
+// EventBus delivers every published event to its sinks synchronously, in subscription order.
+// The zero value has no sinks and is ready to use. It is not safe for concurrent use.
+type EventBus struct {
+	sinks []EventSink
+}
+
+// Subscribe adds a sink that receives every event published from now on.
+func (b *EventBus) Subscribe(sink EventSink) {
+	b.sinks = append(b.sinks, sink)
+}
+
+// Publish delivers e to every sink.
+func (b *EventBus) Publish(e Event) {
+	for _, sink := range b.sinks {
+		sink.Publish(e)
+	}
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExhaustionRule is the house rule for a round in which a card must be drawn but the deck and
+// the discard pile are both empty. The official rules do not cover it: it only happens with
+// short decks or cards lost from the table.
+type ExhaustionRule string
+
+const (
+	// ExhaustionBankHands banks every hand still in play, as if its player had been frozen.
+	// It is the default: players are not punished for cards nobody can draw.
+	ExhaustionBankHands ExhaustionRule = "bank"
+	// ExhaustionDiscardHands scores every hand still in play as 0, as if its player had busted.
+	ExhaustionDiscardHands ExhaustionRule = "discard"
+)
+
+// ParseExhaustionRule parses "bank" or "discard". An empty name is ExhaustionBankHands.
+// An unknown one returns ExhaustionBankHands and an error.
+func ParseExhaustionRule(name string) (ExhaustionRule, error) {
+	switch rule := ExhaustionRule(strings.ToLower(strings.TrimSpace(name))); rule {
+	case "", ExhaustionBankHands:
+		return ExhaustionBankHands, nil
+	case ExhaustionDiscardHands:
+		return rule, nil
+	}
+	return ExhaustionBankHands, fmt.Errorf("unknown exhaustion rule %q (supported: bank, discard)", name)
+}
+
+// RoundExhaustionRule returns the ExhaustionRule of this game.
+// Games that do not configure one (including saves that predate it) use ExhaustionBankHands.
+func (g *Game) RoundExhaustionRule() ExhaustionRule {
+	if g.ExhaustionRule == "" {
+		return ExhaustionBankHands
+	}
+	return g.ExhaustionRule
+}
+
+// ExhaustedHand is a hand that was still in play when its round was exhausted.
+type ExhaustedHand struct {
+	Player *Player
+	Banked int // Points banked under ExhaustionBankHands; 0 when the hand was discarded
+}
+
+// Exhaust ends the round with RoundEndReasonExhausted because a card had to be drawn and none
+// was left. Every player still in play is handled by rule: their hand is banked and frozen, or
+// busted under ExhaustionDiscardHands. Players who stayed, busted or were frozen before keep
+// what they had. It returns the hands it handled, in turn order.
+func (r *Round) Exhaust(rule ExhaustionRule) []ExhaustedHand {
+	var hands []ExhaustedHand
+	for _, p := range append([]*Player(nil), r.ActivePlayers...) {
+		if p.CurrentHand == nil || p.CurrentHand.Status != HandStatusActive {
+			continue
+		}
+		hand := ExhaustedHand{Player: p}
+		if rule == ExhaustionDiscardHands {
+			p.CurrentHand.Status = HandStatusBusted
+		} else {
+			p.CurrentHand.Status = HandStatusFrozen
+			hand.Banked = p.BankCurrentHand()
+		}
+		r.RemoveActivePlayer(p)
+		hands = append(hands, hand)
+	}
+	r.End(RoundEndReasonExhausted)
+	return hands
+}
+
+// EndExhausted ends the game after an exhausted round, with GameEndReasonExhausted: the
+// player(s) with the highest total score win, whether or not they reached the target score
+// (see Leaders).
+func (g *Game) EndExhausted() {
+	g.Winners = g.Leaders()
+	g.End(GameEndReasonExhausted)
+}
@@ -0,0 +1,62 @@
+package domain_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/testutil"
+)
+
+func TestRoundExhaust(t *testing.T) {
+	stayed := playerWith("Stayed", 20, 8)
+	active := playerWith("Active", 30, 5, 6)
+	round := testutil.NewRound([]*domain.Player{stayed, active}, stayed, domain.NewDeckInOrder(nil))
+	stayed.CurrentHand.Status = domain.HandStatusStayed
+	stayed.BankCurrentHand()
+	round.RemoveActivePlayer(stayed)
+
+	hands := round.Exhaust(domain.ExhaustionBankHands)
+
+	if len(hands) != 1 || hands[0].Player != active || hands[0].Banked != 11 {
+		t.Fatalf("Expected only Active's hand to be banked for 11 points, got %+v", hands)
+	}
+	if active.TotalScore != 41 || active.CurrentHand.Status != domain.HandStatusFrozen {
+		t.Errorf("Expected Active to be frozen with 41 points, got %s with %d", active.CurrentHand.Status, active.TotalScore)
+	}
+	if stayed.TotalScore != 28 {
+		t.Errorf("Expected Stayed to keep 28 points, got %d", stayed.TotalScore)
+	}
+	if !round.IsEnded || round.EndReason != domain.RoundEndReasonExhausted || len(round.ActivePlayers) != 0 {
+		t.Errorf("Expected the round to end exhausted with nobody in play, got ended=%v reason=%q active=%d",
+			round.IsEnded, round.EndReason, len(round.ActivePlayers))
+	}
+}
+
+func TestGameEndExhausted_HighestScoreWinsBelowTarget(t *testing.T) {
+	a, b, c := playerWith("A", 120), playerWith("B", 150), playerWith("C", 150)
+	game := domain.NewGame([]*domain.Player{a, b, c})
+
+	game.EndExhausted()
+
+	if !game.IsCompleted || game.EndReason != domain.GameEndReasonExhausted {
+		t.Errorf("Expected the game to end exhausted, got completed=%v reason=%q", game.IsCompleted, game.EndReason)
+	}
+	if len(game.Winners) != 2 || game.Winners[0] != b || game.Winners[1] != c {
+		t.Errorf("Expected B and C to share the win, got %v", game.Winners)
+	}
+}
+
+func TestParseExhaustionRule(t *testing.T) {
+	for name, want := range map[string]domain.ExhaustionRule{
+		"":          domain.ExhaustionBankHands,
+		"bank":      domain.ExhaustionBankHands,
+		" Discard ": domain.ExhaustionDiscardHands,
+	} {
+		if got, err := domain.ParseExhaustionRule(name); err != nil || got != want {
+			t.Errorf("ParseExhaustionRule(%q) = %q, %v; expected %q", name, got, err, want)
+		}
+	}
+	if got, err := domain.ParseExhaustionRule("keep"); err == nil || got != domain.ExhaustionBankHands {
+		t.Errorf("Expected an unknown rule to fall back to bank with an error, got %q, %v", got, err)
+	}
+}
@@ -0,0 +1,157 @@
+package domain
+
+const (
+	// DefaultOpponentHitBelow is the hand score below which Flip7ThreatEstimator assumes an
+	// opponent hits: the default threshold of the Heuristic strategy.
+	DefaultOpponentHitBelow = 27
+	// Flip7ThreatTrials is the default number of simulated rotations of Flip7ThreatEstimator.
+	Flip7ThreatTrials = 2000
+)
+
+// Flip7ThreatEstimator estimates the probability that an opponent still in play completes
+// Flip 7 within the next rotation of the table, that is before play returns to the player
+// deciding. Flip 7 ends the round at once, so every hand not yet banked scores nothing.
+//
+// Each trial deals the opponents' turns, in turn order, from a random order of the cards left
+// in the deck: an opponent whose hand scores below HitBelow draws one card, and stays otherwise.
+// A Flip Three an opponent draws is assumed to be played on themselves (three more cards, the
+// quickest way to Flip 7), and a Second Chance they draw saves a later duplicate. Freeze is
+// ignored, as is the reshuffle when the deck runs out.
+type Flip7ThreatEstimator struct {
+	HitBelow int // 0 means DefaultOpponentHitBelow
+	Trials   int // 0 means Flip7ThreatTrials
+	// Intn returns a random number in [0,n); nil means GetRandomInt. Tests inject a fixed
+	// source, e.g. one that always returns 0 to draw the cards in deck order.
+	Intn func(n int) int
+}
+
+// threatHand is what a trial needs to know about an opponent's hand.
+type threatHand struct {
+	inHand       [13]bool
+	unique       int
+	secondChance bool
+	busted       bool
+	hits         bool
+}
+
+// Estimate returns the probability that one of opponents completes Flip 7 this rotation.
+// opponents are the hands of the other players, in the order they will play; hands that are
+// no longer active are skipped.
+func (e Flip7ThreatEstimator) Estimate(deck *Deck, opponents []*PlayerHand) float64 {
+	hitBelow := e.HitBelow
+	if hitBelow <= 0 {
+		hitBelow = DefaultOpponentHitBelow
+	}
+	trials := e.Trials
+	if trials <= 0 {
+		trials = Flip7ThreatTrials
+	}
+	intn := e.Intn
+	if intn == nil {
+		intn = GetRandomInt
+	}
+
+	calc := NewScoreCalculator()
+	var start []threatHand
+	for _, h := range opponents {
+		if h == nil || h.Status != HandStatusActive {
+			continue
+		}
+		th := threatHand{unique: len(h.NumberCards), secondChance: h.HasSecondChance(), hits: calc.Total(h) < hitBelow}
+		for v := range h.NumberCards {
+			if v >= 0 && int(v) < len(th.inHand) {
+				th.inHand[v] = true
+			}
+		}
+		start = append(start, th)
+	}
+	if len(start) == 0 || deck == nil || len(deck.Cards) == 0 {
+		return 0
+	}
+
+	t := threatTrial{deck: deck.Cards, intn: intn, perm: make([]int, len(deck.Cards))}
+	hands := make([]threatHand, len(start))
+	flip7s := 0
+	for i := 0; i < trials; i++ {
+		copy(hands, start)
+		t.reset()
+		for k := range hands {
+			if t.playTurn(&hands[k]) {
+				flip7s++
+				break
+			}
+		}
+	}
+	return float64(flip7s) / float64(trials)
+}
+
+// threatTrial draws the cards of one trial of Flip7ThreatEstimator without replacement.
+type threatTrial struct {
+	deck  []Card
+	intn  func(n int) int
+	perm  []int // Partial Fisher-Yates shuffle of the deck; perm[:drawn] are the cards drawn
+	drawn int
+}
+
+func (t *threatTrial) reset() {
+	for i := range t.perm {
+		t.perm[i] = i
+	}
+	t.drawn = 0
+}
+
+// draw returns the next card of the trial, or false once the deck is used up.
+func (t *threatTrial) draw() (Card, bool) {
+	if t.drawn >= len(t.perm) {
+		return Card{}, false
+	}
+	k := t.drawn + t.intn(len(t.perm)-t.drawn)
+	t.perm[t.drawn], t.perm[k] = t.perm[k], t.perm[t.drawn]
+	card := t.deck[t.perm[t.drawn]]
+	t.drawn++
+	return card, true
+}
+
+// playTurn plays an opponent's turn and reports whether it completed Flip 7.
+func (t *threatTrial) playTurn(h *threatHand) bool {
+	if !h.hits {
+		return false
+	}
+	card, ok := t.draw()
+	if !ok {
+		return false
+	}
+	if card.Type == CardTypeAction && card.ActionType == ActionFlipThree {
+		for i := 0; i < FlipThreeCardCount && !h.busted; i++ {
+			forced, ok := t.draw()
+			if !ok {
+				return false
+			}
+			if h.take(forced) {
+				return true
+			}
+		}
+		return false
+	}
+	return h.take(card)
+}
+
+// take adds card to the hand and reports whether it completed Flip 7.
+func (h *threatHand) take(card Card) bool {
+	switch {
+	case card.Type == CardTypeNumber && int(card.Value) < len(h.inHand):
+		if !h.inHand[card.Value] {
+			h.inHand[card.Value] = true
+			h.unique++
+			return h.unique >= 7
+		}
+		if h.secondChance {
+			h.secondChance = false
+		} else {
+			h.busted = true
+		}
+	case card.Type == CardTypeAction && card.ActionType == ActionSecondChance:
+		h.secondChance = true
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package domain_test
+
+import (
+	"math"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func TestFlip7ThreatEstimator(t *testing.T) {
+	numberCards := func(values ...int) []domain.Card {
+		cards := make([]domain.Card, len(values))
+		for i, v := range values {
+			cards[i] = domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+		}
+		return cards
+	}
+	flipThree := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}
+	inOrder := func(int) int { return 0 } // Draws the cards in deck order
+
+	tests := []struct {
+		name      string
+		estimator domain.Flip7ThreatEstimator
+		hands     [][]int // Number cards of each opponent, in turn order
+		deck      []domain.Card
+		want      float64
+	}{
+		{"Six uniques and only safe cards left", domain.Flip7ThreatEstimator{}, [][]int{{0, 1, 2, 3, 4, 5}}, numberCards(6, 7, 8, 9, 10, 11, 12), 1},
+		{"Six uniques and only duplicates left", domain.Flip7ThreatEstimator{}, [][]int{{0, 1, 2, 3, 4, 5}}, numberCards(1, 2, 3, 4, 5), 0},
+		{"Opponent stays on a high hand", domain.Flip7ThreatEstimator{HitBelow: 15}, [][]int{{0, 1, 2, 3, 4, 5}}, numberCards(6, 7, 8), 0},
+		{"Five uniques need two draws", domain.Flip7ThreatEstimator{}, [][]int{{0, 1, 2, 3, 4}}, numberCards(6, 7, 8), 0},
+		{"Flip Three gives five uniques three draws", domain.Flip7ThreatEstimator{Intn: inOrder}, [][]int{{0, 1, 2, 3, 4}}, append([]domain.Card{flipThree}, numberCards(6, 7)...), 1},
+		{"Flip Three busts on a duplicate", domain.Flip7ThreatEstimator{Intn: inOrder}, [][]int{{0, 1, 2, 3, 4}}, append([]domain.Card{flipThree}, numberCards(1, 7, 8)...), 0},
+		{"Second opponent completes Flip 7", domain.Flip7ThreatEstimator{Intn: inOrder}, [][]int{{1}, {0, 2, 3, 4, 5, 6}}, numberCards(1, 7), 1},
+		{"Half the safe cards", domain.Flip7ThreatEstimator{Trials: 20000}, [][]int{{0, 1, 2, 3, 4, 5}}, numberCards(6, 6, 1, 1), 0.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var hands []*domain.PlayerHand
+			for _, values := range tt.hands {
+				h := domain.NewPlayerHand()
+				for _, c := range numberCards(values...) {
+					h.AddCard(c)
+				}
+				hands = append(hands, h)
+			}
+			got := tt.estimator.Estimate(domain.NewDeckInOrder(tt.deck), hands)
+			if math.Abs(got-tt.want) > 0.02 {
+				t.Errorf("Expected a threat of %.2f, got %.3f", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFlip7ThreatEstimator_SkipsHandsOutOfPlay(t *testing.T) {
+	h := domain.NewPlayerHand()
+	for v := 0; v < 6; v++ {
+		h.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)})
+	}
+	h.Status = domain.HandStatusStayed
+	deck := domain.NewDeckInOrder([]domain.Card{{Type: domain.CardTypeNumber, Value: 9}})
+
+	if got := (domain.Flip7ThreatEstimator{}).Estimate(deck, []*domain.PlayerHand{h}); got != 0 {
+		t.Errorf("Expected no threat from a hand that stayed, got %.2f", got)
+	}
+}
@@ -7,6 +7,7 @@ import "fmt"
 type FlipThreeCardSource interface {
 	// GetNextCard returns the next card for Flip Three.
 	// Returns error if no card is available (deck empty, invalid input, etc.).
+	// The round is then aborted, unless the source has already ended it (e.g. as exhausted).
 	GetNextCard(cardNum int, target *Player) (Card, error)
 }
 
@@ -20,6 +21,10 @@ type FlipThreeCardProcessor interface {
 	// ProcessQueuedAction processes a queued action card (Flip Three, Freeze)
 	// after all 3 cards have been drawn.
 	ProcessQueuedAction(target *Player, card Card) error
+
+	// DiscardCard puts a queued action card on the discard pile once the Flip Three is over,
+	// whether or not it was resolved. Freeze and Flip Three never stay in a hand.
+	DiscardCard(card Card)
 }
 
 // FlipThreeLogger is an optional callback for logging during Flip Three execution.
@@ -63,6 +68,11 @@ func (fte *FlipThreeExecutor) Execute(target *Player, round *Round) bool {
 	fte.log("--- %s must draw 3 cards! ---", target.Name)
 	
 	queuedActions := []Card{}
+	defer func() {
+		for _, card := range queuedActions {
+			fte.cardProcessor.DiscardCard(card)
+		}
+	}()
 	
 	for i := 0; i < FlipThreeCardCount; i++ {
 		// Exit early if target is no longer active
@@ -74,8 +84,10 @@ func (fte *FlipThreeExecutor) Execute(target *Player, round *Round) bool {
 		card, err := fte.cardSource.GetNextCard(i+1, target)
 		if err != nil {
 			fte.log("Error: %s", err.Error())
-			round.IsEnded = true
-			round.EndReason = RoundEndReasonAborted
+			if !round.IsEnded { // The card source may have ended the round itself, e.g. as exhausted
+				round.IsEnded = true
+				round.EndReason = RoundEndReasonAborted
+			}
 			return true
 		}
 		
@@ -100,28 +112,7 @@ func (fte *FlipThreeExecutor) Execute(target *Player, round *Round) bool {
 				fte.log("Action %s queued for after Flip Three", card.ActionType)
 				queuedActions = append(queuedActions, card)
 				
-				// Add action card to hand WITHOUT triggering immediate resolution.
-				// We bypass AddCard() here intentionally because:
-				// 1. We don't want to trigger the action immediately (it's queued)
-				// 2. AddCard() would check for Flip 7, which we do manually below
-				// 3. Action cards don't add to NumberCards, so no bust risk
-				target.CurrentHand.ActionCards = append(target.CurrentHand.ActionCards, card)
-				
-				// Manually check for Flip 7 (7 unique number cards).
-				// We must check here because Flip 7 ends the round immediately,
-				// preventing further card draws during Flip Three.
-				// Note: Action cards don't add to NumberCards, but we check
-				// because the player might have already had 7 number cards.
-				if len(target.CurrentHand.NumberCards) >= 7 {
-					target.CurrentHand.Status = HandStatusStayed
-					score := target.BankCurrentHand()
-					fte.log("%s FLIP 7! Bonus! Banked %d points! Total: %d", target.Name, score, target.TotalScore)
-					
-					round.RemoveActivePlayer(target)
-					round.EndReason = RoundEndReasonFlip7
-					round.IsEnded = true
-					return true
-				}
+				// The card waits outside the hand, and is discarded once the Flip Three is over.
 				continue
 			}
 		}
@@ -140,8 +131,8 @@ func (fte *FlipThreeExecutor) Execute(target *Player, round *Round) bool {
 		}
 	}
 	
-	// Resolve queued actions if player is still active
-	if target.CurrentHand.Status == HandStatusActive {
+	// Resolve queued actions if player is still active and the round goes on
+	if !round.IsEnded && target.CurrentHand.Status == HandStatusActive {
 		for _, actionCard := range queuedActions {
 			fte.log("Resolving queued action %s...", actionCard.ActionType)
 			
@@ -154,7 +145,7 @@ func (fte *FlipThreeExecutor) Execute(target *Player, round *Round) bool {
 			}
 		}
 	}
-	// Note: If player busted during draws, queued action cards were already added to hand above
+	// Queued actions that were not resolved (the target busted or the round ended) are discarded all the same
 	
 	fte.log("--- End of Flip Three for %s ---", target.Name)
 	return round.IsEnded
@@ -30,6 +30,7 @@ func (m *mockFlipThreeCardSource) GetNextCard(cardNum int, target *domain.Player
 type mockFlipThreeCardProcessor struct {
 	immediateCards []domain.Card
 	queuedCards    []domain.Card
+	discardedCards []domain.Card
 	processError   error
 }
 
@@ -43,6 +44,10 @@ func (m *mockFlipThreeCardProcessor) ProcessQueuedAction(target *domain.Player,
 	return m.processError
 }
 
+func (m *mockFlipThreeCardProcessor) DiscardCard(card domain.Card) {
+	m.discardedCards = append(m.discardedCards, card)
+}
+
 func TestFlipThreeExecutor_Execute(t *testing.T) {
 	tests := []struct {
 		name              string
@@ -138,6 +143,14 @@ func TestFlipThreeExecutor_Execute(t *testing.T) {
 			if roundEnded != tt.shouldEndRound {
 				t.Errorf("Expected roundEnded=%v, got %v", tt.shouldEndRound, roundEnded)
 			}
+
+			// Queued actions are discarded after resolution, never kept in the hand
+			if len(processor.discardedCards) != tt.expectedQueued {
+				t.Errorf("Expected %d discarded cards, got %d", tt.expectedQueued, len(processor.discardedCards))
+			}
+			if len(player.CurrentHand.ActionCards) != 0 {
+				t.Errorf("Expected no action cards in hand, got %v", player.CurrentHand.ActionCards)
+			}
 		})
 	}
 }
@@ -210,3 +223,25 @@ func TestFlipThreeExecutor_ErrorHandling(t *testing.T) {
 		t.Errorf("Expected end reason Aborted, got %v", round.EndReason)
 	}
 }
+
+func TestFlipThreeExecutor_DiscardsUnresolvedQueuedActions(t *testing.T) {
+	player := domain.NewPlayer("TestPlayer", nil)
+	player.StartNewRound()
+	round := &domain.Round{
+		ActivePlayers: []*domain.Player{player},
+	}
+
+	// The Freeze is queued, then the deck runs out before the third card
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	source := &mockFlipThreeCardSource{cards: []domain.Card{freeze, {Type: domain.CardTypeNumber, Value: 5}}}
+	processor := &mockFlipThreeCardProcessor{}
+
+	domain.NewFlipThreeExecutor(source, processor, nil).Execute(player, round)
+
+	if len(processor.queuedCards) != 0 {
+		t.Errorf("Expected the queued Freeze not to be resolved in an aborted round, got %v", processor.queuedCards)
+	}
+	if len(processor.discardedCards) != 1 || processor.discardedCards[0] != freeze {
+		t.Errorf("Expected the Freeze to be discarded, got %v", processor.discardedCards)
+	}
+}
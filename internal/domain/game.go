@@ -1,6 +1,10 @@
 package domain
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/google/uuid"
 )
 
@@ -11,8 +15,29 @@ const (
 	RoundEndReasonNoActivePlayers RoundEndReason = "no_active_players"
 	RoundEndReasonFlip7           RoundEndReason = "flip7_achieved"
 	RoundEndReasonAborted         RoundEndReason = "aborted"
+	RoundEndReasonExhausted       RoundEndReason = "deck_exhausted" // A card had to be drawn but none was left
+)
+
+// GameEndReason explains why a game ended.
+type GameEndReason string
+
+const (
+	GameEndReasonWinner     GameEndReason = "winner"      // A player reached the winning score
+	GameEndReasonRoundLimit GameEndReason = "round_limit" // The round limit was reached without a winner
+	GameEndReasonAborted    GameEndReason = "aborted"     // Play was stopped by hand, or a round could not go on
+	GameEndReasonExhausted  GameEndReason = "exhausted"   // No card was left to draw; the highest total score won
 )
 
+// StepControl tells a game service how to go on after a turn.
+type StepControl int
+
+const (
+	StepContinue StepControl = iota // Play the next turn
+	StepRunToEnd                    // Play the rest of the game without stopping after turns
+	StepAbort                       // End the game now
+)
+
+// WinningThreshold is the default score needed to win a game.
 const WinningThreshold = 200
 
 // Round represents a single round of play.
@@ -25,10 +50,23 @@ type Round struct {
 	CurrentTurnIndex int            `json:"current_turn_index"`
 	IsEnded          bool           `json:"is_ended"`
 	EndReason        RoundEndReason `json:"end_reason"`
+	// CardAllowances maps player IDs to the cards they may hold this round; nil unless
+	// TrackCardAllowances was called.
+	CardAllowances map[string]*CardAllowance `json:"card_allowances"`
+	// DiscardsBefore is the length of the game's discard pile when the round began: the cards
+	// after it were discarded this round (see Discarded). Reshuffle resets it to 0.
+	DiscardsBefore int `json:"discards_before,omitempty"`
+	// Reshuffles counts the times the discard pile became the deck during the round.
+	Reshuffles int `json:"reshuffles,omitempty"`
 }
 
 // NewRound creates a new round.
+// Every player starts the round with a fresh hand (StartNewRound is called on each of them).
 func NewRound(players []*Player, dealer *Player, deck *Deck) *Round {
+	for _, p := range players {
+		p.StartNewRound()
+	}
+
 	// Find dealer index
 	dealerIdx := -1
 	for i, p := range players {
@@ -38,20 +76,21 @@ func NewRound(players []*Player, dealer *Player, deck *Deck) *Round {
 		}
 	}
 
-	// Reorder players starting from dealer
+	// Reorder players starting from dealer (dropped players do not take part)
 	active := []*Player{}
 	if dealerIdx != -1 {
 		for i := 0; i < len(players); i++ {
 			idx := (dealerIdx + i) % len(players)
-			p := players[idx]
-			p.StartNewRound()
-			active = append(active, p)
+			if !players[idx].Dropped {
+				active = append(active, players[idx])
+			}
 		}
 	} else {
 		// Fallback if dealer not found (shouldn't happen)
 		for _, p := range players {
-			p.StartNewRound()
-			active = append(active, p)
+			if !p.Dropped {
+				active = append(active, p)
+			}
 		}
 	}
 
@@ -65,12 +104,30 @@ func NewRound(players []*Player, dealer *Player, deck *Deck) *Round {
 	}
 }
 
+// Reshuffle makes deck, built from the discard pile, the round's deck and counts the
+// reshuffle. The cards discarded so far this round are in deck now, so DiscardsBefore is reset.
+// Services then tell the ReshuffleAware strategies.
+func (r *Round) Reshuffle(deck *Deck) {
+	r.Deck = deck
+	r.Reshuffles++
+	r.DiscardsBefore = 0
+}
+
 // End marks the round as ended with a reason.
 func (r *Round) End(reason RoundEndReason) {
 	r.IsEnded = true
 	r.EndReason = reason
 }
 
+// DealsInitialCardTo reports whether p still receives an initial card when the deal reaches them.
+// An action dealt earlier in the deal can end a player's round before their own card:
+// a player frozen before being dealt banks their empty hand (0 points) and is out, and a
+// player busted by a Flip Three is out too. A Flip Three target who is still active
+// keeps the cards it drew and is dealt their initial card as usual.
+func (r *Round) DealsInitialCardTo(p *Player) bool {
+	return p != nil && p.CurrentHand != nil && p.CurrentHand.Status == HandStatusActive
+}
+
 // Game represents the entire game session.
 type Game struct {
 	ID           uuid.UUID `json:"id"`
@@ -81,28 +138,112 @@ type Game struct {
 	Winners      []*Player `json:"winners"`
 	DiscardPile  []Card    `json:"discard_pile"`
 	RoundCount   int       `json:"round_count"`
-	Deck         *Deck     `json:"deck"`
+	Deck         *Deck     `json:"deck"`          // Carried across rounds; a deck set before RunGame is used for round one
+	WinningScore int       `json:"winning_score"` // Score needed to win (WinningThreshold unless configured)
+	// EndReason is why the game is completed; empty while it is in progress.
+	EndReason GameEndReason `json:"end_reason,omitempty"`
+	// TeamWinningScore is the combined score a team needs to win when players have teams
+	// (TeamWinningThreshold unless configured). Solo games ignore it.
+	TeamWinningScore int `json:"team_winning_score,omitempty"`
+	// ExhaustionRule decides what happens to the hands in play when the deck and the discard
+	// pile run out mid-round (ExhaustionBankHands unless configured). See Round.Exhaust.
+	ExhaustionRule ExhaustionRule `json:"exhaustion_rule,omitempty"`
+	// HoldableActions turns on the house rule where a drawn Freeze or Flip Three may be kept in
+	// the hand and played at the start of a later turn of its holder instead of at once.
+	// Held cards that are never played go to the discard pile with the hand at round end.
+	HoldableActions bool `json:"holdable_actions,omitempty"`
 }
 
-// NewGame creates a new game.
+// NewGame creates a new game played to WinningThreshold points.
 func NewGame(players []*Player) *Game {
 	return &Game{
-		ID:      uuid.New(),
-		Players: players,
+		ID:           uuid.New(),
+		Players:      players,
+		WinningScore: WinningThreshold,
+	}
+}
+
+// End marks the game as completed with a reason.
+func (g *Game) End(reason GameEndReason) {
+	g.IsCompleted = true
+	g.EndReason = reason
+}
+
+// TargetScore returns the score needed to win this game.
+// Games created without NewGame (or loaded from saves that predate WinningScore) use WinningThreshold.
+func (g *Game) TargetScore() int {
+	if g.WinningScore <= 0 {
+		return WinningThreshold
 	}
+	return g.WinningScore
 }
 
-// DetermineWinners checks if any player has >= 200 points and returns the winner(s).
-// If multiple players have >= 200, the one with the highest score wins.
+// DetermineWinners checks if any player has reached the target score and returns the winner(s).
+// If multiple players have reached it, the one with the highest score wins.
 // If there's a tie for the highest score, all tied players are returned.
-// Returns nil if no player has reached 200 points.
+// Returns nil if no player has reached the target score. A player below the target can never
+// outscore one at or above it, so the filter only decides whether the game is over; see
+// FinalStandings for the ranking of every player.
+// When players have teams, combined team scores are compared against TeamTargetScore instead
+// and every player of the winning team(s) is returned.
 func (g *Game) DetermineWinners() []*Player {
+	if g.HasTeams() {
+		return g.determineTeamWinners(g.TeamTargetScore())
+	}
+	return g.highestScorers(g.TargetScore())
+}
+
+// Leaders returns the player(s) with the highest total score, whatever the target score
+// (every player of the leading team(s) when players have teams).
+func (g *Game) Leaders() []*Player {
+	if g.HasTeams() {
+		return g.determineTeamWinners(0)
+	}
+	return g.highestScorers(0)
+}
+
+// Standing is a player's place at the end of a game.
+type Standing struct {
+	Player *Player
+	// Rank is 1 for the highest total score. Players level on score share a rank and the
+	// ones after them skip as many, e.g. 1, 1, 3.
+	Rank int
+	// Margin is how many points the player is behind the highest total score; 0 for the leaders.
+	Margin int
+}
+
+// FinalStandings returns every player, dropped ones included, ranked by TotalScore, highest
+// first, keeping seat order between players level on score. The game ends after the round in
+// which someone reaches the target, so everyone's totals from that round count; the winners
+// are the leaders of the standings whenever a player reached the target. Team games rank the
+// players by their own score too.
+func (g *Game) FinalStandings() []Standing {
+	standings := make([]Standing, len(g.Players))
+	for i, p := range g.Players {
+		standings[i] = Standing{Player: p}
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		return standings[i].Player.TotalScore > standings[j].Player.TotalScore
+	})
+	for i := range standings {
+		s := &standings[i]
+		s.Margin = standings[0].Player.TotalScore - s.Player.TotalScore
+		s.Rank = i + 1
+		if i > 0 && s.Player.TotalScore == standings[i-1].Player.TotalScore {
+			s.Rank = standings[i-1].Rank
+		}
+	}
+	return standings
+}
+
+// highestScorers returns the players with the highest total score among those with at least target points.
+func (g *Game) highestScorers(target int) []*Player {
 	var candidates []*Player
 	highestScore := 0
 
-	// Find players with >= WinningThreshold points
+	// Find players with >= target points
 	for _, p := range g.Players {
-		if p.TotalScore >= WinningThreshold {
+		if p.TotalScore >= target {
 			if p.TotalScore > highestScore {
 				highestScore = p.TotalScore
 				candidates = []*Player{p}
@@ -115,21 +256,134 @@ func (g *Game) DetermineWinners() []*Player {
 	return candidates
 }
 
-// RemoveActivePlayer removes a player from the active players list.
-// If the removed player is before the current turn index, the index is adjusted.
+// DiscardHands moves the cards of every player's hand to the discard pile and empties the hands.
+// Services call it once a round is over; cards removed from play during the round
+// (a used Second Chance and the duplicate it absorbed, an undeliverable Second Chance)
+// go to the discard pile when that happens.
+func (g *Game) DiscardHands() {
+	for _, p := range g.Players {
+		h := p.CurrentHand
+		if h == nil {
+			continue
+		}
+		for _, val := range h.RawNumberCards {
+			g.DiscardPile = append(g.DiscardPile, Card{Type: CardTypeNumber, Value: val})
+		}
+		g.DiscardPile = append(g.DiscardPile, h.ModifierCards...)
+		g.DiscardPile = append(g.DiscardPile, h.ActionCards...)
+
+		clear(h.NumberCards)
+		h.RawNumberCards = nil
+		h.ModifierCards = nil
+		h.ActionCards = nil
+	}
+}
+
+// ValidateConservation checks that every card of the standard deck is in exactly one place:
+// the deck, the discard pile or a player's hand. The deck of the current round is used when
+// there is one, since a reshuffle replaces it. It returns an error wrapping ErrCardsNotConserved
+// that lists the miscounted cards otherwise.
+func (g *Game) ValidateConservation() error {
+	counts := make(map[Card]int)
+	deck := g.Deck
+	if g.CurrentRound != nil && g.CurrentRound.Deck != nil {
+		deck = g.CurrentRound.Deck
+	}
+	if deck != nil {
+		for _, c := range deck.Cards {
+			counts[c]++
+		}
+	}
+	for _, c := range g.DiscardPile {
+		counts[c]++
+	}
+	for _, p := range g.Players {
+		h := p.CurrentHand
+		if h == nil {
+			continue
+		}
+		for _, val := range h.RawNumberCards {
+			counts[Card{Type: CardTypeNumber, Value: val}]++
+		}
+		for _, c := range h.ModifierCards {
+			counts[c]++
+		}
+		for _, c := range h.ActionCards {
+			counts[c]++
+		}
+	}
+
+	expected := make(map[Card]int)
+	for _, c := range StandardDeckCards() {
+		expected[c]++
+	}
+
+	var problems []string
+	for c, want := range expected {
+		if got := counts[c]; got != want {
+			problems = append(problems, fmt.Sprintf("%s: %d counted, %d expected", c, got, want))
+		}
+	}
+	for c, got := range counts {
+		if _, ok := expected[c]; !ok {
+			problems = append(problems, fmt.Sprintf("%s: %d counted, 0 expected", c, got))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return fmt.Errorf("%w: %s", ErrCardsNotConserved, strings.Join(problems, "; "))
+}
+
+// NextDealer passes the deal to the next seat (to the left), skipping dropped players.
+// It updates DealerIndex and returns the new dealer. An out-of-range DealerIndex is
+// normalized first, so rotation never indexes outside Players.
+// If every other player has been dropped, the current dealer keeps the deal.
+func (g *Game) NextDealer() *Player {
+	n := len(g.Players)
+	if n == 0 {
+		return nil
+	}
+	if g.DealerIndex < 0 || g.DealerIndex >= n {
+		g.DealerIndex = 0
+	}
+	for i := 1; i <= n; i++ {
+		idx := (g.DealerIndex + i) % n
+		if !g.Players[idx].Dropped {
+			g.DealerIndex = idx
+			break
+		}
+	}
+	return g.Players[g.DealerIndex]
+}
+
+// RemoveActivePlayer removes every entry of the player from the active players list.
+// Removing a player who is no longer active does nothing.
+// CurrentTurnIndex keeps pointing at the same turn: it moves back once for each entry removed
+// before it, and when the current entry itself is removed the next player slides into its place.
 func (r *Round) RemoveActivePlayer(p *Player) {
+	kept := r.ActivePlayers[:0]
+	turn := r.CurrentTurnIndex
 	for i, ap := range r.ActivePlayers {
-		if ap.ID == p.ID {
-			// If we're removing a player before the current turn index,
-			// decrement the index to account for the shift.
-			// If i == CurrentTurnIndex (removing current player), don't decrement
-			// because the next player slides into the current position.
-			// If i > CurrentTurnIndex, no adjustment needed.
-			if i < r.CurrentTurnIndex {
-				r.CurrentTurnIndex--
-			}
-			r.ActivePlayers = append(r.ActivePlayers[:i], r.ActivePlayers[i+1:]...)
-			return
+		if ap.ID != p.ID {
+			kept = append(kept, ap)
+			continue
+		}
+		if i < r.CurrentTurnIndex {
+			turn--
+		}
+	}
+	r.ActivePlayers = kept
+	r.CurrentTurnIndex = turn
+}
+
+// ContainsActive reports whether the player is still in the active players list.
+func (r *Round) ContainsActive(playerID uuid.UUID) bool {
+	for _, ap := range r.ActivePlayers {
+		if ap.ID == playerID {
+			return true
 		}
 	}
+	return false
 }
@@ -0,0 +1,83 @@
+package domain
+
+// PlayerGameStats counts what happened to one player over a game, for the end-of-game screen.
+type PlayerGameStats struct {
+	Busts             int   `json:"busts"`
+	Flip7s            int   `json:"flip7s"`
+	FreezesGiven      int   `json:"freezes_given"`    // Freezes played on another player
+	FreezesReceived   int   `json:"freezes_received"` // Freezes another player played on this one
+	SecondChancesUsed int   `json:"second_chances_used"`
+	Scores            []int `json:"scores"` // Total score after each round
+}
+
+// BestRound returns the most points banked in a single round, 0 before any round ends.
+func (st *PlayerGameStats) BestRound() int {
+	best, previous := 0, 0
+	for _, score := range st.Scores {
+		if score-previous > best {
+			best = score - previous
+		}
+		previous = score
+	}
+	return best
+}
+
+// GameStats collects the PlayerGameStats of every player of a game, by player ID. It is an
+// EventSink, so a service that publishes events can subscribe it; Manual Mode, which does
+// not, records the same counts directly.
+type GameStats struct {
+	Players map[string]*PlayerGameStats `json:"players"`
+}
+
+// NewGameStats returns an empty GameStats.
+func NewGameStats() *GameStats {
+	return &GameStats{Players: make(map[string]*PlayerGameStats)}
+}
+
+// Player returns the stats of p, starting them if p has none yet.
+func (g *GameStats) Player(p *Player) *PlayerGameStats {
+	if g.Players == nil {
+		g.Players = make(map[string]*PlayerGameStats)
+	}
+	id := p.ID.String()
+	st, ok := g.Players[id]
+	if !ok {
+		st = &PlayerGameStats{}
+		g.Players[id] = st
+	}
+	return st
+}
+
+// RecordFreeze counts a Freeze that by played on target. Freezing oneself is neither given
+// nor received.
+func (g *GameStats) RecordFreeze(by, target *Player) {
+	if by == nil || by.ID == target.ID {
+		return
+	}
+	g.Player(by).FreezesGiven++
+	g.Player(target).FreezesReceived++
+}
+
+// RecordRound appends the total score of every player after a round.
+func (g *GameStats) RecordRound(players []*Player) {
+	for _, p := range players {
+		st := g.Player(p)
+		st.Scores = append(st.Scores, p.TotalScore)
+	}
+}
+
+// Publish implements EventSink.
+func (g *GameStats) Publish(e Event) {
+	switch e := e.(type) {
+	case PlayerBusted:
+		g.Player(e.Player).Busts++
+	case Flip7Achieved:
+		g.Player(e.Player).Flip7s++
+	case PlayerFrozen:
+		g.RecordFreeze(e.By, e.Player)
+	case SecondChanceUsed:
+		g.Player(e.Player).SecondChancesUsed++
+	case RoundEnded:
+		g.RecordRound(e.Round.Players)
+	}
+}
@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func TestPlayerGameStats_BestRound(t *testing.T) {
+	tests := []struct {
+		name   string
+		scores []int
+		want   int
+	}{
+		{"no rounds", nil, 0},
+		{"first round", []int{12}, 12},
+		{"later round", []int{12, 31, 31, 58}, 27},
+		{"nothing banked", []int{0, 0}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st := &domain.PlayerGameStats{Scores: tt.scores}
+			if got := st.BestRound(); got != tt.want {
+				t.Errorf("Expected %d, got %d", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGameStats_RecordFreeze(t *testing.T) {
+	alice := domain.NewPlayer("Alice", nil)
+	bob := domain.NewPlayer("Bob", nil)
+	stats := domain.NewGameStats()
+
+	stats.RecordFreeze(alice, bob)
+	stats.RecordFreeze(bob, bob) // Freezing oneself banks one's own hand
+	stats.RecordFreeze(nil, alice)
+
+	if got := stats.Player(alice); got.FreezesGiven != 1 || got.FreezesReceived != 0 {
+		t.Errorf("Expected Alice to have given 1 Freeze and received none, got %+v", *got)
+	}
+	if got := stats.Player(bob); got.FreezesGiven != 0 || got.FreezesReceived != 1 {
+		t.Errorf("Expected Bob to have received 1 Freeze and given none, got %+v", *got)
+	}
+}
@@ -1,7 +1,9 @@
 package domain_test
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"flip7_strategy/internal/domain"
@@ -77,6 +79,115 @@ func TestDetermineWinners(t *testing.T) {
 	}
 }
 
+func TestDetermineWinners_CustomWinningScore(t *testing.T) {
+	p1 := domain.NewPlayer("P1", nil)
+	p2 := domain.NewPlayer("P2", nil)
+	p1.TotalScore = 99
+	p2.TotalScore = 120
+	game := domain.NewGame([]*domain.Player{p1, p2})
+
+	if game.WinningScore != domain.WinningThreshold {
+		t.Errorf("Expected default WinningScore %d, got %d", domain.WinningThreshold, game.WinningScore)
+	}
+	if winners := game.DetermineWinners(); len(winners) != 0 {
+		t.Errorf("Expected no winners at default score, got %d", len(winners))
+	}
+
+	game.WinningScore = 100
+	winners := game.DetermineWinners()
+	if len(winners) != 1 || winners[0].ID != p2.ID {
+		t.Errorf("Expected P2 to win a game to 100, got %v", winners)
+	}
+
+	// Games without a configured score (e.g. old saves) fall back to the default
+	game.WinningScore = 0
+	if game.TargetScore() != domain.WinningThreshold {
+		t.Errorf("Expected TargetScore %d, got %d", domain.WinningThreshold, game.TargetScore())
+	}
+}
+
+func TestFinalStandings(t *testing.T) {
+	tests := []struct {
+		name    string
+		scores  []int
+		winners int   // Players DetermineWinners returns, the first ones in seat order
+		ranks   []int // In seat order
+		margins []int // In seat order
+	}{
+		{
+			// The game ends after the round someone reaches the target, so everyone's totals count
+			name:    "Exact tie at the threshold",
+			scores:  []int{200, 150, 200},
+			winners: 2,
+			ranks:   []int{1, 3, 1},
+			margins: []int{0, 50, 0},
+		},
+		{
+			name:    "Tie below the top",
+			scores:  []int{190, 215, 190},
+			winners: 1,
+			ranks:   []int{2, 1, 2},
+			margins: []int{25, 0, 25},
+		},
+		{
+			// Only a score of at least 200 wins, but a player below it is never ahead of one
+			// above: the highest score is always a winner's, and everyone is still ranked
+			name:    "Just below the threshold",
+			scores:  []int{199, 200, 40},
+			winners: 1,
+			ranks:   []int{2, 1, 3},
+			margins: []int{1, 0, 160},
+		},
+		{
+			name:    "No one at the threshold",
+			scores:  []int{120, 180},
+			winners: 0,
+			ranks:   []int{2, 1},
+			margins: []int{60, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := make([]*domain.Player, len(tt.scores))
+			for i, score := range tt.scores {
+				players[i] = domain.NewPlayer(fmt.Sprintf("P%d", i+1), nil)
+				players[i].TotalScore = score
+			}
+			game := domain.NewGame(players)
+
+			standings := game.FinalStandings()
+			if len(standings) != len(players) {
+				t.Fatalf("Expected every player in the standings, got %d", len(standings))
+			}
+			for i, s := range standings {
+				if i > 0 && s.Player.TotalScore > standings[i-1].Player.TotalScore {
+					t.Errorf("Expected the standings sorted by score, got %s after %s", s.Player.Name, standings[i-1].Player.Name)
+				}
+				seat := -1
+				for j, p := range players {
+					if p == s.Player {
+						seat = j
+					}
+				}
+				if s.Rank != tt.ranks[seat] || s.Margin != tt.margins[seat] {
+					t.Errorf("Expected %s ranked %d, %d behind, got %d, %d", s.Player.Name, tt.ranks[seat], tt.margins[seat], s.Rank, s.Margin)
+				}
+			}
+
+			winners := game.DetermineWinners()
+			if len(winners) != tt.winners {
+				t.Fatalf("Expected %d winner(s), got %d", tt.winners, len(winners))
+			}
+			for i, w := range winners {
+				if standings[i].Player != w || standings[i].Rank != 1 {
+					t.Errorf("Expected the winner %s to lead the standings, got %s ranked %d", w.Name, standings[i].Player.Name, standings[i].Rank)
+				}
+			}
+		})
+	}
+}
+
 func TestRoundRobinDealerRotation(t *testing.T) {
 	p1 := domain.NewPlayer("P1", nil)
 	p2 := domain.NewPlayer("P2", nil)
@@ -102,7 +213,7 @@ func TestRoundRobinDealerRotation(t *testing.T) {
 		t.Errorf("Round 1: Expected Start Player P1, got %s", game.CurrentRound.ActivePlayers[0].Name)
 	}
 
-	game.DealerIndex = (game.DealerIndex + 1) % len(game.Players)
+	game.NextDealer()
 
 	game.CurrentRound = domain.NewRound(game.Players, game.Players[game.DealerIndex], domain.NewDeck())
 	if game.CurrentRound.Dealer.ID != p2.ID {
@@ -121,7 +232,7 @@ func TestRoundRobinDealerRotation(t *testing.T) {
 		t.Errorf("Round 2: Expected Third Player P1, got %s", game.CurrentRound.ActivePlayers[2].Name)
 	}
 
-	game.DealerIndex = (game.DealerIndex + 1) % len(game.Players)
+	game.NextDealer()
 
 	game.CurrentRound = domain.NewRound(game.Players, game.Players[game.DealerIndex], domain.NewDeck())
 	if game.CurrentRound.Dealer.ID != p3.ID {
@@ -134,8 +245,241 @@ func TestRoundRobinDealerRotation(t *testing.T) {
 		t.Errorf("Round 3: Expected Start Player P3, got %s", game.CurrentRound.ActivePlayers[0].Name)
 	}
 
-	game.DealerIndex = (game.DealerIndex + 1) % len(game.Players)
+	game.NextDealer()
 	if game.DealerIndex != 0 {
 		t.Errorf("Expected DealerIndex to wrap to 0, got %d", game.DealerIndex)
 	}
 }
+
+func TestNextDealer_SkipsDroppedPlayer(t *testing.T) {
+	p1 := domain.NewPlayer("P1", nil)
+	p2 := domain.NewPlayer("P2", nil)
+	p3 := domain.NewPlayer("P3", nil)
+	game := domain.NewGame([]*domain.Player{p1, p2, p3})
+
+	p2.Dropped = true
+
+	if dealer := game.NextDealer(); dealer.ID != p3.ID {
+		t.Errorf("Expected dealer to skip dropped P2 and pass to P3, got %s", dealer.Name)
+	}
+	if game.DealerIndex != 2 {
+		t.Errorf("Expected DealerIndex 2, got %d", game.DealerIndex)
+	}
+	if dealer := game.NextDealer(); dealer.ID != p1.ID {
+		t.Errorf("Expected dealer to wrap to P1, got %s", dealer.Name)
+	}
+
+	// Dropped players are not dealt into the round
+	round := domain.NewRound(game.Players, p1, domain.NewDeck())
+	if len(round.ActivePlayers) != 2 {
+		t.Fatalf("Expected 2 active players, got %d", len(round.ActivePlayers))
+	}
+	if round.ActivePlayers[0].ID != p1.ID || round.ActivePlayers[1].ID != p3.ID {
+		t.Errorf("Expected turn order P1, P3, got %s, %s", round.ActivePlayers[0].Name, round.ActivePlayers[1].Name)
+	}
+
+	// If everyone else has dropped, the current dealer keeps the deal
+	p3.Dropped = true
+	if dealer := game.NextDealer(); dealer.ID != p1.ID {
+		t.Errorf("Expected P1 to keep the deal, got %s", dealer.Name)
+	}
+}
+
+func TestNextDealer_NormalizesOutOfRangeIndex(t *testing.T) {
+	p1 := domain.NewPlayer("P1", nil)
+	p2 := domain.NewPlayer("P2", nil)
+	game := domain.NewGame([]*domain.Player{p1, p2})
+	game.DealerIndex = 7
+
+	if dealer := game.NextDealer(); dealer.ID != p2.ID {
+		t.Errorf("Expected P2 after normalizing dealer index, got %s", dealer.Name)
+	}
+}
+
+func TestGame_ValidateConservation(t *testing.T) {
+	newGame := func() (*domain.Game, *domain.Player) {
+		p := domain.NewPlayer("P1", nil)
+		g := domain.NewGame([]*domain.Player{p})
+		g.Deck = domain.NewDeck()
+		g.CurrentRound = domain.NewRound(g.Players, p, g.Deck)
+		return g, p
+	}
+	draw := func(t *testing.T, g *domain.Game) domain.Card {
+		card, err := g.CurrentRound.Deck.Draw()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return card
+	}
+
+	t.Run("Full deck", func(t *testing.T) {
+		g, _ := newGame()
+		if err := g.ValidateConservation(); err != nil {
+			t.Errorf("Expected a full deck to be conserved, got: %v", err)
+		}
+	})
+
+	t.Run("Cards in hands and discard pile", func(t *testing.T) {
+		g, p := newGame()
+		for i := 0; i < 10; i++ {
+			card := draw(t, g)
+			if _, _, discarded := p.CurrentHand.AddCard(card); len(discarded) > 0 {
+				g.DiscardPile = append(g.DiscardPile, discarded...)
+			}
+		}
+		g.DiscardPile = append(g.DiscardPile, draw(t, g))
+		if err := g.ValidateConservation(); err != nil {
+			t.Errorf("Expected cards to be conserved, got: %v", err)
+		}
+
+		g.DiscardHands()
+		if len(p.CurrentHand.RawNumberCards)+len(p.CurrentHand.ModifierCards)+len(p.CurrentHand.ActionCards) != 0 {
+			t.Errorf("Expected DiscardHands to empty the hand")
+		}
+		if err := g.ValidateConservation(); err != nil {
+			t.Errorf("Expected cards to be conserved after DiscardHands, got: %v", err)
+		}
+	})
+
+	t.Run("Lost card", func(t *testing.T) {
+		g, _ := newGame()
+		draw(t, g)
+		if err := g.ValidateConservation(); !errors.Is(err, domain.ErrCardsNotConserved) {
+			t.Errorf("Expected ErrCardsNotConserved for a lost card, got: %v", err)
+		}
+	})
+
+	t.Run("Card counted twice", func(t *testing.T) {
+		g, _ := newGame()
+		g.Deck = domain.NewDeckInOrder(domain.StandardDeckCards())
+		g.CurrentRound.Deck = g.Deck
+		g.DiscardPile = append(g.DiscardPile, domain.Card{Type: domain.CardTypeNumber, Value: 12})
+
+		err := g.ValidateConservation()
+		if !errors.Is(err, domain.ErrCardsNotConserved) {
+			t.Fatalf("Expected ErrCardsNotConserved for a duplicated card, got: %v", err)
+		}
+		if !strings.Contains(err.Error(), "12: 13 counted, 12 expected") {
+			t.Errorf("Expected the miscounted card in the error, got: %v", err)
+		}
+	})
+}
+
+func TestRound_RemoveActivePlayer(t *testing.T) {
+	a := domain.NewPlayer("A", nil)
+	b := domain.NewPlayer("B", nil)
+	c := domain.NewPlayer("C", nil)
+	d := domain.NewPlayer("D", nil)
+	outsider := domain.NewPlayer("Outsider", nil)
+
+	tests := []struct {
+		name      string
+		active    []*domain.Player
+		turn      int
+		remove    *domain.Player
+		want      []*domain.Player
+		wantTurn  int
+		wantAfter *domain.Player // Player whose turn it is after the removal
+	}{
+		{"current player", []*domain.Player{a, b, c, d}, 1, b, []*domain.Player{a, c, d}, 1, c},
+		{"player before the turn", []*domain.Player{a, b, c, d}, 2, a, []*domain.Player{b, c, d}, 1, c},
+		{"player after the turn", []*domain.Player{a, b, c, d}, 1, d, []*domain.Player{a, b, c}, 1, b},
+		{"duplicate entries", []*domain.Player{a, b, a, c, a}, 3, a, []*domain.Player{b, c}, 1, c},
+		{"non-member", []*domain.Player{a, b, c}, 2, outsider, []*domain.Player{a, b, c}, 2, c},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			round := &domain.Round{ActivePlayers: append([]*domain.Player{}, tt.active...), CurrentTurnIndex: tt.turn}
+
+			round.RemoveActivePlayer(tt.remove)
+			// Removing again must change nothing
+			round.RemoveActivePlayer(tt.remove)
+
+			if len(round.ActivePlayers) != len(tt.want) {
+				t.Fatalf("Expected %d active players, got %d", len(tt.want), len(round.ActivePlayers))
+			}
+			for i, p := range tt.want {
+				if round.ActivePlayers[i] != p {
+					t.Errorf("Position %d: expected %s, got %s", i, p.Name, round.ActivePlayers[i].Name)
+				}
+			}
+			if round.CurrentTurnIndex != tt.wantTurn {
+				t.Errorf("Expected turn index %d, got %d", tt.wantTurn, round.CurrentTurnIndex)
+			}
+			if got := round.ActivePlayers[round.CurrentTurnIndex]; got != tt.wantAfter {
+				t.Errorf("Expected %s to be up next, got %s", tt.wantAfter.Name, got.Name)
+			}
+			if round.ContainsActive(tt.remove.ID) {
+				t.Errorf("Expected %s to be gone from the active players", tt.remove.Name)
+			}
+		})
+	}
+}
+
+func TestRound_ContainsActive(t *testing.T) {
+	a := domain.NewPlayer("A", nil)
+	b := domain.NewPlayer("B", nil)
+	round := domain.NewRound([]*domain.Player{a, b}, a, domain.NewDeck())
+
+	if !round.ContainsActive(a.ID) || !round.ContainsActive(b.ID) {
+		t.Fatalf("Expected both players to be active at the start of the round")
+	}
+	round.RemoveActivePlayer(b)
+	if round.ContainsActive(b.ID) {
+		t.Errorf("Expected B to be inactive after removal")
+	}
+}
+
+func TestGame_Invariants(t *testing.T) {
+	newGame := func() (*domain.Game, *domain.Player, *domain.Player) {
+		a, b := domain.NewPlayer("A", nil), domain.NewPlayer("B", nil)
+		g := domain.NewGame([]*domain.Player{a, b})
+		g.Deck = domain.NewDeck()
+		g.CurrentRound = domain.NewRound(g.Players, a, g.Deck)
+		return g, a, b
+	}
+
+	t.Run("Fresh game", func(t *testing.T) {
+		g, _, _ := newGame()
+		if err := g.Invariants(); err != nil {
+			t.Errorf("Expected a fresh game to hold its invariants, got: %v", err)
+		}
+	})
+
+	t.Run("Completed games", func(t *testing.T) {
+		for _, reason := range []domain.GameEndReason{domain.GameEndReasonRoundLimit, domain.GameEndReasonAborted} {
+			g, _, _ := newGame()
+			g.End(reason)
+			if err := g.Invariants(); err != nil {
+				t.Errorf("Expected a game ended by %q to need no winner, got: %v", reason, err)
+			}
+		}
+		g, a, _ := newGame()
+		g.End(domain.GameEndReasonWinner)
+		if err := g.Invariants(); !errors.Is(err, domain.ErrInvariantBroken) {
+			t.Errorf("Expected ErrInvariantBroken for a won game without winners, got: %v", err)
+		}
+		g.Winners = []*domain.Player{a}
+		if err := g.Invariants(); err != nil {
+			t.Errorf("Expected a won game with a winner to hold its invariants, got: %v", err)
+		}
+	})
+
+	t.Run("Every broken invariant is reported", func(t *testing.T) {
+		g, a, b := newGame()
+		b.TotalScore = -5
+		g.CurrentRound.ActivePlayers = append(g.CurrentRound.ActivePlayers, a)
+		g.DiscardPile = append(g.DiscardPile, domain.Card{Type: domain.CardTypeNumber, Value: 12})
+
+		err := g.Invariants()
+		if !errors.Is(err, domain.ErrInvariantBroken) || !errors.Is(err, domain.ErrCardsNotConserved) {
+			t.Fatalf("Expected both ErrInvariantBroken and ErrCardsNotConserved, got: %v", err)
+		}
+		for _, want := range []string{"B has a negative total score -5", "A is an active player twice", "12: 13 counted"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("Expected %q in %v", want, err)
+			}
+		}
+	})
+}
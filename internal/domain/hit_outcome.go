@@ -0,0 +1,111 @@
+package domain
+
+// HitOutcome summarizes the consequences of hitting versus staying with the current hand.
+// All scores are hand scores for the current round (not including the player's banked total).
+type HitOutcome struct {
+	StayScore int // Score banked by staying now
+
+	// One draw
+	BustProbability     float64 // Probability that the next card busts the hand
+	Flip7Probability    float64 // Probability that the next card completes Flip 7
+	ExpectedScoreIfSafe float64 // Expected hand score after the draw, given no bust
+	ExpectedScore       float64 // Expected hand score after the draw (bust counts as 0)
+
+	// Two draws (hit, then hit again unless the first draw ended the turn)
+	TwoDrawBustProbability float64
+	TwoDrawExpectedScore   float64
+}
+
+// HitOutcomeAnalyzer computes HitOutcome by exact enumeration over the remaining deck.
+// It works on clones of the hand, so neither the deck nor the hand is modified.
+type HitOutcomeAnalyzer struct {
+	calc *ScoreCalculator
+}
+
+// NewHitOutcomeAnalyzer creates a new HitOutcomeAnalyzer.
+func NewHitOutcomeAnalyzer() *HitOutcomeAnalyzer {
+	return &HitOutcomeAnalyzer{calc: NewScoreCalculator()}
+}
+
+// Analyze enumerates every possible next card (and every ordered pair for the two-draw lookahead).
+// Second Chance cards in hand absorb a duplicate and modifiers (including x2) are scored
+// exactly as ScoreCalculator would score the resulting hand.
+func (a *HitOutcomeAnalyzer) Analyze(deck *Deck, hand *PlayerHand) HitOutcome {
+	outcome := HitOutcome{StayScore: a.calc.Compute(hand).Total}
+
+	n := len(deck.Cards)
+	if n == 0 {
+		outcome.ExpectedScore = float64(outcome.StayScore)
+		outcome.ExpectedScoreIfSafe = float64(outcome.StayScore)
+		outcome.TwoDrawExpectedScore = float64(outcome.StayScore)
+		return outcome
+	}
+
+	busts, flip7s := 0, 0
+	safeTotal, total := 0.0, 0.0
+	twoBusts, twoPairs := 0, 0
+	twoTotal := 0.0
+
+	for i, card := range deck.Cards {
+		first := hand.Clone()
+		busted, flip7, _ := first.AddCard(card)
+
+		switch {
+		case busted:
+			busts++
+		case flip7:
+			flip7s++
+			score := float64(a.calc.Compute(first).Total)
+			safeTotal += score
+			total += score
+		default:
+			score := float64(a.calc.Compute(first).Total)
+			safeTotal += score
+			total += score
+		}
+
+		// Two-draw lookahead: the second card is any other remaining card.
+		if n < 2 {
+			continue
+		}
+		for j, next := range deck.Cards {
+			if j == i {
+				continue
+			}
+			twoPairs++
+			if busted {
+				twoBusts++
+				continue
+			}
+			if flip7 {
+				// Flip 7 ends the round; the second draw never happens.
+				twoTotal += float64(a.calc.Compute(first).Total)
+				continue
+			}
+			second := first.Clone()
+			if b, _, _ := second.AddCard(next); b {
+				twoBusts++
+				continue
+			}
+			twoTotal += float64(a.calc.Compute(second).Total)
+		}
+	}
+
+	outcome.BustProbability = float64(busts) / float64(n)
+	outcome.Flip7Probability = float64(flip7s) / float64(n)
+	outcome.ExpectedScore = total / float64(n)
+	if safe := n - busts; safe > 0 {
+		outcome.ExpectedScoreIfSafe = safeTotal / float64(safe)
+	}
+
+	if twoPairs > 0 {
+		outcome.TwoDrawBustProbability = float64(twoBusts) / float64(twoPairs)
+		outcome.TwoDrawExpectedScore = twoTotal / float64(twoPairs)
+	} else {
+		// Only one card left: a second draw is not possible without a reshuffle.
+		outcome.TwoDrawBustProbability = outcome.BustProbability
+		outcome.TwoDrawExpectedScore = outcome.ExpectedScore
+	}
+
+	return outcome
+}
@@ -0,0 +1,128 @@
+package domain_test
+
+import (
+	"math"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func TestHitOutcomeAnalyzer_Analyze(t *testing.T) {
+	number := func(v int) domain.Card {
+		return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+	}
+	secondChance := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}
+	x2 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}
+
+	tests := []struct {
+		name          string
+		handCards     []domain.Card
+		deckCards     []domain.Card
+		wantStay      int
+		wantBust      float64
+		wantFlip7     float64
+		wantIfSafe    float64
+		wantExpected  float64
+		wantTwoBust   float64
+		wantTwoExpect float64
+	}{
+		{
+			name:      "Half the deck busts",
+			handCards: []domain.Card{number(10)},
+			deckCards: []domain.Card{number(10), number(5)},
+			// Hit once: 10 busts, 5 -> 15. Hit twice: both orders draw the 10.
+			wantStay:      10,
+			wantBust:      0.5,
+			wantIfSafe:    15,
+			wantExpected:  7.5,
+			wantTwoBust:   1.0,
+			wantTwoExpect: 0,
+		},
+		{
+			name:      "Second Chance absorbs the duplicate",
+			handCards: []domain.Card{number(10), secondChance},
+			deckCards: []domain.Card{number(10), number(5)},
+			// 10 is absorbed (score stays 10), 5 -> 15.
+			// Two draws: (10,5) -> 15, (5,10) -> 15.
+			wantStay:      10,
+			wantBust:      0,
+			wantIfSafe:    12.5,
+			wantExpected:  12.5,
+			wantTwoBust:   0,
+			wantTwoExpect: 15,
+		},
+		{
+			name:      "x2 in hand doubles the gain",
+			handCards: []domain.Card{number(5), x2},
+			deckCards: []domain.Card{number(5), number(3)},
+			// 5 busts, 3 -> (5+3)*2 = 16.
+			wantStay:      10,
+			wantBust:      0.5,
+			wantIfSafe:    16,
+			wantExpected:  8,
+			wantTwoBust:   1.0,
+			wantTwoExpect: 0,
+		},
+		{
+			name:      "Flip 7 completion ends the lookahead",
+			handCards: []domain.Card{number(1), number(2), number(3), number(4), number(5), number(6)},
+			deckCards: []domain.Card{number(7), number(1)},
+			// 7 -> Flip 7: 28 + 15 = 43. 1 busts.
+			// Two draws: (7,1) stops after Flip 7 -> 43, (1,7) busts.
+			wantStay:      21,
+			wantBust:      0.5,
+			wantFlip7:     0.5,
+			wantIfSafe:    43,
+			wantExpected:  21.5,
+			wantTwoBust:   0.5,
+			wantTwoExpect: 21.5,
+		},
+	}
+
+	analyzer := domain.NewHitOutcomeAnalyzer()
+	approx := func(a, b float64) bool { return math.Abs(a-b) < 1e-9 }
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := domain.NewPlayerHand()
+			for _, c := range tt.handCards {
+				hand.AddCard(c)
+			}
+			deck := domain.NewDeckFromCards(tt.deckCards)
+			deckSize := len(deck.Cards)
+			handSize := len(hand.RawNumberCards)
+
+			got := analyzer.Analyze(deck, hand)
+
+			if got.StayScore != tt.wantStay {
+				t.Errorf("StayScore = %d, want %d", got.StayScore, tt.wantStay)
+			}
+			if !approx(got.BustProbability, tt.wantBust) {
+				t.Errorf("BustProbability = %f, want %f", got.BustProbability, tt.wantBust)
+			}
+			if !approx(got.Flip7Probability, tt.wantFlip7) {
+				t.Errorf("Flip7Probability = %f, want %f", got.Flip7Probability, tt.wantFlip7)
+			}
+			if !approx(got.ExpectedScoreIfSafe, tt.wantIfSafe) {
+				t.Errorf("ExpectedScoreIfSafe = %f, want %f", got.ExpectedScoreIfSafe, tt.wantIfSafe)
+			}
+			if !approx(got.ExpectedScore, tt.wantExpected) {
+				t.Errorf("ExpectedScore = %f, want %f", got.ExpectedScore, tt.wantExpected)
+			}
+			if !approx(got.TwoDrawBustProbability, tt.wantTwoBust) {
+				t.Errorf("TwoDrawBustProbability = %f, want %f", got.TwoDrawBustProbability, tt.wantTwoBust)
+			}
+			if !approx(got.TwoDrawExpectedScore, tt.wantTwoExpect) {
+				t.Errorf("TwoDrawExpectedScore = %f, want %f", got.TwoDrawExpectedScore, tt.wantTwoExpect)
+			}
+
+			// The analysis must not mutate the deck or the hand.
+			if len(deck.Cards) != deckSize {
+				t.Errorf("Deck was modified: %d cards, want %d", len(deck.Cards), deckSize)
+			}
+			if len(hand.RawNumberCards) != handSize || hand.Status != domain.HandStatusActive {
+				t.Errorf("Hand was modified")
+			}
+		})
+	}
+}
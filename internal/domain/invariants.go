@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Invariants checks what must hold of a game between rounds and once it is over, however play
+// went:
+//
+//   - every card is accounted for (ValidateConservation)
+//   - no total score is negative
+//   - no player is among the current round's active players twice
+//   - a completed game has winners, unless it stopped at the round limit or was aborted
+//
+// It returns nil, or an error joining one error per broken invariant: conservation errors wrap
+// ErrCardsNotConserved and the others ErrInvariantBroken.
+func (g *Game) Invariants() error {
+	var errs []error
+	if err := g.ValidateConservation(); err != nil {
+		errs = append(errs, err)
+	}
+	for _, p := range g.Players {
+		if p.TotalScore < 0 {
+			errs = append(errs, fmt.Errorf("%w: %s has a negative total score %d", ErrInvariantBroken, p.Name, p.TotalScore))
+		}
+	}
+	if g.CurrentRound != nil {
+		seen := make(map[string]bool)
+		for _, p := range g.CurrentRound.ActivePlayers {
+			id := p.ID.String()
+			if seen[id] {
+				errs = append(errs, fmt.Errorf("%w: %s is an active player twice", ErrInvariantBroken, p.Name))
+			}
+			seen[id] = true
+		}
+	}
+	if g.IsCompleted && len(g.Winners) == 0 && g.EndReason != GameEndReasonRoundLimit && g.EndReason != GameEndReasonAborted {
+		errs = append(errs, fmt.Errorf("%w: game completed (%q) without winners", ErrInvariantBroken, g.EndReason))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MemoryModel is how much of the game a player is assumed to remember when the risk of their
+// next draws is estimated. Risk estimates read the deck a model returns instead of the tracked
+// deck, so a helper can show the odds a player at a loud table actually plays by.
+type MemoryModel string
+
+const (
+	// MemoryFull counts every card seen since the last shuffle: the tracked deck. It is the default.
+	MemoryFull MemoryModel = "full"
+	// MemoryRound only remembers the cards seen this round: the ones in every hand and those
+	// discarded since the round began. Discards of earlier rounds count as still in the deck.
+	MemoryRound MemoryModel = "round"
+	// MemoryNone counts nothing but the player's own hand against a fresh deck.
+	MemoryNone MemoryModel = "none"
+)
+
+// MemoryModels lists every MemoryModel, from the most to the least remembered.
+var MemoryModels = []MemoryModel{MemoryFull, MemoryRound, MemoryNone}
+
+// ParseMemoryModel parses "full", "round" or "none". An empty name is MemoryFull.
+// An unknown one returns MemoryFull and an error.
+func ParseMemoryModel(name string) (MemoryModel, error) {
+	switch model := MemoryModel(strings.ToLower(strings.TrimSpace(name))); model {
+	case "", MemoryFull:
+		return MemoryFull, nil
+	case MemoryRound, MemoryNone:
+		return model, nil
+	}
+	return MemoryFull, fmt.Errorf("unknown memory model %q (supported: full, round, none)", name)
+}
+
+// Next returns the model after m in MemoryModels, wrapping around, to cycle through them.
+func (m MemoryModel) Next() MemoryModel {
+	for i, model := range MemoryModels {
+		if model == m {
+			return MemoryModels[(i+1)%len(MemoryModels)]
+		}
+	}
+	return MemoryFull
+}
+
+// Deck returns the deck p draws from as far as p remembers under m. MemoryFull returns the
+// current round's deck itself; the other models build a new deck, which callers may only read.
+// It returns nil when there is no round in progress.
+func (m MemoryModel) Deck(g *Game, p *Player) *Deck {
+	round := g.CurrentRound
+	if round == nil {
+		return nil
+	}
+	var seen []Card
+	switch m {
+	case MemoryRound:
+		for _, other := range g.Players {
+			seen = append(seen, handCards(other.CurrentHand)...)
+		}
+		seen = append(seen, round.Discarded(g)...)
+	case MemoryNone:
+		seen = handCards(p.CurrentHand)
+	default:
+		return round.Deck
+	}
+
+	unseen := make(map[Card]int)
+	for _, c := range seen {
+		unseen[c]--
+	}
+	var cards []Card
+	for _, c := range StandardDeckCards() {
+		if unseen[c] < 0 {
+			unseen[c]++
+			continue
+		}
+		cards = append(cards, c)
+	}
+	return NewDeckInOrder(cards)
+}
+
+// Discarded returns the cards of g's discard pile that were discarded during this round, using
+// DiscardsBefore. After a Reshuffle only the cards discarded since are returned: the others
+// are back in the deck.
+func (r *Round) Discarded(g *Game) []Card {
+	if r.DiscardsBefore >= len(g.DiscardPile) {
+		return nil
+	}
+	return g.DiscardPile[r.DiscardsBefore:]
+}
+
+// handCards returns every card of h, or nil for a player without a hand.
+func handCards(h *PlayerHand) []Card {
+	if h == nil {
+		return nil
+	}
+	cards := make([]Card, 0, len(h.RawNumberCards)+len(h.ModifierCards)+len(h.ActionCards))
+	for _, val := range h.RawNumberCards {
+		cards = append(cards, Card{Type: CardTypeNumber, Value: val})
+	}
+	cards = append(cards, h.ModifierCards...)
+	return append(cards, h.ActionCards...)
+}
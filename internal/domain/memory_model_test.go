@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/testutil"
+)
+
+func TestMemoryModel_Deck(t *testing.T) {
+	number := func(v int) domain.Card { return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)} }
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+
+	// Earlier rounds discarded three 12s and an 11. This round Me holds 12 and 5, Ann holds 12
+	// and 3, and a Freeze was played. The deck holds every other card: 85 of them.
+	me := playerWith("Me", 0, 12, 5)
+	ann := playerWith("Ann", 0, 12, 3)
+	earlier := []domain.Card{number(12), number(12), number(12), number(11)}
+	seen := append([]domain.Card{number(12), number(5), number(12), number(3), freeze}, earlier...)
+	var cards []domain.Card
+	for _, c := range domain.StandardDeckCards() {
+		for i, s := range seen {
+			if s == c {
+				seen = append(seen[:i], seen[i+1:]...)
+				c = domain.Card{}
+				break
+			}
+		}
+		if c != (domain.Card{}) {
+			cards = append(cards, c)
+		}
+	}
+	g := domain.NewGame([]*domain.Player{me, ann})
+	g.Deck = domain.NewDeckInOrder(cards)
+	g.CurrentRound = testutil.NewRound(g.Players, me, g.Deck)
+	g.DiscardPile = append(earlier, freeze)
+	g.CurrentRound.DiscardsBefore = len(earlier)
+	if err := g.ValidateConservation(); err != nil {
+		t.Fatalf("Expected a consistent game: %v", err)
+	}
+
+	tests := []struct {
+		model domain.MemoryModel
+		size  int
+		risky int // 5s and 12s left
+	}{
+		{domain.MemoryFull, 85, 4 + 7},   // Every card seen is counted
+		{domain.MemoryRound, 89, 4 + 10}, // The earlier discards count as unseen
+		{domain.MemoryNone, 92, 4 + 11},  // Only Me's own hand is counted
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.model), func(t *testing.T) {
+			deck := tt.model.Deck(g, me)
+			if got := deck.Remaining(); got != tt.size {
+				t.Errorf("Expected %d cards, got %d", tt.size, got)
+			}
+			want := float64(tt.risky) / float64(tt.size)
+			if got := deck.EstimateHitRisk(me.CurrentHand.NumberCards, false); got != want {
+				t.Errorf("Expected a bust rate of %.4f, got %.4f", want, got)
+			}
+		})
+	}
+	if g.Deck.Remaining() != 85 {
+		t.Errorf("Expected the tracked deck to be left alone, got %d cards", g.Deck.Remaining())
+	}
+}
+
+func TestParseMemoryModel(t *testing.T) {
+	for _, name := range []string{"", "full", " Round ", "NONE"} {
+		if _, err := domain.ParseMemoryModel(name); err != nil {
+			t.Errorf("Expected %q to parse, got %v", name, err)
+		}
+	}
+	if model, err := domain.ParseMemoryModel("perfect"); err == nil || model != domain.MemoryFull {
+		t.Errorf("Expected an unknown model to fall back to full with an error, got %q, %v", model, err)
+	}
+	model := domain.MemoryFull
+	for _, want := range []domain.MemoryModel{domain.MemoryRound, domain.MemoryNone, domain.MemoryFull} {
+		if model = model.Next(); model != want {
+			t.Errorf("Expected the next model to be %q, got %q", want, model)
+		}
+	}
+}
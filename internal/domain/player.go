@@ -14,12 +14,17 @@ const (
 	HandStatusFrozen HandStatus = "frozen"
 )
 
-// PointValue represents the calculated score of a hand.
+// PointValue represents the calculated score of a hand:
+// Total = BaseSum * Multiplier + Additive + Bonus (0 if busted).
 type PointValue struct {
-	BaseSum   int   `json:"base_sum"`
-	Modifiers []int `json:"modifiers"`
-	Bonus     int   `json:"bonus"`
-	Total     int   `json:"total"`
+	Numbers    []NumberValue  `json:"numbers"`    // Number cards in the order they were drawn
+	BaseSum    int            `json:"base_sum"`   // Sum of Numbers
+	Modifiers  []ModifierType `json:"modifiers"`  // Modifier cards in the order they were drawn
+	Multiplier int            `json:"multiplier"` // Product of the x2 modifiers; 1 without any
+	Additive   int            `json:"additive"`   // Sum of the +N modifiers
+	Bonus      int            `json:"bonus"`      // Flip 7 bonus
+	Busted     bool           `json:"busted"`
+	Total      int            `json:"total"`
 }
 
 // PlayerHand represents a player's cards in a single round.
@@ -29,8 +34,10 @@ type PlayerHand struct {
 	RawNumberCards   []NumberValue            `json:"raw_number_cards"` // For display/calculation
 	ModifierCards    []Card                   `json:"modifier_cards"`
 	ActionCards      []Card                   `json:"action_cards"`
-	SecondChanceUsed bool                     `json:"second_chance_used"`
+	SecondChanceUsed bool                     `json:"second_chance_used"` // A Second Chance absorbed a duplicate this round
 	Status           HandStatus               `json:"status"`
+	// HasDrawnThisRound records whether the player has flipped at least one card this round.
+	HasDrawnThisRound bool `json:"has_drawn_this_round"`
 }
 
 // HasSecondChance checks if the hand contains an unused Second Chance card.
@@ -44,34 +51,10 @@ func (h *PlayerHand) HasSecondChance() bool {
 }
 
 // CanStay checks if the player is allowed to stay.
+// Per the rules a player cannot stay before flipping at least one card in the round;
+// after that, staying is always allowed regardless of what the hand contains.
 func (h *PlayerHand) CanStay() bool {
-	// Conditions to stay:
-	// 1. Has at least one Number card (standard rule)
-	// 2. Has at least one effective Modifier card (not just X2 with no numbers/additives)
-	// 3. Has Second Chance AND at least one other Action card (strategic stay)
-	return len(h.NumberCards) > 0 ||
-		h.HasEffectiveModifiers() ||
-		(h.HasSecondChance() && len(h.ActionCards) > 1)
-}
-
-// HasEffectiveModifiers checks if the modifier cards would actually contribute points.
-func (h *PlayerHand) HasEffectiveModifiers() bool {
-	// If there are no modifier cards, return false
-	if len(h.ModifierCards) == 0 {
-		return false
-	}
-	// If there is at least one number card, modifiers will contribute
-	if len(h.NumberCards) > 0 {
-		return true
-	}
-	// If there is at least one additive modifier (e.g., +2), allow stay
-	for _, c := range h.ModifierCards {
-		if c.ModifierType.IsAdditive() {
-			return true
-		}
-	}
-	// Only X2 (or other non-additive) modifiers with no numbers/additives: not effective
-	return false
+	return h.HasDrawnThisRound
 }
 
 // NewPlayerHand creates a new empty hand.
@@ -79,46 +62,48 @@ func NewPlayerHand() *PlayerHand {
 	return &PlayerHand{
 		ID:          uuid.New(),
 		NumberCards: make(map[NumberValue]struct{}),
-		Status:      HandStatusActive,
+		// Sized for a Flip 7 so the numbers are not copied as they grow; modifiers and actions
+		// are rare enough to be allocated when the first one arrives.
+		RawNumberCards: make([]NumberValue, 0, 7),
+		Status:         HandStatusActive,
 	}
 }
 
 // AddCard adds a card to the hand and checks for bust.
 // Returns busted=true if the card caused a bust (duplicate number).
-// Returns discarded cards if Second Chance was used.
+// Returns discarded cards if Second Chance was used. A hand that is no longer
+// active cannot take cards, so the card itself is returned as discarded.
 func (h *PlayerHand) AddCard(card Card) (busted bool, flip7 bool, discarded []Card) {
 	if h.Status != HandStatusActive {
-		return false, false, nil
+		return false, false, []Card{card}
 	}
+	h.HasDrawnThisRound = true
 
 	switch card.Type {
 	case CardTypeNumber:
 		if _, exists := h.NumberCards[card.Value]; exists {
-			if !h.SecondChanceUsed {
-				// If player has a Second Chance card, use it to avoid the bust.
-				hasSecondChance := false
-				scIndex := -1
-				for i, c := range h.ActionCards {
-					if c.ActionType == ActionSecondChance {
-						hasSecondChance = true
-						scIndex = i
-						break
-					}
+			// A Second Chance in hand always protects, even if an earlier one was
+			// already used this round: a newly passed or drawn card works again.
+			scIndex := -1
+			for i, c := range h.ActionCards {
+				if c.ActionType == ActionSecondChance {
+					scIndex = i
+					break
 				}
+			}
 
-				if hasSecondChance {
-					// Use Second Chance: Discard the duplicate (don't add it), discard the Second Chance card.
-					h.SecondChanceUsed = true
+			if scIndex >= 0 {
+				// Use Second Chance: Discard the duplicate (don't add it), discard the Second Chance card.
+				h.SecondChanceUsed = true
 
-					// Collect discarded cards
-					scCard := h.ActionCards[scIndex]
-					discarded = append(discarded, scCard)
-					discarded = append(discarded, card)
+				// Collect discarded cards
+				scCard := h.ActionCards[scIndex]
+				discarded = append(discarded, scCard)
+				discarded = append(discarded, card)
 
-					// Remove the Second Chance card
-					h.ActionCards = append(h.ActionCards[:scIndex], h.ActionCards[scIndex+1:]...)
-					return false, false, discarded
-				}
+				// Remove the Second Chance card
+				h.ActionCards = append(h.ActionCards[:scIndex], h.ActionCards[scIndex+1:]...)
+				return false, false, discarded
 			}
 			h.Status = HandStatusBusted
 			// Add the busting card to the hand so it stays on the table until round end
@@ -133,6 +118,11 @@ func (h *PlayerHand) AddCard(card Card) (busted bool, flip7 bool, discarded []Ca
 		h.ModifierCards = append(h.ModifierCards, card)
 
 	case CardTypeAction:
+		// A player may hold at most one Second Chance. Services pass a second one on
+		// before it reaches the hand; anything that still gets here is discarded.
+		if card.ActionType == ActionSecondChance && h.HasSecondChance() {
+			return false, false, []Card{card}
+		}
 		h.ActionCards = append(h.ActionCards, card)
 		// Note: Immediate actions like FlipThree need to be handled by the caller/Round.
 	}
@@ -146,6 +136,74 @@ func (h *PlayerHand) AddCard(card Card) (busted bool, flip7 bool, discarded []Ca
 	return false, false, nil
 }
 
+// ReceiveSecondChance gives the hand a Second Chance passed on by another player. Unlike
+// AddCard it does not count as a flip. A hand that is out of the round or already holds a
+// Second Chance refuses it: ok is false and the caller discards the card.
+func (h *PlayerHand) ReceiveSecondChance(card Card) (ok bool) {
+	if h.Status != HandStatusActive || h.HasSecondChance() {
+		return false
+	}
+	h.ActionCards = append(h.ActionCards, card)
+	return true
+}
+
+// HeldActions returns the Freeze and Flip Three cards kept in the hand under the
+// holdable-actions house rule (Game.HoldableActions).
+func (h *PlayerHand) HeldActions() []Card {
+	var held []Card
+	for _, c := range h.ActionCards {
+		if c.ActionType == ActionFreeze || c.ActionType == ActionFlipThree {
+			held = append(held, c)
+		}
+	}
+	return held
+}
+
+// TakeHeldAction removes a held card of the given action from the hand so it can be played.
+// ok is false if the hand holds none.
+func (h *PlayerHand) TakeHeldAction(action ActionType) (card Card, ok bool) {
+	if action != ActionFreeze && action != ActionFlipThree {
+		return Card{}, false
+	}
+	for i, c := range h.ActionCards {
+		if c.ActionType == action {
+			h.ActionCards = append(h.ActionCards[:i], h.ActionCards[i+1:]...)
+			return c, true
+		}
+	}
+	return Card{}, false
+}
+
+// BustInfo describes the draw that busted a hand.
+type BustInfo struct {
+	Duplicate NumberValue // The number drawn a second time
+	ScoreLost int         // What the hand would have banked by staying before that draw
+}
+
+// BustInfo returns how the hand busted. AddCard keeps the busting card as the last number
+// card, so this is known as long as the hand is on the table. ok is false when the hand has
+// not busted on a duplicate number.
+func (h *PlayerHand) BustInfo() (info BustInfo, ok bool) {
+	n := len(h.RawNumberCards)
+	if h.Status != HandStatusBusted || n == 0 {
+		return BustInfo{}, false
+	}
+	duplicate := h.RawNumberCards[n-1]
+	seen := false
+	for _, v := range h.RawNumberCards[:n-1] {
+		seen = seen || v == duplicate
+	}
+	if !seen {
+		return BustInfo{}, false
+	}
+
+	// NumberCards still holds the first copy of the duplicate.
+	before := h.Clone()
+	before.Status = HandStatusActive
+	before.RawNumberCards = before.RawNumberCards[:n-1]
+	return BustInfo{Duplicate: duplicate, ScoreLost: NewScoreCalculator().Total(before)}, true
+}
+
 // Player represents a participant in the game.
 type Player struct {
 	ID          uuid.UUID   `json:"id"`
@@ -153,6 +211,11 @@ type Player struct {
 	TotalScore  int         `json:"total_score"`
 	CurrentHand *PlayerHand `json:"current_hand"`
 	Strategy    Strategy    `json:"-"` // AI Strategy
+	// Dropped marks a player who has left the game. Dropped players keep their seat
+	// (and score) but are skipped when dealing rounds and rotating the dealer.
+	Dropped bool `json:"dropped,omitempty"`
+	// Team names the player's partnership in the team play variant; empty in a solo game.
+	Team string `json:"team,omitempty"`
 }
 
 // NewPlayer creates a new player.
@@ -177,22 +240,22 @@ func (p *Player) BankScore(score int) {
 // BankCurrentHand calculates the score of the current hand and adds it to the total score.
 // Returns the banked score.
 func (p *Player) BankCurrentHand() int {
-	calc := NewScoreCalculator()
-	score := calc.Compute(p.CurrentHand)
-	p.BankScore(score.Total)
-	return score.Total
+	score := NewScoreCalculator().Total(p.CurrentHand)
+	p.BankScore(score)
+	return score
 }
 
 // Clone creates a deep copy of the PlayerHand.
 func (h *PlayerHand) Clone() *PlayerHand {
 	newHand := &PlayerHand{
-		ID:               h.ID,
-		NumberCards:      make(map[NumberValue]struct{}),
-		RawNumberCards:   make([]NumberValue, len(h.RawNumberCards)),
-		ModifierCards:    make([]Card, len(h.ModifierCards)),
-		ActionCards:      make([]Card, len(h.ActionCards)),
-		SecondChanceUsed: h.SecondChanceUsed,
-		Status:           h.Status,
+		ID:                h.ID,
+		NumberCards:       make(map[NumberValue]struct{}),
+		RawNumberCards:    make([]NumberValue, len(h.RawNumberCards)),
+		ModifierCards:     make([]Card, len(h.ModifierCards)),
+		ActionCards:       make([]Card, len(h.ActionCards)),
+		SecondChanceUsed:  h.SecondChanceUsed,
+		Status:            h.Status,
+		HasDrawnThisRound: h.HasDrawnThisRound,
 	}
 
 	for k, v := range h.NumberCards {
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -78,3 +79,272 @@ func TestPlayerHand_AddCard_Flip7(t *testing.T) {
 		})
 	}
 }
+
+func TestPlayerHand_AddCard_SecondChanceAfterUse(t *testing.T) {
+	secondChance := Card{Type: CardTypeAction, ActionType: ActionSecondChance}
+	five := Card{Type: CardTypeNumber, Value: 5}
+
+	h := NewPlayerHand()
+	h.AddCard(five)
+	h.AddCard(secondChance)
+
+	// First duplicate is absorbed by the Second Chance
+	busted, _, discarded := h.AddCard(five)
+	if busted {
+		t.Fatalf("Expected first duplicate to be absorbed by Second Chance")
+	}
+	if len(discarded) != 2 {
+		t.Errorf("Expected 2 discarded cards, got %d", len(discarded))
+	}
+	if !h.SecondChanceUsed {
+		t.Errorf("Expected SecondChanceUsed to be set")
+	}
+	if h.HasSecondChance() {
+		t.Errorf("Expected used Second Chance to leave the hand")
+	}
+
+	// A newly received Second Chance protects again
+	h.AddCard(secondChance)
+	if !h.HasSecondChance() {
+		t.Fatalf("Expected new Second Chance to be in hand")
+	}
+	busted, _, discarded = h.AddCard(five)
+	if busted {
+		t.Errorf("Expected new Second Chance to absorb the second duplicate")
+	}
+	if len(discarded) != 2 {
+		t.Errorf("Expected 2 discarded cards, got %d", len(discarded))
+	}
+
+	// Without another Second Chance the next duplicate busts
+	busted, _, _ = h.AddCard(five)
+	if !busted {
+		t.Errorf("Expected bust without a Second Chance in hand")
+	}
+}
+
+func TestPlayerHand_AddCard_RejectsSecondSecondChance(t *testing.T) {
+	secondChance := Card{Type: CardTypeAction, ActionType: ActionSecondChance}
+
+	h := NewPlayerHand()
+	h.AddCard(secondChance)
+	_, _, discarded := h.AddCard(secondChance)
+
+	if len(discarded) != 1 || discarded[0] != secondChance {
+		t.Errorf("Expected the extra Second Chance to be discarded, got %v", discarded)
+	}
+
+	count := 0
+	for _, c := range h.ActionCards {
+		if c.ActionType == ActionSecondChance {
+			count++
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected 1 Second Chance in hand, got %d", count)
+	}
+}
+
+func TestPlayerHand_AddCard_InactiveHandDiscardsCard(t *testing.T) {
+	h := NewPlayerHand()
+	h.Status = HandStatusFrozen
+	card := Card{Type: CardTypeNumber, Value: 7}
+
+	_, _, discarded := h.AddCard(card)
+	if len(discarded) != 1 || discarded[0] != card {
+		t.Errorf("Expected the card to be returned as discarded, got %v", discarded)
+	}
+	if len(h.RawNumberCards) != 0 {
+		t.Errorf("Expected the frozen hand to stay empty, got %v", h.RawNumberCards)
+	}
+}
+
+func TestPlayerHand_BustInfo(t *testing.T) {
+	h := NewPlayerHand()
+	for _, c := range []Card{
+		{Type: CardTypeNumber, Value: 9},
+		{Type: CardTypeModifier, ModifierType: ModifierPlus4},
+		{Type: CardTypeNumber, Value: 3},
+	} {
+		h.AddCard(c)
+	}
+	if _, ok := h.BustInfo(); ok {
+		t.Error("Expected no bust info before the hand busts")
+	}
+
+	if busted, _, _ := h.AddCard(Card{Type: CardTypeNumber, Value: 9}); !busted {
+		t.Fatal("Expected the second 9 to bust the hand")
+	}
+	info, ok := h.BustInfo()
+	if !ok {
+		t.Fatal("Expected bust info for a busted hand")
+	}
+	if want := (BustInfo{Duplicate: 9, ScoreLost: 16}); info != want {
+		t.Errorf("Expected %+v, got %+v", want, info)
+	}
+}
+
+func TestPlayerHand_AddCard_StateMachine(t *testing.T) {
+	secondChance := Card{Type: CardTypeAction, ActionType: ActionSecondChance}
+	cards := []struct {
+		name string
+		card Card
+	}{
+		{"new number", Card{Type: CardTypeNumber, Value: 9}},
+		{"duplicate number", Card{Type: CardTypeNumber, Value: 5}},
+		{"modifier", Card{Type: CardTypeModifier, ModifierType: ModifierPlus4}},
+		{"x2", Card{Type: CardTypeModifier, ModifierType: ModifierX2}},
+		{"second chance", secondChance},
+		{"freeze", Card{Type: CardTypeAction, ActionType: ActionFreeze}},
+		{"flip three", Card{Type: CardTypeAction, ActionType: ActionFlipThree}},
+	}
+	statuses := []HandStatus{HandStatusActive, HandStatusStayed, HandStatusBusted, HandStatusFrozen}
+
+	for _, status := range statuses {
+		for _, withSC := range []bool{false, true} {
+			for _, c := range cards {
+				name := fmt.Sprintf("%s/second chance %v/%s", status, withSC, c.name)
+				t.Run(name, func(t *testing.T) {
+					h := NewPlayerHand()
+					h.AddCard(Card{Type: CardTypeNumber, Value: 5})
+					if withSC {
+						h.AddCard(secondChance)
+					}
+					h.Status = status
+					before := h.Clone()
+
+					busted, flip7, discarded := h.AddCard(c.card)
+
+					if flip7 {
+						t.Errorf("Expected no Flip 7 from a hand with 1 number")
+					}
+					if status != HandStatusActive {
+						// A hand out of the round takes nothing: the card goes back to be discarded.
+						if busted || len(discarded) != 1 || discarded[0] != c.card {
+							t.Errorf("Expected the card to be refused, got busted=%v discarded=%v", busted, discarded)
+						}
+						if h.Status != status || len(h.RawNumberCards) != len(before.RawNumberCards) ||
+							len(h.ModifierCards) != 0 || len(h.ActionCards) != len(before.ActionCards) {
+							t.Errorf("Expected the %s hand to be unchanged, got %+v", status, h)
+						}
+						return
+					}
+
+					wantBusted := c.name == "duplicate number" && !withSC
+					if busted != wantBusted {
+						t.Errorf("Expected busted=%v, got %v", wantBusted, busted)
+					}
+					if wantBusted && h.Status != HandStatusBusted {
+						t.Errorf("Expected the hand to be busted, got %s", h.Status)
+					}
+					if !wantBusted && h.Status != HandStatusActive {
+						t.Errorf("Expected the hand to stay active, got %s", h.Status)
+					}
+
+					switch {
+					case c.name == "duplicate number" && withSC:
+						// The Second Chance takes the duplicate and both are discarded.
+						if len(discarded) != 2 || h.HasSecondChance() || !h.SecondChanceUsed {
+							t.Errorf("Expected the Second Chance and the duplicate to be discarded, got %v", discarded)
+						}
+						if len(h.RawNumberCards) != 1 {
+							t.Errorf("Expected the duplicate not to join the hand, got %v", h.RawNumberCards)
+						}
+					case c.name == "second chance" && withSC:
+						// A second Second Chance cannot be held.
+						if len(discarded) != 1 || discarded[0] != c.card || len(h.ActionCards) != 1 {
+							t.Errorf("Expected the extra Second Chance to be discarded, got %v and %v", discarded, h.ActionCards)
+						}
+					default:
+						if len(discarded) != 0 {
+							t.Errorf("Expected nothing discarded, got %v", discarded)
+						}
+					}
+					if !h.HasDrawnThisRound {
+						t.Errorf("Expected the card to count as a flip")
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestPlayerHand_AddCard_ModifierNeverCompletesFlip7(t *testing.T) {
+	h := NewPlayerHand()
+	for v := 1; v <= 6; v++ {
+		h.AddCard(Card{Type: CardTypeNumber, Value: NumberValue(v)})
+	}
+	// 7 cards, but only 6 different numbers.
+	if _, flip7, _ := h.AddCard(Card{Type: CardTypeModifier, ModifierType: ModifierPlus2}); flip7 {
+		t.Errorf("Expected a modifier as the 7th card not to be a Flip 7")
+	}
+	if _, flip7, _ := h.AddCard(Card{Type: CardTypeNumber, Value: 7}); !flip7 {
+		t.Errorf("Expected the 7th different number to be a Flip 7")
+	}
+}
+
+func TestPlayerHand_ReceiveSecondChance(t *testing.T) {
+	secondChance := Card{Type: CardTypeAction, ActionType: ActionSecondChance}
+	tests := []struct {
+		name   string
+		status HandStatus
+		holds  bool
+		want   bool
+	}{
+		{"active", HandStatusActive, false, true},
+		{"active holding one", HandStatusActive, true, false},
+		{"stayed", HandStatusStayed, false, false},
+		{"busted", HandStatusBusted, false, false},
+		{"frozen", HandStatusFrozen, false, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewPlayerHand()
+			if tt.holds {
+				h.AddCard(secondChance)
+			}
+			h.HasDrawnThisRound = false
+			h.Status = tt.status
+
+			if got := h.ReceiveSecondChance(secondChance); got != tt.want {
+				t.Errorf("Expected ok=%v, got %v", tt.want, got)
+			}
+			wantCards := 0
+			if tt.holds || tt.want {
+				wantCards = 1
+			}
+			if len(h.ActionCards) != wantCards {
+				t.Errorf("Expected %d Second Chance in hand, got %v", wantCards, h.ActionCards)
+			}
+			if h.HasDrawnThisRound {
+				t.Errorf("Expected a passed card not to count as a flip")
+			}
+		})
+	}
+}
+
+func TestPlayerHand_HeldActions(t *testing.T) {
+	freeze := Card{Type: CardTypeAction, ActionType: ActionFreeze}
+	flipThree := Card{Type: CardTypeAction, ActionType: ActionFlipThree}
+	secondChance := Card{Type: CardTypeAction, ActionType: ActionSecondChance}
+	h := NewPlayerHand()
+	for _, c := range []Card{freeze, secondChance, flipThree, freeze} {
+		h.AddCard(c)
+	}
+
+	if got, want := fmt.Sprint(h.HeldActions()), fmt.Sprint([]Card{freeze, flipThree, freeze}); got != want {
+		t.Errorf("Expected held actions %s, got %s", want, got)
+	}
+	if _, ok := h.TakeHeldAction(ActionSecondChance); ok {
+		t.Error("Expected a Second Chance not to be played as a held action")
+	}
+	if card, ok := h.TakeHeldAction(ActionFlipThree); !ok || card != flipThree {
+		t.Errorf("Expected to take the Flip Three, got %v, %v", card, ok)
+	}
+	if _, ok := h.TakeHeldAction(ActionFlipThree); ok {
+		t.Error("Expected no second Flip Three to take")
+	}
+	if got, want := fmt.Sprint(h.ActionCards), fmt.Sprint([]Card{freeze, secondChance, freeze}); got != want {
+		t.Errorf("Expected %s left in the hand, got %s", want, got)
+	}
+}
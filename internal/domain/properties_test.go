@@ -0,0 +1,171 @@
+package domain_test
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/testutil"
+)
+
+// quickConfig is the testing/quick budget of the property tests.
+var quickConfig = &quick.Config{MaxCount: 300}
+
+func TestProperty_AddCardKeepsCountsAndUniqueness(t *testing.T) {
+	property := func(g testutil.Game, pick uint8) bool {
+		if g.Deck.Remaining() == 0 {
+			return true
+		}
+		p := g.Players[int(pick)%len(g.Players)]
+		card, _ := g.Deck.Draw()
+		wasActive := p.CurrentHand.Status == domain.HandStatusActive
+		busted, _, _ := p.CurrentHand.AddCard(card)
+
+		for v, n := range g.Deck.RemainingCounts {
+			if n < 0 {
+				t.Logf("%v: %d copies of %d left", g, n, v)
+				return false
+			}
+		}
+		h := p.CurrentHand
+		seen := make(map[domain.NumberValue]bool)
+		duplicate := false
+		for _, v := range h.RawNumberCards {
+			duplicate = duplicate || seen[v]
+			seen[v] = true
+		}
+		if duplicate && h.Status != domain.HandStatusBusted {
+			t.Logf("%v: %s holds a duplicate without busting after %s", g, p.Name, card)
+			return false
+		}
+		if len(seen) != len(h.NumberCards) {
+			t.Logf("%v: %s's numbers %v disagree with its set", g, p.Name, h.RawNumberCards)
+			return false
+		}
+		if busted && !wasActive {
+			t.Logf("%v: %s busted out of play", g, p.Name)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(property, quickConfig); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProperty_RemoveActivePlayerKeepsTurnInRange(t *testing.T) {
+	property := func(g testutil.Game, picks []uint8) bool {
+		round := g.CurrentRound
+		for _, pick := range picks {
+			if len(round.ActivePlayers) == 0 {
+				break
+			}
+			turn := round.CurrentTurnIndex
+			var current *domain.Player
+			if turn < len(round.ActivePlayers) {
+				current = round.ActivePlayers[turn]
+			}
+			p := round.ActivePlayers[int(pick)%len(round.ActivePlayers)]
+			round.RemoveActivePlayer(p)
+
+			// One past the last player is in range: the turn then passes to the first one.
+			if round.CurrentTurnIndex < 0 || round.CurrentTurnIndex > len(round.ActivePlayers) {
+				t.Logf("%v: turn index %d outside the %d active players", g, round.CurrentTurnIndex, len(round.ActivePlayers))
+				return false
+			}
+			if current != nil && current != p && round.ActivePlayers[round.CurrentTurnIndex] != current {
+				t.Logf("%v: the turn moved off %s when %s left", g, current.Name, p.Name)
+				return false
+			}
+			if round.ContainsActive(p.ID) {
+				t.Logf("%v: %s is still active", g, p.Name)
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, quickConfig); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProperty_EstimateHitRiskIsMonotoneProbability(t *testing.T) {
+	property := func(g testutil.Game, extra uint8, secondChance bool) bool {
+		deck := g.Deck
+		for _, p := range g.Players {
+			numbers := p.CurrentHand.NumberCards
+			risk := deck.EstimateHitRisk(numbers, secondChance)
+			if risk < 0 || risk > 1 {
+				t.Logf("%v: %s's risk %v", g, p.Name, risk)
+				return false
+			}
+			if secondChance && risk != 0 {
+				t.Logf("%v: %s's risk %v with a Second Chance", g, p.Name, risk)
+				return false
+			}
+
+			// Holding one more number can only add cards that bust the hand.
+			more := make(map[domain.NumberValue]struct{}, len(numbers)+1)
+			for v := range numbers {
+				more[v] = struct{}{}
+			}
+			more[domain.NumberValue(extra%13)] = struct{}{}
+			if bigger := deck.EstimateHitRisk(more, secondChance); bigger < risk || bigger > 1 {
+				t.Logf("%v: %s's risk went from %v to %v holding %d too", g, p.Name, risk, bigger, extra%13)
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, quickConfig); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestProperty_CloneIsIndependent(t *testing.T) {
+	property := func(g testutil.Game, seed int64) bool {
+		rng := rand.New(rand.NewSource(seed))
+		for _, p := range g.Players {
+			original := p.CurrentHand
+			before := original.Clone()
+			clone := original.Clone()
+			for _, c := range domain.StandardDeckCards()[:5+rng.Intn(10)] {
+				clone.AddCard(c)
+			}
+			clone.Status = domain.HandStatusBusted
+			if !sameHand(original, before) {
+				t.Logf("%v: changing %s's clone changed the hand", g, p.Name)
+				return false
+			}
+		}
+		return true
+	}
+	if err := quick.Check(property, quickConfig); err != nil {
+		t.Error(err)
+	}
+}
+
+// sameHand reports whether a and b hold the same cards in the same state.
+func sameHand(a, b *domain.PlayerHand) bool {
+	if a.Status != b.Status || a.SecondChanceUsed != b.SecondChanceUsed || len(a.NumberCards) != len(b.NumberCards) ||
+		len(a.RawNumberCards) != len(b.RawNumberCards) || len(a.ModifierCards) != len(b.ModifierCards) || len(a.ActionCards) != len(b.ActionCards) {
+		return false
+	}
+	for i := range a.RawNumberCards {
+		if a.RawNumberCards[i] != b.RawNumberCards[i] {
+			return false
+		}
+	}
+	for i := range a.ModifierCards {
+		if a.ModifierCards[i] != b.ModifierCards[i] {
+			return false
+		}
+	}
+	for i := range a.ActionCards {
+		if a.ActionCards[i] != b.ActionCards[i] {
+			return false
+		}
+	}
+	return true
+}
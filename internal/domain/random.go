@@ -2,28 +2,41 @@ package domain
 
 import (
 	"math/rand"
+	"sync"
 	"time"
 )
 
-// rnd is a package-level random source seeded once.
-var rnd = rand.New(rand.NewSource(time.Now().UnixNano()))
+// rnd is a package-level random source seeded once. rand.Rand is not safe for concurrent
+// use, so every access holds rndMu (simulations play several games in parallel).
+var (
+	rnd   = rand.New(rand.NewSource(time.Now().UnixNano()))
+	rndMu sync.Mutex
+)
 
 // GetRandomInt returns a non-negative pseudo-random number in [0,n).
 func GetRandomInt(n int) int {
+	rndMu.Lock()
+	defer rndMu.Unlock()
 	return rnd.Intn(n)
 }
 
 // GetRandomFloat returns a pseudo-random number in [0.0,1.0).
 func GetRandomFloat() float64 {
+	rndMu.Lock()
+	defer rndMu.Unlock()
 	return rnd.Float64()
 }
 
 // Shuffle pseudo-randomizes the order of elements.
 func Shuffle(n int, swap func(i, j int)) {
+	rndMu.Lock()
+	defer rndMu.Unlock()
 	rnd.Shuffle(n, swap)
 }
 
 // Perm returns, as a slice of n ints, a pseudo-random permutation of the integers [0,n).
 func Perm(n int) []int {
+	rndMu.Lock()
+	defer rndMu.Unlock()
 	return rnd.Perm(n)
 }
@@ -0,0 +1,65 @@
+package domain
+
+// RoundOutcome is how a round ended for one player.
+type RoundOutcome string
+
+const (
+	RoundOutcomeStayed RoundOutcome = "stayed"
+	RoundOutcomeBusted RoundOutcome = "busted"
+	RoundOutcomeFrozen RoundOutcome = "frozen"
+	RoundOutcomeFlip7  RoundOutcome = "flip7"
+	// RoundOutcomeInPlay is a hand that was never settled: the round was aborted with the player still drawing.
+	RoundOutcomeInPlay RoundOutcome = "in_play"
+)
+
+// PlayerRoundResult is one player's line of a RoundSummary.
+type PlayerRoundResult struct {
+	Player  *Player
+	Hand    *PlayerHand // A copy of the final hand, so the summary outlives DiscardHands
+	Outcome RoundOutcome
+	Banked  int // Points banked this round
+	Total   int // Total score after the round
+}
+
+// RoundSummary recaps a round that ended: what every player was left with and banked.
+type RoundSummary struct {
+	Number  int
+	Reason  RoundEndReason
+	Results []PlayerRoundResult // In seat order; dropped players are left out
+	// DeckRemaining is the number of cards left in the deck for the next round.
+	DeckRemaining int
+}
+
+// Summary recaps the round as round number of the game. It must be called while the hands are
+// still on the table, before Game.DiscardHands.
+func (r *Round) Summary(number int) RoundSummary {
+	summary := RoundSummary{Number: number, Reason: r.EndReason}
+	if r.Deck != nil {
+		summary.DeckRemaining = r.Deck.Remaining()
+	}
+	calc := NewScoreCalculator()
+	for _, p := range r.Players {
+		if p.Dropped || p.CurrentHand == nil {
+			continue
+		}
+		hand := p.CurrentHand
+		result := PlayerRoundResult{Player: p, Hand: hand.Clone(), Total: p.TotalScore}
+		switch {
+		case hand.Status == HandStatusBusted:
+			result.Outcome = RoundOutcomeBusted
+		case hand.Status == HandStatusActive:
+			result.Outcome = RoundOutcomeInPlay
+		case len(hand.NumberCards) >= 7:
+			result.Outcome = RoundOutcomeFlip7
+			result.Banked = calc.Total(hand)
+		case hand.Status == HandStatusFrozen:
+			result.Outcome = RoundOutcomeFrozen
+			result.Banked = calc.Total(hand)
+		default:
+			result.Outcome = RoundOutcomeStayed
+			result.Banked = calc.Total(hand)
+		}
+		summary.Results = append(summary.Results, result)
+	}
+	return summary
+}
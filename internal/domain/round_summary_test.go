@@ -0,0 +1,61 @@
+package domain_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/testutil"
+)
+
+func TestRoundSummary(t *testing.T) {
+	stayed := playerWith("Stayed", 10, 3, 8)
+	stayed.CurrentHand.Status = domain.HandStatusStayed
+	stayed.TotalScore += 11
+	frozen := playerWith("Frozen", 0, 4)
+	frozen.CurrentHand.Status = domain.HandStatusFrozen
+	busted := playerWith("Busted", 20, 6, 6)
+	flip7 := playerWith("Flip7", 0, 0, 1, 2, 3, 4, 5, 6)
+	flip7.CurrentHand.Status = domain.HandStatusStayed
+	inPlay := playerWith("InPlay", 5, 9)
+	dropped := playerWith("Dropped", 50, 12)
+	dropped.Dropped = true
+
+	players := []*domain.Player{stayed, frozen, busted, flip7, inPlay, dropped}
+	round := testutil.NewRound(players, stayed, domain.NewDeckInOrder([]domain.Card{{Type: domain.CardTypeNumber, Value: 1}}))
+	round.End(domain.RoundEndReasonAborted)
+
+	summary := round.Summary(3)
+	if summary.Number != 3 || summary.Reason != domain.RoundEndReasonAborted || summary.DeckRemaining != 1 {
+		t.Errorf("Expected round 3, aborted, 1 card left, got %d, %s, %d", summary.Number, summary.Reason, summary.DeckRemaining)
+	}
+
+	want := []struct {
+		name    string
+		outcome domain.RoundOutcome
+		banked  int
+		total   int
+	}{
+		{"Stayed", domain.RoundOutcomeStayed, 11, 21},
+		{"Frozen", domain.RoundOutcomeFrozen, 4, 0},
+		{"Busted", domain.RoundOutcomeBusted, 0, 20},
+		{"Flip7", domain.RoundOutcomeFlip7, 36, 0},
+		{"InPlay", domain.RoundOutcomeInPlay, 0, 5},
+	}
+	if len(summary.Results) != len(want) {
+		t.Fatalf("Expected %d results without the dropped player, got %d", len(want), len(summary.Results))
+	}
+	for i, w := range want {
+		r := summary.Results[i]
+		if r.Player.Name != w.name || r.Outcome != w.outcome || r.Banked != w.banked || r.Total != w.total {
+			t.Errorf("Result %d: expected %s %s +%d total %d, got %s %s +%d total %d",
+				i, w.name, w.outcome, w.banked, w.total, r.Player.Name, r.Outcome, r.Banked, r.Total)
+		}
+	}
+
+	// The hands are copies, so the summary outlives the discard.
+	game := domain.NewGame(players)
+	game.DiscardHands()
+	if len(summary.Results[0].Hand.RawNumberCards) != 2 {
+		t.Errorf("Expected the summary to keep Stayed's hand after the discard, got %v", summary.Results[0].Hand.RawNumberCards)
+	}
+}
@@ -0,0 +1,71 @@
+package domain
+
+import "sort"
+
+// RoundTarget is what a player needs from the current round, on top of the hand they hold.
+type RoundTarget struct {
+	HandScore int // Points the player banks by staying now
+	ToLead    int // More points needed to end up ahead of every opponent's banked total; 0 if staying now leads
+	ToWin     int // More points needed to reach the winning threshold; 0 if staying now reaches it
+
+	// Leapfrog is the opponent with the largest unbanked hand who is not ahead of the player's
+	// total after staying now, but would be if they stayed too; nil if there is none.
+	Leapfrog       *Player
+	LeapfrogPoints int // Leapfrog's current hand score
+}
+
+// RoundTargets computes the RoundTarget of player against the opponents' banked totals and,
+// for opponents still in the round, their current hands (scored with ScoreCalculator).
+// Opponents who already stayed, were frozen or busted have nothing left to bank.
+func RoundTargets(player *Player, opponents []*Player, winningThreshold int) RoundTarget {
+	calc := NewScoreCalculator()
+	target := RoundTarget{}
+	if player.CurrentHand != nil {
+		target.HandScore = calc.Compute(player.CurrentHand).Total
+	}
+	stayTotal := player.TotalScore + target.HandScore
+
+	if need := winningThreshold - stayTotal; need > 0 {
+		target.ToWin = need
+	}
+
+	for _, o := range opponents {
+		if need := o.TotalScore + 1 - stayTotal; need > target.ToLead {
+			target.ToLead = need
+		}
+
+		if o.CurrentHand == nil || o.CurrentHand.Status != HandStatusActive || o.TotalScore > stayTotal {
+			continue
+		}
+		points := calc.Compute(o.CurrentHand).Total
+		if o.TotalScore+points > stayTotal && points > target.LeapfrogPoints {
+			target.Leapfrog = o
+			target.LeapfrogPoints = points
+		}
+	}
+	return target
+}
+
+// StayingWinner is a player who reaches the winning threshold by staying now.
+type StayingWinner struct {
+	Player *Player
+	Total  int // Banked total plus the current hand score
+}
+
+// StayingWinners returns the players still in the round whose banked total plus their current
+// hand (scored with ScoreCalculator) reaches winningThreshold, highest Total first; ties keep
+// the order of players.
+func StayingWinners(players []*Player, winningThreshold int) []StayingWinner {
+	calc := NewScoreCalculator()
+	var winners []StayingWinner
+	for _, p := range players {
+		if p == nil || p.CurrentHand == nil || p.CurrentHand.Status != HandStatusActive {
+			continue
+		}
+		if total := p.TotalScore + calc.Compute(p.CurrentHand).Total; total >= winningThreshold {
+			winners = append(winners, StayingWinner{Player: p, Total: total})
+		}
+	}
+	sort.SliceStable(winners, func(i, j int) bool { return winners[i].Total > winners[j].Total })
+	return winners
+}
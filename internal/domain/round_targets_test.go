@@ -0,0 +1,84 @@
+package domain_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+// playerWith returns a player with the given banked total and a round in progress holding numbers.
+func playerWith(name string, total int, numbers ...int) *domain.Player {
+	p := domain.NewPlayer(name, nil)
+	p.TotalScore = total
+	p.StartNewRound()
+	for _, v := range numbers {
+		p.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)})
+	}
+	return p
+}
+
+func TestRoundTargets(t *testing.T) {
+	t.Run("behind with an opponent who can leapfrog", func(t *testing.T) {
+		me := playerWith("Me", 100, 11, 12)        // Stays at 123
+		alice := playerWith("Alice", 140)          // 17 ahead of 123 after staying
+		bob := playerWith("Bob", 95, 9, 10, 4, 5)  // 95 + 28 = 123 ties: no pass
+		carol := playerWith("Carol", 110, 7, 8, 1) // 110 + 16 = 126 passes
+		dave := playerWith("Dave", 120, 12, 10)    // Stayed: banked already, nothing left to pass with
+		dave.CurrentHand.Status = domain.HandStatusStayed
+
+		got := domain.RoundTargets(me, []*domain.Player{alice, bob, carol, dave}, 200)
+
+		if got.HandScore != 23 {
+			t.Errorf("Expected hand score 23, got %d", got.HandScore)
+		}
+		if got.ToLead != 18 {
+			t.Errorf("Expected +18 to lead (141 - 123), got %d", got.ToLead)
+		}
+		if got.ToWin != 77 {
+			t.Errorf("Expected +77 to win, got %d", got.ToWin)
+		}
+		if got.Leapfrog != carol || got.LeapfrogPoints != 16 {
+			t.Errorf("Expected Carol to leapfrog with 16, got %v with %d", got.Leapfrog, got.LeapfrogPoints)
+		}
+	})
+
+	t.Run("already leading", func(t *testing.T) {
+		me := playerWith("Me", 150, 10, 12) // Stays at 172
+		alice := playerWith("Alice", 130, 5)
+		bob := playerWith("Bob", 160) // Busted: nothing to bank
+		bob.CurrentHand.Status = domain.HandStatusBusted
+
+		got := domain.RoundTargets(me, []*domain.Player{alice, bob}, 170)
+
+		if got.ToLead != 0 || got.ToWin != 0 {
+			t.Errorf("Expected nothing more needed to lead or win, got +%d / +%d", got.ToLead, got.ToWin)
+		}
+		if got.Leapfrog != nil {
+			t.Errorf("Expected no leapfrog, got %s (+%d)", got.Leapfrog.Name, got.LeapfrogPoints)
+		}
+	})
+}
+
+func TestStayingWinners(t *testing.T) {
+	alice := playerWith("Alice", 190, 4, 6)  // 200: reaches it exactly
+	bob := playerWith("Bob", 150, 12, 11)    // 173: short
+	carol := playerWith("Carol", 180, 9, 12) // 201
+	dave := playerWith("Dave", 199, 5)       // Busted: nothing left to bank
+	dave.CurrentHand.Status = domain.HandStatusBusted
+
+	got := domain.StayingWinners([]*domain.Player{alice, bob, carol, dave}, 200)
+
+	want := []domain.StayingWinner{{Player: carol, Total: 201}, {Player: alice, Total: 200}}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d staying winners, got %v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Winner %d: expected %s at %d, got %s at %d", i+1, want[i].Player.Name, want[i].Total, got[i].Player.Name, got[i].Total)
+		}
+	}
+
+	if got := domain.StayingWinners([]*domain.Player{alice, bob, carol}, 250); len(got) != 0 {
+		t.Errorf("Expected no staying winners at 250, got %v", got)
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+// NumberDraw is a number value still in the deck and how many copies of it are left.
+type NumberDraw struct {
+	Value  NumberValue
+	Copies int
+}
+
+// SafeDrawBreakdown splits the number cards left in the deck by what they would do to a hand.
+type SafeDrawBreakdown struct {
+	UniqueNumbers int // Distinct numbers in the hand
+	ToFlip7       int // Distinct numbers still needed for Flip 7; 0 once reached
+
+	Safe        []NumberDraw // Values not in the hand, ascending; each would be a new number
+	SafeCards   int
+	Unsafe      []NumberDraw // Values already in the hand, ascending; each would be a duplicate
+	UnsafeCards int
+
+	// Flip7Chance is the probability that the next number card drawn completes Flip 7
+	// (safe cards ÷ number cards left). It is 0 unless the hand is one number away.
+	Flip7Chance float64
+}
+
+// SafeDraws computes the SafeDrawBreakdown of hand against the number cards left in deck.
+// Values with no copies left appear in neither list. A Second Chance in hand does not make
+// a duplicate safe here: it is still a card the hand would rather not draw.
+func SafeDraws(hand *PlayerHand, deck DeckView) SafeDrawBreakdown {
+	b := SafeDrawBreakdown{UniqueNumbers: len(hand.NumberCards)}
+	if b.UniqueNumbers < 7 {
+		b.ToFlip7 = 7 - b.UniqueNumbers
+	}
+
+	counts := deck.RemainingNumberCounts()
+	for v := NumberValue(0); v <= 12; v++ {
+		n := counts[v]
+		if n == 0 {
+			continue
+		}
+		if _, inHand := hand.NumberCards[v]; inHand {
+			b.Unsafe = append(b.Unsafe, NumberDraw{Value: v, Copies: n})
+			b.UnsafeCards += n
+		} else {
+			b.Safe = append(b.Safe, NumberDraw{Value: v, Copies: n})
+			b.SafeCards += n
+		}
+	}
+
+	if total := b.SafeCards + b.UnsafeCards; b.ToFlip7 == 1 && total > 0 {
+		b.Flip7Chance = float64(b.SafeCards) / float64(total)
+	}
+	return b
+}
@@ -0,0 +1,67 @@
+package domain_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func TestSafeDraws(t *testing.T) {
+	number := func(v int) domain.Card {
+		return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+	}
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	plus4 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4}
+
+	t.Run("splits the numbers left by the hand", func(t *testing.T) {
+		hand := playerWith("Me", 0, 3, 9).CurrentHand
+		deck := domain.NewDeckInOrder([]domain.Card{number(3), number(9), number(9), number(0), number(8), number(8), freeze, plus4})
+
+		got := domain.SafeDraws(hand, deck)
+
+		want := domain.SafeDrawBreakdown{
+			UniqueNumbers: 2,
+			ToFlip7:       5,
+			Safe:          []domain.NumberDraw{{Value: 0, Copies: 1}, {Value: 8, Copies: 2}},
+			SafeCards:     3,
+			Unsafe:        []domain.NumberDraw{{Value: 3, Copies: 1}, {Value: 9, Copies: 2}},
+			UnsafeCards:   3,
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Expected %+v, got %+v", want, got)
+		}
+	})
+
+	t.Run("one number away from Flip 7", func(t *testing.T) {
+		hand := playerWith("Me", 0, 1, 2, 3, 4, 5, 6).CurrentHand
+		deck := domain.NewDeckInOrder([]domain.Card{number(7), number(12), number(12), number(1), freeze})
+
+		got := domain.SafeDraws(hand, deck)
+
+		if got.ToFlip7 != 1 || got.SafeCards != 3 || got.UnsafeCards != 1 {
+			t.Fatalf("Expected 1 to go with 3 safe and 1 unsafe card, got %+v", got)
+		}
+		// The Freeze is not a number card, so it does not dilute the chance.
+		if math.Abs(got.Flip7Chance-0.75) > 1e-9 {
+			t.Errorf("Expected a 75%% Flip 7 chance, got %.4f", got.Flip7Chance)
+		}
+	})
+
+	t.Run("further from Flip 7 has no chance on the next draw", func(t *testing.T) {
+		hand := playerWith("Me", 0, 1, 2, 3, 4, 5).CurrentHand
+		got := domain.SafeDraws(hand, domain.NewDeckInOrder([]domain.Card{number(7), number(8)}))
+		if got.Flip7Chance != 0 {
+			t.Errorf("Expected no Flip 7 chance two numbers away, got %.4f", got.Flip7Chance)
+		}
+	})
+
+	t.Run("no number cards left", func(t *testing.T) {
+		hand := playerWith("Me", 0, 1, 2, 3, 4, 5, 6).CurrentHand
+		got := domain.SafeDraws(hand, domain.NewDeckInOrder([]domain.Card{freeze}))
+		if got.Safe != nil || got.Unsafe != nil || got.Flip7Chance != 0 {
+			t.Errorf("Expected nothing to draw, got %+v", got)
+		}
+	})
+}
@@ -1,5 +1,10 @@
 package domain
 
+import (
+	"fmt"
+	"strings"
+)
+
 // ScoreCalculator calculates the score for a hand.
 type ScoreCalculator struct{}
 
@@ -7,53 +12,136 @@ func NewScoreCalculator() *ScoreCalculator {
 	return &ScoreCalculator{}
 }
 
+// Compute scores a hand. A x2 modifier doubles only the sum of the number cards; +N modifiers
+// and the Flip 7 bonus are added afterwards. A busted hand scores 0.
+//
+// Hands without number cards follow the same rule, which is how the rulebook scores them: +N
+// cards add their value to the round's points whether or not a number card was flipped, so a
+// player frozen or staying on a lone +10 banks 10, while x2 doubles the number cards only, so a
+// lone x2 banks 0 and x2 with +4 banks 4.
 func (sc *ScoreCalculator) Compute(hand *PlayerHand) PointValue {
 	if hand.Status == HandStatusBusted {
-		return PointValue{Total: 0}
+		return PointValue{Multiplier: 1, Busted: true}
 	}
 
-	baseSum := 0
+	pv := PointValue{
+		Numbers:    append([]NumberValue(nil), hand.RawNumberCards...),
+		Multiplier: 1,
+	}
 	for _, val := range hand.RawNumberCards {
-		baseSum += int(val)
+		pv.BaseSum += int(val)
+	}
+
+	for _, mod := range hand.ModifierCards {
+		pv.Modifiers = append(pv.Modifiers, mod.ModifierType)
+		if mod.ModifierType == ModifierX2 {
+			pv.Multiplier *= 2
+		}
+		pv.Additive += mod.ModifierType.Points()
+	}
+
+	// Flip 7 bonus: awards 15 points if the player has 7 or more unique number cards.
+	pv.Bonus = flip7Bonus(len(hand.NumberCards))
+
+	pv.Total = pv.BaseSum*pv.Multiplier + pv.Additive + pv.Bonus
+	return pv
+}
+
+// Total returns Compute(hand).Total without building the breakdown. It does not allocate, so
+// strategies can score hands on every decision.
+func (sc *ScoreCalculator) Total(hand *PlayerHand) int {
+	if hand.Status == HandStatusBusted {
+		return 0
 	}
+	base, multiplier, additive := scoreParts(hand)
+	return base*multiplier + additive + flip7Bonus(len(hand.NumberCards))
+}
 
-	multiplier := 1
-	addModifiers := 0
+// TotalAfter returns the Total that hand would have after AddCard(card), and whether the card
+// would bust it, without changing the hand. It does not allocate, so the hand does not have to
+// be cloned to score each possible draw.
+func (sc *ScoreCalculator) TotalAfter(hand *PlayerHand, card Card) (total int, busted bool) {
+	if hand.Status != HandStatusActive {
+		return sc.Total(hand), false // The card would be discarded
+	}
+	base, multiplier, additive := scoreParts(hand)
+	unique := len(hand.NumberCards)
+	switch card.Type {
+	case CardTypeNumber:
+		if _, exists := hand.NumberCards[card.Value]; exists {
+			if !hand.HasSecondChance() {
+				return 0, true
+			}
+			// Second Chance takes the duplicate: the hand is unchanged
+		} else {
+			base += int(card.Value)
+			unique++
+		}
+	case CardTypeModifier:
+		if card.ModifierType == ModifierX2 {
+			multiplier *= 2
+		}
+		additive += card.ModifierType.Points()
+	}
+	// Action cards score nothing
+	return base*multiplier + additive + flip7Bonus(unique), false
+}
 
+// scoreParts returns the number sum, the multiplier of the x2 modifiers and the sum of the
+// +N modifiers of a hand.
+func scoreParts(hand *PlayerHand) (base, multiplier, additive int) {
+	for _, val := range hand.RawNumberCards {
+		base += int(val)
+	}
+	multiplier = 1
 	for _, mod := range hand.ModifierCards {
-		switch mod.ModifierType {
-		case ModifierPlus2:
-			addModifiers += 2
-		case ModifierPlus4:
-			addModifiers += 4
-		case ModifierPlus6:
-			addModifiers += 6
-		case ModifierPlus8:
-			addModifiers += 8
-		case ModifierPlus10:
-			addModifiers += 10
-		case ModifierX2:
+		if mod.ModifierType == ModifierX2 {
 			multiplier *= 2
 		}
+		additive += mod.ModifierType.Points()
 	}
+	return base, multiplier, additive
+}
 
-	// Calculate total score
-	// Formula: (BaseSum + AddModifiers) * Multiplier + Bonus
+// flip7Bonus returns the Flip 7 bonus of a hand holding unique distinct numbers.
+func flip7Bonus(unique int) int {
+	if unique >= 7 {
+		return 15
+	}
+	return 0
+}
 
-	// Flip 7 bonus: awards 15 points if the player has 7 or more unique number cards.
-	bonus := 0
-	// Check if Flip 7 achieved (7 unique number cards)
-	totalCards := len(hand.NumberCards)
-	if totalCards >= 7 {
-		bonus = 15
+// Breakdown shows how Total was reached, e.g. "(5+8+9) ×2 +4" for 48.
+func (pv PointValue) Breakdown() string {
+	if pv.Busted {
+		return "0 (busted)"
 	}
 
-	total := (baseSum * multiplier) + addModifiers + bonus
+	numbers := make([]string, len(pv.Numbers))
+	for i, v := range pv.Numbers {
+		numbers[i] = fmt.Sprint(int(v))
+	}
+	base := strings.Join(numbers, "+")
+	switch {
+	case len(numbers) == 0:
+		base = "0"
+	case len(numbers) > 1 && pv.Multiplier != 1:
+		base = "(" + base + ")"
+	}
 
-	return PointValue{
-		BaseSum:   baseSum,
-		Modifiers: []int{addModifiers, multiplier}, // Simplified representation
-		Bonus:     bonus,
-		Total:     total,
+	parts := []string{base}
+	for _, m := range pv.Modifiers {
+		if m == ModifierX2 {
+			parts = append(parts, "×2")
+		}
+	}
+	for _, m := range pv.Modifiers {
+		if m.IsAdditive() {
+			parts = append(parts, fmt.Sprintf("+%d", m.Points()))
+		}
+	}
+	if pv.Bonus > 0 {
+		parts = append(parts, fmt.Sprintf("+%d (Flip 7)", pv.Bonus))
 	}
+	return strings.Join(parts, " ")
 }
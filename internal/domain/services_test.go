@@ -87,6 +87,46 @@ func TestScoreCalculator_Compute(t *testing.T) {
 			// Expected: (5 * 4) + 4 = 24
 			expected: 24,
 		},
+		{
+			// x2 doubles the number cards only, so with none it doubles nothing
+			name: "No numbers: multiply_2",
+			hand: &PlayerHand{
+				Status: HandStatusStayed,
+				ModifierCards: []Card{
+					{Type: CardTypeModifier, ModifierType: ModifierX2},
+				},
+				NumberCards: map[NumberValue]struct{}{},
+			},
+			expected: 0,
+		},
+		{
+			// Additive modifiers score even without number cards, and are not doubled
+			name: "No numbers: multiply_2, plus_4",
+			hand: &PlayerHand{
+				Status: HandStatusStayed,
+				ModifierCards: []Card{
+					{Type: CardTypeModifier, ModifierType: ModifierX2},
+					{Type: CardTypeModifier, ModifierType: ModifierPlus4},
+				},
+				NumberCards: map[NumberValue]struct{}{},
+			},
+			// Expected: 0 * 2 + 4 = 4
+			expected: 4,
+		},
+		{
+			name: "Busted hand with modifiers",
+			hand: &PlayerHand{
+				Status:         HandStatusBusted,
+				RawNumberCards: []NumberValue{5},
+				ModifierCards: []Card{
+					{Type: CardTypeModifier, ModifierType: ModifierPlus10},
+				},
+				NumberCards: map[NumberValue]struct{}{
+					5: {},
+				},
+			},
+			expected: 0,
+		},
 	}
 
 	calc := NewScoreCalculator()
@@ -100,3 +140,132 @@ func TestScoreCalculator_Compute(t *testing.T) {
 		})
 	}
 }
+
+func TestPointValue_Breakdown(t *testing.T) {
+	x2 := Card{Type: CardTypeModifier, ModifierType: ModifierX2}
+	plus4 := Card{Type: CardTypeModifier, ModifierType: ModifierPlus4}
+
+	tests := []struct {
+		name      string
+		numbers   []NumberValue
+		modifiers []Card
+		status    HandStatus
+		expected  string
+		total     int
+	}{
+		{"Numbers, x2 and +4", []NumberValue{5, 8, 9}, []Card{plus4, x2}, HandStatusStayed, "(5+8+9) ×2 +4", 48},
+		{"Numbers only", []NumberValue{5, 8, 9}, nil, HandStatusStayed, "5+8+9", 22},
+		{"Single number doubled", []NumberValue{7}, []Card{x2}, HandStatusStayed, "7 ×2", 14},
+		{"No numbers", nil, []Card{x2, plus4}, HandStatusStayed, "0 ×2 +4", 4},
+		{"Flip 7 bonus", []NumberValue{0, 1, 2, 3, 4, 5, 6}, nil, HandStatusStayed, "0+1+2+3+4+5+6 +15 (Flip 7)", 36},
+		{"Busted", []NumberValue{5}, []Card{plus4}, HandStatusBusted, "0 (busted)", 0},
+	}
+	calc := NewScoreCalculator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := NewPlayerHand()
+			for _, v := range tt.numbers {
+				hand.AddCard(Card{Type: CardTypeNumber, Value: v})
+			}
+			for _, c := range tt.modifiers {
+				hand.AddCard(c)
+			}
+			hand.Status = tt.status
+
+			pv := calc.Compute(hand)
+			if got := pv.Breakdown(); got != tt.expected {
+				t.Errorf("Breakdown() = %q, want %q", got, tt.expected)
+			}
+			if pv.Total != tt.total {
+				t.Errorf("Total = %d, want %d", pv.Total, tt.total)
+			}
+		})
+	}
+}
+
+func TestScoreCalculator_TotalAfterMatchesAddCard(t *testing.T) {
+	handOf := func(status HandStatus, numbers []NumberValue, others ...Card) *PlayerHand {
+		h := NewPlayerHand()
+		for _, v := range numbers {
+			h.AddCard(Card{Type: CardTypeNumber, Value: v})
+		}
+		for _, c := range others {
+			h.AddCard(c)
+		}
+		h.Status = status
+		return h
+	}
+	x2 := Card{Type: CardTypeModifier, ModifierType: ModifierX2}
+	plus4 := Card{Type: CardTypeModifier, ModifierType: ModifierPlus4}
+	secondChance := Card{Type: CardTypeAction, ActionType: ActionSecondChance}
+	hands := map[string]*PlayerHand{
+		"empty":                 handOf(HandStatusActive, nil),
+		"numbers":               handOf(HandStatusActive, []NumberValue{0, 5, 12}),
+		"numbers and modifiers": handOf(HandStatusActive, []NumberValue{3, 9}, x2, plus4),
+		"modifiers only":        handOf(HandStatusActive, nil, x2, plus4),
+		"second chance":         handOf(HandStatusActive, []NumberValue{4, 7}, secondChance),
+		"one away from Flip 7":  handOf(HandStatusActive, []NumberValue{0, 1, 2, 3, 4, 5}, x2),
+		"stayed":                handOf(HandStatusStayed, []NumberValue{6, 8}, plus4),
+		"busted":                handOf(HandStatusBusted, []NumberValue{6, 8}),
+	}
+
+	calc := NewScoreCalculator()
+	for name, hand := range hands {
+		if got, want := calc.Total(hand), calc.Compute(hand).Total; got != want {
+			t.Errorf("%s: Total %d, Compute %d", name, got, want)
+		}
+		for _, card := range StandardDeckCards() {
+			clone := hand.Clone()
+			wantBusted, _, _ := clone.AddCard(card)
+			want := calc.Compute(clone).Total
+
+			got, busted := calc.TotalAfter(hand, card)
+			if got != want || busted != wantBusted {
+				t.Errorf("%s + %s: expected %d (busted %v), got %d (busted %v)", name, card, want, wantBusted, got, busted)
+			}
+		}
+	}
+}
+
+func TestScoreCalculator_ModifierOnlyHands(t *testing.T) {
+	modifier := func(m ModifierType) Card { return Card{Type: CardTypeModifier, ModifierType: m} }
+	secondChance := Card{Type: CardTypeAction, ActionType: ActionSecondChance}
+
+	tests := []struct {
+		name      string
+		cards     []Card
+		status    HandStatus
+		total     int
+		breakdown string
+	}{
+		{"Lone +10 scores its face value", []Card{modifier(ModifierPlus10)}, HandStatusStayed, 10, "0 +10"},
+		{"Lone +2 when frozen", []Card{modifier(ModifierPlus2)}, HandStatusFrozen, 2, "0 +2"},
+		{"Additive modifiers add up", []Card{modifier(ModifierPlus4), modifier(ModifierPlus6)}, HandStatusStayed, 10, "0 +4 +6"},
+		{"Lone x2 scores zero", []Card{modifier(ModifierX2)}, HandStatusStayed, 0, "0 ×2"},
+		{"Lone x2 when frozen", []Card{modifier(ModifierX2)}, HandStatusFrozen, 0, "0 ×2"},
+		{"x2 does not double +N", []Card{modifier(ModifierX2), modifier(ModifierPlus8)}, HandStatusStayed, 8, "0 ×2 +8"},
+		{"Action cards score nothing", []Card{secondChance, modifier(ModifierX2)}, HandStatusStayed, 0, "0 ×2"},
+		{"Busted +10 scores zero", []Card{modifier(ModifierPlus10)}, HandStatusBusted, 0, "0 (busted)"},
+	}
+	calc := NewScoreCalculator()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hand := NewPlayerHand()
+			for _, c := range tt.cards {
+				hand.AddCard(c)
+			}
+			hand.Status = tt.status
+
+			pv := calc.Compute(hand)
+			if pv.Total != tt.total {
+				t.Errorf("Compute().Total = %d, want %d", pv.Total, tt.total)
+			}
+			if got := calc.Total(hand); got != tt.total {
+				t.Errorf("Total() = %d, want %d", got, tt.total)
+			}
+			if got := pv.Breakdown(); got != tt.breakdown {
+				t.Errorf("Breakdown() = %q, want %q", got, tt.breakdown)
+			}
+		})
+	}
+}
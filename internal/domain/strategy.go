@@ -10,7 +10,38 @@ const (
 
 // Strategy defines the behavior for an AI player.
 type Strategy interface {
-	Decide(deck *Deck, hand *PlayerHand, playerScore int, otherPlayers []*Player) TurnChoice
+	// Decide chooses to hit or stay. A player may not stay before flipping a card in the round
+	// (hand.CanStay reports whether staying is allowed yet); the engine turns such a Stay into
+	// a Hit and warns about the strategy.
+	Decide(deck DeckView, hand *PlayerHand, playerScore int, otherPlayers []*Player) TurnChoice
+	// ChooseTarget must return one of candidates, which is never empty when the engine asks.
+	// The engine checks the choice: nil or a player outside candidates is replaced by
+	// candidates[0], with a warning in the game log.
 	ChooseTarget(action ActionType, candidates []*Player, self *Player) *Player
 	Name() string
 }
+
+// WinningScoreAware is implemented by strategies whose decisions depend on the score needed to win.
+// Services call SetWinningScore before play so these strategies follow the game's configured target.
+type WinningScoreAware interface {
+	SetWinningScore(score int)
+}
+
+// HeldActionContext is what an ActionHolder sees when it may play a held action card.
+type HeldActionContext struct {
+	Deck         DeckView
+	Hand         *PlayerHand
+	PlayerScore  int
+	OtherPlayers []*Player // Everyone in the round, as passed to Decide
+	Held         []Card    // The Freeze and Flip Three cards in Hand
+}
+
+// ActionHolder is implemented by strategies that play the holdable-actions house rule
+// (Game.HoldableActions). Under that rule they keep the Freeze and Flip Three cards they draw
+// and may play one at the start of each of their turns; strategies without it resolve their
+// action cards at once, as in the standard rules.
+type ActionHolder interface {
+	// PlayHeldAction returns the held card to play before hitting or staying, or nil to keep
+	// holding. The target is then chosen with ChooseTarget as usual.
+	PlayHeldAction(ctx HeldActionContext) *Card
+}
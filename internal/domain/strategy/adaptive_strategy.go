@@ -1,38 +1,114 @@
 package strategy
 
 import (
+	"fmt"
+	"strings"
+
 	"flip7_strategy/internal/domain"
 )
 
+// AdaptiveConfig holds the tunable parameters of AdaptiveStrategy.
+type AdaptiveConfig struct {
+	ThreatScoreThreshold            int     // Opponent score that switches to Aggressive mode; 0 means the winning score
+	AggressiveRiskCap               float64 // Hit risk above which Aggressive mode stays
+	AggressiveRiskSelectorThreshold float64 // Flip Three risk threshold of the Aggressive mode target selector
+	EVRiskSelectorThreshold         float64 // Flip Three risk threshold of the Expected Value mode target selector
+}
+
+// DefaultAdaptiveConfig returns the configuration used by NewAdaptiveStrategy.
+func DefaultAdaptiveConfig() AdaptiveConfig {
+	return AdaptiveConfig{
+		AggressiveRiskCap:               DefaultAggressiveRiskCap,
+		AggressiveRiskSelectorThreshold: 0.65,
+		EVRiskSelectorThreshold:         0.80,
+	}
+}
+
 // AdaptiveStrategy switches behavior based on game state.
-// If any opponent has reached the winning threshold (200 points), it becomes Aggressive.
+// If any opponent has reached the threat threshold (the winning score by default), it becomes Aggressive.
 // Otherwise, it plays conservatively using Expected Value.
 type AdaptiveStrategy struct {
 	Aggressive    *AggressiveStrategy
 	ExpectedValue *ExpectedValueStrategy
+	WinningScore  int // Score needed to win; 0 means domain.WinningThreshold
+	Config        AdaptiveConfig
 }
 
 func NewAdaptiveStrategy() *AdaptiveStrategy {
+	return NewAdaptiveStrategyWithConfig(DefaultAdaptiveConfig())
+}
+
+// NewAdaptiveStrategyWithConfig creates an AdaptiveStrategy with custom parameters.
+func NewAdaptiveStrategyWithConfig(config AdaptiveConfig) *AdaptiveStrategy {
+	aggressive := NewAggressiveStrategyWithSelector(NewRiskBasedTargetSelector(config.AggressiveRiskSelectorThreshold))
+	aggressive.RiskCap = config.AggressiveRiskCap
 	return &AdaptiveStrategy{
-		Aggressive:    NewAggressiveStrategyWithSelector(NewRiskBasedTargetSelector(0.65)),
-		ExpectedValue: NewExpectedValueStrategyWithSelector(NewRiskBasedTargetSelector(0.80)),
+		Aggressive:    aggressive,
+		ExpectedValue: NewExpectedValueStrategyWithSelector(NewRiskBasedTargetSelector(config.EVRiskSelectorThreshold)),
+		Config:        config,
 	}
 }
 
+// Name returns "Adaptive" for the default configuration and encodes the
+// parameters that differ from it otherwise, e.g. "Adaptive(t=180)".
 func (s *AdaptiveStrategy) Name() string {
-	return "Adaptive"
+	def := DefaultAdaptiveConfig()
+	var params []string
+	if s.Config.ThreatScoreThreshold != def.ThreatScoreThreshold {
+		params = append(params, fmt.Sprintf("t=%d", s.Config.ThreatScoreThreshold))
+	}
+	if s.Config.AggressiveRiskCap != def.AggressiveRiskCap {
+		params = append(params, fmt.Sprintf("cap=%.2f", s.Config.AggressiveRiskCap))
+	}
+	if s.Config.AggressiveRiskSelectorThreshold != def.AggressiveRiskSelectorThreshold {
+		params = append(params, fmt.Sprintf("agg=%.2f", s.Config.AggressiveRiskSelectorThreshold))
+	}
+	if s.Config.EVRiskSelectorThreshold != def.EVRiskSelectorThreshold {
+		params = append(params, fmt.Sprintf("ev=%.2f", s.Config.EVRiskSelectorThreshold))
+	}
+	if len(params) == 0 {
+		return "Adaptive"
+	}
+	return fmt.Sprintf("Adaptive(%s)", strings.Join(params, ","))
+}
+
+func (s *AdaptiveStrategy) SetWinningScore(score int) {
+	s.WinningScore = score
+	s.Aggressive.SetWinningScore(score)
+	s.ExpectedValue.SetWinningScore(score)
+}
+
+func (s *AdaptiveStrategy) winningScore() int {
+	if s.WinningScore <= 0 {
+		return domain.WinningThreshold
+	}
+	return s.WinningScore
 }
 
-func (s *AdaptiveStrategy) SetDeck(deck *domain.Deck) {
+// threatScore is the opponent score at which the strategy switches to Aggressive mode.
+func (s *AdaptiveStrategy) threatScore() int {
+	if s.Config.ThreatScoreThreshold > 0 {
+		return s.Config.ThreatScoreThreshold
+	}
+	return s.winningScore()
+}
+
+func (s *AdaptiveStrategy) SetDeck(deck domain.DeckView) {
 	s.Aggressive.SetDeck(deck)
 	s.ExpectedValue.SetDeck(deck)
 }
 
-func (s *AdaptiveStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
-	// Check if any opponent has reached the winning threshold
+// OnReshuffle points both modes, and their target selectors, at the reshuffled deck: the deck
+// they were last given is used up, and so are its counts.
+func (s *AdaptiveStrategy) OnReshuffle(deck domain.DeckView) {
+	s.SetDeck(deck)
+}
+
+func (s *AdaptiveStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+	// Check if any opponent has reached the threat threshold
 	opponentThreat := false
 	for _, p := range otherPlayers {
-		if p.TotalScore >= domain.WinningThreshold {
+		if p.TotalScore >= s.threatScore() {
 			opponentThreat = true
 			break
 		}
@@ -48,10 +124,10 @@ func (s *AdaptiveStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, pl
 }
 
 func (s *AdaptiveStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
-	// Check if any opponent has reached the winning threshold
+	// Check if any opponent has reached the threat threshold
 	opponentThreat := false
 	for _, p := range candidates {
-		if p.ID != self.ID && p.TotalScore >= domain.WinningThreshold {
+		if p.ID != self.ID && p.TotalScore >= s.threatScore() {
 			opponentThreat = true
 			break
 		}
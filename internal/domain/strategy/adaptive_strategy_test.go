@@ -71,6 +71,20 @@ func TestAdaptiveStrategy_Decide(t *testing.T) {
 			t.Errorf("Expected Hit (Aggressive behavior), got %v", choice)
 		}
 	})
+
+	// Test Case 3: Short game to 100 -> 150 is already a threat
+	t.Run("Opponent Threat at Custom Winning Score", func(t *testing.T) {
+		short := strategy.NewAdaptiveStrategy()
+		short.SetWinningScore(100)
+		otherPlayers := []*domain.Player{
+			{ID: uuid.New(), TotalScore: 50},
+			{ID: uuid.New(), TotalScore: 150},
+		}
+		choice := short.Decide(deck, hand, 50, otherPlayers)
+		if choice != domain.TurnChoiceHit {
+			t.Errorf("Expected Hit (Aggressive behavior) when playing to 100, got %v", choice)
+		}
+	})
 }
 
 func TestAdaptiveStrategy_ChooseTarget(t *testing.T) {
@@ -187,3 +201,62 @@ func TestAdaptiveStrategy_ChooseTarget_DynamicDelegation(t *testing.T) {
 		}
 	})
 }
+
+func TestAdaptiveStrategy_ThreatScoreThreshold(t *testing.T) {
+	// Same setup as TestAdaptiveStrategy_Decide: EV stays, Aggressive hits.
+	hand := domain.NewPlayerHand()
+	for _, n := range []int{0, 8, 9, 10, 11, 12} {
+		hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(n)})
+	}
+	var deckCards []domain.Card
+	for _, n := range []int{8, 9, 10, 11, 1, 2, 3, 4, 5, 6} {
+		deckCards = append(deckCards, domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(n)})
+	}
+	deck := domain.NewDeckFromCards(deckCards)
+
+	config := strategy.DefaultAdaptiveConfig()
+	config.ThreatScoreThreshold = 180
+	s := strategy.NewAdaptiveStrategyWithConfig(config)
+
+	tests := []struct {
+		name          string
+		opponentScore int
+		expected      domain.TurnChoice
+	}{
+		{"Below threshold uses EV", 179, domain.TurnChoiceStay},
+		{"At threshold uses Aggressive", 180, domain.TurnChoiceHit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			otherPlayers := []*domain.Player{{ID: uuid.New(), TotalScore: tt.opponentScore}}
+			if choice := s.Decide(deck, hand, 100, otherPlayers); choice != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, choice)
+			}
+		})
+	}
+}
+
+func TestAdaptiveStrategy_NameEncodesConfig(t *testing.T) {
+	threat := strategy.DefaultAdaptiveConfig()
+	threat.ThreatScoreThreshold = 180
+
+	combined := threat
+	combined.AggressiveRiskCap = 0.35
+
+	tests := []struct {
+		name     string
+		config   strategy.AdaptiveConfig
+		expected string
+	}{
+		{"Default", strategy.DefaultAdaptiveConfig(), "Adaptive"},
+		{"Threat threshold", threat, "Adaptive(t=180)"},
+		{"Several parameters", combined, "Adaptive(t=180,cap=0.35)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := strategy.NewAdaptiveStrategyWithConfig(tt.config).Name(); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
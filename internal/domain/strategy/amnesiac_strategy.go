@@ -0,0 +1,42 @@
+package strategy
+
+import "flip7_strategy/internal/domain"
+
+// AmnesiacStrategy plays Inner as a player who does not count cards: every deck Inner is shown,
+// when deciding and when choosing targets, has the full composition of a standard deck,
+// whatever has already been drawn. Comparing a deck-aware strategy with its amnesiac version
+// isolates what card counting is worth to it.
+type AmnesiacStrategy struct {
+	Inner domain.Strategy
+}
+
+// NewAmnesiacStrategy wraps inner so that it never sees the cards left in the deck.
+func NewAmnesiacStrategy(inner domain.Strategy) *AmnesiacStrategy {
+	return &AmnesiacStrategy{Inner: inner}
+}
+
+func (s *AmnesiacStrategy) Name() string {
+	return s.Inner.Name() + " (amnesiac)"
+}
+
+// Decide shows Inner a full deck instead of deck.
+func (s *AmnesiacStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+	return s.Inner.Decide(fullDeck, hand, playerScore, otherPlayers)
+}
+
+func (s *AmnesiacStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	return s.Inner.ChooseTarget(action, candidates, self)
+}
+
+// SetDeck gives Inner a full deck instead of deck, for its target choices.
+func (s *AmnesiacStrategy) SetDeck(deck domain.DeckView) {
+	if ds, ok := s.Inner.(domain.DeckAware); ok {
+		ds.SetDeck(fullDeck)
+	}
+}
+
+func (s *AmnesiacStrategy) SetWinningScore(score int) {
+	if ws, ok := s.Inner.(domain.WinningScoreAware); ok {
+		ws.SetWinningScore(score)
+	}
+}
@@ -0,0 +1,40 @@
+package strategy_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+)
+
+func TestAmnesiacStrategy_IgnoresCountedCards(t *testing.T) {
+	// Holding a 12 with three of the last four cards 12s, a counting player stays (75% bust risk).
+	// Without counting the deck looks full: 11 of the other 93 cards are 12s, so hitting looks safe.
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 12})
+	twelve := domain.Card{Type: domain.CardTypeNumber, Value: 12}
+	deck := domain.NewDeckInOrder([]domain.Card{twelve, twelve, twelve, {Type: domain.CardTypeNumber, Value: 1}})
+
+	counting := strategy.NewProbabilisticStrategy()
+	if got := counting.Decide(deck, hand, 0, nil); got != domain.TurnChoiceStay {
+		t.Errorf("Expected the counting strategy to stay, got %s", got)
+	}
+	amnesiac := strategy.NewAmnesiacStrategy(strategy.NewProbabilisticStrategy())
+	if got := amnesiac.Decide(deck, hand, 0, nil); got != domain.TurnChoiceHit {
+		t.Errorf("Expected the amnesiac strategy to hit, got %s", got)
+	}
+	if amnesiac.Name() != "Probabilistic (amnesiac)" {
+		t.Errorf("Unexpected name %q", amnesiac.Name())
+	}
+}
+
+func TestAmnesiacStrategy_SetDeckShowsFullDeck(t *testing.T) {
+	inner := &fixedStrategy{name: "Fixed", choice: domain.TurnChoiceHit}
+	s := strategy.NewAmnesiacStrategy(inner)
+
+	s.SetDeck(domain.NewDeckInOrder([]domain.Card{{Type: domain.CardTypeNumber, Value: 5}}))
+
+	if inner.deck == nil || inner.deck.Remaining() != len(domain.StandardDeckCards()) {
+		t.Errorf("Expected the inner strategy to see a full deck, got %v", inner.deck)
+	}
+}
@@ -5,6 +5,8 @@ import (
 )
 
 // ExpectedValueStrategy calculates the expected value of the next hit.
+// Build it with one of its constructors: the zero value has no TargetSelector, and choosing a
+// target panics.
 type ExpectedValueStrategy struct {
 	TargetSelector
 }
@@ -23,58 +25,50 @@ func NewExpectedValueStrategyWithSelector(selector TargetSelector) *ExpectedValu
 	}
 }
 
+func (s *ExpectedValueStrategy) SetWinningScore(score int) {
+	setSelectorWinningScore(s.TargetSelector, score)
+}
+
 func (s *ExpectedValueStrategy) Name() string {
 	return "ExpectedValue"
 }
 
-func (s *ExpectedValueStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, _ int, _ []*domain.Player) domain.TurnChoice {
+func (s *ExpectedValueStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, _ int, _ []*domain.Player) domain.TurnChoice {
 	if hand.HasSecondChance() {
 		return domain.TurnChoiceHit
 	}
 	// If deck is empty, must stay (though game logic usually handles this)
-	if len(deck.Cards) == 0 {
+	totalCards := deck.Remaining()
+	if totalCards == 0 {
 		return domain.TurnChoiceStay
 	}
 
 	// Calculate current score
 	calc := domain.NewScoreCalculator()
-	currentScore := calc.Compute(hand).Total
+	currentScore := calc.Total(hand)
 
-	// Calculate Expected Value of the next card
+	// Calculate Expected Value of the next card.
+	// The deck only reveals how many cards of each kind are left (perfect card counting),
+	// so each kind of card is scored once and weighted by its remaining count.
 	totalEV := 0.0
-	totalCards := 0
-
-	// We need to iterate over all possible next cards.
-	// Since we don't have direct access to the deck's remaining cards list efficiently without peeking,
-	// we rely on RemainingCounts for numbers and knowledge of deck composition for others.
-	// However, the Deck struct exposes Cards slice, so we can iterate over it.
-	// But iterating over the shuffled deck is cheating? No, the strategy receives the *Deck.
-	// In a real game, you count cards. The Deck struct has RemainingCounts which simulates card counting.
-	// But for modifiers and actions, we might need to know how many are left if we want to be precise.
-	// The Deck struct in `card.go` has `RemainingCounts` only for numbers.
-	// For this implementation, let's assume we can see the remaining cards in the deck (perfect counting)
-	// or we should approximate. The issue description says "based on the left deck".
-	// Let's use the `Cards` slice in the deck which represents the actual remaining cards.
-	// This is equivalent to perfect card counting.
-
-	for _, card := range deck.Cards {
-		totalCards++
-
-		// Simulate adding this card
-		clonedHand := hand.Clone()
-		busted, _, _ := clonedHand.AddCard(card)
-
-		if busted {
-			totalEV += 0 // Score becomes 0 if busted
-		} else {
-			// Calculate new score
-			newScore := calc.Compute(clonedHand).Total
-			totalEV += float64(newScore)
+	addOutcome := func(card domain.Card, count int) {
+		if count == 0 {
+			return
+		}
+		// Score the hand as it would be with this card, without copying it
+		if score, busted := calc.TotalAfter(hand, card); !busted { // Score becomes 0 if busted
+			totalEV += float64(count * score)
 		}
 	}
 
-	if totalCards == 0 {
-		return domain.TurnChoiceStay
+	for value, count := range deck.RemainingNumberCounts() {
+		addOutcome(domain.Card{Type: domain.CardTypeNumber, Value: value}, count)
+	}
+	for _, m := range domain.AllModifierTypes {
+		addOutcome(domain.Card{Type: domain.CardTypeModifier, ModifierType: m}, deck.RemainingModifierCount(m))
+	}
+	for _, a := range domain.AllActionTypes {
+		addOutcome(domain.Card{Type: domain.CardTypeAction, ActionType: a}, deck.RemainingActionCount(a))
 	}
 
 	averageEV := totalEV / float64(totalCards)
@@ -83,3 +83,69 @@ func TestExpectedValueStrategy_Decide(t *testing.T) {
 		})
 	}
 }
+
+// countingView is a DeckView with composition only: there is no card order to peek at.
+type countingView struct {
+	numbers   map[domain.NumberValue]int
+	modifiers map[domain.ModifierType]int
+}
+
+func (v countingView) RemainingNumberCounts() map[domain.NumberValue]int { return v.numbers }
+func (v countingView) RemainingModifierCount(m domain.ModifierType) int  { return v.modifiers[m] }
+func (v countingView) RemainingActionCount(domain.ActionType) int        { return 0 }
+func (v countingView) Remaining() int {
+	total := 0
+	for _, n := range v.numbers {
+		total += n
+	}
+	for _, n := range v.modifiers {
+		total += n
+	}
+	return total
+}
+func (v countingView) EstimateHitRisk(map[domain.NumberValue]struct{}, bool) float64 { return 0 }
+func (v countingView) EstimateFlipThreeRisk(map[domain.NumberValue]struct{}, bool) float64 {
+	return 0
+}
+func (v countingView) EstimateFlipThreeRiskWithTrials(map[domain.NumberValue]struct{}, bool, int) float64 {
+	return 0
+}
+func (v countingView) EstimateFlipThreeEV(map[domain.NumberValue]struct{}, []domain.Card, bool) float64 {
+	return 0
+}
+func (v countingView) EstimateMultiHitRisk(map[domain.NumberValue]struct{}, bool, int) float64 {
+	return 0
+}
+
+func TestExpectedValueStrategy_DecidesFromCountsOnly(t *testing.T) {
+	s := strategy.NewExpectedValueStrategy()
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 10})
+
+	// Hand scores 10. Hitting: 3 of 4 cards bust (10s), one adds +10 -> EV 20/4 = 5 < 10.
+	risky := countingView{numbers: map[domain.NumberValue]int{10: 3}, modifiers: map[domain.ModifierType]int{domain.ModifierPlus10: 1}}
+	if got := s.Decide(risky, hand, 0, nil); got != domain.TurnChoiceStay {
+		t.Errorf("Expected stay, got %s", got)
+	}
+
+	// One 10 among three +10s: EV (0 + 3*20)/4 = 15 > 10.
+	safe := countingView{numbers: map[domain.NumberValue]int{10: 1}, modifiers: map[domain.ModifierType]int{domain.ModifierPlus10: 3}}
+	if got := s.Decide(safe, hand, 0, nil); got != domain.TurnChoiceHit {
+		t.Errorf("Expected hit, got %s", got)
+	}
+}
+
+func BenchmarkExpectedValueStrategy_Decide(b *testing.B) {
+	s := strategy.NewExpectedValueStrategy()
+	deck := domain.NewDeck()
+	hand := domain.NewPlayerHand()
+	for _, v := range []domain.NumberValue{3, 7, 9, 11} {
+		hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: v})
+	}
+	hand.AddCard(domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Decide(deck, hand, 0, nil)
+	}
+}
@@ -0,0 +1,96 @@
+package strategy
+
+import "flip7_strategy/internal/domain"
+
+// Defaults of the HoldingStrategy timing.
+const (
+	DefaultHoldFlipThreeFrom = 4 // Unique numbers an opponent holds before a held Flip Three is played
+	DefaultHoldFreezeUpTo    = 8 // Hand score up to which an opponent is worth a held Freeze
+)
+
+// HoldingStrategy plays Inner under the holdable-actions house rule (domain.Game.HoldableActions):
+// it keeps the Freeze and Flip Three cards it draws and plays them when they hurt most.
+//
+//   - A Flip Three is played once an active opponent holds FlipThreeFrom unique numbers or more,
+//     when three forced flips are most likely to bust them.
+//   - A Freeze is played once an active opponent has flipped a card but scores FreezeUpTo or
+//     less, ending their round before it is worth anything.
+//   - Whatever is still held is played when Inner would stay, since unplayed cards are
+//     discarded at round end.
+//
+// Targets are chosen by Inner, and Inner decides whether to hit or stay as usual.
+type HoldingStrategy struct {
+	Inner         domain.Strategy
+	FlipThreeFrom int
+	FreezeUpTo    int
+}
+
+// NewHoldingStrategy wraps inner with the default timing.
+func NewHoldingStrategy(inner domain.Strategy) *HoldingStrategy {
+	return &HoldingStrategy{Inner: inner, FlipThreeFrom: DefaultHoldFlipThreeFrom, FreezeUpTo: DefaultHoldFreezeUpTo}
+}
+
+func (s *HoldingStrategy) Name() string {
+	return s.Inner.Name() + " (holds actions)"
+}
+
+func (s *HoldingStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+	return s.Inner.Decide(deck, hand, playerScore, otherPlayers)
+}
+
+func (s *HoldingStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	return s.Inner.ChooseTarget(action, candidates, self)
+}
+
+// PlayHeldAction implements domain.ActionHolder.
+func (s *HoldingStrategy) PlayHeldAction(ctx domain.HeldActionContext) *domain.Card {
+	held := func(action domain.ActionType) *domain.Card {
+		for _, c := range ctx.Held {
+			if c.ActionType == action {
+				return &c
+			}
+		}
+		return nil
+	}
+	flipThree, freeze := held(domain.ActionFlipThree), held(domain.ActionFreeze)
+
+	calc := domain.NewScoreCalculator()
+	for _, p := range ctx.OtherPlayers {
+		h := p.CurrentHand
+		if h == nil || h == ctx.Hand || h.Status != domain.HandStatusActive {
+			continue
+		}
+		if flipThree != nil && len(h.NumberCards) >= s.FlipThreeFrom {
+			return flipThree
+		}
+		if freeze != nil && h.HasDrawnThisRound && calc.Total(h) <= s.FreezeUpTo {
+			return freeze
+		}
+	}
+
+	if ctx.Hand.CanStay() && s.Inner.Decide(ctx.Deck, ctx.Hand, ctx.PlayerScore, ctx.OtherPlayers) == domain.TurnChoiceStay {
+		if freeze != nil {
+			return freeze
+		}
+		return flipThree
+	}
+	return nil
+}
+
+func (s *HoldingStrategy) SetDeck(deck domain.DeckView) {
+	if ds, ok := s.Inner.(domain.DeckAware); ok {
+		ds.SetDeck(deck)
+	}
+}
+
+func (s *HoldingStrategy) OnReshuffle(deck domain.DeckView) {
+	if ra, ok := s.Inner.(domain.ReshuffleAware); ok {
+		ra.OnReshuffle(deck)
+	}
+}
+
+func (s *HoldingStrategy) SetWinningScore(score int) {
+	if ws, ok := s.Inner.(domain.WinningScoreAware); ok {
+		ws.SetWinningScore(score)
+	}
+}
@@ -0,0 +1,53 @@
+package strategy_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+)
+
+func TestHoldingStrategy_PlayHeldAction(t *testing.T) {
+	freeze := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}
+	flipThree := domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}
+	tests := []struct {
+		name     string
+		inner    domain.TurnChoice
+		opponent []int // Numbers in the opponent's hand
+		held     []domain.Card
+		want     *domain.Card
+	}{
+		{"flip three on four numbers", domain.TurnChoiceHit, []int{1, 2, 3, 4}, []domain.Card{freeze, flipThree}, &flipThree},
+		{"flip three waits for four numbers", domain.TurnChoiceHit, []int{9, 10, 11}, []domain.Card{flipThree}, nil},
+		{"freeze on a small hand", domain.TurnChoiceHit, []int{3}, []domain.Card{freeze}, &freeze},
+		{"freeze waits for a flip", domain.TurnChoiceHit, nil, []domain.Card{freeze}, nil},
+		{"freeze not on a big hand", domain.TurnChoiceHit, []int{12}, []domain.Card{freeze}, nil},
+		{"play before staying", domain.TurnChoiceStay, []int{12}, []domain.Card{flipThree}, &flipThree},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := strategy.NewHoldingStrategy(&fixedStrategy{name: "Fixed", choice: tt.inner})
+			me := domain.NewPlayer("Me", s)
+			me.StartNewRound()
+			me.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 5})
+			opponent := domain.NewPlayer("Opponent", nil)
+			opponent.StartNewRound()
+			for _, v := range tt.opponent {
+				opponent.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)})
+			}
+
+			got := s.PlayHeldAction(domain.HeldActionContext{
+				Deck:         domain.NewDeck(),
+				Hand:         me.CurrentHand,
+				OtherPlayers: []*domain.Player{me, opponent},
+				Held:         tt.held,
+			})
+			switch {
+			case tt.want == nil && got != nil:
+				t.Errorf("Expected to keep holding, got %v", *got)
+			case tt.want != nil && (got == nil || *got != *tt.want):
+				t.Errorf("Expected to play %v, got %v", *tt.want, got)
+			}
+		})
+	}
+}
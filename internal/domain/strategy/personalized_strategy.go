@@ -0,0 +1,166 @@
+package strategy
+
+import (
+	"errors"
+	"fmt"
+
+	"flip7_strategy/internal/domain"
+)
+
+// PersonalBustDeciles is the number of bust-rate bands a PersonalizedStrategy has a cut point
+// for: 0-10%, 10-20%, ..., 90-100%.
+const PersonalBustDeciles = 10
+
+// DefaultPersonalBinWidth is the width, in hand score points, of the bins FitPersonalizedStrategy
+// groups decisions into.
+const DefaultPersonalBinWidth = 5
+
+// PersonalizedStrategy plays like a player whose hit/stay choices were fitted from their logged
+// games: like HeuristicStrategy it stays once the hand score reaches a threshold, but the
+// threshold depends on the bust rate of the next card. A Second Chance counts only through the
+// bust rate, as in the logged advice the fit reads.
+type PersonalizedStrategy struct {
+	TargetSelector
+	Label string // Name, e.g. "personal:game_logs.csv"
+	// StayFrom holds, for each bust-rate decile, the lowest hand score the player stays on.
+	// It never increases with the bust rate.
+	StayFrom [PersonalBustDeciles]int
+}
+
+// NewPersonalizedStrategy returns a PersonalizedStrategy with the default target selector.
+func NewPersonalizedStrategy(label string, stayFrom [PersonalBustDeciles]int) *PersonalizedStrategy {
+	return &PersonalizedStrategy{
+		TargetSelector: NewDefaultTargetSelector(),
+		Label:          label,
+		StayFrom:       stayFrom,
+	}
+}
+
+func (s *PersonalizedStrategy) SetWinningScore(score int) {
+	setSelectorWinningScore(s.TargetSelector, score)
+}
+
+func (s *PersonalizedStrategy) Name() string {
+	return s.Label
+}
+
+func (s *PersonalizedStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+	risk := deck.EstimateHitRisk(hand.NumberCards, hand.HasSecondChance())
+	if domain.NewScoreCalculator().Total(hand) >= s.StayFrom[bustDecile(risk)] {
+		return domain.TurnChoiceStay
+	}
+	return domain.TurnChoiceHit
+}
+
+// bustDecile returns the PersonalBustDeciles band of a bust rate between 0 and 1.
+func bustDecile(rate float64) int {
+	return min(max(int(rate*PersonalBustDeciles), 0), PersonalBustDeciles-1)
+}
+
+// ObservedDecision is one hit/stay choice of a player, such as a DecisionAnalysis event of the
+// Manual Mode log: the hand score they would have banked, the bust rate of the next card (0 to 1)
+// and whether they stayed.
+type ObservedDecision struct {
+	HandScore int
+	BustRate  float64
+	Stayed    bool
+}
+
+// PersonalFit is the result of FitPersonalizedStrategy: the fitted cut points and the
+// decisions they were fitted on.
+type PersonalFit struct {
+	BinWidth int
+	StayFrom [PersonalBustDeciles]int
+	// Decisions and Stays count the observed decisions per decile.
+	Decisions, Stays [PersonalBustDeciles]int
+	// Disagreements counts the observed decisions the fitted cut points would have made differently.
+	Disagreements int
+}
+
+// Strategy returns a PersonalizedStrategy playing the fitted cut points under the given name.
+func (f PersonalFit) Strategy(label string) *PersonalizedStrategy {
+	return NewPersonalizedStrategy(label, f.StayFrom)
+}
+
+// FitPersonalizedStrategy fits the stay threshold of a player to their decisions. Decisions are
+// binned by bust-rate decile and by hand score (binWidth points per bin). Within each decile the
+// bins' majority actions are smoothed into a single cut, hit below and stay from there, placed where
+// it disagrees with the fewest decisions (the lowest such cut on a tie). Deciles without decisions
+// take the cut of the nearest decile with some (the lower one on a tie), and a final pass keeps
+// the cuts from rising with the bust rate.
+func FitPersonalizedStrategy(decisions []ObservedDecision, binWidth int) (PersonalFit, error) {
+	if binWidth <= 0 {
+		return PersonalFit{}, fmt.Errorf("bin width must be positive, got %d", binWidth)
+	}
+	if len(decisions) == 0 {
+		return PersonalFit{}, errors.New("no decisions to fit")
+	}
+
+	bins := 0
+	for _, d := range decisions {
+		bins = max(bins, max(d.HandScore, 0)/binWidth+1)
+	}
+	var hits, stays [PersonalBustDeciles][]int
+	fit := PersonalFit{BinWidth: binWidth}
+	for decile := range hits {
+		hits[decile] = make([]int, bins)
+		stays[decile] = make([]int, bins)
+	}
+	for _, d := range decisions {
+		decile, bin := bustDecile(d.BustRate), max(d.HandScore, 0)/binWidth
+		fit.Decisions[decile]++
+		if d.Stayed {
+			stays[decile][bin]++
+			fit.Stays[decile]++
+		} else {
+			hits[decile][bin]++
+		}
+	}
+
+	var cuts [PersonalBustDeciles]int
+	var fitted [PersonalBustDeciles]bool
+	for decile := range cuts {
+		if fit.Decisions[decile] == 0 {
+			continue
+		}
+		// Cut c stays from bin c on; c == bins never stays.
+		errs := fit.Stays[decile] // Every stay disagrees with a cut above all bins
+		best, bestErrs := bins, errs
+		for c := bins - 1; c >= 0; c-- {
+			errs += hits[decile][c] - stays[decile][c]
+			if errs <= bestErrs {
+				best, bestErrs = c, errs
+			}
+		}
+		cuts[decile], fitted[decile] = best, true
+	}
+
+	for decile := range cuts {
+		if fitted[decile] {
+			continue
+		}
+		for offset := 1; offset < PersonalBustDeciles; offset++ {
+			if lower := decile - offset; lower >= 0 && fitted[lower] {
+				cuts[decile] = cuts[lower]
+				break
+			}
+			if higher := decile + offset; higher < PersonalBustDeciles && fitted[higher] {
+				cuts[decile] = cuts[higher]
+				break
+			}
+		}
+	}
+	for decile := 1; decile < PersonalBustDeciles; decile++ {
+		cuts[decile] = min(cuts[decile], cuts[decile-1])
+	}
+
+	for decile, cut := range cuts {
+		fit.StayFrom[decile] = cut * binWidth
+	}
+	for _, d := range decisions {
+		if (d.HandScore >= fit.StayFrom[bustDecile(d.BustRate)]) != d.Stayed {
+			fit.Disagreements++
+		}
+	}
+	return fit, nil
+}
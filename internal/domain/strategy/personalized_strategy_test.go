@@ -0,0 +1,122 @@
+package strategy_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+)
+
+func TestFitPersonalizedStrategy_RecoversThreshold(t *testing.T) {
+	// A player who stays from 23 points while the bust rate is below 30%, and from 14 above it.
+	// A few choices (5%) go the other way, as real ones do.
+	stayFrom := func(bustRate float64) int {
+		if bustRate < 0.3 {
+			return 23
+		}
+		return 14
+	}
+	rng := rand.New(rand.NewSource(1))
+	var decisions []strategy.ObservedDecision
+	for i := 0; i < 2000; i++ {
+		d := strategy.ObservedDecision{HandScore: rng.Intn(50), BustRate: rng.Float64() * 0.6}
+		d.Stayed = d.HandScore >= stayFrom(d.BustRate)
+		if rng.Float64() < 0.05 {
+			d.Stayed = !d.Stayed
+		}
+		decisions = append(decisions, d)
+	}
+
+	fit, err := strategy.FitPersonalizedStrategy(decisions, strategy.DefaultPersonalBinWidth)
+	if err != nil {
+		t.Fatalf("FitPersonalizedStrategy failed: %v", err)
+	}
+	for decile := 0; decile < 6; decile++ {
+		want := stayFrom(float64(decile) / 10)
+		if got := fit.StayFrom[decile]; got < want-fit.BinWidth || got > want+fit.BinWidth {
+			t.Errorf("Decile %d: expected a cut within one bin of %d, got %d", decile, want, got)
+		}
+	}
+	// Deciles never observed take the cut of the highest observed one.
+	for decile := 6; decile < strategy.PersonalBustDeciles; decile++ {
+		if fit.StayFrom[decile] != fit.StayFrom[5] {
+			t.Errorf("Decile %d: expected the cut of decile 5 (%d), got %d", decile, fit.StayFrom[5], fit.StayFrom[decile])
+		}
+	}
+	if fit.Disagreements > len(decisions)/10 {
+		t.Errorf("Expected the fit to disagree with about 5%% of the decisions, got %d of %d", fit.Disagreements, len(decisions))
+	}
+}
+
+func TestFitPersonalizedStrategy_CutsNeverRiseWithBustRate(t *testing.T) {
+	// Stays from 10 at a low bust rate but only from 30 at a high one: the riskier decile is
+	// smoothed down to the safer one's cut.
+	decisions := []strategy.ObservedDecision{
+		{HandScore: 5, BustRate: 0.05}, {HandScore: 12, BustRate: 0.05, Stayed: true},
+		{HandScore: 20, BustRate: 0.45}, {HandScore: 32, BustRate: 0.45, Stayed: true},
+	}
+
+	fit, err := strategy.FitPersonalizedStrategy(decisions, 5)
+	if err != nil {
+		t.Fatalf("FitPersonalizedStrategy failed: %v", err)
+	}
+	if fit.StayFrom[0] != 10 || fit.StayFrom[4] != 10 || fit.StayFrom[9] != 10 {
+		t.Errorf("Expected every cut at 10, got %v", fit.StayFrom)
+	}
+	if fit.Decisions[0] != 2 || fit.Stays[4] != 1 {
+		t.Errorf("Expected 2 decisions in decile 0 and 1 stay in decile 4, got %v and %v", fit.Decisions, fit.Stays)
+	}
+
+	if _, err := strategy.FitPersonalizedStrategy(nil, 5); err == nil {
+		t.Error("Expected an error without decisions")
+	}
+}
+
+func TestPersonalizedStrategy_Decide(t *testing.T) {
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 12})
+	deck := domain.NewDeck() // 11 of the 12s are left: a bust rate in the 10-20% decile
+
+	var stayFrom [strategy.PersonalBustDeciles]int
+	for decile := range stayFrom {
+		stayFrom[decile] = 100
+	}
+	stayFrom[1] = 12
+	if got := strategy.NewPersonalizedStrategy("personal:test", stayFrom).Decide(deck, hand, 0, nil); got != domain.TurnChoiceStay {
+		t.Errorf("Expected to stay on 12 with a cut of 12, got %s", got)
+	}
+	stayFrom[1] = 13
+	if got := strategy.NewPersonalizedStrategy("personal:test", stayFrom).Decide(deck, hand, 0, nil); got != domain.TurnChoiceHit {
+		t.Errorf("Expected to hit on 12 with a cut of 13, got %s", got)
+	}
+}
+
+func TestRegister(t *testing.T) {
+	var stayFrom [strategy.PersonalBustDeciles]int
+	build := func() domain.Strategy { return strategy.NewPersonalizedStrategy("personal:register_test", stayFrom) }
+	if err := strategy.Register("personal:register_test", build); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+	t.Cleanup(func() { strategy.Unregister("personal:register_test") })
+
+	if s, err := strategy.New("personal:register_test"); err != nil || s.Name() != "personal:register_test" {
+		t.Errorf("Expected New to build the registered strategy, got %v (%v)", s, err)
+	}
+	found := false
+	for _, name := range strategy.Names() {
+		found = found || name == "personal:register_test"
+	}
+	if !found {
+		t.Errorf("Expected Names to list the registered strategy, got %v", strategy.Names())
+	}
+	if err := strategy.Register("personal:register_test", build); err == nil {
+		t.Error("Expected an error when registering a name twice")
+	}
+	if err := strategy.Register("Cautious", build); err == nil {
+		t.Error("Expected an error when registering a built-in name")
+	}
+	if strategy.Unregister("Cautious") {
+		t.Error("Expected a built-in strategy to stay registered")
+	}
+}
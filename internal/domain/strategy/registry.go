@@ -0,0 +1,99 @@
+package strategy
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"flip7_strategy/internal/domain"
+)
+
+// registryEntry is a registered strategy name and its constructor.
+type registryEntry struct {
+	name string
+	new  func() domain.Strategy
+}
+
+// registryMu guards registry, so strategies can be registered and removed while other
+// goroutines build them.
+var registryMu sync.RWMutex
+
+// registry maps the name of every registered strategy to its constructor, in menu order: the
+// built-in strategies first, then the ones added by Register.
+var registry = []registryEntry{
+	{"Cautious", func() domain.Strategy { return NewCautiousStrategy() }},
+	{"Aggressive", func() domain.Strategy { return NewAggressiveStrategy() }},
+	{"Probabilistic", func() domain.Strategy { return NewProbabilisticStrategy() }},
+	{fmt.Sprintf("Heuristic-%d", DefaultHeuristicThreshold), func() domain.Strategy { return NewHeuristicStrategy(DefaultHeuristicThreshold) }},
+	{"ExpectedValue", func() domain.Strategy { return NewExpectedValueStrategy() }},
+	{"Adaptive", func() domain.Strategy { return NewAdaptiveStrategy() }},
+}
+
+// builtinStrategies is the number of built-in strategies at the start of registry.
+var builtinStrategies = len(registry)
+
+// Register adds a strategy under name, so New and Names (and every evaluation iterating them)
+// include it, e.g. a PersonalizedStrategy fitted at startup. build must return a fresh strategy
+// whose Name is name.
+func Register(name string, build func() domain.Strategy) error {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, err := builder(name); err == nil {
+		return fmt.Errorf("strategy %q is already registered", name)
+	}
+	registry = append(registry, registryEntry{name, build})
+	return nil
+}
+
+// Unregister removes a strategy added by Register. It reports whether name was registered;
+// built-in strategies cannot be removed.
+func Unregister(name string) bool {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for i := builtinStrategies; i < len(registry); i++ {
+		if registry[i].name == name {
+			registry = append(registry[:i:i], registry[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Names returns the names of the registered strategies, in menu order.
+func Names() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	names := make([]string, len(registry))
+	for i, r := range registry {
+		names[i] = r.name
+	}
+	return names
+}
+
+// New builds a fresh strategy from the name its Name method reports.
+// Besides the registered names it accepts "Heuristic-<threshold>" for any positive threshold.
+func New(name string) (domain.Strategy, error) {
+	registryMu.RLock()
+	build, err := builder(name)
+	registryMu.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+	return build(), nil
+}
+
+// builder returns the constructor New uses for name; the caller holds registryMu.
+func builder(name string) (func() domain.Strategy, error) {
+	for _, r := range registry {
+		if r.name == name {
+			return r.new, nil
+		}
+	}
+	if rest, ok := strings.CutPrefix(name, "Heuristic-"); ok {
+		if threshold, err := strconv.Atoi(rest); err == nil && threshold > 0 {
+			return func() domain.Strategy { return NewHeuristicStrategy(threshold) }, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown strategy %q", name)
+}
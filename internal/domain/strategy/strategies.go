@@ -3,32 +3,58 @@ package strategy
 import (
 	"flip7_strategy/internal/domain"
 	"fmt"
-	"math/rand"
 )
 
 // CautiousStrategy stays if the risk is even slightly elevated.
+// A zero-value CautiousStrategy targets like a DefaultTargetSelector that knows neither the
+// deck nor the winning score; NewCautiousStrategy gives it one that keeps both.
 type CautiousStrategy struct {
-	deck *domain.Deck
+	TargetSelector
+}
+
+// NewCautiousStrategy returns a new CautiousStrategy instance with default target selector.
+func NewCautiousStrategy() *CautiousStrategy {
+	return &CautiousStrategy{
+		TargetSelector: NewDefaultTargetSelector(),
+	}
 }
 
-func (s *CautiousStrategy) SetDeck(d *domain.Deck) {
-	s.deck = d
+// NewCautiousStrategyWithSelector returns a new CautiousStrategy instance with a custom target selector.
+func NewCautiousStrategyWithSelector(selector TargetSelector) *CautiousStrategy {
+	return &CautiousStrategy{
+		TargetSelector: selector,
+	}
+}
+
+// selector never assigns TargetSelector, so a zero value used from several goroutines does
+// not race: it gets a fresh default selector on every call instead.
+func (s *CautiousStrategy) selector() TargetSelector {
+	if s.TargetSelector == nil {
+		return NewDefaultTargetSelector()
+	}
+	return s.TargetSelector
+}
+
+func (s *CautiousStrategy) SetDeck(d domain.DeckView) {
+	s.selector().SetDeck(d)
+}
+
+func (s *CautiousStrategy) SetWinningScore(score int) {
+	setSelectorWinningScore(s.selector(), score)
 }
 
 func (s *CautiousStrategy) Name() string {
 	return "Cautious"
 }
 
-func (s *CautiousStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+func (s *CautiousStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
 	if hand.HasSecondChance() {
 		return domain.TurnChoiceHit
 	}
 	if len(hand.NumberCards) == 0 {
 		return domain.TurnChoiceHit
 	}
-	calc := domain.NewScoreCalculator()
-	score := calc.Compute(hand)
-	if score.Total > 30 {
+	if domain.NewScoreCalculator().Total(hand) > 30 {
 		return domain.TurnChoiceStay
 	}
 	risk := deck.EstimateHitRisk(hand.NumberCards, hand.HasSecondChance())
@@ -39,64 +65,28 @@ func (s *CautiousStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, pl
 }
 
 func (s *CautiousStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
-	// Cautious:
-	// Freeze -> Self (secure points)
-	// FlipThree -> Opponent (avoid risk)
-	// GiveSecondChance -> Player with lowest score (keep game balanced)
-
-	if action == domain.ActionFreeze {
-		return chooseFreezeTarget(candidates, self, s.deck)
-	}
-
-	if action == domain.ActionGiveSecondChance {
-		// GiveSecondChance: Give to player with lowest score to prolong game
-		var bestTarget *domain.Player
-		minScore := -1 // Initialize with -1 to indicate no target selected yet
-
-		for _, p := range candidates {
-			if p.ID != self.ID {
-				// Skip players who already have a Second Chance card
-				if p.CurrentHand.HasSecondChance() {
-					continue
-				}
-				if minScore == -1 || p.TotalScore < minScore {
-					minScore = p.TotalScore
-					bestTarget = p
-				}
-			}
-		}
-
-		if bestTarget != nil {
-			return bestTarget
-		}
-		// Fallback: if everyone has one or no opponents, give to first candidate (even if they have one, game service handles discard)
-		return candidates[0]
-	}
-
-	// For FlipThree (or if self not available for Freeze), target random opponent
-	var opponents []*domain.Player
-	for _, p := range candidates {
-		if p.ID != self.ID {
-			opponents = append(opponents, p)
-		}
-	}
-	if len(opponents) > 0 {
-		return opponents[rand.Intn(len(opponents))]
-	}
-	return self
+	return s.selector().ChooseTarget(action, candidates, self)
 }
 
+// DefaultAggressiveRiskCap is the hit risk above which AggressiveStrategy stays.
+const DefaultAggressiveRiskCap = 0.30
+
 // AggressiveStrategy pushes luck until high risk.
 type AggressiveStrategy struct {
 	TargetSelector
+	RiskCap float64 // Hit risk above which it stays; 0 means DefaultAggressiveRiskCap
 }
 
-func (s *AggressiveStrategy) SetDeck(d *domain.Deck) {
+func (s *AggressiveStrategy) SetDeck(d domain.DeckView) {
 	if s.TargetSelector != nil {
 		s.TargetSelector.SetDeck(d)
 	}
 }
 
+func (s *AggressiveStrategy) SetWinningScore(score int) {
+	setSelectorWinningScore(s.TargetSelector, score)
+}
+
 func (s *AggressiveStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
 	return s.TargetSelector.ChooseTarget(action, candidates, self)
 }
@@ -119,7 +109,7 @@ func (s *AggressiveStrategy) Name() string {
 	return "Aggressive"
 }
 
-func (s *AggressiveStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+func (s *AggressiveStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
 	if hand.HasSecondChance() {
 		return domain.TurnChoiceHit
 	}
@@ -128,15 +118,92 @@ func (s *AggressiveStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand,
 	if totalCards == 6 && risk < 0.5 {
 		return domain.TurnChoiceHit
 	}
-	if risk > 0.30 {
+	if risk > s.riskCap() {
 		return domain.TurnChoiceStay
 	}
 	return domain.TurnChoiceHit
 }
 
+func (s *AggressiveStrategy) riskCap() float64 {
+	if s.RiskCap <= 0 {
+		return DefaultAggressiveRiskCap
+	}
+	return s.RiskCap
+}
+
+// fullDeck is a fresh standard deck, the uncounted baseline for risk estimates. It is only read.
+var fullDeck = domain.NewDeckInOrder(domain.StandardDeckCards())
+
+// DefaultProbabilisticRiskThreshold is the hit risk above which ProbabilisticStrategy stays
+// while the scores are close.
+const DefaultProbabilisticRiskThreshold = 0.20
+
+// TableScaling adjusts a risk threshold to the size of the table. With more opponents a player
+// gets fewer turns per round and the leader pulls away faster, so more risk pays; with fewer,
+// less. The zero value keeps the threshold fixed.
+type TableScaling struct {
+	// PerOpponent is added to the threshold for every opponent beyond ReferenceOpponents, and
+	// taken off for every one fewer.
+	PerOpponent float64
+	// ReferenceOpponents is the number of opponents the unscaled threshold is tuned for.
+	ReferenceOpponents int
+	// MinThreshold and MaxThreshold clamp the scaled threshold; 0 leaves that side open.
+	MinThreshold float64
+	MaxThreshold float64
+}
+
+// DefaultTableScaling returns the curve of NewScaledProbabilisticStrategy: 0.03 per opponent,
+// clamped to [0.10, 0.35], around the three opponents the fixed thresholds seem tuned for.
+func DefaultTableScaling() TableScaling {
+	return TableScaling{PerOpponent: 0.03, ReferenceOpponents: 3, MinThreshold: 0.10, MaxThreshold: 0.35}
+}
+
+// Scale returns threshold adjusted to a table of opponents other players.
+func (t TableScaling) Scale(threshold float64, opponents int) float64 {
+	if t.PerOpponent == 0 {
+		return threshold
+	}
+	threshold += t.PerOpponent * float64(opponents-t.ReferenceOpponents)
+	if t.MinThreshold > 0 && threshold < t.MinThreshold {
+		threshold = t.MinThreshold
+	}
+	if t.MaxThreshold > 0 && threshold > t.MaxThreshold {
+		threshold = t.MaxThreshold
+	}
+	return threshold
+}
+
+// ProbabilisticConfig holds the tunable parameters of ProbabilisticStrategy.
+type ProbabilisticConfig struct {
+	RiskThreshold float64      // See ProbabilisticStrategy.RiskThreshold
+	TableScaling  TableScaling // See ProbabilisticStrategy.TableScaling
+	PlanAhead     bool         // See ProbabilisticStrategy.PlanAhead
+}
+
+// DefaultProbabilisticConfig returns the configuration used by NewProbabilisticStrategy: the
+// default threshold, the same at every table size.
+func DefaultProbabilisticConfig() ProbabilisticConfig {
+	return ProbabilisticConfig{RiskThreshold: DefaultProbabilisticRiskThreshold}
+}
+
 // ProbabilisticStrategy uses expected value (simplified).
+// Build it with one of its constructors: the zero value has no TargetSelector, and choosing a
+// target panics.
 type ProbabilisticStrategy struct {
 	TargetSelector
+	WinningScore int // Score needed to win; 0 means domain.WinningThreshold
+	// RiskThreshold is the hit risk above which it stays while the scores are close; 0 means
+	// DefaultProbabilisticRiskThreshold. Far behind it still risks up to 0.40, and close to
+	// winning only 0.05.
+	RiskThreshold float64
+	// TableScaling adjusts RiskThreshold to the number of opponents; the zero value keeps it
+	// fixed. The thresholds far behind and close to winning do not scale.
+	TableScaling TableScaling
+	// PlanAhead makes it plan two hits ahead: it also stays when the risk of busting within
+	// the next two hits (see domain.DeckView.EstimateMultiHitRisk) is above what two
+	// independent hits at the threshold would risk, since every safe card it draws makes the
+	// next one riskier.
+	PlanAhead bool
 }
 
 // NewProbabilisticStrategy returns a new ProbabilisticStrategy instance with default target selector.
@@ -153,45 +220,122 @@ func NewProbabilisticStrategyWithSelector(selector TargetSelector) *Probabilisti
 	}
 }
 
+// NewProbabilisticStrategyWithConfig creates a ProbabilisticStrategy with custom parameters
+// and the default target selector.
+func NewProbabilisticStrategyWithConfig(config ProbabilisticConfig) *ProbabilisticStrategy {
+	s := NewProbabilisticStrategy()
+	s.RiskThreshold = config.RiskThreshold
+	s.TableScaling = config.TableScaling
+	s.PlanAhead = config.PlanAhead
+	return s
+}
+
+// NewScaledProbabilisticStrategy returns a ProbabilisticStrategy whose threshold follows
+// DefaultTableScaling: the default one against three opponents, 0.14 against one, 0.26
+// against five.
+func NewScaledProbabilisticStrategy() *ProbabilisticStrategy {
+	config := DefaultProbabilisticConfig()
+	config.TableScaling = DefaultTableScaling()
+	return NewProbabilisticStrategyWithConfig(config)
+}
+
+// NewPlanningProbabilisticStrategy returns a ProbabilisticStrategy that plans two hits ahead
+// (see PlanAhead), with the default target selector.
+func NewPlanningProbabilisticStrategy() *ProbabilisticStrategy {
+	s := NewProbabilisticStrategy()
+	s.PlanAhead = true
+	return s
+}
+
+// Name returns "Probabilistic", with "-Plan2" when it plans ahead and "-Scaled" when its
+// threshold scales with the table.
 func (s *ProbabilisticStrategy) Name() string {
-	return "Probabilistic"
+	name := "Probabilistic"
+	if s.PlanAhead {
+		name += "-Plan2"
+	}
+	if s.TableScaling.PerOpponent != 0 {
+		name += "-Scaled"
+	}
+	return name
 }
 
-func (s *ProbabilisticStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+func (s *ProbabilisticStrategy) SetWinningScore(score int) {
+	s.WinningScore = score
+	setSelectorWinningScore(s.TargetSelector, score)
+}
+
+func (s *ProbabilisticStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
 	if hand.HasSecondChance() {
 		return domain.TurnChoiceHit
 	}
-	risk := deck.EstimateHitRisk(hand.NumberCards, hand.HasSecondChance())
+	counted := deck
+	if deck.Remaining() == 0 {
+		// The next card comes from the reshuffled discard pile, which counting knows nothing
+		// about; assume it looks like a full deck.
+		counted = fullDeck
+	}
+	risk := counted.EstimateHitRisk(hand.NumberCards, hand.HasSecondChance())
 	maxOpponentScore := 0
 	for _, p := range otherPlayers {
 		if p.TotalScore > maxOpponentScore {
 			maxOpponentScore = p.TotalScore
 		}
 	}
-	threshold := 0.20
+	threshold := s.TableScaling.Scale(s.riskThreshold(), len(otherPlayers))
 	if playerScore < maxOpponentScore-50 {
 		threshold = 0.40
-	} else if playerScore > 180 {
+	} else if playerScore > closeToWinning(s.WinningScore) {
 		threshold = 0.05
 	}
 	if risk > threshold {
 		return domain.TurnChoiceStay
 	}
+	if s.PlanAhead && counted.EstimateMultiHitRisk(hand.NumberCards, hand.HasSecondChance(), 2) > 1-(1-threshold)*(1-threshold) {
+		return domain.TurnChoiceStay
+	}
 	return domain.TurnChoiceHit
 }
 
+func (s *ProbabilisticStrategy) riskThreshold() float64 {
+	if s.RiskThreshold <= 0 {
+		return DefaultProbabilisticRiskThreshold
+	}
+	return s.RiskThreshold
+}
+
+// closeToWinning returns the score above which a player is one good round from winning
+// (180 when playing to 200). winningScore 0 means domain.WinningThreshold.
+func closeToWinning(winningScore int) int {
+	if winningScore <= 0 {
+		winningScore = domain.WinningThreshold
+	}
+	return winningScore * 9 / 10
+}
+
 // chooseFreezeTarget encapsulates the logic for selecting a target for ActionFreeze.
-func chooseFreezeTarget(candidates []*domain.Player, self *domain.Player, deck *domain.Deck) *domain.Player {
-	// Freeze -> Opponent with highest score
+func chooseFreezeTarget(candidates []*domain.Player, self *domain.Player, deck domain.DeckView, winningScore int) *domain.Player {
+	// Freeze -> Opponent with highest score. Freezing banks the target's hand, so an opponent
+	// who reaches winningScore by staying now is only frozen if every opponent does
+	// (winningScore 0 skips this).
+	opponents := filterOpponents(candidates, self)
+	spare := make(map[*domain.Player]bool)
+	if winningScore > 0 {
+		if winners := domain.StayingWinners(opponents, winningScore); len(winners) < len(opponents) {
+			for _, w := range winners {
+				spare[w.Player] = true
+			}
+		}
+	}
+
 	var bestTarget *domain.Player
-	maxScore := -1
+	maxScore, bestScore := -1, -1
 
-	for _, p := range candidates {
-		if p.ID != self.ID {
-			if p.TotalScore > maxScore {
-				maxScore = p.TotalScore
-				bestTarget = p
-			}
+	for _, p := range opponents {
+		maxScore = max(maxScore, p.TotalScore)
+		if !spare[p] && p.TotalScore > bestScore {
+			bestScore = p.TotalScore
+			bestTarget = p
 		}
 	}
 
@@ -218,10 +362,21 @@ func chooseFreezeTarget(candidates []*domain.Player, self *domain.Player, deck *
 
 const DefaultHeuristicThreshold = 27
 
-// HeuristicStrategy stops when sum of number cards >= Threshold.
+// HeuristicSumMode selects what HeuristicStrategy compares against its threshold.
+type HeuristicSumMode int
+
+const (
+	// HeuristicFullScore compares the score the hand would bank, modifiers included (the default).
+	HeuristicFullScore HeuristicSumMode = iota
+	// HeuristicRawNumbers compares the sum of the number cards alone, ignoring modifiers.
+	HeuristicRawNumbers
+)
+
+// HeuristicStrategy stops when the hand's score reaches Threshold.
 type HeuristicStrategy struct {
 	TargetSelector
 	Threshold int
+	SumMode   HeuristicSumMode
 }
 
 func NewHeuristicStrategy(threshold int) *HeuristicStrategy {
@@ -239,17 +394,24 @@ func NewHeuristicStrategyWithSelector(threshold int, selector TargetSelector) *H
 	}
 }
 
+func (s *HeuristicStrategy) SetWinningScore(score int) {
+	setSelectorWinningScore(s.TargetSelector, score)
+}
+
 func (s *HeuristicStrategy) Name() string {
 	return fmt.Sprintf("Heuristic-%d", s.Threshold)
 }
 
-func (s *HeuristicStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+func (s *HeuristicStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
 	if hand.HasSecondChance() {
 		return domain.TurnChoiceHit
 	}
-	sum := 0
-	for val := range hand.NumberCards {
-		sum += int(val)
+	sum := domain.NewScoreCalculator().Total(hand)
+	if s.SumMode == HeuristicRawNumbers {
+		sum = 0
+		for val := range hand.NumberCards {
+			sum += int(val)
+		}
 	}
 
 	if sum >= s.Threshold {
@@ -1,6 +1,8 @@
 package strategy_test
 
 import (
+	"math"
+	"sync"
 	"testing"
 
 	"flip7_strategy/internal/domain"
@@ -8,12 +10,15 @@ import (
 )
 
 func TestHeuristicStrategy_Decide(t *testing.T) {
-	s := strategy.NewHeuristicStrategy(22)
 	deck := &domain.Deck{} // Deck state doesn't matter for HeuristicStrategy
+	plus10 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus10}
+	x2 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}
 
 	tests := []struct {
 		name           string
+		mode           strategy.HeuristicSumMode
 		handNumbers    []int
+		modifiers      []domain.Card
 		expectedChoice domain.TurnChoice
 	}{
 		{
@@ -36,15 +41,43 @@ func TestHeuristicStrategy_Decide(t *testing.T) {
 			handNumbers:    []int{11, 12},
 			expectedChoice: domain.TurnChoiceStay,
 		},
+		{
+			name:           "Modifiers reach the threshold (19 x2 +10 = 48)",
+			handNumbers:    []int{10, 9},
+			modifiers:      []domain.Card{plus10, x2},
+			expectedChoice: domain.TurnChoiceStay,
+		},
+		{
+			name:           "Additive modifier reaches the threshold (12 +10 = 22)",
+			handNumbers:    []int{5, 7},
+			modifiers:      []domain.Card{plus10},
+			expectedChoice: domain.TurnChoiceStay,
+		},
+		{
+			name:           "Raw numbers ignore modifiers (19)",
+			mode:           strategy.HeuristicRawNumbers,
+			handNumbers:    []int{10, 9},
+			modifiers:      []domain.Card{plus10, x2},
+			expectedChoice: domain.TurnChoiceHit,
+		},
+		{
+			name:           "Raw numbers = 22",
+			mode:           strategy.HeuristicRawNumbers,
+			handNumbers:    []int{10, 12},
+			expectedChoice: domain.TurnChoiceStay,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			hand := &domain.PlayerHand{
-				NumberCards: make(map[domain.NumberValue]struct{}),
-			}
+			s := strategy.NewHeuristicStrategy(22)
+			s.SumMode = tt.mode
+			hand := domain.NewPlayerHand()
 			for _, n := range tt.handNumbers {
-				hand.NumberCards[domain.NumberValue(n)] = struct{}{}
+				hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(n)})
+			}
+			for _, m := range tt.modifiers {
+				hand.AddCard(m)
 			}
 
 			choice := s.Decide(deck, hand, 0, nil)
@@ -367,3 +400,244 @@ func TestChooseTarget_FlipThree_HighRisk(t *testing.T) {
 		t.Errorf("Expected target to be Op2 (High Risk), got %s (Score: %d)", target.Name, target.TotalScore)
 	}
 }
+
+func TestCautiousStrategy_ChooseTarget_HonorsInjectedSelector(t *testing.T) {
+	self := domain.NewPlayer("Self", nil)
+	self.CurrentHand = domain.NewPlayerHand()
+
+	// Leader: high score, empty hand (low risk)
+	leader := domain.NewPlayer("Leader", nil)
+	leader.TotalScore = 150
+	leader.CurrentHand = domain.NewPlayerHand()
+
+	// Risky: low score, holds 0, 1, 2 against a deck of 0, 1, 2 (guaranteed bust)
+	risky := domain.NewPlayer("Risky", nil)
+	risky.TotalScore = 50
+	risky.CurrentHand = domain.NewPlayerHand()
+	for v := 0; v <= 2; v++ {
+		risky.CurrentHand.NumberCards[domain.NumberValue(v)] = struct{}{}
+	}
+
+	candidates := []*domain.Player{self, leader, risky}
+	deck := domain.NewDeckFromCards([]domain.Card{
+		{Type: domain.CardTypeNumber, Value: 0},
+		{Type: domain.CardTypeNumber, Value: 1},
+		{Type: domain.CardTypeNumber, Value: 2},
+	})
+
+	tests := []struct {
+		name      string
+		threshold float64
+		expected  *domain.Player
+	}{
+		{"Risk above threshold targets risky opponent", 0.8, risky},
+		{"Threshold unreachable falls back to leader", 1.1, leader},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := strategy.NewCautiousStrategyWithSelector(strategy.NewPureRiskTargetSelector(tt.threshold))
+			s.SetDeck(deck)
+
+			target := s.ChooseTarget(domain.ActionFlipThree, candidates, self)
+			if target != tt.expected {
+				t.Errorf("Expected %s, got %s", tt.expected.Name, target.Name)
+			}
+		})
+	}
+}
+
+func TestCautiousStrategy_ZeroValueUsesDefaultSelector(t *testing.T) {
+	self := domain.NewPlayer("Self", nil)
+	self.CurrentHand = domain.NewPlayerHand()
+	leader := domain.NewPlayer("Leader", nil)
+	leader.TotalScore = 150
+	leader.CurrentHand = domain.NewPlayerHand()
+	trailer := domain.NewPlayer("Trailer", nil)
+	trailer.TotalScore = 20
+	trailer.CurrentHand = domain.NewPlayerHand()
+	candidates := []*domain.Player{self, leader, trailer}
+
+	s := &strategy.CautiousStrategy{}
+	s.SetDeck(domain.NewDeck())
+
+	// FlipThree is no longer random: without a high-risk opponent it targets the leader
+	for i := 0; i < 10; i++ {
+		if target := s.ChooseTarget(domain.ActionFlipThree, candidates, self); target != leader {
+			t.Fatalf("Expected Leader, got %s", target.Name)
+		}
+	}
+
+	// GiveSecondChance still goes to the weakest opponent
+	if target := s.ChooseTarget(domain.ActionGiveSecondChance, candidates, self); target != trailer {
+		t.Errorf("Expected Trailer, got %s", target.Name)
+	}
+}
+
+func TestNew_BuildsEveryRegisteredStrategy(t *testing.T) {
+	for _, name := range strategy.Names() {
+		s, err := strategy.New(name)
+		if err != nil {
+			t.Fatalf("New(%q) failed: %v", name, err)
+		}
+		if s.Name() != name {
+			t.Errorf("New(%q) built a strategy named %q", name, s.Name())
+		}
+	}
+
+	if s, err := strategy.New("Heuristic-22"); err != nil || s.Name() != "Heuristic-22" {
+		t.Errorf("Expected Heuristic-22 to be built from its name, got %v (%v)", s, err)
+	}
+	for _, name := range []string{"", "Human", "Heuristic-0", "Heuristic-x"} {
+		if _, err := strategy.New(name); err == nil {
+			t.Errorf("Expected an error for %q", name)
+		}
+	}
+}
+
+func TestProbabilisticStrategy_EmptyDeckAssumesFullDeckRisk(t *testing.T) {
+	s := strategy.NewProbabilisticStrategy()
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 12})
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 11})
+
+	// With the deck used up counting sees no risk, but the reshuffled discards are
+	// (12+11)/94 = 24% likely to bust this hand as far as the player knows.
+	empty := domain.NewDeckInOrder(nil)
+	if got := s.Decide(empty, hand, 0, nil); got != domain.TurnChoiceStay {
+		t.Errorf("Expected Stay before a reshuffle, got %v", got)
+	}
+
+	// Two safe cards left: counting is exact and says hit.
+	safe := domain.NewDeckInOrder([]domain.Card{{Type: domain.CardTypeNumber, Value: 1}, {Type: domain.CardTypeNumber, Value: 2}})
+	if got := s.Decide(safe, hand, 0, nil); got != domain.TurnChoiceHit {
+		t.Errorf("Expected Hit with a known safe deck, got %v", got)
+	}
+}
+
+func TestProbabilisticStrategy_RiskThreshold(t *testing.T) {
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 12})
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 11})
+	deck := domain.NewDeckInOrder(domain.StandardDeckCards()) // (12+11)/94 = 24% to bust
+
+	if got := strategy.NewProbabilisticStrategy().Decide(deck, hand, 0, nil); got != domain.TurnChoiceStay {
+		t.Errorf("Expected Stay above the default threshold, got %v", got)
+	}
+	bold := &strategy.ProbabilisticStrategy{TargetSelector: strategy.NewDefaultTargetSelector(), RiskThreshold: 0.30}
+	if got := bold.Decide(deck, hand, 0, nil); got != domain.TurnChoiceHit {
+		t.Errorf("Expected Hit below a 0.30 threshold, got %v", got)
+	}
+}
+
+func TestTableScaling_Scale(t *testing.T) {
+	scaling := strategy.DefaultTableScaling()
+	// 1 to 6 players; three opponents keep the fixed threshold.
+	want := []float64{0.11, 0.14, 0.17, 0.20, 0.23, 0.26}
+	for opponents, w := range want {
+		if got := scaling.Scale(strategy.DefaultProbabilisticRiskThreshold, opponents); math.Abs(got-w) > 1e-9 {
+			t.Errorf("Expected a threshold of %.2f against %d opponent(s), got %.4f", w, opponents, got)
+		}
+	}
+	if got := scaling.Scale(0.30, 6); got != 0.35 {
+		t.Errorf("Expected the threshold clamped to 0.35, got %.4f", got)
+	}
+	if got := scaling.Scale(0.15, 0); got != 0.10 {
+		t.Errorf("Expected the threshold clamped to 0.10, got %.4f", got)
+	}
+	if got := (strategy.TableScaling{}).Scale(0.20, 5); got != 0.20 {
+		t.Errorf("Expected no scaling to keep the threshold, got %.4f", got)
+	}
+}
+
+func TestProbabilisticStrategy_TableScaling(t *testing.T) {
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 12})
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 11})
+	deck := domain.NewDeckInOrder(domain.StandardDeckCards()) // (12+11)/94 = 24% to bust
+	table := func(opponents int) []*domain.Player {
+		players := make([]*domain.Player, opponents)
+		for i := range players {
+			players[i] = domain.NewPlayer("Opponent", nil)
+		}
+		return players
+	}
+
+	scaled := strategy.NewScaledProbabilisticStrategy()
+	fixed := strategy.NewProbabilisticStrategy()
+	for opponents := 1; opponents <= 5; opponents++ {
+		want := domain.TurnChoiceStay
+		if opponents == 5 { // Only 0.26 takes the 24% risk
+			want = domain.TurnChoiceHit
+		}
+		if got := scaled.Decide(deck, hand, 0, table(opponents)); got != want {
+			t.Errorf("Expected %v against %d opponent(s), got %v", want, opponents, got)
+		}
+		if got := fixed.Decide(deck, hand, 0, table(opponents)); got != domain.TurnChoiceStay {
+			t.Errorf("Expected the fixed threshold to stay against %d opponent(s), got %v", opponents, got)
+		}
+	}
+	if scaled.Name() != "Probabilistic-Scaled" || fixed.Name() != "Probabilistic" {
+		t.Errorf("Expected the scaled strategy to be named apart, got %q and %q", scaled.Name(), fixed.Name())
+	}
+	config := strategy.DefaultProbabilisticConfig()
+	config.PlanAhead = true
+	if got := strategy.NewProbabilisticStrategyWithConfig(config); got.Name() != "Probabilistic-Plan2" || got.RiskThreshold != strategy.DefaultProbabilisticRiskThreshold {
+		t.Errorf("Expected the config to be applied, got %+v", got)
+	}
+}
+
+func TestProbabilisticStrategy_PlanAhead(t *testing.T) {
+	number := func(v int) domain.Card { return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)} }
+	hand := domain.NewPlayerHand()
+	hand.AddCard(number(5))
+
+	// One 5 among ten cards is a 10% risk, but the other nine are 7s: any safe card is a 7 the
+	// next one duplicates, so two hits are certain to bust.
+	cards := []domain.Card{number(5)}
+	for i := 0; i < 9; i++ {
+		cards = append(cards, number(7))
+	}
+	trap := domain.NewDeckInOrder(cards)
+	if got := strategy.NewProbabilisticStrategy().Decide(trap, hand, 0, nil); got != domain.TurnChoiceHit {
+		t.Errorf("Expected Hit on the single-hit risk, got %v", got)
+	}
+	planner := strategy.NewPlanningProbabilisticStrategy()
+	if got := planner.Decide(trap, hand, 0, nil); got != domain.TurnChoiceStay {
+		t.Errorf("Expected Stay when two hits are certain to bust, got %v", got)
+	}
+
+	// Distinct safe cards keep two hits as safe as one.
+	safe := domain.NewDeckInOrder([]domain.Card{number(1), number(2), number(3), number(4)})
+	if got := planner.Decide(safe, hand, 0, nil); got != domain.TurnChoiceHit {
+		t.Errorf("Expected Hit with a safe deck, got %v", got)
+	}
+	if planner.Name() != "Probabilistic-Plan2" {
+		t.Errorf("Expected the planner to be named apart, got %q", planner.Name())
+	}
+}
+
+func TestCautiousStrategy_ZeroValueIsSafeForConcurrentUse(t *testing.T) {
+	self := domain.NewPlayer("Self", nil)
+	self.CurrentHand = domain.NewPlayerHand()
+	other := domain.NewPlayer("Other", nil)
+	other.CurrentHand = domain.NewPlayerHand()
+	candidates := []*domain.Player{self, other}
+
+	s := &strategy.CautiousStrategy{}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.SetWinningScore(200)
+			if target := s.ChooseTarget(domain.ActionFreeze, candidates, self); target != other {
+				t.Errorf("Expected Other, got %v", target)
+			}
+		}()
+	}
+	wg.Wait()
+	if s.TargetSelector != nil {
+		t.Error("Expected the zero value to keep its nil TargetSelector")
+	}
+}
@@ -0,0 +1,119 @@
+package strategy
+
+import (
+	"fmt"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// SwitchContext is the game state a SwitchPredicate sees on each decision.
+type SwitchContext struct {
+	OwnScore         int
+	MaxOpponentScore int
+}
+
+// SwitchPredicate returns the index of the sub-strategy that should play the current decision.
+// Out-of-range indices are clamped to the available strategies.
+type SwitchPredicate func(ctx SwitchContext) int
+
+// CompositeSwitchingStrategy wraps several strategies and picks one of them on every Decide call.
+// It models opponents that change behavior as the game progresses, e.g. a player that plays
+// Cautious until someone reaches 150 and Aggressive afterwards.
+type CompositeSwitchingStrategy struct {
+	Strategies []domain.Strategy
+	Predicate  SwitchPredicate
+	Label      string // Describes the predicate in Name()
+
+	active int // Index of the strategy chosen by the last Decide call
+}
+
+// NewCompositeSwitchingStrategy creates a switching strategy over the given strategies.
+// label describes the predicate and is included in Name().
+func NewCompositeSwitchingStrategy(label string, predicate SwitchPredicate, strategies ...domain.Strategy) *CompositeSwitchingStrategy {
+	return &CompositeSwitchingStrategy{
+		Strategies: strategies,
+		Predicate:  predicate,
+		Label:      label,
+	}
+}
+
+// NewScoreThresholdSwitchingStrategy plays before until any player (self or opponent)
+// reaches threshold, then plays after.
+func NewScoreThresholdSwitchingStrategy(threshold int, before, after domain.Strategy) *CompositeSwitchingStrategy {
+	predicate := func(ctx SwitchContext) int {
+		if ctx.OwnScore >= threshold || ctx.MaxOpponentScore >= threshold {
+			return 1
+		}
+		return 0
+	}
+	return NewCompositeSwitchingStrategy(fmt.Sprintf("any>=%d", threshold), predicate, before, after)
+}
+
+func (s *CompositeSwitchingStrategy) Name() string {
+	names := make([]string, len(s.Strategies))
+	for i, strat := range s.Strategies {
+		names[i] = strat.Name()
+	}
+	return fmt.Sprintf("Switching(%s@%s)", strings.Join(names, "->"), s.Label)
+}
+
+// Active returns the strategy chosen by the most recent Decide call.
+func (s *CompositeSwitchingStrategy) Active() domain.Strategy {
+	return s.Strategies[s.active]
+}
+
+func (s *CompositeSwitchingStrategy) SetDeck(deck domain.DeckView) {
+	for _, strat := range s.Strategies {
+		if ds, ok := strat.(domain.DeckAware); ok {
+			ds.SetDeck(deck)
+		}
+	}
+}
+
+// OnReshuffle tells every strategy that counts cards about the reshuffle, not only the active
+// one: any of them may play the next decision.
+func (s *CompositeSwitchingStrategy) OnReshuffle(deck domain.DeckView) {
+	for _, strat := range s.Strategies {
+		if ra, ok := strat.(domain.ReshuffleAware); ok {
+			ra.OnReshuffle(deck)
+		}
+	}
+}
+
+func (s *CompositeSwitchingStrategy) SetWinningScore(score int) {
+	for _, strat := range s.Strategies {
+		if ws, ok := strat.(domain.WinningScoreAware); ok {
+			ws.SetWinningScore(score)
+		}
+	}
+}
+
+func (s *CompositeSwitchingStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+	// otherPlayers may include the deciding player; skip them by their hand.
+	ctx := SwitchContext{OwnScore: playerScore}
+	for _, p := range otherPlayers {
+		if hand != nil && p.CurrentHand == hand {
+			continue
+		}
+		if p.TotalScore > ctx.MaxOpponentScore {
+			ctx.MaxOpponentScore = p.TotalScore
+		}
+	}
+
+	s.active = s.Predicate(ctx)
+	if s.active < 0 {
+		s.active = 0
+	}
+	if s.active >= len(s.Strategies) {
+		s.active = len(s.Strategies) - 1
+	}
+
+	return s.Active().Decide(deck, hand, playerScore, otherPlayers)
+}
+
+// ChooseTarget delegates to the strategy chosen by the most recent Decide call.
+// Before the first decision (e.g. during the initial deal) the first strategy is used.
+func (s *CompositeSwitchingStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	return s.Active().ChooseTarget(action, candidates, self)
+}
@@ -0,0 +1,140 @@
+package strategy_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+)
+
+// fixedStrategy always returns the same choice and records the deck it was given, and the one
+// it was told a reshuffle made.
+type fixedStrategy struct {
+	name       string
+	choice     domain.TurnChoice
+	deck       domain.DeckView
+	reshuffled domain.DeckView
+}
+
+func (s *fixedStrategy) Name() string { return s.name }
+
+func (s *fixedStrategy) SetDeck(deck domain.DeckView) { s.deck = deck }
+
+func (s *fixedStrategy) OnReshuffle(deck domain.DeckView) { s.reshuffled = deck }
+
+func (s *fixedStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+	return s.choice
+}
+
+func (s *fixedStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
+	// Stay-strategy targets the first candidate, hit-strategy the last one.
+	if s.choice == domain.TurnChoiceStay {
+		return candidates[0]
+	}
+	return candidates[len(candidates)-1]
+}
+
+func TestCompositeSwitchingStrategy_SwitchesAtBoundary(t *testing.T) {
+	before := &fixedStrategy{name: "Before", choice: domain.TurnChoiceStay}
+	after := &fixedStrategy{name: "After", choice: domain.TurnChoiceHit}
+	s := strategy.NewScoreThresholdSwitchingStrategy(150, before, after)
+
+	self := domain.NewPlayer("Self", s)
+	opponent := domain.NewPlayer("Opponent", nil)
+	players := []*domain.Player{self, opponent}
+	for _, p := range players {
+		p.StartNewRound()
+	}
+
+	tests := []struct {
+		name          string
+		ownScore      int
+		opponentScore int
+		expected      domain.TurnChoice
+	}{
+		{"Both below threshold", 100, 149, domain.TurnChoiceStay},
+		{"Opponent reaches threshold", 100, 150, domain.TurnChoiceHit},
+		{"Self one below threshold", 149, 0, domain.TurnChoiceStay},
+		{"Self reaches threshold", 150, 0, domain.TurnChoiceHit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			self.TotalScore = tt.ownScore
+			opponent.TotalScore = tt.opponentScore
+
+			choice := s.Decide(domain.NewDeck(), self.CurrentHand, self.TotalScore, players)
+			if choice != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, choice)
+			}
+		})
+	}
+}
+
+func TestCompositeSwitchingStrategy_ChooseTargetFollowsActiveStrategy(t *testing.T) {
+	before := &fixedStrategy{name: "Before", choice: domain.TurnChoiceStay}
+	after := &fixedStrategy{name: "After", choice: domain.TurnChoiceHit}
+	s := strategy.NewScoreThresholdSwitchingStrategy(150, before, after)
+
+	self := domain.NewPlayer("Self", s)
+	p2 := domain.NewPlayer("P2", nil)
+	p3 := domain.NewPlayer("P3", nil)
+	players := []*domain.Player{self, p2, p3}
+	for _, p := range players {
+		p.StartNewRound()
+	}
+
+	// Before any decision the first strategy is active
+	if target := s.ChooseTarget(domain.ActionFreeze, players, self); target != self {
+		t.Errorf("Expected first strategy to choose %s, got %s", self.Name, target.Name)
+	}
+
+	p2.TotalScore = 160
+	s.Decide(domain.NewDeck(), self.CurrentHand, self.TotalScore, players)
+	if target := s.ChooseTarget(domain.ActionFreeze, players, self); target != p3 {
+		t.Errorf("Expected second strategy to choose %s, got %s", p3.Name, target.Name)
+	}
+}
+
+func TestCompositeSwitchingStrategy_SetDeckPropagates(t *testing.T) {
+	before := &fixedStrategy{name: "Before"}
+	after := &fixedStrategy{name: "After"}
+	s := strategy.NewScoreThresholdSwitchingStrategy(150, before, after)
+
+	deck := domain.NewDeck()
+	s.SetDeck(deck)
+
+	if before.deck != deck {
+		t.Errorf("Expected SetDeck to reach the first strategy")
+	}
+	if after.deck != deck {
+		t.Errorf("Expected SetDeck to reach the second strategy")
+	}
+}
+
+func TestCompositeSwitchingStrategy_OnReshufflePropagates(t *testing.T) {
+	before := &fixedStrategy{name: "Before"}
+	after := &fixedStrategy{name: "After"}
+	// Cautious does not count cards, so it is not told.
+	s := strategy.NewCompositeSwitchingStrategy("test", func(strategy.SwitchContext) int { return 0 },
+		before, strategy.NewCautiousStrategy(), after)
+
+	deck := domain.NewDeck()
+	s.OnReshuffle(deck)
+
+	if before.reshuffled != deck {
+		t.Errorf("Expected OnReshuffle to reach the active strategy")
+	}
+	if after.reshuffled != deck {
+		t.Errorf("Expected OnReshuffle to reach the inactive strategy")
+	}
+}
+
+func TestCompositeSwitchingStrategy_Name(t *testing.T) {
+	s := strategy.NewScoreThresholdSwitchingStrategy(150, &strategy.CautiousStrategy{}, strategy.NewAggressiveStrategy())
+
+	expected := "Switching(Cautious->Aggressive@any>=150)"
+	if s.Name() != expected {
+		t.Errorf("Expected %s, got %s", expected, s.Name())
+	}
+}
@@ -8,60 +8,68 @@ import (
 // TargetSelector defines the logic for selecting a target for an action.
 type TargetSelector interface {
 	ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player
-	SetDeck(deck *domain.Deck)
+	SetDeck(deck domain.DeckView)
 }
 
 // DefaultTargetSelector implements the standard target selection logic.
 type DefaultTargetSelector struct {
-	deck *domain.Deck
+	deck domain.DeckView
+
+	// PureRisk targets Flip Three by bust probability alone, the behavior before
+	// EstimateFlipThreeEV: the leader among the opponents likely to bust, else the leader.
+	PureRisk bool
+
+	// WinningScore is the score needed to win; 0 means domain.WinningThreshold. An opponent who
+	// reaches it by staying now is the Flip Three target, and no Freeze target as long as
+	// there is another (freezing them would bank their win).
+	WinningScore int
 }
 
 func NewDefaultTargetSelector() *DefaultTargetSelector {
 	return &DefaultTargetSelector{}
 }
 
-func (s *DefaultTargetSelector) SetDeck(d *domain.Deck) {
+func (s *DefaultTargetSelector) SetDeck(d domain.DeckView) {
 	s.deck = d
 }
 
+func (s *DefaultTargetSelector) SetWinningScore(score int) {
+	s.WinningScore = score
+}
+
+func (s *DefaultTargetSelector) winningScore() int {
+	if s.WinningScore <= 0 {
+		return domain.WinningThreshold
+	}
+	return s.WinningScore
+}
+
+// defaultFlipThreeRiskThreshold is the bust probability above which DefaultTargetSelector
+// prefers a Flip Three target.
+const defaultFlipThreeRiskThreshold = 0.8
+
 func (s *DefaultTargetSelector) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
 	// Shared logic:
-	// Freeze -> Self (if winning and high risk) or opponent with highest score.
-	// FlipThree -> High risk opponent (bust probability > 0.8) -> Leader opponent.
+	// Freeze -> Self (if winning and high risk) or opponent with highest score, except one who
+	// would win by staying now.
+	// FlipThree -> Opponent who would win by staying now -> Opponent it hurts most, preferring
+	// likely busts (bust probability > 0.8) -> Leader opponent.
 	// GiveSecondChance -> Weakest opponent (least threat).
 
 	if action == domain.ActionFreeze {
-		return chooseFreezeTarget(candidates, self, s.deck)
+		return chooseFreezeTarget(candidates, self, s.deck, s.winningScore())
 	}
 
 	if action == domain.ActionFlipThree {
-		// Check for high-risk opponents
-		var bestTarget *domain.Player
-		highestScore := -1
-
-		// Filter opponents
 		opponents := filterOpponents(candidates, self)
-
 		if len(opponents) == 0 {
 			return self // Should not happen usually
 		}
-
-		// Check risk for each opponent
-		for _, p := range opponents {
-			risk := 0.0
-			if s.deck != nil {
-				risk = s.deck.EstimateFlipThreeRisk(p.CurrentHand.NumberCards, p.CurrentHand.HasSecondChance())
-			}
-			if risk > 0.8 {
-				if p.TotalScore > highestScore {
-					highestScore = p.TotalScore
-					bestTarget = p
-				}
-			}
+		if winners := domain.StayingWinners(opponents, s.winningScore()); len(winners) > 0 {
+			return winners[0].Player
 		}
-
-		if bestTarget != nil {
-			return bestTarget
+		if target := chooseFlipThreeTarget(s.deck, opponents, defaultFlipThreeRiskThreshold, 0, s.PureRisk); target != nil {
+			return target
 		}
 
 		// Fallback to Leader logic
@@ -95,6 +103,7 @@ func (s *DefaultTargetSelector) ChooseTarget(action domain.ActionType, candidate
 type RiskBasedTargetSelector struct {
 	DefaultTargetSelector
 	FlipThreeRiskThreshold float64
+	RiskTrials             int // Monte Carlo trials per risk estimate; 0 means domain.FlipThreeRiskTrials
 }
 
 func NewRiskBasedTargetSelector(threshold float64) *RiskBasedTargetSelector {
@@ -103,35 +112,25 @@ func NewRiskBasedTargetSelector(threshold float64) *RiskBasedTargetSelector {
 	}
 }
 
+// NewPureRiskTargetSelector returns a RiskBasedTargetSelector with PureRisk set: Flip Three goes
+// to the leader among the opponents whose bust probability exceeds threshold, else to the leader.
+func NewPureRiskTargetSelector(threshold float64) *RiskBasedTargetSelector {
+	s := NewRiskBasedTargetSelector(threshold)
+	s.PureRisk = true
+	return s
+}
+
 func (s *RiskBasedTargetSelector) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
 	if action == domain.ActionFlipThree {
-		// Check for high-risk opponents using custom threshold
-		var bestTarget *domain.Player
-		highestScore := -1
-
-		// Filter opponents
 		opponents := filterOpponents(candidates, self)
-
 		if len(opponents) == 0 {
 			return self
 		}
-
-		// Check risk for each opponent
-		for _, p := range opponents {
-			risk := 0.0
-			if s.deck != nil {
-				risk = s.deck.EstimateFlipThreeRisk(p.CurrentHand.NumberCards, p.CurrentHand.HasSecondChance())
-			}
-			if risk > s.FlipThreeRiskThreshold {
-				if p.TotalScore > highestScore {
-					highestScore = p.TotalScore
-					bestTarget = p
-				}
-			}
+		if winners := domain.StayingWinners(opponents, s.winningScore()); len(winners) > 0 {
+			return winners[0].Player
 		}
-
-		if bestTarget != nil {
-			return bestTarget
+		if target := chooseFlipThreeTarget(s.deck, opponents, s.FlipThreeRiskThreshold, s.RiskTrials, s.PureRisk); target != nil {
+			return target
 		}
 		// Fallback to default logic (Leader)
 		return selectLeader(candidates, self)
@@ -140,6 +139,45 @@ func (s *RiskBasedTargetSelector) ChooseTarget(action domain.ActionType, candida
 	return s.DefaultTargetSelector.ChooseTarget(action, candidates, self)
 }
 
+// chooseFlipThreeTarget picks the opponent to force to draw three cards, or returns nil to
+// leave the choice to the leader fallback (always without a deck to estimate from).
+//
+// Flip Three hurts a target that busts by their whole hand, but hands points to one that
+// survives, especially while x2 and big modifiers are live. So the target is the opponent
+// whose hand is expected to change the most for the worse (domain.DeckView.EstimateFlipThreeEV),
+// looking first at the opponents whose bust probability exceeds threshold; ties go to the
+// higher total score. With pureRisk the expected change is ignored: the target is the
+// highest-scoring opponent above threshold, or nil if there is none.
+func chooseFlipThreeTarget(deck domain.DeckView, opponents []*domain.Player, threshold float64, trials int, pureRisk bool) *domain.Player {
+	if deck == nil {
+		return nil
+	}
+
+	var best *domain.Player
+	bestRisky, bestEV := false, 0.0
+	for _, p := range opponents {
+		hand := p.CurrentHand
+		risky := deck.EstimateFlipThreeRiskWithTrials(hand.NumberCards, hand.HasSecondChance(), trials) > threshold
+		if pureRisk {
+			if risky && (best == nil || p.TotalScore > best.TotalScore) {
+				best = p
+			}
+			continue
+		}
+
+		ev := deck.EstimateFlipThreeEV(hand.NumberCards, hand.ModifierCards, hand.HasSecondChance())
+		switch {
+		case best == nil, risky && !bestRisky:
+		case risky != bestRisky, ev > bestEV:
+			continue
+		case ev == bestEV && p.TotalScore <= best.TotalScore:
+			continue
+		}
+		best, bestRisky, bestEV = p, risky, ev
+	}
+	return best
+}
+
 // selectLeader selects the opponent with the highest score.
 func selectLeader(candidates []*domain.Player, self *domain.Player) *domain.Player {
 	bestTarget := self
@@ -164,6 +202,13 @@ func selectLeader(candidates []*domain.Player, self *domain.Player) *domain.Play
 	return bestTarget
 }
 
+// setSelectorWinningScore passes score on to selector if its targets depend on the winning score.
+func setSelectorWinningScore(selector TargetSelector, score int) {
+	if ws, ok := selector.(domain.WinningScoreAware); ok {
+		ws.SetWinningScore(score)
+	}
+}
+
 // filterOpponents filters out the self player from the candidates list.
 func filterOpponents(candidates []*domain.Player, self *domain.Player) []*domain.Player {
 	var opponents []*domain.Player
@@ -177,20 +222,20 @@ func filterOpponents(candidates []*domain.Player, self *domain.Player) []*domain
 
 // RandomTargetSelector selects targets randomly (for Aggressive strategy).
 type RandomTargetSelector struct {
-	deck *domain.Deck
+	deck domain.DeckView
 }
 
 func NewRandomTargetSelector() *RandomTargetSelector {
 	return &RandomTargetSelector{}
 }
 
-func (s *RandomTargetSelector) SetDeck(d *domain.Deck) {
+func (s *RandomTargetSelector) SetDeck(d domain.DeckView) {
 	s.deck = d
 }
 
 func (s *RandomTargetSelector) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
 	if action == domain.ActionFreeze {
-		return chooseFreezeTarget(candidates, self, s.deck)
+		return chooseFreezeTarget(candidates, self, s.deck, 0)
 	}
 
 	if action == domain.ActionGiveSecondChance {
@@ -42,7 +42,7 @@ func TestRiskBasedTargetSelector_ChooseTarget_FlipThree(t *testing.T) {
 	// Test Case 1: High Threshold (0.8) - Default behavior
 	// Op2 risk is 1.0 (guaranteed bust). 1.0 > 0.8, so Op2 should be targeted.
 	t.Run("High Threshold (0.8)", func(t *testing.T) {
-		selector := strategy.NewRiskBasedTargetSelector(0.8)
+		selector := strategy.NewPureRiskTargetSelector(0.8)
 		selector.SetDeck(deck)
 		target := selector.ChooseTarget(domain.ActionFlipThree, candidates, self)
 
@@ -55,7 +55,7 @@ func TestRiskBasedTargetSelector_ChooseTarget_FlipThree(t *testing.T) {
 	// Op2 risk is 1.0. 1.0 < 1.1, so Op2 is NOT targeted by risk.
 	// Fallback to Leader -> Op1 (150 points)
 	t.Run("Very High Threshold (1.1)", func(t *testing.T) {
-		selector := strategy.NewRiskBasedTargetSelector(1.1)
+		selector := strategy.NewPureRiskTargetSelector(1.1)
 		selector.SetDeck(deck)
 		target := selector.ChooseTarget(domain.ActionFlipThree, candidates, self)
 
@@ -67,7 +67,7 @@ func TestRiskBasedTargetSelector_ChooseTarget_FlipThree(t *testing.T) {
 	// Test Case 3: Low Threshold (0.5)
 	// Op2 risk is 1.0 > 0.5. Op2 targeted.
 	t.Run("Low Threshold (0.5)", func(t *testing.T) {
-		selector := strategy.NewRiskBasedTargetSelector(0.5)
+		selector := strategy.NewPureRiskTargetSelector(0.5)
 		selector.SetDeck(deck)
 		target := selector.ChooseTarget(domain.ActionFlipThree, candidates, self)
 
@@ -77,6 +77,39 @@ func TestRiskBasedTargetSelector_ChooseTarget_FlipThree(t *testing.T) {
 	})
 }
 
+func TestRiskBasedTargetSelector_FlipThreeSeesReshuffle(t *testing.T) {
+	self := domain.NewPlayer("Self", nil)
+	self.CurrentHand = domain.NewPlayerHand()
+	leader := domain.NewPlayer("Leader", nil)
+	leader.TotalScore = 150
+	leader.CurrentHand = domain.NewPlayerHand()
+	trailer := domain.NewPlayer("Trailer", nil)
+	trailer.TotalScore = 50
+	trailer.CurrentHand = domain.NewPlayerHand()
+	trailer.CurrentHand.NumberCards[domain.NumberValue(9)] = struct{}{}
+	candidates := []*domain.Player{self, leader, trailer}
+
+	// Two safe cards are left; the discard pile reshuffled after them is all 9s.
+	deck := domain.NewDeckFromCards([]domain.Card{
+		{Type: domain.CardTypeNumber, Value: 1},
+		{Type: domain.CardTypeNumber, Value: 2},
+	})
+	discards := make([]domain.Card, 8)
+	for i := range discards {
+		discards[i] = domain.Card{Type: domain.CardTypeNumber, Value: 9}
+	}
+
+	selector := strategy.NewPureRiskTargetSelector(0.8)
+	selector.SetDeck(deck)
+	if target := selector.ChooseTarget(domain.ActionFlipThree, candidates, self); target.ID != leader.ID {
+		t.Errorf("Expected the leader without the discard pile, got %s", target.Name)
+	}
+	selector.SetDeck(domain.NewReshuffleView(deck, discards))
+	if target := selector.ChooseTarget(domain.ActionFlipThree, candidates, self); target.ID != trailer.ID {
+		t.Errorf("Expected Trailer, sure to draw a 9 after the reshuffle, got %s", target.Name)
+	}
+}
+
 func TestRiskBasedTargetSelector_ChooseTarget_Freeze(t *testing.T) {
 	// Setup
 	self := domain.NewPlayer("Self", nil)
@@ -187,3 +220,125 @@ func TestRiskBasedTargetSelector_ChooseTarget_GiveSecondChance(t *testing.T) {
 		}
 	})
 }
+
+func TestRiskBasedTargetSelector_FlipThreeWeighsExpectedOutcome(t *testing.T) {
+	number := func(v int) domain.Card {
+		return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+	}
+	opponent := func(name string, total int, numbers ...int) *domain.Player {
+		p := domain.NewPlayer(name, nil)
+		p.TotalScore = total
+		p.CurrentHand = domain.NewPlayerHand()
+		for _, v := range numbers {
+			p.CurrentHand.AddCard(number(v))
+		}
+		return p
+	}
+	self := opponent("Self", 100)
+
+	t.Run("Likely busts: the bigger hand loses more", func(t *testing.T) {
+		// Both bust for sure on a deck of 5s; Big loses 17 points, Small only 5.
+		small := opponent("Small", 150, 5)
+		big := opponent("Big", 90, 5, 12)
+		deck := domain.NewDeckFromCards([]domain.Card{number(5), number(5), number(5)})
+
+		selector := strategy.NewRiskBasedTargetSelector(0.8)
+		selector.SetDeck(deck)
+		if target := selector.ChooseTarget(domain.ActionFlipThree, []*domain.Player{self, small, big}, self); target != big {
+			t.Errorf("Expected Big, got %s", target.Name)
+		}
+
+		legacy := strategy.NewPureRiskTargetSelector(0.8)
+		legacy.SetDeck(deck)
+		if target := legacy.ChooseTarget(domain.ActionFlipThree, []*domain.Player{self, small, big}, self); target != small {
+			t.Errorf("Expected the pure-risk selector to pick the leader Small, got %s", target.Name)
+		}
+	})
+
+	t.Run("Safe draws: avoid feeding the doubled hand", func(t *testing.T) {
+		// Nobody can bust on 10, 11, 12. The leader holds x2 and would gain 66, the trailer 33.
+		leader := opponent("Leader", 150, 1)
+		leader.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2})
+		trailer := opponent("Trailer", 100, 2)
+		deck := domain.NewDeckFromCards([]domain.Card{number(10), number(11), number(12)})
+
+		var selector strategy.DefaultTargetSelector
+		selector.SetDeck(deck)
+		if target := selector.ChooseTarget(domain.ActionFlipThree, []*domain.Player{self, leader, trailer}, self); target != trailer {
+			t.Errorf("Expected Trailer, got %s", target.Name)
+		}
+
+		legacy := strategy.DefaultTargetSelector{PureRisk: true}
+		legacy.SetDeck(deck)
+		if target := legacy.ChooseTarget(domain.ActionFlipThree, []*domain.Player{self, leader, trailer}, self); target != leader {
+			t.Errorf("Expected the pure-risk selector to pick Leader, got %s", target.Name)
+		}
+	})
+}
+
+func TestDefaultTargetSelector_StayingWinner(t *testing.T) {
+	player := func(name string, total int, cards ...domain.Card) *domain.Player {
+		p := domain.NewPlayer(name, nil)
+		p.TotalScore = total
+		p.CurrentHand = domain.NewPlayerHand()
+		for _, c := range cards {
+			p.CurrentHand.AddCard(c)
+		}
+		return p
+	}
+	number := func(v int) domain.Card { return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)} }
+	x2 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}
+	plus10 := domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus10}
+	self := player("Self", 50)
+	leader := player("Leader", 180, number(1), number(2), number(3), number(4), number(5)) // 195 by staying now
+	bob := player("Bob", 175, number(8), x2, plus10)                                       // 201 by staying now
+	carol := player("Carol", 10)                                                           // 10 by staying now
+	candidates := []*domain.Player{self, leader, bob, carol}
+
+	// Bob trails the leader, but staying now wins Bob the game: Flip Three goes to Bob although
+	// under x2 it is expected to add to Bob's hand more than to the leader's, and Freeze, which
+	// would bank Bob's hand, goes to the leader.
+	tests := []struct {
+		name     string
+		selector interface {
+			strategy.TargetSelector
+			SetWinningScore(int)
+		}
+	}{
+		{"Default", strategy.NewDefaultTargetSelector()},
+		{"Risk-based", strategy.NewRiskBasedTargetSelector(0.8)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.selector.SetDeck(domain.NewDeck())
+			if target := tt.selector.ChooseTarget(domain.ActionFlipThree, candidates, self); target != bob {
+				t.Errorf("Expected Flip Three on Bob, got %s", target.Name)
+			}
+			if target := tt.selector.ChooseTarget(domain.ActionFreeze, candidates, self); target != leader {
+				t.Errorf("Expected Freeze on Leader, got %s", target.Name)
+			}
+
+			// At 195 points the leader wins by staying too, so Freeze spares both.
+			tt.selector.SetWinningScore(195)
+			if target := tt.selector.ChooseTarget(domain.ActionFreeze, candidates, self); target != carol {
+				t.Errorf("Expected Freeze on Carol with a winning score of 195, got %s", target.Name)
+			}
+			// Only staying winners left to freeze: the leader it is.
+			if target := tt.selector.ChooseTarget(domain.ActionFreeze, []*domain.Player{self, leader, bob}, self); target != leader {
+				t.Errorf("Expected Freeze on Leader when every opponent wins by staying, got %s", target.Name)
+			}
+		})
+	}
+
+	t.Run("Adaptive follows the game's winning score", func(t *testing.T) {
+		adaptive := strategy.NewAdaptiveStrategy()
+		adaptive.SetDeck(domain.NewDeck())
+		adaptive.SetWinningScore(195)
+		if target := adaptive.ChooseTarget(domain.ActionFreeze, candidates, self); target != carol {
+			t.Errorf("Expected Freeze on Carol with a winning score of 195, got %s", target.Name)
+		}
+		if target := adaptive.ChooseTarget(domain.ActionFlipThree, candidates, self); target != bob {
+			t.Errorf("Expected Flip Three on Bob, got %s", target.Name)
+		}
+	})
+}
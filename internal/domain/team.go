@@ -0,0 +1,115 @@
+package domain
+
+// TeamWinningThreshold is the default combined score a team needs to win in the team play variant.
+const TeamWinningThreshold = 300
+
+// IsTeammate reports whether other is p's partner: another player on the same team.
+// Players without a team have no teammates.
+func (p *Player) IsTeammate(other *Player) bool {
+	return p.Team != "" && other != nil && other.ID != p.ID && other.Team == p.Team
+}
+
+// HasTeams reports whether the game is played in teams, that is whether any player has a team.
+func (g *Game) HasTeams() bool {
+	for _, p := range g.Players {
+		if p.Team != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// TeamTargetScore returns the combined score a team needs to win this game.
+func (g *Game) TeamTargetScore() int {
+	if g.TeamWinningScore <= 0 {
+		return TeamWinningThreshold
+	}
+	return g.TeamWinningScore
+}
+
+// teamKey identifies the side p plays for: their team, or p alone without one.
+func teamKey(p *Player) string {
+	if p.Team == "" {
+		return "player:" + p.ID.String()
+	}
+	return "team:" + p.Team
+}
+
+// TeamScores returns the combined total score of every team, by team name.
+// Players without a team are not included.
+func (g *Game) TeamScores() map[string]int {
+	scores := make(map[string]int)
+	for _, p := range g.Players {
+		if p.Team != "" {
+			scores[p.Team] += p.TotalScore
+		}
+	}
+	return scores
+}
+
+// determineTeamWinners is DetermineWinners for a game played in teams: the sides whose combined
+// score reaches target compete, the highest combined score wins, and every player of the
+// winning side (or of every side tied for it) is returned. A player without a team is a side of one.
+func (g *Game) determineTeamWinners(target int) []*Player {
+	combined := make(map[string]int)
+	var order []string // Sides in seat order, so the winners keep the order of Players
+	for _, p := range g.Players {
+		key := teamKey(p)
+		if _, seen := combined[key]; !seen {
+			order = append(order, key)
+		}
+		combined[key] += p.TotalScore
+	}
+
+	highest := 0
+	winning := make(map[string]bool)
+	for _, key := range order {
+		score := combined[key]
+		if score < target {
+			continue
+		}
+		if score > highest {
+			highest = score
+			winning = map[string]bool{key: true}
+		} else if score == highest {
+			winning[key] = true
+		}
+	}
+
+	var winners []*Player
+	for _, p := range g.Players {
+		if winning[teamKey(p)] {
+			winners = append(winners, p)
+		}
+	}
+	return winners
+}
+
+// TeamTargets narrows the candidates for actor's action to what the team play variant allows.
+// Freeze and Flip Three are never aimed at a teammate, and a Second Chance goes to a teammate
+// when one can take it. Without teams, or when the rule would leave nobody to choose, the
+// candidates are returned unchanged.
+func TeamTargets(action ActionType, candidates []*Player, actor *Player) []*Player {
+	if actor == nil || actor.Team == "" {
+		return candidates
+	}
+	var filtered []*Player
+	switch action {
+	case ActionFreeze, ActionFlipThree:
+		for _, c := range candidates {
+			if !actor.IsTeammate(c) {
+				filtered = append(filtered, c)
+			}
+		}
+	case ActionGiveSecondChance:
+		for _, c := range candidates {
+			if actor.IsTeammate(c) {
+				filtered = append(filtered, c)
+			}
+		}
+	}
+	if len(filtered) == 0 {
+		return candidates
+	}
+	return filtered
+}
@@ -0,0 +1,86 @@
+package domain_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func TestDetermineWinners_Teams(t *testing.T) {
+	tests := []struct {
+		name        string
+		teams       []string
+		scores      []int
+		teamTarget  int // 0 means TeamWinningThreshold
+		wantWinners []string
+	}{
+		{"No team reached 300", []string{"A", "B", "A", "B"}, []int{150, 160, 149, 139}, 0, nil},
+		{"Combined score wins although no player reached 200", []string{"A", "B", "A", "B"}, []int{150, 190, 150, 100}, 0, []string{"P1", "P3"}},
+		{"Both teams reached 300, highest combined score wins", []string{"A", "B", "A", "B"}, []int{150, 161, 160, 150}, 0, []string{"P2", "P4"}},
+		{"Both teams tied", []string{"A", "B", "A", "B"}, []int{150, 160, 160, 150}, 0, []string{"P1", "P2", "P3", "P4"}},
+		{"Tie ignores a team below the target", []string{"A", "B", "C", "A", "B", "C"}, []int{150, 200, 100, 150, 100, 100}, 0, []string{"P1", "P2", "P4", "P5"}},
+		{"Player without a team is a side of one", []string{"A", "", "A"}, []int{100, 310, 100}, 0, []string{"P2"}},
+		{"Custom team winning score", []string{"A", "B", "A", "B"}, []int{100, 60, 100, 60}, 200, []string{"P1", "P3"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			players := make([]*domain.Player, len(tt.scores))
+			for i, score := range tt.scores {
+				players[i] = playerWith(fmt.Sprintf("P%d", i+1), score)
+				players[i].Team = tt.teams[i]
+			}
+			game := domain.NewGame(players)
+			game.TeamWinningScore = tt.teamTarget
+
+			var got []string
+			for _, w := range game.DetermineWinners() {
+				got = append(got, w.Name)
+			}
+			if !reflect.DeepEqual(got, tt.wantWinners) {
+				t.Errorf("Expected winners %v, got %v", tt.wantWinners, got)
+			}
+		})
+	}
+}
+
+func TestTeamTargets(t *testing.T) {
+	me := playerWith("Me", 0)
+	partner := playerWith("Partner", 0)
+	rival := playerWith("Rival", 0)
+	loner := playerWith("Loner", 0)
+	me.Team, partner.Team, rival.Team = "A", "A", "B"
+	all := []*domain.Player{me, partner, rival}
+
+	names := func(players []*domain.Player) []string {
+		var out []string
+		for _, p := range players {
+			out = append(out, p.Name)
+		}
+		return out
+	}
+	tests := []struct {
+		name       string
+		action     domain.ActionType
+		candidates []*domain.Player
+		actor      *domain.Player
+		want       []string
+	}{
+		{"Freeze skips the partner", domain.ActionFreeze, all, me, []string{"Me", "Rival"}},
+		{"Flip Three skips the partner", domain.ActionFlipThree, all, me, []string{"Me", "Rival"}},
+		{"Second Chance goes to the partner", domain.ActionGiveSecondChance, []*domain.Player{partner, rival}, me, []string{"Partner"}},
+		{"Second Chance without the partner", domain.ActionGiveSecondChance, []*domain.Player{rival}, me, []string{"Rival"}},
+		{"Only the partner left to freeze", domain.ActionFreeze, []*domain.Player{partner}, me, []string{"Partner"}},
+		{"Actor without a team", domain.ActionFreeze, []*domain.Player{loner, me, partner}, loner, []string{"Loner", "Me", "Partner"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := names(domain.TeamTargets(tt.action, tt.candidates, tt.actor))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package domain
+
+// TurnPredictionTrials is the default number of simulated rounds of TurnPredictor.
+const TurnPredictionTrials = 1000
+
+// TurnPrediction is how many more turns a player can expect this round.
+type TurnPrediction struct {
+	// Alone is set when no opponent is in play: the player gets as many more turns as they
+	// choose, and the other fields are 0.
+	Alone bool
+	// ExpectedTurns is the average number of turns the player gets after this one, counted
+	// until the round ends or every opponent has stayed or busted (the turn the player is
+	// left alone on counts; any after it are theirs to choose).
+	ExpectedTurns float64
+	// EndsFirst is the probability that the round ends before the player's next turn.
+	EndsFirst float64
+	// LeftAlone is the probability that every opponent stays or busts before the round ends.
+	LeftAlone float64
+}
+
+// TurnPredictor estimates how many more turns a player gets this round, assuming they hit on
+// this turn and every later one without busting: whether to go on is theirs to decide, and
+// their bust risk is shown apart. Only an opponent's Flip 7 then ends the round before the
+// player is out; opponents who stay or bust leave the player to play on alone.
+//
+// Each trial deals from a random order of the cards left in the deck: the player draws a card,
+// then each opponent still in play, in turn order, hits or stays as Opponent decides (an
+// opponent who cannot stay yet hits). A Flip Three an opponent draws is played on themselves
+// and a Second Chance saves a later duplicate; Freeze is ignored. A trial stops when the deck
+// runs out, as the reshuffle is not simulated.
+type TurnPredictor struct {
+	// Opponent decides for every opponent whether to hit or stay; nil means hitting below
+	// HitBelow points, as the Heuristic strategy does.
+	Opponent Strategy
+	HitBelow int // 0 means DefaultOpponentHitBelow; ignored when Opponent is set
+	Trials   int // 0 means TurnPredictionTrials
+	// Intn returns a random number in [0,n); nil means GetRandomInt. Tests inject a fixed
+	// source, e.g. one that always returns 0 to draw the cards in deck order.
+	Intn func(n int) int
+}
+
+// Predict returns the turn prediction of the player whose turn it is. opponents are the other
+// players, in the order they play after that player; players no longer in play are skipped.
+func (tp TurnPredictor) Predict(deck *Deck, opponents []*Player) TurnPrediction {
+	var start []*Player
+	for _, p := range opponents {
+		if p != nil && p.CurrentHand != nil && p.CurrentHand.Status == HandStatusActive {
+			start = append(start, p)
+		}
+	}
+	if len(start) == 0 {
+		return TurnPrediction{Alone: true}
+	}
+	if deck == nil || len(deck.Cards) == 0 {
+		return TurnPrediction{}
+	}
+	trials := tp.Trials
+	if trials <= 0 {
+		trials = TurnPredictionTrials
+	}
+	intn := tp.Intn
+	if intn == nil {
+		intn = GetRandomInt
+	}
+
+	t := threatTrial{deck: deck.Cards, intn: intn, perm: make([]int, len(deck.Cards))}
+	hands := make([]*PlayerHand, len(start))
+	turns, endsFirst, leftAlone := 0, 0, 0
+	for i := 0; i < trials; i++ {
+		t.reset()
+		for k, p := range start {
+			hands[k] = p.CurrentHand.Clone()
+		}
+		for rotation := 0; ; rotation++ {
+			if _, ok := t.draw(); !ok { // The player's own hit
+				break
+			}
+			ended, outOfCards := false, false
+			for k, p := range start {
+				if ended, outOfCards = tp.playTurn(&t, deck, p, hands[k], opponents); ended || outOfCards {
+					break
+				}
+			}
+			if outOfCards {
+				break
+			}
+			if ended {
+				if rotation == 0 {
+					endsFirst++
+				}
+				break
+			}
+			turns++
+			if !anyActive(hands) {
+				leftAlone++
+				break
+			}
+		}
+	}
+	return TurnPrediction{
+		ExpectedTurns: float64(turns) / float64(trials),
+		EndsFirst:     float64(endsFirst) / float64(trials),
+		LeftAlone:     float64(leftAlone) / float64(trials),
+	}
+}
+
+// playTurn plays the turn of opponent p holding hand in a trial. It reports whether p completed
+// Flip 7, ending the round, or the trial ran out of cards.
+func (tp TurnPredictor) playTurn(t *threatTrial, deck *Deck, p *Player, hand *PlayerHand, players []*Player) (flip7, outOfCards bool) {
+	if hand.Status != HandStatusActive {
+		return false, false
+	}
+	if hand.CanStay() && !tp.hits(deck, p, hand, players) {
+		hand.Status = HandStatusStayed
+		return false, false
+	}
+	card, ok := t.draw()
+	if !ok {
+		return false, true
+	}
+	draws := 1
+	if card.Type == CardTypeAction && card.ActionType == ActionFlipThree {
+		draws = FlipThreeCardCount
+		if card, ok = t.draw(); !ok {
+			return false, true
+		}
+	}
+	for i := 0; ; {
+		if card.Type != CardTypeAction || card.ActionType == ActionSecondChance {
+			if _, flip7, _ := hand.AddCard(card); flip7 {
+				return true, false
+			}
+		}
+		if i++; i >= draws || hand.Status != HandStatusActive {
+			return false, false
+		}
+		if card, ok = t.draw(); !ok {
+			return false, true
+		}
+	}
+}
+
+// hits reports whether the opponent model has p hit on hand.
+func (tp TurnPredictor) hits(deck *Deck, p *Player, hand *PlayerHand, players []*Player) bool {
+	if tp.Opponent != nil {
+		return tp.Opponent.Decide(deck, hand, p.TotalScore, players) == TurnChoiceHit
+	}
+	hitBelow := tp.HitBelow
+	if hitBelow <= 0 {
+		hitBelow = DefaultOpponentHitBelow
+	}
+	return NewScoreCalculator().Total(hand) < hitBelow
+}
+
+// anyActive reports whether one of hands is still in play.
+func anyActive(hands []*PlayerHand) bool {
+	for _, h := range hands {
+		if h.Status == HandStatusActive {
+			return true
+		}
+	}
+	return false
+}
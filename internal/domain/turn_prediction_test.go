@@ -0,0 +1,123 @@
+package domain_test
+
+import (
+	"math"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+// alwaysStays is an opponent model that stays whenever it may.
+type alwaysStays struct{}
+
+func (alwaysStays) Name() string { return "AlwaysStays" }
+func (alwaysStays) Decide(domain.DeckView, *domain.PlayerHand, int, []*domain.Player) domain.TurnChoice {
+	return domain.TurnChoiceStay
+}
+func (alwaysStays) ChooseTarget(_ domain.ActionType, candidates []*domain.Player, _ *domain.Player) *domain.Player {
+	return candidates[0]
+}
+
+func TestTurnPredictor(t *testing.T) {
+	numberCards := func(values ...int) []domain.Card {
+		cards := make([]domain.Card, len(values))
+		for i, v := range values {
+			cards[i] = domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+		}
+		return cards
+	}
+	opponent := func(values ...int) *domain.Player {
+		p := domain.NewPlayer("Opponent", nil)
+		p.StartNewRound()
+		for _, c := range numberCards(values...) {
+			p.CurrentHand.AddCard(c)
+		}
+		return p
+	}
+	inOrder := func(int) int { return 0 } // Draws the cards in deck order
+
+	tests := []struct {
+		name      string
+		predictor domain.TurnPredictor
+		opponents []*domain.Player
+		deck      []domain.Card
+		want      domain.TurnPrediction
+	}{
+		{
+			"One safe card from Flip 7 with only safe cards left", domain.TurnPredictor{},
+			[]*domain.Player{opponent(0, 1, 2, 3, 4, 5)}, numberCards(6, 7, 8, 9, 10, 11, 12, 12),
+			domain.TurnPrediction{EndsFirst: 1},
+		},
+		{
+			"Opponent on a high hand stays", domain.TurnPredictor{},
+			[]*domain.Player{opponent(9, 10, 11)}, numberCards(1, 2, 3),
+			domain.TurnPrediction{ExpectedTurns: 1, LeftAlone: 1},
+		},
+		{
+			"Opponent model decides", domain.TurnPredictor{Opponent: alwaysStays{}},
+			[]*domain.Player{opponent(1)}, numberCards(2, 3, 4),
+			domain.TurnPrediction{ExpectedTurns: 1, LeftAlone: 1},
+		},
+		{
+			// Both opponents hit on 4 and 5, then the player's next draw, then 4 and 5 busts them
+			"Opponents bust on the second rotation", domain.TurnPredictor{Intn: inOrder},
+			[]*domain.Player{opponent(1), opponent(2)}, numberCards(9, 4, 5, 10, 4, 5),
+			domain.TurnPrediction{ExpectedTurns: 2, LeftAlone: 1},
+		},
+		{
+			"Flip Three is played on the opponent", domain.TurnPredictor{Intn: inOrder},
+			[]*domain.Player{opponent(0, 1, 2, 3)},
+			append(numberCards(12), append([]domain.Card{{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}}, numberCards(4, 5, 6)...)...),
+			domain.TurnPrediction{EndsFirst: 1},
+		},
+		{
+			"The deck runs out", domain.TurnPredictor{}, []*domain.Player{opponent(1)}, numberCards(2),
+			domain.TurnPrediction{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.predictor.Predict(domain.NewDeckInOrder(tt.deck), tt.opponents)
+			if got.Alone || math.Abs(got.ExpectedTurns-tt.want.ExpectedTurns) > 0.02 ||
+				math.Abs(got.EndsFirst-tt.want.EndsFirst) > 0.02 || math.Abs(got.LeftAlone-tt.want.LeftAlone) > 0.02 {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTurnPredictor_LastPlayerInTheRound(t *testing.T) {
+	stayed := domain.NewPlayer("Stayed", nil)
+	stayed.StartNewRound()
+	stayed.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 5})
+	stayed.CurrentHand.Status = domain.HandStatusStayed
+
+	for _, opponents := range [][]*domain.Player{nil, {stayed}} {
+		got := (domain.TurnPredictor{}).Predict(domain.NewDeck(), opponents)
+		if got != (domain.TurnPrediction{Alone: true}) {
+			t.Errorf("Expected the last player in the round to play on alone, got %+v", got)
+		}
+	}
+}
+
+func TestTurnPredictor_HalfTheRounds(t *testing.T) {
+	// The opponent completes Flip 7 on a 6 and busts on a 1. Whichever card the player draws
+	// first, the opponent's is a 6 half the time; a bust leaves the player one turn alone.
+	p := domain.NewPlayer("Opponent", nil)
+	p.StartNewRound()
+	for v := 0; v < 6; v++ {
+		p.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)})
+	}
+	deck := domain.NewDeckInOrder([]domain.Card{
+		{Type: domain.CardTypeNumber, Value: 6}, {Type: domain.CardTypeNumber, Value: 6},
+		{Type: domain.CardTypeNumber, Value: 1}, {Type: domain.CardTypeNumber, Value: 1},
+	})
+
+	got := domain.TurnPredictor{Trials: 20000}.Predict(deck, []*domain.Player{p})
+
+	for name, value := range map[string]float64{"EndsFirst": got.EndsFirst, "LeftAlone": got.LeftAlone, "ExpectedTurns": got.ExpectedTurns} {
+		if math.Abs(value-0.5) > 0.02 {
+			t.Errorf("Expected %s of 0.5, got %.3f", name, value)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package console
+
+import (
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// FinalStandings renders the ranking of every player at the end of a game (see
+// domain.Game.FinalStandings), one line per player, e.g. " 2. Bob: 185 (20 behind)". Every
+// line ends with a newline.
+func (m *Messages) FinalStandings(standings []domain.Standing) string {
+	var b strings.Builder
+	b.WriteString(m.Format(MsgStandingsHeader, nil))
+	b.WriteString("\n")
+	for _, s := range standings {
+		margin := ""
+		if s.Margin > 0 {
+			margin = m.Format(MsgStandingsMargin, Args{"margin": s.Margin})
+		}
+		b.WriteString(m.Format(MsgStandingsPlayer, Args{
+			"rank":   s.Rank,
+			"name":   s.Player.Name,
+			"score":  s.Player.TotalScore,
+			"margin": margin,
+		}))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
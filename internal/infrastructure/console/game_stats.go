@@ -0,0 +1,55 @@
+package console
+
+import (
+	"strconv"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// FormatGameStats renders the end-of-game screen: the number of rounds played, then for every
+// player in seat order their total, best single round, busts, Flip 7s, Freezes given and
+// received and Second Chances used, followed by their total after each round
+// ("12→31→31→58"). A nil stats counts nothing. Every line ends with a newline.
+func FormatGameStats(game *domain.Game, stats *domain.GameStats) string {
+	return (*Messages)(nil).GameStats(game, stats)
+}
+
+// GameStats is FormatGameStats in the language of m.
+func (m *Messages) GameStats(game *domain.Game, stats *domain.GameStats) string {
+	var b strings.Builder
+	b.WriteString(m.Format(MsgGameStatsHeader, Args{"rounds": game.RoundCount}))
+	b.WriteString("\n")
+	for _, p := range game.Players {
+		st := &domain.PlayerGameStats{}
+		if stats != nil && stats.Players[p.ID.String()] != nil {
+			st = stats.Players[p.ID.String()]
+		}
+		b.WriteString(m.Format(MsgGameStatsPlayer, Args{
+			"name":          p.Name,
+			"total":         p.TotalScore,
+			"best":          st.BestRound(),
+			"busts":         st.Busts,
+			"flip7s":        st.Flip7s,
+			"given":         st.FreezesGiven,
+			"received":      st.FreezesReceived,
+			"secondChances": st.SecondChancesUsed,
+		}))
+		b.WriteString("\n")
+		b.WriteString(m.Format(MsgGameStatsProgression, Args{"progression": ScoreProgression(st.Scores)}))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// ScoreProgression joins totals with arrows, e.g. "12→31→58", or returns "-" when there are none.
+func ScoreProgression(scores []int) string {
+	if len(scores) == 0 {
+		return "-"
+	}
+	parts := make([]string, len(scores))
+	for i, score := range scores {
+		parts[i] = strconv.Itoa(score)
+	}
+	return strings.Join(parts, "→")
+}
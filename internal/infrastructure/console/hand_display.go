@@ -0,0 +1,86 @@
+package console
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// DisplayHand renders a hand the way its cards are laid out on the table, e.g.
+// "[3, 5, 8, +4, x2, SC]": numbers ascending, then modifiers from +2 to +10 and x2, then actions
+// as short labels. The hand itself is not changed (RawNumberCards keeps the draw order).
+// A nil hand renders as "[]". Logs use FormatHand, which keeps the draw order.
+func DisplayHand(h *domain.PlayerHand) string {
+	if h == nil {
+		return "[]"
+	}
+	numbers := append([]domain.NumberValue(nil), h.RawNumberCards...)
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+	parts := make([]string, 0, len(numbers)+len(h.ModifierCards)+len(h.ActionCards))
+	for _, v := range numbers {
+		parts = append(parts, strconv.Itoa(int(v)))
+	}
+
+	modifiers := append([]domain.Card(nil), h.ModifierCards...)
+	sort.SliceStable(modifiers, func(i, j int) bool {
+		return modifierRank(modifiers[i].ModifierType) < modifierRank(modifiers[j].ModifierType)
+	})
+	for _, c := range modifiers {
+		parts = append(parts, CardLabel(c))
+	}
+
+	actions := append([]domain.Card(nil), h.ActionCards...)
+	sort.SliceStable(actions, func(i, j int) bool {
+		return actionRank(actions[i].ActionType) < actionRank(actions[j].ActionType)
+	})
+	for _, c := range actions {
+		parts = append(parts, CardLabel(c))
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// CardLabel returns the short label of a card as DisplayHand shows it: "7", "+4", "x2",
+// "FRZ" (Freeze), "F3" (Flip Three) or "SC" (Second Chance).
+func CardLabel(c domain.Card) string {
+	switch c.Type {
+	case domain.CardTypeNumber:
+		return strconv.Itoa(int(c.Value))
+	case domain.CardTypeModifier:
+		if c.ModifierType == domain.ModifierX2 {
+			return "x2"
+		}
+		return "+" + strconv.Itoa(c.ModifierType.Points())
+	case domain.CardTypeAction:
+		switch c.ActionType {
+		case domain.ActionFreeze:
+			return "FRZ"
+		case domain.ActionFlipThree:
+			return "F3"
+		case domain.ActionSecondChance:
+			return "SC"
+		}
+	}
+	return c.String()
+}
+
+// modifierRank orders modifiers as domain.AllModifierTypes lists them: +2 to +10, then x2.
+func modifierRank(m domain.ModifierType) int {
+	for i, t := range domain.AllModifierTypes {
+		if t == m {
+			return i
+		}
+	}
+	return len(domain.AllModifierTypes)
+}
+
+// actionRank orders actions as domain.AllActionTypes lists them.
+func actionRank(a domain.ActionType) int {
+	for i, t := range domain.AllActionTypes {
+		if t == a {
+			return i
+		}
+	}
+	return len(domain.AllActionTypes)
+}
@@ -0,0 +1,55 @@
+package console_test
+
+import (
+	"reflect"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+func TestDisplayHand(t *testing.T) {
+	modifier := func(m domain.ModifierType) domain.Card {
+		return domain.Card{Type: domain.CardTypeModifier, ModifierType: m}
+	}
+	hand := playerWithHand("Me", 0,
+		numberCard(8), modifier(domain.ModifierX2), numberCard(0), modifier(domain.ModifierPlus10),
+		domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance},
+		numberCard(5), modifier(domain.ModifierPlus2),
+	).CurrentHand
+
+	if got, want := console.DisplayHand(hand), "[0, 5, 8, +2, +10, x2, SC]"; got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	// The hand keeps its draw order for the logs and the other tools.
+	if want := []domain.NumberValue{8, 0, 5}; !reflect.DeepEqual(hand.RawNumberCards, want) {
+		t.Errorf("Expected RawNumberCards to stay %v, got %v", want, hand.RawNumberCards)
+	}
+	if got := console.FormatHand(hand); got != "[8, 0, 5, multiply_2, plus_10, plus_2, second_chance]" {
+		t.Errorf("Expected FormatHand to keep the draw order, got %s", got)
+	}
+	if got := console.DisplayHand(nil); got != "[]" {
+		t.Errorf("Expected [] for a nil hand, got %s", got)
+	}
+}
+
+func TestCardLabel(t *testing.T) {
+	tests := []struct {
+		card domain.Card
+		want string
+	}{
+		{numberCard(0), "0"},
+		{numberCard(12), "12"},
+		{domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4}, "+4"},
+		{domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus10}, "+10"},
+		{domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2}, "x2"},
+		{domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}, "SC"},
+		{domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFreeze}, "FRZ"},
+		{domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionFlipThree}, "F3"},
+	}
+	for _, tt := range tests {
+		if got := console.CardLabel(tt.card); got != tt.want {
+			t.Errorf("CardLabel(%s): expected %s, got %s", tt.card, tt.want, got)
+		}
+	}
+}
@@ -3,8 +3,8 @@ package console
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
-	"strconv"
 	"strings"
 
 	"flip7_strategy/internal/domain"
@@ -12,13 +12,28 @@ import (
 
 // HumanStrategy allows a human to play via CLI.
 type HumanStrategy struct {
+	// SaveAndQuit, if set, is offered as "save" at the hit/stay prompt. It is expected to save the
+	// game and end the program; if it returns, the error (if any) is shown and the player is asked again.
+	SaveAndQuit func() error
+	// Messages is the language of the prompts; nil is English.
+	Messages *Messages
+
 	reader *bufio.Reader
-	deck   *domain.Deck
+	out    io.Writer
+	deck   domain.DeckView
 }
 
+// NewHumanStrategy creates a HumanStrategy that reads from stdin and writes to stdout.
 func NewHumanStrategy() *HumanStrategy {
+	return NewHumanStrategyWithIO(os.Stdin, os.Stdout)
+}
+
+// NewHumanStrategyWithIO creates a HumanStrategy that reads choices from in and writes prompts to out.
+// Passing a *bufio.Reader shared with other prompts keeps buffered input from being lost between them.
+func NewHumanStrategyWithIO(in io.Reader, out io.Writer) *HumanStrategy {
 	return &HumanStrategy{
-		reader: bufio.NewReader(os.Stdin),
+		reader: bufio.NewReader(in),
+		out:    out,
 	}
 }
 
@@ -26,23 +41,29 @@ func (s *HumanStrategy) Name() string {
 	return "Human"
 }
 
-func (s *HumanStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
-	fmt.Printf("\n--- Your Turn ---\n")
-	fmt.Printf("Your Hand: %v (Modifiers: %v, Actions: %v)\n", hand.RawNumberCards, hand.ModifierCards, hand.ActionCards)
+// Decide asks hit or stay until a valid answer is entered. When input runs out it stays.
+func (s *HumanStrategy) Decide(deck domain.DeckView, hand *domain.PlayerHand, playerScore int, otherPlayers []*domain.Player) domain.TurnChoice {
+	s.say(MsgYourTurn, nil)
+	s.say(MsgYourHand, Args{"hand": DisplayHand(hand)})
 
 	calc := domain.NewScoreCalculator()
 	score := calc.Compute(hand)
-	fmt.Printf("Current Hand Score: %d (Total Banked: %d)\n", score.Total, playerScore)
+	s.say(MsgHandScore, Args{"score": score.Total, "banked": playerScore})
 
 	risk := deck.EstimateHitRisk(hand.NumberCards, hand.HasSecondChance())
-	fmt.Printf("Estimated Risk of Bust: %.2f%%\n", risk*100)
+	s.say(MsgBustRisk, Args{"risk": risk * 100})
 
+	prompt := MsgHitStayPrompt
+	if s.SaveAndQuit != nil {
+		prompt = MsgHitStaySavePrompt
+	}
 	for {
-		fmt.Print("Choose action (hit/stay): ")
+		fmt.Fprint(s.out, s.Messages.Format(prompt, nil))
 		input, err := s.reader.ReadString('\n')
-		if err != nil {
-			fmt.Printf("Error reading input: %v\n", err)
-			continue
+		if err != nil && strings.TrimSpace(input) == "" {
+			// No more input (e.g. stdin closed); asking again would loop forever.
+			s.say(MsgInputErrorStaying, Args{"err": err})
+			return domain.TurnChoiceStay
 		}
 		input = strings.TrimSpace(strings.ToLower(input))
 
@@ -52,37 +73,44 @@ func (s *HumanStrategy) Decide(deck *domain.Deck, hand *domain.PlayerHand, playe
 		if input == "stay" || input == "s" {
 			return domain.TurnChoiceStay
 		}
-		fmt.Println("Invalid input. Please enter 'hit' or 'stay'.")
+		if input == "save" && s.SaveAndQuit != nil {
+			if err := s.SaveAndQuit(); err != nil {
+				s.say(MsgSaveFailed, Args{"err": err})
+			}
+			continue
+		}
+		s.say(MsgInvalidHitStay, nil)
 	}
 }
 
-func (h *HumanStrategy) SetDeck(d *domain.Deck) {
+func (h *HumanStrategy) SetDeck(d domain.DeckView) {
 	h.deck = d
 }
 
+// ChooseTarget lists every candidate with their hand and bust risk and asks until a valid number is entered.
+// Candidates include yourself: freezing yourself banks your current hand.
 func (h *HumanStrategy) ChooseTarget(action domain.ActionType, candidates []*domain.Player, self *domain.Player) *domain.Player {
-	fmt.Printf("\n--- Choose Target for %s ---\n", action)
-	for i, p := range candidates {
-		label := p.Name
-		if p.ID == self.ID {
-			label += " (You)"
-		}
-		fmt.Printf("%d: %s (Score: %d)\n", i+1, label, p.TotalScore)
-	}
+	h.say(MsgChooseTargetHeader, Args{"action": action})
+	h.Messages.WriteTargetOptions(h.out, action, candidates, self, h.deck, nil)
 
 	for {
-		fmt.Printf("Enter number (1-%d): ", len(candidates))
+		fmt.Fprint(h.out, h.Messages.Format(MsgTargetNumberPrompt, Args{"count": len(candidates)}))
 		input, err := h.reader.ReadString('\n')
-		if err != nil {
-			fmt.Printf("Error reading input: %v\n", err)
-			continue
+		if err != nil && strings.TrimSpace(input) == "" {
+			// No more input (e.g. stdin closed); asking again would loop forever.
+			h.say(MsgInputErrorChoosing, Args{"err": err, "name": candidates[0].Name})
+			return candidates[0]
 		}
-		input = strings.TrimSpace(input)
 
-		idx, err := strconv.Atoi(input)
-		if err == nil && idx >= 1 && idx <= len(candidates) {
-			return candidates[idx-1]
+		idx, err := ParseTargetChoice(input, len(candidates))
+		if err == nil {
+			return candidates[idx]
 		}
-		fmt.Println("Invalid selection.")
+		h.say(MsgInvalidTargetChoice, Args{"err": err})
 	}
 }
+
+// say writes the message id on a line of its own.
+func (h *HumanStrategy) say(id MessageID, args Args) {
+	fmt.Fprintln(h.out, h.Messages.Format(id, args))
+}
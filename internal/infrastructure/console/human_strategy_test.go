@@ -0,0 +1,105 @@
+package console
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func TestHumanStrategy_ChooseTarget(t *testing.T) {
+	me := domain.NewPlayer("Me", nil)
+	bob := domain.NewPlayer("Bob", nil)
+	candidates := []*domain.Player{me, bob}
+
+	t.Run("Re-prompts until the input is valid", func(t *testing.T) {
+		h := NewHumanStrategyWithIO(strings.NewReader("Bob\n3\n2\n"), io.Discard)
+		if got := h.ChooseTarget(domain.ActionFreeze, candidates, me); got != bob {
+			t.Errorf("Expected Bob, got %s", got.Name)
+		}
+	})
+
+	t.Run("Choosing yourself is allowed", func(t *testing.T) {
+		h := NewHumanStrategyWithIO(strings.NewReader("1\n"), io.Discard)
+		if got := h.ChooseTarget(domain.ActionFreeze, candidates, me); got != me {
+			t.Errorf("Expected Me, got %s", got.Name)
+		}
+	})
+
+	t.Run("End of input falls back to the first candidate", func(t *testing.T) {
+		h := NewHumanStrategyWithIO(strings.NewReader("x\n"), io.Discard)
+		if got := h.ChooseTarget(domain.ActionFlipThree, candidates, me); got != me {
+			t.Errorf("Expected Me, got %s", got.Name)
+		}
+	})
+}
+
+func TestHumanStrategy_Decide(t *testing.T) {
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 5})
+	deck := domain.NewDeck()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected domain.TurnChoice
+	}{
+		{"Hit", "hit\n", domain.TurnChoiceHit},
+		{"Short stay", "S\n", domain.TurnChoiceStay},
+		{"Re-prompts on garbage", "maybe\n\nh\n", domain.TurnChoiceHit},
+		{"End of input stays", "", domain.TurnChoiceStay},
+		{"Last line without newline", "hit", domain.TurnChoiceHit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHumanStrategyWithIO(strings.NewReader(tt.input), io.Discard)
+			if got := h.Decide(deck, hand, 0, nil); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestHumanStrategy_DecideShowsHand(t *testing.T) {
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 9})
+	hand.AddCard(domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierX2})
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 2})
+	var out strings.Builder
+
+	NewHumanStrategyWithIO(strings.NewReader("s\n"), &out).Decide(domain.NewDeck(), hand, 0, nil)
+
+	if !strings.Contains(out.String(), "Your Hand: [2, 9, x2]") {
+		t.Errorf("Expected the hand in table order, got:\n%s", out.String())
+	}
+}
+
+func TestHumanStrategy_DecideSaveAndQuit(t *testing.T) {
+	hand := domain.NewPlayerHand()
+	hand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 5})
+	deck := domain.NewDeck()
+
+	saves := 0
+	h := NewHumanStrategyWithIO(strings.NewReader("save\nsave\nstay\n"), io.Discard)
+	h.SaveAndQuit = func() error {
+		saves++
+		if saves == 1 {
+			return errors.New("disk full")
+		}
+		return nil
+	}
+	if got := h.Decide(deck, hand, 0, nil); got != domain.TurnChoiceStay {
+		t.Errorf("Expected to be asked again after saving, got %v", got)
+	}
+	if saves != 2 {
+		t.Errorf("Expected 2 save attempts, got %d", saves)
+	}
+
+	// Without a save handler "save" is just invalid input
+	h = NewHumanStrategyWithIO(strings.NewReader("save\nhit\n"), io.Discard)
+	if got := h.Decide(deck, hand, 0, nil); got != domain.TurnChoiceHit {
+		t.Errorf("Expected Hit, got %v", got)
+	}
+}
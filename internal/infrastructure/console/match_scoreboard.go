@@ -0,0 +1,23 @@
+package console
+
+import "strings"
+
+// MatchStanding is a player's line on the scoreboard of a series.
+type MatchStanding struct {
+	Name   string
+	Wins   int // Games won in the series
+	Points int // Final scores of every game added up, which break ties on wins
+}
+
+// MatchScoreboard renders the standings of a best-of-bestOf series after game, one line per
+// player in the order given, leader first. Every line ends with a newline.
+func (m *Messages) MatchScoreboard(game, bestOf int, standings []MatchStanding) string {
+	var b strings.Builder
+	b.WriteString(m.Format(MsgMatchScoreboardHeader, Args{"game": game, "best_of": bestOf}))
+	b.WriteString("\n")
+	for _, s := range standings {
+		b.WriteString(m.Format(MsgMatchStanding, Args{"name": s.Name, "wins": s.Wins, "points": s.Points}))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,18 @@
+package console
+
+import "flip7_strategy/internal/domain"
+
+// MemoryModelName names model in the language of m, e.g. "round memory".
+func (m *Messages) MemoryModelName(model domain.MemoryModel) string {
+	return m.Format(memoryModelMessage(model), nil)
+}
+
+func memoryModelMessage(model domain.MemoryModel) MessageID {
+	switch model {
+	case domain.MemoryRound:
+		return MsgMemoryRound
+	case domain.MemoryNone:
+		return MsgMemoryNone
+	}
+	return MsgMemoryFull
+}
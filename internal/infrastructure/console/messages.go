@@ -0,0 +1,504 @@
+package console
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Language selects the catalog that console messages are rendered from.
+type Language string
+
+const (
+	LanguageEnglish  Language = "en"
+	LanguageJapanese Language = "ja"
+)
+
+// ParseLanguage parses a language name such as "ja" or a locale such as "ja_JP.UTF-8".
+// An empty name is English. An unsupported one returns English and an error.
+func ParseLanguage(name string) (Language, error) {
+	code := strings.ToLower(strings.TrimSpace(name))
+	if i := strings.IndexAny(code, "_-."); i >= 0 {
+		code = code[:i]
+	}
+	switch Language(code) {
+	case "", LanguageEnglish:
+		return LanguageEnglish, nil
+	case LanguageJapanese:
+		return LanguageJapanese, nil
+	}
+	return LanguageEnglish, fmt.Errorf("unsupported language %q (supported: en, ja)", name)
+}
+
+// MessageID identifies a message in the catalogs.
+type MessageID string
+
+// Args are the values substituted into a message template, by placeholder name.
+type Args map[string]any
+
+// Messages renders console messages in one language.
+// Templates refer to their values by name, so a translation may put them in any order:
+// "{name}" is replaced with fmt.Sprint of Args["name"], and "{rate:%.2f}" with the value
+// formatted by the verb after the colon. A nil *Messages renders English.
+type Messages struct {
+	Language Language
+	// Style emphasizes the key moments of a game (see emphasis); the zero value renders plain text.
+	Style     Style
+	templates map[MessageID]string
+}
+
+// NewMessages returns the catalog of lang.
+func NewMessages(lang Language) *Messages {
+	templates := englishMessages
+	if lang == LanguageJapanese {
+		templates = japaneseMessages
+	}
+	return &Messages{Language: lang, templates: templates}
+}
+
+// emphasis styles the messages that are easy to miss during fast play, in every language.
+var emphasis = map[MessageID]func(Style, string) string{
+	MsgBusted:              Style.Red,
+	MsgBustedDuplicate:     Style.Red,
+	MsgBustedDuplicateRisk: Style.Red,
+	MsgFlip7:               func(s Style, text string) string { return s.Bold(s.Green(text)) },
+	MsgStayingWinner:       func(s Style, text string) string { return s.Bold(s.Yellow(text)) },
+	MsgSuggestedMove:       Style.Bold,
+	MsgTargetSuggested:     Style.Bold,
+	MsgTurnHeader:          Style.Underline,
+	MsgYourTurn:            Style.Underline,
+}
+
+var placeholder = regexp.MustCompile(`\{(\w+)(?::(%[^}]+))?\}`)
+
+// Format renders the message id with args. A message missing from the catalog falls back to
+// English, and a placeholder without a value is left as it is.
+func (m *Messages) Format(id MessageID, args Args) string {
+	template, ok := englishMessages[id]
+	if m != nil {
+		if translated, found := m.templates[id]; found {
+			template, ok = translated, true
+		}
+	}
+	if !ok {
+		return string(id)
+	}
+	text := placeholder.ReplaceAllStringFunc(template, func(p string) string {
+		match := placeholder.FindStringSubmatch(p)
+		value, found := args[match[1]]
+		if !found {
+			return p
+		}
+		if match[2] != "" {
+			return fmt.Sprintf(match[2], value)
+		}
+		return fmt.Sprint(value)
+	})
+	if style, ok := emphasis[id]; ok && m != nil {
+		text = style(m.Style, text)
+	}
+	return text
+}
+
+// Manual Mode messages.
+const (
+	MsgManualModeHeader         MessageID = "manual_mode_header"
+	MsgResumePrompt             MessageID = "resume_prompt"
+	MsgSaveCodePrompt           MessageID = "save_code_prompt"
+	MsgReadSaveFile             MessageID = "read_save_file"
+	MsgLoadFailed               MessageID = "load_failed"
+	MsgResumed                  MessageID = "resumed"
+	MsgPlayerCountPrompt        MessageID = "player_count_prompt"
+	MsgInvalidPlayerCount       MessageID = "invalid_player_count"
+	MsgPlayerNamePrompt         MessageID = "player_name_prompt"
+	MsgDuplicateName            MessageID = "duplicate_name"
+	MsgStartPlayerPrompt        MessageID = "start_player_prompt"
+	MsgSeatPrompt               MessageID = "seat_prompt"
+	MsgSeatStrategyPrompt       MessageID = "seat_strategy_prompt"
+	MsgInvalidSeat              MessageID = "invalid_seat"
+	MsgInvalidSeatStrategy      MessageID = "invalid_seat_strategy"
+	MsgChoicePrompt             MessageID = "choice_prompt"
+	MsgInvalidStartPlayer       MessageID = "invalid_start_player"
+	MsgWinningScorePrompt       MessageID = "winning_score_prompt"
+	MsgInvalidWinningScore      MessageID = "invalid_winning_score"
+	MsgGameStarted              MessageID = "game_started"
+	MsgInputClosed              MessageID = "input_closed"
+	MsgNewRound                 MessageID = "new_round"
+	MsgResumingRound            MessageID = "resuming_round"
+	MsgInitialCardPrompt        MessageID = "initial_card_prompt"
+	MsgTurnHeader               MessageID = "turn_header"
+	MsgCurrentHand              MessageID = "current_hand"
+	MsgTurnPrompt               MessageID = "turn_prompt"
+	MsgFlipThreeCardPrompt      MessageID = "flip_three_card_prompt"
+	MsgFlipThreeProgress        MessageID = "flip_three_progress"
+	MsgAppDraws                 MessageID = "app_draws"
+	MsgAppStays                 MessageID = "app_stays"
+	MsgAppTarget                MessageID = "app_target"
+	MsgInvalidInput             MessageID = "invalid_input"
+	MsgErrorTryAgain            MessageID = "error_try_again"
+	MsgErrorEndingRound         MessageID = "error_ending_round"
+	MsgSaveCode                 MessageID = "save_code"
+	MsgSaveCodeFailed           MessageID = "save_code_failed"
+	MsgCannotStay               MessageID = "cannot_stay"
+	MsgBustRate                 MessageID = "bust_rate"
+	MsgBustWithin               MessageID = "bust_within"
+	MsgMemorySwitched           MessageID = "memory_switched"
+	MsgReshuffledThisRound      MessageID = "reshuffled_this_round"
+	MsgMemoryFull               MessageID = "memory_full"
+	MsgMemoryRound              MessageID = "memory_round"
+	MsgMemoryNone               MessageID = "memory_none"
+	MsgLowDeck                  MessageID = "low_deck"
+	MsgDrawBreakdown            MessageID = "draw_breakdown"
+	MsgFlip7Chance              MessageID = "flip7_chance"
+	MsgOpponentFlip7Threat      MessageID = "opponent_flip7_threat"
+	MsgTurnOutlook              MessageID = "turn_outlook"
+	MsgTurnOutlookAlone         MessageID = "turn_outlook_alone"
+	MsgNoValues                 MessageID = "no_values"
+	MsgSuggestedMove            MessageID = "suggested_move"
+	MsgMoveHit                  MessageID = "move_hit"
+	MsgMoveStay                 MessageID = "move_stay"
+	MsgRoundTargets             MessageID = "round_targets"
+	MsgStayingLeads             MessageID = "staying_leads"
+	MsgNeedToLead               MessageID = "need_to_lead"
+	MsgStayingWins              MessageID = "staying_wins"
+	MsgNeedToWin                MessageID = "need_to_win"
+	MsgLeapfrog                 MessageID = "leapfrog"
+	MsgStayingWinner            MessageID = "staying_winner"
+	MsgWhatIfHeader             MessageID = "what_if_header"
+	MsgWhatIfStay               MessageID = "what_if_stay"
+	MsgWhatIfHitOnce            MessageID = "what_if_hit_once"
+	MsgWhatIfHitTwice           MessageID = "what_if_hit_twice"
+	MsgAnomalyWarning           MessageID = "anomaly_warning"
+	MsgAuditClean               MessageID = "audit_clean"
+	MsgAuditProblems            MessageID = "audit_problems"
+	MsgReshuffle                MessageID = "reshuffle"
+	MsgPlayed                   MessageID = "played"
+	MsgSecondChanceDiscarded    MessageID = "second_chance_discarded"
+	MsgSecondChancePassed       MessageID = "second_chance_passed"
+	MsgSecondChanceRefused      MessageID = "second_chance_refused"
+	MsgSecondChanceUsed         MessageID = "second_chance_used"
+	MsgBusted                   MessageID = "busted"
+	MsgBustedDuplicate          MessageID = "busted_duplicate"
+	MsgBustedDuplicateRisk      MessageID = "busted_duplicate_risk"
+	MsgFlip7                    MessageID = "flip7"
+	MsgActionCancelled          MessageID = "action_cancelled"
+	MsgFreezing                 MessageID = "freezing"
+	MsgFlipThreeOn              MessageID = "flip_three_on"
+	MsgFlipThreeBackfired       MessageID = "flip_three_backfired"
+	MsgNoSecondChanceTarget     MessageID = "no_second_chance_target"
+	MsgNoTarget                 MessageID = "no_target"
+	MsgSelectFreezeTarget       MessageID = "select_freeze_target"
+	MsgSelectFlipThreeTarget    MessageID = "select_flip_three_target"
+	MsgSelectSecondChanceTarget MessageID = "select_second_chance_target"
+	MsgSelectTarget             MessageID = "select_target"
+	MsgBanked                   MessageID = "banked"
+	MsgBankedBreakdown          MessageID = "banked_breakdown"
+	MsgDeckExhausted            MessageID = "deck_exhausted"
+	MsgExhaustedHandDiscarded   MessageID = "exhausted_hand_discarded"
+	MsgGameExhausted            MessageID = "game_exhausted"
+	MsgRoundSummaryHeader       MessageID = "round_summary_header"
+	MsgRoundSummaryPlayer       MessageID = "round_summary_player"
+	MsgRoundSummaryDeck         MessageID = "round_summary_deck"
+	MsgNoWinner                 MessageID = "no_winner"
+	MsgWinners                  MessageID = "winners"
+	MsgWinner                   MessageID = "winner"
+	MsgStandingsHeader          MessageID = "standings_header"
+	MsgStandingsPlayer          MessageID = "standings_player"
+	MsgStandingsMargin          MessageID = "standings_margin"
+	MsgGameStatsHeader          MessageID = "game_stats_header"
+	MsgGameStatsPlayer          MessageID = "game_stats_player"
+	MsgGameStatsProgression     MessageID = "game_stats_progression"
+	MsgGameExported             MessageID = "game_exported"
+	MsgGameExportFailed         MessageID = "game_export_failed"
+	MsgShadowHeader             MessageID = "shadow_header"
+	MsgShadowAgreement          MessageID = "shadow_agreement"
+	MsgShadowNoDecisions        MessageID = "shadow_no_decisions"
+	MsgShadowTurnDiverged       MessageID = "shadow_turn_diverged"
+	MsgShadowTargetDiverged     MessageID = "shadow_target_diverged"
+	MsgMatchGame                MessageID = "match_game"
+	MsgMatchScoreboardHeader    MessageID = "match_scoreboard_header"
+	MsgMatchStanding            MessageID = "match_standing"
+	MsgMatchWinner              MessageID = "match_winner"
+	MsgMatchStopped             MessageID = "match_stopped"
+	MsgDealerOutOfRange         MessageID = "dealer_out_of_range"
+	MsgHistoryPushFailed        MessageID = "history_push_failed"
+	MsgHistory                  MessageID = "history"
+	MsgCannotUndo               MessageID = "cannot_undo"
+	MsgCannotUndoPastRound      MessageID = "cannot_undo_past_round"
+	MsgUndoFailed               MessageID = "undo_failed"
+	MsgUndone                   MessageID = "undone"
+	MsgCannotRedo               MessageID = "cannot_redo"
+	MsgRedoFailed               MessageID = "redo_failed"
+	MsgRedone                   MessageID = "redone"
+)
+
+// Target list messages (WriteTargetOptions).
+const (
+	MsgTargetOption    MessageID = "target_option"
+	MsgTargetYou       MessageID = "target_you"
+	MsgTargetBustRisk  MessageID = "target_bust_risk"
+	MsgTargetBankSelf  MessageID = "target_bank_self"
+	MsgTargetSuggested MessageID = "target_suggested"
+	MsgTargetAdvice    MessageID = "target_advice"
+)
+
+// Suggestion panel messages (SuggestionPanel).
+const (
+	MsgSuggestionPanel      MessageID = "suggestion_panel"
+	MsgSuggestionMajority   MessageID = "suggestion_majority"
+	MsgSuggestionNoMajority MessageID = "suggestion_no_majority"
+)
+
+// Round summary labels (RoundSummary).
+const (
+	MsgRoundEndNoActivePlayers MessageID = "round_end_no_active_players"
+	MsgRoundEndFlip7           MessageID = "round_end_flip7"
+	MsgRoundEndAborted         MessageID = "round_end_aborted"
+	MsgRoundEndExhausted       MessageID = "round_end_exhausted"
+	MsgOutcomeStayed           MessageID = "outcome_stayed"
+	MsgOutcomeBusted           MessageID = "outcome_busted"
+	MsgOutcomeFrozen           MessageID = "outcome_frozen"
+	MsgOutcomeFlip7            MessageID = "outcome_flip7"
+	MsgOutcomeInPlay           MessageID = "outcome_in_play"
+)
+
+// Table status labels (TableStatus).
+const (
+	MsgTableStatusHeader MessageID = "table_status_header"
+	MsgTableColumnPlayer MessageID = "table_column_player"
+	MsgTableColumnStatus MessageID = "table_column_status"
+	MsgTableColumnTotal  MessageID = "table_column_total"
+	MsgTableColumnHand   MessageID = "table_column_hand"
+	MsgTableColumnScore  MessageID = "table_column_score"
+	MsgTableColumnSecond MessageID = "table_column_second_chance"
+	MsgTableDealer       MessageID = "table_dealer"
+	MsgTableStatusActive MessageID = "table_status_active"
+	MsgTableSecondChance MessageID = "table_second_chance"
+	MsgTableNoRound      MessageID = "table_no_round"
+
+	// Holdable-actions house rule (PLAY F / PLAY T).
+	MsgActionHeld         MessageID = "action_held"
+	MsgHeldActions        MessageID = "held_actions"
+	MsgPlayHeldAction     MessageID = "play_held_action"
+	MsgNoHeldAction       MessageID = "no_held_action"
+	MsgInvalidHeldAction  MessageID = "invalid_held_action"
+	MsgHoldableActionsOff MessageID = "holdable_actions_off"
+
+	// Player profiles (ManualGameService.Profiles).
+	MsgYourNamePrompt      MessageID = "your_name_prompt"
+	MsgProfileFound        MessageID = "profile_found"
+	MsgCreateProfilePrompt MessageID = "create_profile_prompt"
+	MsgProfileCreated      MessageID = "profile_created"
+	MsgProfilesUpdated     MessageID = "profiles_updated"
+	MsgProfilesNotUpdated  MessageID = "profiles_not_updated"
+	MsgProfilesSaveFailed  MessageID = "profiles_save_failed"
+)
+
+// HumanStrategy messages.
+const (
+	MsgYourTurn            MessageID = "your_turn"
+	MsgYourHand            MessageID = "your_hand"
+	MsgHandScore           MessageID = "hand_score"
+	MsgBustRisk            MessageID = "bust_risk"
+	MsgHitStayPrompt       MessageID = "hit_stay_prompt"
+	MsgHitStaySavePrompt   MessageID = "hit_stay_save_prompt"
+	MsgInputErrorStaying   MessageID = "input_error_staying"
+	MsgSaveFailed          MessageID = "save_failed"
+	MsgInvalidHitStay      MessageID = "invalid_hit_stay"
+	MsgChooseTargetHeader  MessageID = "choose_target_header"
+	MsgTargetNumberPrompt  MessageID = "target_number_prompt"
+	MsgInputErrorChoosing  MessageID = "input_error_choosing"
+	MsgInvalidTargetChoice MessageID = "invalid_target_choice"
+)
+
+var englishMessages = map[MessageID]string{
+	MsgManualModeHeader:         "\n--- Manual Mode ---",
+	MsgResumePrompt:             "Do you want to resume a game? (Enter save code, file path, or press Enter to start new)",
+	MsgSaveCodePrompt:           "Save Code / File Path: ",
+	MsgReadSaveFile:             "Read save code from file: {path}",
+	MsgLoadFailed:               "Failed to load game: {err}. Starting new game.",
+	MsgResumed:                  "Game resumed successfully!",
+	MsgPlayerCountPrompt:        "Enter number of players: ",
+	MsgInvalidPlayerCount:       "Invalid number of players. Defaulting to 2.",
+	MsgPlayerNamePrompt:         "Enter name for Player {number}: ",
+	MsgDuplicateName:            "{name} is already playing. This player is {unique}.",
+	MsgStartPlayerPrompt:        "Select start player:",
+	MsgSeatPrompt:               "Who plays {name}? 1. At the table (you enter the cards)  2. App AI  3. In the app (press Enter for 1): ",
+	MsgSeatStrategyPrompt:       "Strategy for {name} (press Enter for {default}): ",
+	MsgInvalidSeat:              "Invalid choice. {name} plays at the table.",
+	MsgInvalidSeatStrategy:      "{err}. Using {default}.",
+	MsgChoicePrompt:             "Enter choice: ",
+	MsgInvalidStartPlayer:       "Invalid choice. Defaulting to Me.",
+	MsgWinningScorePrompt:       "Enter winning score (press Enter for {score}): ",
+	MsgInvalidWinningScore:      "Invalid winning score. Defaulting to {score}.",
+	MsgGameStarted:              "Game started!",
+	MsgInputClosed:              "Error reading input. Exiting game.",
+	MsgNewRound:                 "\n--- New Round! Dealer: {dealer} ---",
+	MsgResumingRound:            "Resuming round...",
+	MsgInitialCardPrompt:        "Initial card for {name}: ",
+	MsgTurnHeader:               "\n>>> Turn: {name} (Score: {score})",
+	MsgCurrentHand:              "Current Hand: {hand} | Score: {score}",
+	MsgTurnPrompt:               "Input (0-12, +N, x2, F, T, C, S, W, P/TABLE, MEM, U/UNDO/<, R/REDO/>, HIST, CHECK, SAVE, EMPTY): ",
+	MsgFlipThreeCardPrompt:      "Input card {number}/3 for {name}: ",
+	MsgFlipThreeProgress:        "{name} before card {number}/3: {hand} | Score: {score} | Bust Rate: {rate:%.2f}%",
+	MsgAppDraws:                 "The app draws for {name} from the deck.",
+	MsgAppStays:                 "{name} stays.",
+	MsgAppTarget:                "{name} targets {target}.",
+	MsgInvalidInput:             "Invalid input: {err}. Try again.",
+	MsgErrorTryAgain:            "Error: {err}. Try again.",
+	MsgErrorEndingRound:         "Error: {err}. Ending round.",
+	MsgSaveCode:                 "\n[Save Code]: {code}",
+	MsgSaveCodeFailed:           "\nFailed to generate save code: {err}",
+	MsgCannotStay:               "Invalid move: You must flip at least one card this round before staying!",
+	MsgBustRate:                 "Bust Rate: {rate:%.2f}% ({memory}){note}",
+	MsgBustWithin:               "Bust within: 1 hit {one:%.0f}% | 2 hits {two:%.0f}% | 3 hits {three:%.0f}%",
+	MsgMemorySwitched:           "Memory model: {memory}.",
+	MsgReshuffledThisRound:      " (deck reshuffled this round)",
+	MsgMemoryFull:               "full counting",
+	MsgMemoryRound:              "round memory",
+	MsgMemoryNone:               "no counting",
+	MsgLowDeck:                  "Low deck: {count} card(s) left before the discard pile is reshuffled.",
+	MsgDrawBreakdown:            "Unique numbers: {unique}/7 — safe values remaining: {safe} ({safeCards} cards), unsafe: {unsafe} ({unsafeCards} cards)",
+	MsgFlip7Chance:              "Flip 7 on the next number card: {chance:%.1f}%",
+	MsgOpponentFlip7Threat:      "Opponent Flip7 threat: ~{chance:%.0f}% this rotation",
+	MsgTurnOutlook:              "~{turns:%.1f} more turns expected; {chance:%.0f}% chance the round ends before your next turn",
+	MsgTurnOutlookAlone:         "You are the last player in the round: you get as many more turns as you choose",
+	MsgNoValues:                 "none",
+	MsgSuggestedMove:            "Suggested Move: {move}",
+	MsgMoveHit:                  "hit",
+	MsgMoveStay:                 "stay",
+	MsgRoundTargets:             "{lead}, {win}{leapfrog}",
+	MsgStayingLeads:             "Staying now leads",
+	MsgNeedToLead:               "Need +{points} to lead",
+	MsgStayingWins:              "staying now reaches the winning score",
+	MsgNeedToWin:                "+{points} to win",
+	MsgLeapfrog:                 "; {name} would pass by staying now (+{points})",
+	MsgStayingWinner:            "⚠ {name} reaches {total} by staying now — consider Flip Three on {name} (a Freeze would bank it)",
+	MsgWhatIfHeader:             "--- What-if for {name} ---",
+	MsgWhatIfStay:               "Stay now : {points} pts",
+	MsgWhatIfHitOnce:            "Hit once : bust {bust:%.1f}% | Flip 7 {flip7:%.1f}% | E[score|safe] {safe:%.1f} | E[score] {expected:%.1f}",
+	MsgWhatIfHitTwice:           "Hit twice: bust {bust:%.1f}% | E[score] {expected:%.1f}",
+	MsgAnomalyWarning:           "Check the table: {problem}. A card may be missing or entered for the wrong player (U to undo).",
+	MsgAuditClean:               "Check: every hand is consistent with the rules.",
+	MsgAuditProblems:            "Check: {count} problem(s) found:",
+	MsgReshuffle:                "Card not found in current deck. Attempting to reshuffle {count} cards from discard pile...",
+	MsgPlayed:                   "Played: {card}",
+	MsgSecondChanceDiscarded:    "All other active players already have a Second Chance. Discarding card.\n(Remove the Second Chance card from play)",
+	MsgSecondChancePassed:       "{name} already has a Second Chance! Giving it to {target}\n(Give the Second Chance card to {target})",
+	MsgSecondChanceRefused:      "{name} is out of the round and cannot take the Second Chance. Discarding card.\n(Remove the Second Chance card from play)",
+	MsgSecondChanceUsed:         "Second Chance used! Remove {count} card(s) from play: {cards}",
+	MsgBusted:                   "BUSTED!",
+	MsgBustedDuplicate:          "BUSTED! Duplicate {value}",
+	MsgBustedDuplicateRisk:      "BUSTED! Duplicate {value} — you had a {rate:%.0f}% bust chance",
+	MsgFlip7:                    "FLIP 7!",
+	MsgActionCancelled:          "No target selected (or invalid). Action cancelled (card still played).",
+	MsgFreezing:                 "Freezing {name}!",
+	MsgFlipThreeOn:              "Flip Three on {name}! They must draw 3 cards.",
+	MsgFlipThreeBackfired:       "{actor}'s Flip Three backfired: {target} completed Flip 7.",
+	MsgNoSecondChanceTarget:     "No valid targets available: All other players already have a Second Chance card.",
+	MsgNoTarget:                 "No active players available to target.",
+	MsgSelectFreezeTarget:       "Select target to Freeze:",
+	MsgSelectFlipThreeTarget:    "Select target for Flip Three:",
+	MsgSelectSecondChanceTarget: "Select player to give Second Chance to:",
+	MsgSelectTarget:             "Select Target:",
+	MsgBanked:                   "{name} banked {points} points! Total: {total}",
+	MsgBankedBreakdown:          "Banked {points} = {breakdown}",
+	MsgDeckExhausted:            "No card left to draw: the deck and the discard pile are empty. The round ends.",
+	MsgExhaustedHandDiscarded:   "{name}'s hand is discarded (0 points).",
+	MsgGameExhausted:            "No cards are left to play on. The highest total score wins.",
+	MsgRoundSummaryHeader:       "\n--- Round {round} Summary ({reason}) ---",
+	MsgRoundSummaryPlayer:       " - {name}: {hand} {outcome} | +{points} | Total: {total}",
+	MsgRoundSummaryDeck:         "Cards left in the deck: {count}",
+	MsgNoWinner:                 "Game Over. No winner determined.",
+	MsgWinners:                  "Game Over. Winner(s):",
+	MsgWinner:                   " - {name} with {score} points",
+	MsgStandingsHeader:          "Final Standings:",
+	MsgStandingsPlayer:          " {rank}. {name}: {score}{margin}",
+	MsgStandingsMargin:          " ({margin} behind)",
+	MsgGameStatsHeader:          "\n--- Game Statistics ({rounds} rounds) ---",
+	MsgGameStatsPlayer:          " - {name}: {total} points | best round +{best} | busts {busts} | Flip 7s {flip7s} | Freezes given {given}, received {received} | Second Chances used {secondChances}",
+	MsgGameStatsProgression:     "   Scores: {progression}",
+	MsgGameExported:             "Final game state exported to {path}",
+	MsgGameExportFailed:         "Failed to export the game to {path}: {err}",
+	MsgShadowHeader:             "--- Shadow Advisors ---",
+	MsgShadowAgreement:          "{advisor}: agreed on {agreed} of {decisions} decisions ({rate:%.0f}%)",
+	MsgShadowNoDecisions:        "{advisor}: no decisions to compare",
+	MsgShadowTurnDiverged:       " - Round {round}, {name}: {advisor} would {shadow}, you chose {chosen}",
+	MsgShadowTargetDiverged:     " - Round {round}, {name}'s {action}: {advisor} would target {shadow}, you chose {chosen}",
+	MsgMatchGame:                "\n=== Game {game} of the series (best of {best_of}) ===",
+	MsgMatchScoreboardHeader:    "\n--- Series after game {game} (best of {best_of}) ---",
+	MsgMatchStanding:            " - {name}: {wins} win(s), {points} points in all",
+	MsgMatchWinner:              "Series winner(s): {names}",
+	MsgMatchStopped:             "The series stopped during game {game} (best of {best_of}).",
+	MsgDealerOutOfRange:         "Warning: dealer index {index} is out of range for {count} players. Resetting dealer to {name}.",
+	MsgHistoryPushFailed:        "Warning: Failed to save state for history: {err}",
+	MsgHistory:                  "History: {undo} undo step(s), {redo} redo step(s) available ({kept} of at most {max} states kept).",
+	MsgCannotUndo:               "Cannot undo: No previous state.",
+	MsgCannotUndoPastRound:      "Cannot undo past the start of this round: the previous round is already scored.",
+	MsgUndoFailed:               "Error undoing state: {err}",
+	MsgUndone:                   "Undid last action.",
+	MsgCannotRedo:               "Cannot redo: No future state.",
+	MsgRedoFailed:               "Error redoing state: {err}",
+	MsgRedone:                   "Redid action.",
+
+	MsgTargetOption:    "{name} (Score: {score}) Hand: {hand}",
+	MsgTargetYou:       "{name} (You)",
+	MsgTargetBustRisk:  " | Bust risk: {risk:%.0f}%",
+	MsgTargetBankSelf:  " (bank your current {points} points)",
+	MsgTargetSuggested: " [Suggested]",
+	MsgTargetAdvice:    " ({advice})",
+
+	MsgSuggestionPanel:      "Suggestions — {advice} → {majority}",
+	MsgSuggestionMajority:   "majority: {move} ({votes} of {count})",
+	MsgSuggestionNoMajority: "no majority",
+
+	MsgRoundEndNoActivePlayers: "every player is out",
+	MsgRoundEndFlip7:           "Flip 7",
+	MsgRoundEndAborted:         "aborted",
+	MsgRoundEndExhausted:       "no card left to draw",
+	MsgOutcomeStayed:           "stayed",
+	MsgOutcomeBusted:           "busted",
+	MsgOutcomeFrozen:           "frozen",
+	MsgOutcomeFlip7:            "FLIP 7",
+	MsgOutcomeInPlay:           "still in play",
+	MsgTableStatusHeader:       "\n--- Table: round {round} | deck {deck} | discard {discard} ---",
+	MsgTableColumnPlayer:       "Player",
+	MsgTableColumnStatus:       "Status",
+	MsgTableColumnTotal:        "Total",
+	MsgTableColumnHand:         "Hand",
+	MsgTableColumnScore:        "Hand score",
+	MsgTableColumnSecond:       "SC",
+	MsgTableDealer:             "{name} (dealer)",
+	MsgTableStatusActive:       "active",
+	MsgTableSecondChance:       "yes",
+	MsgTableNoRound:            "No round is in progress.",
+
+	MsgActionHeld:         "{name} holds {card} for a later turn.",
+	MsgHeldActions:        "Held: {cards}. Type PLAY F or PLAY T to play one before hitting or staying.",
+	MsgPlayHeldAction:     "{name} plays the held {card}.",
+	MsgNoHeldAction:       "{name} does not hold {card}.",
+	MsgInvalidHeldAction:  "PLAY takes F (Freeze) or T (Flip Three), e.g. PLAY F.",
+	MsgHoldableActionsOff: "Action cards cannot be held in this game: they are played when drawn.",
+
+	MsgYourNamePrompt:      "Enter your name (press Enter for Me): ",
+	MsgProfileFound:        "Welcome back, {name}! {games} games played, {wins} won.",
+	MsgCreateProfilePrompt: "No profile named {name}. Create one? (y/N): ",
+	MsgProfileCreated:      "Created a profile for {name}.",
+	MsgProfilesUpdated:     "Profiles updated: {names}.",
+	MsgProfilesNotUpdated:  "The game did not finish, so the profiles are not updated.",
+	MsgProfilesSaveFailed:  "Failed to save the profiles to {path}: {err}",
+
+	MsgYourTurn:            "\n--- Your Turn ---",
+	MsgYourHand:            "Your Hand: {hand}",
+	MsgHandScore:           "Current Hand Score: {score} (Total Banked: {banked})",
+	MsgBustRisk:            "Estimated Risk of Bust: {risk:%.2f}%",
+	MsgHitStayPrompt:       "Choose action (hit/stay): ",
+	MsgHitStaySavePrompt:   "Choose action (hit/stay/save): ",
+	MsgInputErrorStaying:   "Warning: error reading input ({err}). Staying.",
+	MsgSaveFailed:          "Failed to save: {err}",
+	MsgInvalidHitStay:      "Invalid input. Please enter 'hit' or 'stay'.",
+	MsgChooseTargetHeader:  "\n--- Choose Target for {action} ---",
+	MsgTargetNumberPrompt:  "Enter number (1-{count}): ",
+	MsgInputErrorChoosing:  "Warning: error reading input ({err}). Choosing {name}.",
+	MsgInvalidTargetChoice: "Invalid selection: {err}.",
+}
@@ -0,0 +1,195 @@
+package console
+
+// japaneseMessages is the Japanese catalog. Card tokens and commands (S, U, SAVE, hit, stay, ...)
+// are typed the same in every language, so they are left untranslated.
+var japaneseMessages = map[MessageID]string{
+	MsgManualModeHeader:         "\n--- マニュアルモード ---",
+	MsgResumePrompt:             "ゲームを再開しますか？（セーブコードかファイルパスを入力、新しく始める場合はそのまま Enter）",
+	MsgSaveCodePrompt:           "セーブコード / ファイルパス: ",
+	MsgReadSaveFile:             "ファイル {path} からセーブコードを読み込みました",
+	MsgLoadFailed:               "ゲームを読み込めませんでした: {err}。新しいゲームを始めます。",
+	MsgResumed:                  "ゲームを再開しました！",
+	MsgPlayerCountPrompt:        "プレイヤー数を入力: ",
+	MsgInvalidPlayerCount:       "プレイヤー数が不正です。2人で始めます。",
+	MsgPlayerNamePrompt:         "プレイヤー{number}の名前を入力: ",
+	MsgDuplicateName:            "{name}はすでに参加しています。このプレイヤーは{unique}です。",
+	MsgStartPlayerPrompt:        "最初の親を選択:",
+	MsgSeatPrompt:               "{name}の席: 1. テーブル（カードを入力） 2. アプリのAI 3. アプリで参加（Enterで1）: ",
+	MsgSeatStrategyPrompt:       "{name}の戦略（Enterで{default}）: ",
+	MsgInvalidSeat:              "無効な選択です。{name}はテーブルで参加します。",
+	MsgInvalidSeatStrategy:      "{err}。{default}を使います。",
+	MsgChoicePrompt:             "番号を入力: ",
+	MsgInvalidStartPlayer:       "選択が不正です。Me から始めます。",
+	MsgWinningScorePrompt:       "勝利点を入力（Enter で {score}）: ",
+	MsgInvalidWinningScore:      "勝利点が不正です。{score}点にします。",
+	MsgGameStarted:              "ゲーム開始！",
+	MsgInputClosed:              "入力を読み込めません。ゲームを終了します。",
+	MsgNewRound:                 "\n--- 新しいラウンド！ 親: {dealer} ---",
+	MsgResumingRound:            "ラウンドを再開します...",
+	MsgInitialCardPrompt:        "{name}の最初のカード: ",
+	MsgTurnHeader:               "\n>>> {name}の番（得点: {score}）",
+	MsgCurrentHand:              "現在の手札: {hand} | 得点: {score}",
+	MsgTurnPrompt:               "入力 (0-12, +N, x2, F, T, C, S, W, P/TABLE, MEM, U/UNDO/<, R/REDO/>, HIST, CHECK, SAVE, EMPTY): ",
+	MsgFlipThreeCardPrompt:      "{name}の{number}/3枚目のカードを入力: ",
+	MsgFlipThreeProgress:        "{name}の{number}/3枚目の前: {hand} | 得点: {score} | バースト率: {rate:%.2f}%",
+	MsgAppDraws:                 "アプリが{name}の分を山札から引きます。",
+	MsgAppStays:                 "{name}はステイ。",
+	MsgAppTarget:                "{name}は{target}を対象にしました。",
+	MsgInvalidInput:             "入力が不正です: {err}。もう一度入力してください。",
+	MsgErrorTryAgain:            "エラー: {err}。もう一度入力してください。",
+	MsgErrorEndingRound:         "エラー: {err}。ラウンドを終了します。",
+	MsgSaveCode:                 "\n[セーブコード]: {code}",
+	MsgSaveCodeFailed:           "\nセーブコードを作成できませんでした: {err}",
+	MsgCannotStay:               "その操作はできません: ステイする前に、このラウンドで少なくとも1枚めくってください！",
+	MsgBustRate:                 "バースト率: {rate:%.2f}%（{memory}）{note}",
+	MsgBustWithin:               "続けて引いた場合のバースト率: 1枚 {one:%.0f}% | 2枚 {two:%.0f}% | 3枚 {three:%.0f}%",
+	MsgMemorySwitched:           "記憶モデル: {memory}。",
+	MsgReshuffledThisRound:      "（このラウンドで山札を再シャッフル）",
+	MsgMemoryFull:               "すべて記憶",
+	MsgMemoryRound:              "このラウンドのみ記憶",
+	MsgMemoryNone:               "記憶なし",
+	MsgLowDeck:                  "山札が少なくなっています: 捨て札をシャッフルするまで残り{count}枚です。",
+	MsgDrawBreakdown:            "数字の種類: {unique}/7 — 安全な残り: {safe}（{safeCards}枚）、危険: {unsafe}（{unsafeCards}枚）",
+	MsgFlip7Chance:              "次の数字カードで Flip 7: {chance:%.1f}%",
+	MsgOpponentFlip7Threat:      "相手の Flip 7 の危険: この一巡で約{chance:%.0f}%",
+	MsgTurnOutlook:              "残りの手番は約{turns:%.1f}回の見込み。次の手番の前にラウンドが終わる確率は{chance:%.0f}%",
+	MsgTurnOutlookAlone:         "ラウンドに残っているのはあなただけです。好きなだけ手番を続けられます",
+	MsgNoValues:                 "なし",
+	MsgSuggestedMove:            "おすすめ: {move}",
+	MsgMoveHit:                  "ヒット",
+	MsgMoveStay:                 "ステイ",
+	MsgRoundTargets:             "{lead}、{win}{leapfrog}",
+	MsgStayingLeads:             "今ステイすればトップ",
+	MsgNeedToLead:               "トップまであと+{points}",
+	MsgStayingWins:              "今ステイすれば勝利点に到達",
+	MsgNeedToWin:                "勝利まであと+{points}",
+	MsgLeapfrog:                 "。{name}が今ステイすると逆転されます（+{points}）",
+	MsgStayingWinner:            "⚠ {name}は今ステイすれば{total}点に到達 — {name}にフリップスリーを検討（フリーズすると得点が確定します）",
+	MsgWhatIfHeader:             "--- {name}のもしも分析 ---",
+	MsgWhatIfStay:               "今ステイ  : {points}点",
+	MsgWhatIfHitOnce:            "1回ヒット: バースト {bust:%.1f}% | Flip 7 {flip7:%.1f}% | 期待値(安全時) {safe:%.1f} | 期待値 {expected:%.1f}",
+	MsgWhatIfHitTwice:           "2回ヒット: バースト {bust:%.1f}% | 期待値 {expected:%.1f}",
+	MsgAnomalyWarning:           "テーブルを確認してください: {problem}。カードの入力漏れか、別のプレイヤーへの入力ミスかもしれません（U で取り消し）。",
+	MsgAuditClean:               "チェック: すべての手札がルールと一致しています。",
+	MsgAuditProblems:            "チェック: {count}件の問題が見つかりました:",
+	MsgReshuffle:                "山札にそのカードがありません。捨て札{count}枚をシャッフルして山札にします...",
+	MsgPlayed:                   "出たカード: {card}",
+	MsgSecondChanceDiscarded:    "他の参加中のプレイヤーは全員セカンドチャンスを持っています。このカードは捨て札にします。\n（セカンドチャンスのカードを場から取り除いてください）",
+	MsgSecondChancePassed:       "{name}はすでにセカンドチャンスを持っています！ {target}に渡します\n（セカンドチャンスのカードを{target}に渡してください）",
+	MsgSecondChanceRefused:      "{name}はこのラウンドから抜けているため、セカンドチャンスを受け取れません。捨て札にします。\n（セカンドチャンスのカードを場から除いてください）",
+	MsgSecondChanceUsed:         "セカンドチャンスを使いました！ {count}枚のカードを場から取り除いてください: {cards}",
+	MsgBusted:                   "バースト！",
+	MsgBustedDuplicate:          "バースト！ {value}が重複しました",
+	MsgBustedDuplicateRisk:      "バースト！ {value}が重複しました — バースト率は{rate:%.0f}%でした",
+	MsgFlip7:                    "FLIP 7！",
+	MsgActionCancelled:          "対象が選ばれていない（または不正な）ため、アクションは無効になりました（カードは使用済みです）。",
+	MsgFreezing:                 "{name}をフリーズ！",
+	MsgFlipThreeOn:              "{name}にフリップスリー！ 3枚引かなければなりません。",
+	MsgFlipThreeBackfired:       "{actor}のフリップスリーが裏目に出ました: {target}が Flip 7 を達成しました。",
+	MsgNoSecondChanceTarget:     "渡せる相手がいません: 他のプレイヤーは全員セカンドチャンスを持っています。",
+	MsgNoTarget:                 "対象にできる参加中のプレイヤーがいません。",
+	MsgSelectFreezeTarget:       "フリーズする対象を選択:",
+	MsgSelectFlipThreeTarget:    "フリップスリーの対象を選択:",
+	MsgSelectSecondChanceTarget: "セカンドチャンスを渡すプレイヤーを選択:",
+	MsgSelectTarget:             "対象を選択:",
+	MsgBanked:                   "{name}は{points}点を獲得！ 合計: {total}",
+	MsgBankedBreakdown:          "獲得 {points} = {breakdown}",
+	MsgDeckExhausted:            "引けるカードがありません: 山札も捨て札も空です。ラウンドを終了します。",
+	MsgExhaustedHandDiscarded:   "{name}の手札は捨てられます (0点)。",
+	MsgGameExhausted:            "これ以上プレイできるカードがありません。合計点が最も高いプレイヤーの勝ちです。",
+	MsgRoundSummaryHeader:       "\n--- ラウンド{round}の結果（{reason}）---",
+	MsgRoundSummaryPlayer:       " - {name}: {hand} {outcome} | +{points} | 合計: {total}",
+	MsgRoundSummaryDeck:         "山札の残り: {count}枚",
+	MsgNoWinner:                 "ゲーム終了。勝者は決まりませんでした。",
+	MsgWinners:                  "ゲーム終了。勝者:",
+	MsgWinner:                   " - {name}（{score}点）",
+	MsgStandingsHeader:          "最終順位:",
+	MsgStandingsPlayer:          " {rank}位 {name}: {score}点{margin}",
+	MsgStandingsMargin:          "（トップと{margin}点差）",
+	MsgGameStatsHeader:          "\n--- ゲームの統計（{rounds}ラウンド）---",
+	MsgGameStatsPlayer:          " - {name}: {total}点 | 最高ラウンド +{best} | バースト {busts} | フリップ7 {flip7s} | フリーズ 使用 {given}・被弾 {received} | セカンドチャンス使用 {secondChances}",
+	MsgGameStatsProgression:     "   得点推移: {progression}",
+	MsgGameExported:             "最終状態を{path}に書き出しました",
+	MsgGameExportFailed:         "{path}への書き出しに失敗しました: {err}",
+	MsgShadowHeader:             "--- シャドウ比較 ---",
+	MsgShadowAgreement:          "{advisor}: {decisions} 回中 {agreed} 回一致（{rate:%.0f}%）",
+	MsgShadowNoDecisions:        "{advisor}: 比較できる判断はありません",
+	MsgShadowTurnDiverged:       " - ラウンド{round}、{name}: {advisor} なら{shadow}、あなたは{chosen}",
+	MsgShadowTargetDiverged:     " - ラウンド{round}、{name} の {action}: {advisor} なら {shadow} を対象に、あなたは {chosen}",
+	MsgMatchGame:                "\n=== シリーズ第{game}ゲーム（{best_of}番勝負）===",
+	MsgMatchScoreboardHeader:    "\n--- 第{game}ゲーム終了時のシリーズ成績（{best_of}番勝負）---",
+	MsgMatchStanding:            " - {name}: {wins}勝、合計{points}点",
+	MsgMatchWinner:              "シリーズ優勝: {names}",
+	MsgMatchStopped:             "シリーズは第{game}ゲームの途中で中断しました（{best_of}番勝負）。",
+	MsgDealerOutOfRange:         "警告: 親の番号 {index} が{count}人のプレイヤーの範囲外です。親を{name}に戻します。",
+	MsgHistoryPushFailed:        "警告: 履歴に状態を保存できませんでした: {err}",
+	MsgHistory:                  "履歴: 取り消し{undo}回、やり直し{redo}回が可能です（保存中の状態 {kept} / 最大 {max}）。",
+	MsgCannotUndo:               "取り消せません: 前の状態がありません。",
+	MsgCannotUndoPastRound:      "このラウンドの開始より前には取り消せません: 前のラウンドは精算済みです。",
+	MsgUndoFailed:               "取り消しに失敗しました: {err}",
+	MsgUndone:                   "直前の操作を取り消しました。",
+	MsgCannotRedo:               "やり直せません: 次の状態がありません。",
+	MsgRedoFailed:               "やり直しに失敗しました: {err}",
+	MsgRedone:                   "操作をやり直しました。",
+
+	MsgTargetOption:    "{name}（得点: {score}）手札: {hand}",
+	MsgTargetYou:       "{name}（あなた）",
+	MsgTargetBustRisk:  " | バースト率: {risk:%.0f}%",
+	MsgTargetBankSelf:  "（今の{points}点を獲得）",
+	MsgTargetSuggested: " [おすすめ]",
+	MsgTargetAdvice:    "（{advice}）",
+
+	MsgSuggestionPanel:      "提案 — {advice} → {majority}",
+	MsgSuggestionMajority:   "多数: {move}（{count}人中{votes}人）",
+	MsgSuggestionNoMajority: "多数なし",
+
+	MsgRoundEndNoActivePlayers: "全員が終了",
+	MsgRoundEndFlip7:           "Flip 7 達成",
+	MsgRoundEndAborted:         "中断",
+	MsgRoundEndExhausted:       "引けるカードなし",
+	MsgOutcomeStayed:           "ステイ",
+	MsgOutcomeBusted:           "バースト",
+	MsgOutcomeFrozen:           "フリーズ",
+	MsgOutcomeFlip7:            "FLIP 7",
+	MsgOutcomeInPlay:           "プレイ中",
+	MsgTableStatusHeader:       "\n--- テーブル: ラウンド{round} | 山札 {deck} | 捨て札 {discard} ---",
+	MsgTableColumnPlayer:       "プレイヤー",
+	MsgTableColumnStatus:       "状態",
+	MsgTableColumnTotal:        "合計",
+	MsgTableColumnHand:         "手札",
+	MsgTableColumnScore:        "手札の得点",
+	MsgTableColumnSecond:       "SC",
+	MsgTableDealer:             "{name}（親）",
+	MsgTableStatusActive:       "プレイ中",
+	MsgTableSecondChance:       "あり",
+	MsgTableNoRound:            "進行中のラウンドはありません。",
+
+	MsgActionHeld:         "{name}は{card}を後の番のために持っておきます。",
+	MsgHeldActions:        "保持中: {cards}。ヒットかステイの前に使うには PLAY F または PLAY T と入力してください。",
+	MsgPlayHeldAction:     "{name}は保持していた{card}を使います。",
+	MsgNoHeldAction:       "{name}は{card}を持っていません。",
+	MsgInvalidHeldAction:  "PLAY には F（フリーズ）か T（フリップスリー）を指定してください。例: PLAY F",
+	MsgHoldableActionsOff: "このゲームではアクションカードを保持できません。引いたときに使います。",
+
+	MsgYourNamePrompt:      "あなたの名前を入力（Enter で Me）: ",
+	MsgProfileFound:        "おかえりなさい、{name}さん！ これまで{games}ゲーム、{wins}勝。",
+	MsgCreateProfilePrompt: "{name}のプロフィールはありません。作成しますか？ (y/N): ",
+	MsgProfileCreated:      "{name}のプロフィールを作成しました。",
+	MsgProfilesUpdated:     "プロフィールを更新しました: {names}。",
+	MsgProfilesNotUpdated:  "ゲームが終わらなかったため、プロフィールは更新しません。",
+	MsgProfilesSaveFailed:  "プロフィールを {path} に保存できませんでした: {err}",
+
+	MsgYourTurn:            "\n--- あなたの番 ---",
+	MsgYourHand:            "あなたの手札: {hand}",
+	MsgHandScore:           "手札の得点: {score}（獲得済み: {banked}）",
+	MsgBustRisk:            "バーストの危険: {risk:%.2f}%",
+	MsgHitStayPrompt:       "行動を選択 (hit/stay): ",
+	MsgHitStaySavePrompt:   "行動を選択 (hit/stay/save): ",
+	MsgInputErrorStaying:   "警告: 入力を読み込めません（{err}）。ステイします。",
+	MsgSaveFailed:          "保存できませんでした: {err}",
+	MsgInvalidHitStay:      "入力が不正です。'hit' か 'stay' を入力してください。",
+	MsgChooseTargetHeader:  "\n--- {action}の対象を選択 ---",
+	MsgTargetNumberPrompt:  "番号を入力 (1-{count}): ",
+	MsgInputErrorChoosing:  "警告: 入力を読み込めません（{err}）。{name}を選びます。",
+	MsgInvalidTargetChoice: "選択が不正です: {err}。",
+}
@@ -0,0 +1,106 @@
+package console
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func placeholders(template string) []string {
+	var names []string
+	for _, m := range placeholder.FindAllStringSubmatch(template, -1) {
+		names = append(names, m[1])
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestMessages_JapaneseCatalogIsComplete(t *testing.T) {
+	for id, en := range englishMessages {
+		ja, ok := japaneseMessages[id]
+		if !ok {
+			t.Errorf("%s has no Japanese translation", id)
+			continue
+		}
+		if !reflect.DeepEqual(placeholders(en), placeholders(ja)) {
+			t.Errorf("%s: Japanese placeholders %v differ from English %v", id, placeholders(ja), placeholders(en))
+		}
+	}
+	for id := range japaneseMessages {
+		if _, ok := englishMessages[id]; !ok {
+			t.Errorf("%s is translated but has no English message", id)
+		}
+	}
+}
+
+func TestMessages_Format(t *testing.T) {
+	en := NewMessages(LanguageEnglish)
+	ja := NewMessages(LanguageJapanese)
+
+	tests := []struct {
+		name     string
+		messages *Messages
+		id       MessageID
+		args     Args
+		want     string
+	}{
+		{"turn header", en, MsgTurnHeader, Args{"name": "Bob", "score": 42}, "\n>>> Turn: Bob (Score: 42)"},
+		// Japanese puts the name first and drops "Turn"; the values follow their names, not their position.
+		{"turn header in Japanese", ja, MsgTurnHeader, Args{"name": "Bob", "score": 42}, "\n>>> Bobの番（得点: 42）"},
+		{"verb survives translation", ja, MsgBustRate, Args{"rate": 4.3478, "memory": "すべて記憶", "note": ""}, "バースト率: 4.35%（すべて記憶）"},
+		{"reordered values", ja, MsgFlipThreeCardPrompt, Args{"number": 2, "name": "Bob"}, "Bobの2/3枚目のカードを入力: "},
+		{"nil renders English", nil, MsgSelectFreezeTarget, nil, "Select target to Freeze:"},
+		{"missing value is left as is", en, MsgFreezing, nil, "Freezing {name}!"},
+		{"unknown message shows its ID", ja, MessageID("no_such_message"), nil, "no_such_message"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.messages.Format(tt.id, tt.args); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		name    string
+		want    Language
+		wantErr bool
+	}{
+		{"", LanguageEnglish, false},
+		{"en", LanguageEnglish, false},
+		{"ja", LanguageJapanese, false},
+		{"ja_JP.UTF-8", LanguageJapanese, false},
+		{"JA", LanguageJapanese, false},
+		{"fr", LanguageEnglish, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseLanguage(tt.name)
+		if got != tt.want || (err != nil) != tt.wantErr {
+			t.Errorf("ParseLanguage(%q) = %q, %v; expected %q (error: %v)", tt.name, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestHumanStrategy_ChooseTargetInJapanese(t *testing.T) {
+	var out strings.Builder
+	h := NewHumanStrategyWithIO(strings.NewReader("2\n"), &out)
+	h.Messages = NewMessages(LanguageJapanese)
+	me := domain.NewPlayer("Me", h)
+	bob := domain.NewPlayer("Bob", nil)
+	me.StartNewRound()
+	bob.StartNewRound()
+
+	if got := h.ChooseTarget(domain.ActionFreeze, []*domain.Player{me, bob}, me); got != bob {
+		t.Fatalf("Expected Bob to be chosen, got %v", got)
+	}
+	for _, want := range []string{"--- freezeの対象を選択 ---", "1. Me（あなた）（得点: 0）手札: []（今の0点を獲得）", "番号を入力 (1-2): "} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("Expected the prompt to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package console
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// progressBarWidth is the number of cells in the bar.
+const progressBarWidth = 30
+
+// ProgressBar renders the progress of a long run on a single terminal line,
+// redrawing it in place with a carriage return.
+type ProgressBar struct {
+	out     io.Writer
+	start   time.Time
+	now     func() time.Time
+	lastLen int // Length of the line drawn last, so a shorter one can blank out its tail
+}
+
+// NewProgressBar creates a ProgressBar that writes to out and measures the ETA from now.
+func NewProgressBar(out io.Writer) *ProgressBar {
+	return &ProgressBar{out: out, start: time.Now(), now: time.Now}
+}
+
+// Update redraws the bar for done of total. Once done reaches total the line is finished
+// with the elapsed time and a newline, and the next Update starts a new run.
+func (b *ProgressBar) Update(done, total int) {
+	if total <= 0 {
+		return
+	}
+	elapsed := b.now().Sub(b.start)
+	filled := done * progressBarWidth / total
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", progressBarWidth-filled)
+	line := fmt.Sprintf("[%s] %3d%% %d/%d", bar, done*100/total, done, total)
+
+	if done >= total {
+		b.draw(fmt.Sprintf("%s in %s", line, elapsed.Round(time.Second)))
+		fmt.Fprintln(b.out)
+		b.start = b.now()
+		b.lastLen = 0
+		return
+	}
+	eta := "?"
+	if done > 0 {
+		eta = (elapsed * time.Duration(total-done) / time.Duration(done)).Round(time.Second).String()
+	}
+	b.draw(fmt.Sprintf("%s ETA %s", line, eta))
+}
+
+// draw replaces the current line with line.
+func (b *ProgressBar) draw(line string) {
+	padding := ""
+	if n := b.lastLen - len(line); n > 0 {
+		padding = strings.Repeat(" ", n)
+	}
+	fmt.Fprintf(b.out, "\r%s%s", line, padding)
+	b.lastLen = len(line)
+}
@@ -0,0 +1,38 @@
+package console
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProgressBar_Update(t *testing.T) {
+	clock := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var out strings.Builder
+	b := &ProgressBar{out: &out, start: clock, now: func() time.Time { return clock }}
+
+	b.Update(0, 100)
+	if got := out.String(); got != "\r[------------------------------]   0% 0/100 ETA ?" {
+		t.Errorf("Unexpected start line %q", got)
+	}
+
+	out.Reset()
+	clock = clock.Add(10 * time.Second)
+	b.Update(1, 100)
+	if got := out.String(); got != "\r[------------------------------]   1% 1/100 ETA 16m30s" {
+		t.Errorf("Unexpected line %q", got)
+	}
+
+	out.Reset()
+	b.Update(50, 100)
+	if got := out.String(); got != "\r[###############---------------]  50% 50/100 ETA 10s  " {
+		t.Errorf("Unexpected halfway line %q (padded over the longer line before it)", got)
+	}
+
+	out.Reset()
+	clock = clock.Add(10 * time.Second)
+	b.Update(100, 100)
+	if got := out.String(); got != "\r[##############################] 100% 100/100 in 20s\n" {
+		t.Errorf("Unexpected final line %q", got)
+	}
+}
@@ -0,0 +1,60 @@
+package console
+
+import (
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// FormatRoundSummary renders the recap of a round that ended, one line per player with their
+// final hand, how the round ended for them, the points banked and their new total, then the
+// number of cards left in the deck. Every line ends with a newline.
+func FormatRoundSummary(summary domain.RoundSummary) string {
+	return (*Messages)(nil).RoundSummary(summary)
+}
+
+// RoundSummary is FormatRoundSummary in the language of m.
+func (m *Messages) RoundSummary(summary domain.RoundSummary) string {
+	var b strings.Builder
+	b.WriteString(m.Format(MsgRoundSummaryHeader, Args{"round": summary.Number, "reason": m.Format(roundEndReasonMessage(summary.Reason), nil)}))
+	b.WriteString("\n")
+	for _, r := range summary.Results {
+		b.WriteString(m.Format(MsgRoundSummaryPlayer, Args{
+			"name":    r.Player.Name,
+			"hand":    DisplayHand(r.Hand),
+			"outcome": m.Format(roundOutcomeMessage(r.Outcome), nil),
+			"points":  r.Banked,
+			"total":   r.Total,
+		}))
+		b.WriteString("\n")
+	}
+	b.WriteString(m.Format(MsgRoundSummaryDeck, Args{"count": summary.DeckRemaining}))
+	b.WriteString("\n")
+	return b.String()
+}
+
+func roundEndReasonMessage(reason domain.RoundEndReason) MessageID {
+	switch reason {
+	case domain.RoundEndReasonFlip7:
+		return MsgRoundEndFlip7
+	case domain.RoundEndReasonAborted:
+		return MsgRoundEndAborted
+	case domain.RoundEndReasonExhausted:
+		return MsgRoundEndExhausted
+	}
+	return MsgRoundEndNoActivePlayers
+}
+
+func roundOutcomeMessage(outcome domain.RoundOutcome) MessageID {
+	switch outcome {
+	case domain.RoundOutcomeBusted:
+		return MsgOutcomeBusted
+	case domain.RoundOutcomeFrozen:
+		return MsgOutcomeFrozen
+	case domain.RoundOutcomeFlip7:
+		return MsgOutcomeFlip7
+	case domain.RoundOutcomeInPlay:
+		return MsgOutcomeInPlay
+	}
+	return MsgOutcomeStayed
+}
@@ -0,0 +1,58 @@
+package console
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// Style emphasizes text with ANSI escape codes when Color is set, and returns it unchanged
+// otherwise. The output only depends on the flag, so tests keep it off (the zero value) for
+// stable output, while ColorEnabled decides it for a real writer.
+type Style struct {
+	Color bool
+}
+
+// ansiReset ends every styled span.
+const ansiReset = "\x1b[0m"
+
+// Red is used for busts.
+func (s Style) Red(text string) string { return s.apply("31", text) }
+
+// Green is used for Flip 7s.
+func (s Style) Green(text string) string { return s.apply("32", text) }
+
+// Yellow is used for warnings.
+func (s Style) Yellow(text string) string { return s.apply("33", text) }
+
+// Bold is used for suggestions.
+func (s Style) Bold(text string) string { return s.apply("1", text) }
+
+// Underline is used for turn headers.
+func (s Style) Underline(text string) string { return s.apply("4", text) }
+
+// apply wraps text in the SGR code, leaving its leading and trailing spaces and line breaks
+// (e.g. the blank line before a turn header) outside the styled span.
+func (s Style) apply(code, text string) string {
+	trimmed := strings.TrimSpace(text)
+	if !s.Color || trimmed == "" {
+		return text
+	}
+	start := strings.Index(text, trimmed)
+	return text[:start] + "\x1b[" + code + "m" + trimmed + ansiReset + text[start+len(trimmed):]
+}
+
+// ColorEnabled reports whether output written to w should be styled: w is a terminal, and
+// neither NO_COLOR (see https://no-color.org) nor FLIP7_NO_COLOR is set to a non-empty value.
+// Pipes, files and writers that copy the output elsewhere (such as a -tee file) get plain text.
+func ColorEnabled(w io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("FLIP7_NO_COLOR") != "" {
+		return false
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
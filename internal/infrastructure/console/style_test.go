@@ -0,0 +1,74 @@
+package console
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestStyle_Rendering(t *testing.T) {
+	on, off := Style{Color: true}, Style{}
+	tests := []struct {
+		name      string
+		style     func(Style, string) string
+		text      string
+		colored   string
+		uncolored string
+	}{
+		{"red", Style.Red, "BUSTED!", "\x1b[31mBUSTED!\x1b[0m", "BUSTED!"},
+		{"nested", func(s Style, text string) string { return s.Bold(s.Green(text)) }, "FLIP 7!", "\x1b[1m\x1b[32mFLIP 7!\x1b[0m\x1b[0m", "FLIP 7!"},
+		{"surrounding space", Style.Underline, "\n>>> Turn: Me ", "\n\x1b[4m>>> Turn: Me\x1b[0m ", "\n>>> Turn: Me "},
+		{"blank", Style.Bold, " ", " ", " "},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style(on, tt.text); got != tt.colored {
+				t.Errorf("Expected %q with color, got %q", tt.colored, got)
+			}
+			if got := tt.style(off, tt.text); got != tt.uncolored {
+				t.Errorf("Expected %q without color, got %q", tt.uncolored, got)
+			}
+		})
+	}
+}
+
+func TestMessages_StyleEmphasizesKeyMoments(t *testing.T) {
+	for _, lang := range []Language{LanguageEnglish, LanguageJapanese} {
+		m := NewMessages(lang)
+		plain := m.Format(MsgBustedDuplicate, Args{"value": 5})
+		if strings.Contains(plain, "\x1b[") {
+			t.Errorf("%s: expected no escape codes by default, got %q", lang, plain)
+		}
+
+		m.Style = Style{Color: true}
+		if got, want := m.Format(MsgBustedDuplicate, Args{"value": 5}), "\x1b[31m"+plain+ansiReset; got != want {
+			t.Errorf("%s: expected the bust in red, got %q", lang, got)
+		}
+		if got := m.Format(MsgTargetSuggested, nil); !strings.HasPrefix(got, " \x1b[1m") {
+			t.Errorf("%s: expected [Suggested] in bold after its space, got %q", lang, got)
+		}
+		if got := m.Format(MsgPlayed, Args{"card": 5}); strings.Contains(got, "\x1b[") {
+			t.Errorf("%s: expected an ordinary message to stay plain, got %q", lang, got)
+		}
+	}
+
+	var unset *Messages
+	if got := unset.Format(MsgFlip7, nil); got != "FLIP 7!" {
+		t.Errorf("Expected a nil catalog to render plain English, got %q", got)
+	}
+}
+
+func TestColorEnabled(t *testing.T) {
+	if ColorEnabled(&strings.Builder{}) {
+		t.Error("Expected no color for a writer that is not a terminal")
+	}
+	t.Setenv("NO_COLOR", "1")
+	if ColorEnabled(os.Stdout) {
+		t.Error("Expected NO_COLOR to turn color off")
+	}
+	t.Setenv("NO_COLOR", "")
+	t.Setenv("FLIP7_NO_COLOR", "1")
+	if ColorEnabled(os.Stdout) {
+		t.Error("Expected FLIP7_NO_COLOR to turn color off")
+	}
+}
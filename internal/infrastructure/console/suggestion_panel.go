@@ -0,0 +1,57 @@
+package console
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// AdvisorSuggestion is one advisor's entry in the Manual Mode suggestion panel.
+type AdvisorSuggestion struct {
+	Advisor string // Name of the advising strategy
+	Choice  string // The move it suggests, or the name of the target it suggests; "" for none
+}
+
+// FormatSuggestionPanel renders the moves suggested by several advisors on one line. The moves
+// that agree with majority are in brackets, and the line ends with the majority's size, e.g.
+// "Suggestions — Adaptive: [hit], ExpectedValue: stay, Aggressive: [hit] → majority: hit (2 of 3)".
+// votes is the number of advisors suggesting majority; unless that is more than half of them
+// the line ends "→ no majority" and nothing is bracketed.
+func FormatSuggestionPanel(suggestions []AdvisorSuggestion, majority string, votes int) string {
+	return (*Messages)(nil).SuggestionPanel(suggestions, majority, votes)
+}
+
+// SuggestionPanel is FormatSuggestionPanel in the language of m.
+func (m *Messages) SuggestionPanel(suggestions []AdvisorSuggestion, majority string, votes int) string {
+	hasMajority := votes*2 > len(suggestions)
+	parts := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		choice := s.Choice
+		if hasMajority && choice == majority {
+			choice = "[" + choice + "]"
+		}
+		parts[i] = s.Advisor + ": " + choice
+	}
+	summary := m.Format(MsgSuggestionNoMajority, nil)
+	if hasMajority {
+		summary = m.Format(MsgSuggestionMajority, Args{"move": majority, "votes": votes, "count": len(suggestions)})
+	}
+	return m.Format(MsgSuggestionPanel, Args{"advice": strings.Join(parts, ", "), "majority": summary})
+}
+
+// TargetAdvice lists the target each advisor suggests by its initial, e.g.
+// " (Adaptive: B, ExpectedValue: C)", or "-" for an advisor without a target. It returns ""
+// for fewer than two advisors, whose choice the [Suggested] marker already shows.
+func (m *Messages) TargetAdvice(suggestions []AdvisorSuggestion) string {
+	if len(suggestions) < 2 {
+		return ""
+	}
+	parts := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		initial := "-"
+		if r, size := utf8.DecodeRuneInString(s.Choice); size > 0 {
+			initial = string(r)
+		}
+		parts[i] = s.Advisor + ": " + initial
+	}
+	return m.Format(MsgTargetAdvice, Args{"advice": strings.Join(parts, ", ")})
+}
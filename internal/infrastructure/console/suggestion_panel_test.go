@@ -0,0 +1,43 @@
+package console_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+func TestFormatSuggestionPanel(t *testing.T) {
+	suggestions := []console.AdvisorSuggestion{
+		{Advisor: "Adaptive", Choice: "hit"},
+		{Advisor: "ExpectedValue", Choice: "stay"},
+		{Advisor: "Heuristic-27", Choice: "stay"},
+		{Advisor: "Aggressive", Choice: "hit"},
+	}
+	tests := []struct {
+		name     string
+		majority string
+		votes    int
+		want     string
+	}{
+		{"majority", "hit", 3, "Suggestions — Adaptive: [hit], ExpectedValue: stay, Heuristic-27: stay, Aggressive: [hit] → majority: hit (3 of 4)"},
+		{"tie", "hit", 2, "Suggestions — Adaptive: hit, ExpectedValue: stay, Heuristic-27: stay, Aggressive: hit → no majority"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := console.FormatSuggestionPanel(suggestions, tt.majority, tt.votes); got != tt.want {
+				t.Errorf("Expected:\n%s\ngot:\n%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTargetAdvice(t *testing.T) {
+	m := console.NewMessages(console.LanguageEnglish)
+	advice := []console.AdvisorSuggestion{{Advisor: "Adaptive", Choice: "Bob"}, {Advisor: "Cautious"}}
+	if got, want := m.TargetAdvice(advice), " (Adaptive: B, Cautious: -)"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	if got := m.TargetAdvice(advice[:1]); got != "" {
+		t.Errorf("Expected no advice for a single advisor, got %q", got)
+	}
+}
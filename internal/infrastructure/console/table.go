@@ -0,0 +1,202 @@
+package console
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Table collects rows of pre-formatted cells and renders them either as a
+// column-aligned text table or as CSV.
+// Columns are sized to their widest cell; numeric cells (e.g. "12", "3.50", "45.00%", "+1.20")
+// are right-aligned, everything else is left-aligned.
+type Table struct {
+	header     []string
+	rows       [][]string
+	sortColumn int
+	sortDesc   bool
+	sorted     bool
+}
+
+// NewTable creates an empty table.
+func NewTable() *Table {
+	return &Table{}
+}
+
+// AddHeader sets the column titles.
+func (t *Table) AddHeader(columns ...string) {
+	t.header = columns
+}
+
+// AddRow appends a row. Values are formatted with %v; use AddRow(fmt.Sprintf(...)) for custom formats.
+func (t *Table) AddRow(cells ...interface{}) {
+	row := make([]string, len(cells))
+	for i, c := range cells {
+		row[i] = fmt.Sprint(c)
+	}
+	t.rows = append(t.rows, row)
+}
+
+// SortBy orders the rows by the given column when rendering.
+// Numeric columns are compared by value, others as strings. Ties keep insertion order.
+func (t *Table) SortBy(column int, descending bool) {
+	t.sortColumn = column
+	t.sortDesc = descending
+	t.sorted = true
+}
+
+// Render writes the table as aligned text.
+func (t *Table) Render(w io.Writer) {
+	rows := t.sortedRows()
+
+	columns := len(t.header)
+	for _, row := range rows {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+
+	widths := make([]int, columns)
+	numeric := make([]bool, columns)
+	for col := 0; col < columns; col++ {
+		numeric[col] = len(rows) > 0
+		for _, row := range rows {
+			cell := cellAt(row, col)
+			if cell != "" && !isNumeric(cell) {
+				numeric[col] = false
+			}
+			if width := displayWidth(cell); width > widths[col] {
+				widths[col] = width
+			}
+		}
+		if width := displayWidth(cellAt(t.header, col)); width > widths[col] {
+			widths[col] = width
+		}
+	}
+
+	if len(t.header) > 0 {
+		writeRow(w, t.header, widths, numeric)
+		separators := make([]string, columns)
+		for col, width := range widths {
+			separators[col] = strings.Repeat("-", width)
+		}
+		fmt.Fprintln(w, strings.Join(separators, "-+-"))
+	}
+	for _, row := range rows {
+		writeRow(w, row, widths, numeric)
+	}
+}
+
+// RenderCSV writes the header and rows as CSV, in the same order as Render.
+func (t *Table) RenderCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if len(t.header) > 0 {
+		if err := cw.Write(t.header); err != nil {
+			return err
+		}
+	}
+	for _, row := range t.sortedRows() {
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (t *Table) sortedRows() [][]string {
+	rows := make([][]string, len(t.rows))
+	copy(rows, t.rows)
+	if !t.sorted {
+		return rows
+	}
+
+	col := t.sortColumn
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, b := cellAt(rows[i], col), cellAt(rows[j], col)
+		less := a < b
+		greater := a > b
+		if va, okA := numericValue(a); okA {
+			if vb, okB := numericValue(b); okB {
+				less = va < vb
+				greater = va > vb
+			}
+		}
+		if t.sortDesc {
+			return greater
+		}
+		return less
+	})
+	return rows
+}
+
+func writeRow(w io.Writer, row []string, widths []int, numeric []bool) {
+	cells := make([]string, len(widths))
+	for col, width := range widths {
+		cell := cellAt(row, col)
+		padding := strings.Repeat(" ", width-displayWidth(cell))
+		if numeric[col] {
+			cells[col] = padding + cell
+		} else {
+			cells[col] = cell + padding
+		}
+	}
+	// Trailing spaces of the last column are not useful in a terminal.
+	fmt.Fprintln(w, strings.TrimRight(strings.Join(cells, " | "), " "))
+}
+
+func cellAt(row []string, col int) string {
+	if col < len(row) {
+		return row[col]
+	}
+	return ""
+}
+
+func isNumeric(cell string) bool {
+	_, ok := numericValue(cell)
+	return ok
+}
+
+// numericValue parses numbers with an optional sign and trailing percent sign.
+func numericValue(cell string) (float64, bool) {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(cell), "%"), 64)
+	return v, err == nil
+}
+
+// displayWidth returns the number of terminal columns needed to print s.
+// East Asian wide characters and most emoji take two columns; combining marks take none.
+func displayWidth(s string) int {
+	width := 0
+	for _, r := range s {
+		switch {
+		case unicode.Is(unicode.Mn, r) || unicode.Is(unicode.Me, r) || r == '\u200d':
+			// Combining marks and zero-width joiners
+		case isWide(r):
+			width += 2
+		default:
+			width++
+		}
+	}
+	return width
+}
+
+func isWide(r rune) bool {
+	return (r >= 0x1100 && r <= 0x115F) || // Hangul Jamo
+		(r >= 0x2E80 && r <= 0x303E) || // CJK radicals, punctuation
+		(r >= 0x3041 && r <= 0x33FF) || // Hiragana, Katakana, CJK symbols
+		(r >= 0x3400 && r <= 0x4DBF) || // CJK Extension A
+		(r >= 0x4E00 && r <= 0x9FFF) || // CJK Unified Ideographs
+		(r >= 0xA000 && r <= 0xA4CF) || // Yi
+		(r >= 0xAC00 && r <= 0xD7A3) || // Hangul syllables
+		(r >= 0xF900 && r <= 0xFAFF) || // CJK compatibility ideographs
+		(r >= 0xFE30 && r <= 0xFE4F) || // CJK compatibility forms
+		(r >= 0xFF00 && r <= 0xFF60) || // Fullwidth forms
+		(r >= 0xFFE0 && r <= 0xFFE6) ||
+		(r >= 0x1F300 && r <= 0x1F64F) || // Emoji
+		(r >= 0x1F900 && r <= 0x1F9FF) ||
+		(r >= 0x20000 && r <= 0x3FFFD) // CJK Extensions B+
+}
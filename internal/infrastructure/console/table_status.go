@@ -0,0 +1,63 @@
+package console
+
+import (
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// FormatTableStatus renders the table at a glance during a round: the round number and the
+// deck and discard pile sizes, then one row per player in seat order with their status, banked
+// total, hand as laid out on the table, hand score and whether they hold a Second Chance.
+// The row of current (nil for none) is marked with an arrow and the dealer is labelled.
+// Dropped players are left out. Every line ends with a newline.
+func FormatTableStatus(game *domain.Game, current *domain.Player) string {
+	return (*Messages)(nil).TableStatus(game, current)
+}
+
+// TableStatus is FormatTableStatus in the language of m.
+func (m *Messages) TableStatus(game *domain.Game, current *domain.Player) string {
+	round := game.CurrentRound
+	if round == nil {
+		return m.Format(MsgTableNoRound, nil) + "\n"
+	}
+	deck := 0
+	if round.Deck != nil {
+		deck = round.Deck.Remaining()
+	}
+
+	var b strings.Builder
+	b.WriteString(m.Format(MsgTableStatusHeader, Args{"round": game.RoundCount, "deck": deck, "discard": len(game.DiscardPile)}))
+	b.WriteString("\n")
+
+	table := NewTable()
+	table.AddHeader("",
+		m.Format(MsgTableColumnPlayer, nil),
+		m.Format(MsgTableColumnStatus, nil),
+		m.Format(MsgTableColumnTotal, nil),
+		m.Format(MsgTableColumnHand, nil),
+		m.Format(MsgTableColumnScore, nil),
+		m.Format(MsgTableColumnSecond, nil))
+	calc := domain.NewScoreCalculator()
+	for _, r := range round.Summary(game.RoundCount).Results {
+		marker := ""
+		if current != nil && r.Player.ID == current.ID {
+			marker = "→"
+		}
+		name := r.Player.Name
+		if round.Dealer != nil && r.Player.ID == round.Dealer.ID {
+			name = m.Format(MsgTableDealer, Args{"name": name})
+		}
+		status := m.Format(roundOutcomeMessage(r.Outcome), nil)
+		if r.Outcome == domain.RoundOutcomeInPlay {
+			status = m.Format(MsgTableStatusActive, nil)
+		}
+		secondChance := ""
+		if r.Hand.HasSecondChance() {
+			secondChance = m.Format(MsgTableSecondChance, nil)
+		}
+		table.AddRow(marker, name, status, r.Player.TotalScore, DisplayHand(r.Hand), calc.Total(r.Hand), secondChance)
+	}
+	table.Render(&b)
+	return b.String()
+}
@@ -0,0 +1,43 @@
+package console_test
+
+import (
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+	"flip7_strategy/internal/testutil"
+)
+
+func TestFormatTableStatus_MixedStatuses(t *testing.T) {
+	ann := playerWithHand("Ann", 12, numberCard(9), numberCard(3))
+	ann.CurrentHand.Status = domain.HandStatusStayed
+	bob := playerWithHand("Bob", 30, numberCard(8), domain.Card{Type: domain.CardTypeAction, ActionType: domain.ActionSecondChance}, numberCard(2))
+	cat := playerWithHand("Cat", 40, numberCard(6), numberCard(6))
+	cat.CurrentHand.Status = domain.HandStatusBusted
+	dan := playerWithHand("Dan", 10, numberCard(10), domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4})
+	dan.CurrentHand.Status = domain.HandStatusFrozen
+
+	players := []*domain.Player{ann, bob, cat, dan}
+	game := domain.NewGame(players)
+	game.RoundCount = 3
+	game.CurrentRound = testutil.NewRound(players, ann, domain.NewDeckInOrder([]domain.Card{numberCard(1), numberCard(4), numberCard(7)}))
+	game.DiscardPile = []domain.Card{numberCard(11), numberCard(12)}
+
+	want := "\n--- Table: round 3 | deck 3 | discard 2 ---\n" +
+		"  | Player       | Status | Total | Hand       | Hand score | SC\n" +
+		"--+--------------+--------+-------+------------+------------+----\n" +
+		"  | Ann (dealer) | stayed |    12 | [3, 9]     |         12 |\n" +
+		"→ | Bob          | active |    30 | [2, 8, SC] |         10 | yes\n" +
+		"  | Cat          | busted |    40 | [6, 6]     |          0 |\n" +
+		"  | Dan          | frozen |    10 | [10, +4]   |         14 |\n"
+	if got := console.FormatTableStatus(game, bob); got != want {
+		t.Errorf("Expected:\n%s\ngot:\n%s", want, got)
+	}
+}
+
+func TestFormatTableStatus_NoRound(t *testing.T) {
+	game := domain.NewGame([]*domain.Player{domain.NewPlayer("Ann", nil)})
+	if got, want := console.FormatTableStatus(game, nil), "No round is in progress.\n"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
@@ -0,0 +1,70 @@
+package console_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+func TestTable_RenderAlignsMixedWidthNames(t *testing.T) {
+	table := console.NewTable()
+	table.AddHeader("Player", "Wins", "Win Rate")
+	table.AddRow("Alice", 12, "12.00%")
+	table.AddRow("太郎", 3, "3.00%")
+	table.AddRow("Zoë", 105, "105.00%")
+
+	var buf bytes.Buffer
+	table.Render(&buf)
+
+	expected := strings.Join([]string{
+		"Player | Wins | Win Rate",
+		"-------+------+---------",
+		"Alice  |   12 |   12.00%",
+		"太郎   |    3 |    3.00%",
+		"Zoë    |  105 |  105.00%",
+	}, "\n") + "\n"
+	if buf.String() != expected {
+		t.Errorf("Unexpected rendering.\nExpected:\n%s\nGot:\n%s", expected, buf.String())
+	}
+}
+
+func TestTable_SortByNumericColumn(t *testing.T) {
+	table := console.NewTable()
+	table.AddHeader("Strategy", "Win Rate")
+	table.AddRow("Cautious", "9.50%")
+	table.AddRow("Aggressive", "20.25%")
+	table.AddRow("Adaptive", "100.00%")
+	table.SortBy(1, true)
+
+	var buf bytes.Buffer
+	table.Render(&buf)
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+
+	// Numeric sort: "100.00%" > "20.25%" > "9.50%" (a string sort would put 9.50% first)
+	want := []string{"Adaptive", "Aggressive", "Cautious"}
+	for i, name := range want {
+		if !strings.HasPrefix(lines[i+2], name) {
+			t.Errorf("Row %d: expected %s, got %q", i, name, lines[i+2])
+		}
+	}
+}
+
+func TestTable_RenderCSV(t *testing.T) {
+	table := console.NewTable()
+	table.AddHeader("Player", "Score")
+	table.AddRow("Bob", 10)
+	table.AddRow("Smith, Anna", 30)
+	table.SortBy(1, true)
+
+	var buf bytes.Buffer
+	if err := table.RenderCSV(&buf); err != nil {
+		t.Fatalf("RenderCSV failed: %v", err)
+	}
+
+	expected := "Player,Score\n\"Smith, Anna\",30\nBob,10\n"
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
@@ -0,0 +1,94 @@
+package console
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// FormatHand renders a hand as "[5, 8, plus_4, freeze]": number cards in draw order, then modifiers, then actions.
+// A nil hand renders as "[]". It is the form logs record; prompts show DisplayHand.
+func FormatHand(h *domain.PlayerHand) string {
+	if h == nil {
+		return "[]"
+	}
+	var parts []string
+	for _, val := range h.RawNumberCards {
+		parts = append(parts, strconv.Itoa(int(val)))
+	}
+	for _, mod := range h.ModifierCards {
+		parts = append(parts, string(mod.ModifierType))
+	}
+	for _, act := range h.ActionCards {
+		parts = append(parts, string(act.ActionType))
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// FormatTargetOption describes a candidate for an action card: name, banked score and hand.
+// When deck is known, players still drawing also show their bust risk on the next hit.
+// The acting player (self) is marked "(You)"; freezing yourself banks your hand, so for Freeze
+// the points that would be banked are shown. self and deck may be nil.
+func FormatTargetOption(action domain.ActionType, candidate, self *domain.Player, deck domain.DeckView) string {
+	return (*Messages)(nil).TargetOption(action, candidate, self, deck)
+}
+
+// TargetOption is FormatTargetOption in the language of m.
+func (m *Messages) TargetOption(action domain.ActionType, candidate, self *domain.Player, deck domain.DeckView) string {
+	isSelf := self != nil && candidate.ID == self.ID
+	name := candidate.Name
+	if isSelf {
+		name = m.Format(MsgTargetYou, Args{"name": name})
+	}
+	text := m.Format(MsgTargetOption, Args{"name": name, "score": candidate.TotalScore, "hand": DisplayHand(candidate.CurrentHand)})
+
+	hand := candidate.CurrentHand
+	if deck != nil && hand != nil && hand.Status == domain.HandStatusActive {
+		risk := deck.EstimateHitRisk(hand.NumberCards, hand.HasSecondChance())
+		text += m.Format(MsgTargetBustRisk, Args{"risk": risk * 100})
+	}
+	if isSelf && action == domain.ActionFreeze && hand != nil {
+		text += m.Format(MsgTargetBankSelf, Args{"points": domain.NewScoreCalculator().Compute(hand).Total})
+	}
+	return text
+}
+
+// WriteTargetOptions writes the numbered list of candidates, marking the suggested one if any.
+func WriteTargetOptions(w io.Writer, action domain.ActionType, candidates []*domain.Player, self *domain.Player, deck domain.DeckView, suggested *domain.Player) {
+	(*Messages)(nil).WriteTargetOptions(w, action, candidates, self, deck, suggested)
+}
+
+// WriteTargetOptions is the package-level WriteTargetOptions in the language of m.
+func (m *Messages) WriteTargetOptions(w io.Writer, action domain.ActionType, candidates []*domain.Player, self *domain.Player, deck domain.DeckView, suggested *domain.Player) {
+	m.WriteAdvisedTargetOptions(w, action, candidates, self, deck, suggested, nil)
+}
+
+// WriteAdvisedTargetOptions is WriteTargetOptions with the targets of several advisors shown
+// after the [Suggested] marker, e.g. " [Suggested] (Adaptive: B, ExpectedValue: C)" (see
+// TargetAdvice). Without advice it writes the same list as WriteTargetOptions.
+func (m *Messages) WriteAdvisedTargetOptions(w io.Writer, action domain.ActionType, candidates []*domain.Player, self *domain.Player, deck domain.DeckView, suggested *domain.Player, advice []AdvisorSuggestion) {
+	for i, c := range candidates {
+		marker := ""
+		if suggested != nil && c.ID == suggested.ID {
+			marker = m.Format(MsgTargetSuggested, nil) + m.TargetAdvice(advice)
+		}
+		fmt.Fprintf(w, "%d. %s%s\n", i+1, m.TargetOption(action, c, self, deck), marker)
+	}
+}
+
+// ParseTargetChoice parses a 1-based selection from a list of count candidates
+// and returns the 0-based index. Full-width digits are read as ASCII (see domain.NormalizeToken).
+func ParseTargetChoice(input string, count int) (int, error) {
+	input = domain.NormalizeToken(input)
+	idx, err := strconv.Atoi(input)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a number", input)
+	}
+	if idx < 1 || idx > count {
+		return 0, fmt.Errorf("choose a number from 1 to %d", count)
+	}
+	return idx - 1, nil
+}
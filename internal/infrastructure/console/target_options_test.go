@@ -0,0 +1,119 @@
+package console_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/infrastructure/console"
+)
+
+func numberCard(v int) domain.Card {
+	return domain.Card{Type: domain.CardTypeNumber, Value: domain.NumberValue(v)}
+}
+
+func playerWithHand(name string, score int, cards ...domain.Card) *domain.Player {
+	p := domain.NewPlayer(name, nil)
+	p.TotalScore = score
+	p.StartNewRound()
+	for _, c := range cards {
+		p.CurrentHand.AddCard(c)
+	}
+	return p
+}
+
+func TestFormatHand(t *testing.T) {
+	hand := domain.NewPlayerHand()
+	hand.AddCard(numberCard(8))
+	hand.AddCard(domain.Card{Type: domain.CardTypeModifier, ModifierType: domain.ModifierPlus4})
+	hand.AddCard(numberCard(5))
+
+	if got := console.FormatHand(hand); got != "[8, 5, plus_4]" {
+		t.Errorf("Expected [8, 5, plus_4], got %s", got)
+	}
+	if got := console.FormatHand(nil); got != "[]" {
+		t.Errorf("Expected [] for a nil hand, got %s", got)
+	}
+}
+
+func TestFormatTargetOption(t *testing.T) {
+	me := playerWithHand("Me", 40, numberCard(5), numberCard(8))
+	bob := playerWithHand("Bob", 120, numberCard(3))
+	// Two of the four remaining cards are 5s, which would bust Me.
+	deck := domain.NewDeckInOrder([]domain.Card{numberCard(5), numberCard(5), numberCard(1), numberCard(2)})
+
+	tests := []struct {
+		name      string
+		action    domain.ActionType
+		candidate *domain.Player
+		deck      domain.DeckView
+		want      string
+	}{
+		{"Freeze on yourself shows the points banked", domain.ActionFreeze, me, deck,
+			"Me (You) (Score: 40) Hand: [5, 8] | Bust risk: 50% (bank your current 13 points)"},
+		{"Flip Three on yourself has no banking note", domain.ActionFlipThree, me, deck,
+			"Me (You) (Score: 40) Hand: [5, 8] | Bust risk: 50%"},
+		{"Opponent", domain.ActionFreeze, bob, deck,
+			"Bob (Score: 120) Hand: [3] | Bust risk: 0%"},
+		{"Unknown deck omits bust risk", domain.ActionFreeze, bob, nil,
+			"Bob (Score: 120) Hand: [3]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := console.FormatTargetOption(tt.action, tt.candidate, me, tt.deck); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("Stayed players have no bust risk", func(t *testing.T) {
+		stayed := playerWithHand("Carol", 10, numberCard(5))
+		stayed.CurrentHand.Status = domain.HandStatusStayed
+		if got := console.FormatTargetOption(domain.ActionFreeze, stayed, me, deck); strings.Contains(got, "Bust risk") {
+			t.Errorf("Expected no bust risk for a stayed player, got %q", got)
+		}
+	})
+}
+
+func TestWriteTargetOptions(t *testing.T) {
+	me := playerWithHand("Me", 40, numberCard(5))
+	bob := playerWithHand("Bob", 120)
+
+	var buf bytes.Buffer
+	console.WriteTargetOptions(&buf, domain.ActionFreeze, []*domain.Player{me, bob}, me, nil, bob)
+
+	want := "1. Me (You) (Score: 40) Hand: [5] (bank your current 5 points)\n" +
+		"2. Bob (Score: 120) Hand: [] [Suggested]\n"
+	if buf.String() != want {
+		t.Errorf("Expected:\n%s\nGot:\n%s", want, buf.String())
+	}
+}
+
+func TestParseTargetChoice(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    int
+		wantErr bool
+	}{
+		{"1", 0, false},
+		{" 3 \n", 2, false},
+		{"2\r\n", 1, false},
+		{"\uFEFF2", 1, false},
+		{"２", 1, false},
+		{"0", 0, true},
+		{"4", 0, true},
+		{"Bob", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := console.ParseTargetChoice(tt.input, 3)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseTargetChoice(%q): expected error %v, got %v", tt.input, tt.wantErr, err)
+			continue
+		}
+		if err == nil && got != tt.want {
+			t.Errorf("ParseTargetChoice(%q): expected %d, got %d", tt.input, tt.want, got)
+		}
+	}
+}
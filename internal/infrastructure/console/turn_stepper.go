@@ -0,0 +1,46 @@
+package console
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// TurnStepper pauses an automatic game after every turn until Enter is pressed,
+// so the strategies' choices can be followed one at a time.
+type TurnStepper struct {
+	reader *bufio.Reader
+	out    io.Writer
+}
+
+// NewTurnStepper creates a TurnStepper that reads from in and writes its prompt to out.
+// Passing a *bufio.Reader shared with other prompts keeps buffered input from being lost between them.
+func NewTurnStepper(in io.Reader, out io.Writer) *TurnStepper {
+	return &TurnStepper{
+		reader: bufio.NewReader(in),
+		out:    out,
+	}
+}
+
+// AfterTurn is the GameService.AfterTurn hook. Enter plays the next turn, "a" plays the rest of
+// the game without pausing and "q" ends it. When input runs out the game is played to the end.
+func (s *TurnStepper) AfterTurn(round *domain.Round, player *domain.Player) domain.StepControl {
+	fmt.Fprintf(s.out, "[Enter] next turn, [a] run to end, [q] quit: ")
+	input, err := s.reader.ReadString('\n')
+	if err != nil && strings.TrimSpace(input) == "" {
+		// No more input (e.g. stdin closed); pausing again would loop forever.
+		fmt.Fprintln(s.out)
+		return domain.StepRunToEnd
+	}
+	switch strings.ToLower(strings.TrimSpace(input)) {
+	case "a":
+		return domain.StepRunToEnd
+	case "q":
+		return domain.StepAbort
+	default:
+		return domain.StepContinue
+	}
+}
@@ -0,0 +1,19 @@
+package console
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/domain"
+)
+
+func TestTurnStepper_AfterTurn(t *testing.T) {
+	stepper := NewTurnStepper(strings.NewReader("\nx\nA\nq\n"), io.Discard)
+	expected := []domain.StepControl{domain.StepContinue, domain.StepContinue, domain.StepRunToEnd, domain.StepAbort, domain.StepRunToEnd}
+	for i, want := range expected {
+		if got := stepper.AfterTurn(nil, nil); got != want {
+			t.Errorf("Answer %d: expected %v, got %v", i+1, want, got)
+		}
+	}
+}
@@ -14,6 +14,9 @@ type CSVLogger struct {
 	file   *os.File
 	writer *csv.Writer
 	mu     sync.Mutex
+
+	// Now is the source of the Timestamp column; time.Now if nil.
+	Now func() time.Time
 }
 
 // NewCSVLogger creates a new CSVLogger writing to the specified path.
@@ -51,7 +54,11 @@ func (l *CSVLogger) Log(gameID, roundID, playerID, eventType string, details map
 	l.mu.Lock()
 	defer l.mu.Unlock()
 
-	timestamp := time.Now().Format(time.RFC3339)
+	now := l.Now
+	if now == nil {
+		now = time.Now
+	}
+	timestamp := now().Format(time.RFC3339)
 	detailsJSON, err := json.Marshal(details)
 	if err != nil {
 		detailsJSON = []byte("{}") // Fallback
@@ -0,0 +1,69 @@
+package logging
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// LogRecord is one row of a CSV game log written by CSVLogger.
+type LogRecord struct {
+	Timestamp string
+	GameID    string
+	RoundID   string
+	PlayerID  string
+	EventType string
+	Details   map[string]interface{} // Decoded JSON; numbers are float64
+}
+
+// ReadLogRecords reads every record of a CSV game log, skipping the header.
+// Malformed rows are skipped and reported to warnings (which may be nil); the only
+// error returned is the one from reading the header.
+func ReadLogRecords(r io.Reader, warnings io.Writer) ([]LogRecord, error) {
+	if warnings == nil {
+		warnings = io.Discard
+	}
+	reader := csv.NewReader(r)
+
+	// Read header
+	if _, err := reader.Read(); err != nil {
+		return nil, err
+	}
+
+	var records []LogRecord
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Fprintf(warnings, "Error reading row: %v\n", err)
+			continue
+		}
+
+		// Validate row has at least 5 fields before accessing
+		if len(row) < 5 {
+			fmt.Fprintf(warnings, "Skipping malformed row (expected at least 5 fields, got %d): %v\n", len(row), row)
+			continue
+		}
+
+		var details map[string]interface{}
+		if len(row) > 5 {
+			if err := json.Unmarshal([]byte(row[5]), &details); err != nil {
+				fmt.Fprintf(warnings, "Error unmarshalling details for row: %v\n\tJSON: %s\n\tError: %v\n", row, row[5], err)
+				details = make(map[string]interface{})
+			}
+		}
+
+		records = append(records, LogRecord{
+			Timestamp: row[0],
+			GameID:    row[1],
+			RoundID:   row[2],
+			PlayerID:  row[3],
+			EventType: row[4],
+			Details:   details,
+		})
+	}
+	return records, nil
+}
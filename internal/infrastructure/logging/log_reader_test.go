@@ -0,0 +1,75 @@
+package logging_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"flip7_strategy/internal/infrastructure/logging"
+)
+
+func TestReadLogRecords_ReadsWhatCSVLoggerWrites(t *testing.T) {
+	logPath := filepath.Join(t.TempDir(), "game.csv")
+	logger, err := logging.NewCSVLogger(logPath)
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	logger.Log("game1", "1", "p1", "Stay", map[string]interface{}{"banked_score": 12, "total_score": 30})
+	logger.Close()
+
+	file, err := os.Open(logPath)
+	if err != nil {
+		t.Fatalf("Failed to open log: %v", err)
+	}
+	defer file.Close()
+
+	records, err := logging.ReadLogRecords(file, nil)
+	if err != nil {
+		t.Fatalf("Failed to read log: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 record, got %d", len(records))
+	}
+	r := records[0]
+	if r.GameID != "game1" || r.RoundID != "1" || r.PlayerID != "p1" || r.EventType != "Stay" {
+		t.Errorf("Unexpected record: %+v", r)
+	}
+	if r.Details["banked_score"] != 12.0 {
+		t.Errorf("Expected banked_score 12, got %v", r.Details["banked_score"])
+	}
+}
+
+func TestReadLogRecords_SkipsMalformedRows(t *testing.T) {
+	log := strings.Join([]string{
+		"Timestamp,GameID,RoundID,PlayerID,EventType,Details",
+		"t,game1,1,p1",
+		`t,game1,1,p1,Stay,{not json}`,
+		`t,game1,1,p1,Bust,"{""hand"":""[5, 5]""}"`,
+	}, "\n") + "\n"
+
+	var warnings bytes.Buffer
+	records, err := logging.ReadLogRecords(strings.NewReader(log), &warnings)
+	if err != nil {
+		t.Fatalf("Failed to read log: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %d", len(records))
+	}
+	if len(records[0].Details) != 0 {
+		t.Errorf("Expected empty details for invalid JSON, got %v", records[0].Details)
+	}
+	if records[1].Details["hand"] != "[5, 5]" {
+		t.Errorf("Expected hand [5, 5], got %v", records[1].Details["hand"])
+	}
+	if !strings.Contains(warnings.String(), "Error reading row") {
+		t.Errorf("Expected a warning for the short row, got: %s", warnings.String())
+	}
+}
+
+func TestReadLogRecords_MissingHeader(t *testing.T) {
+	if _, err := logging.ReadLogRecords(strings.NewReader(""), nil); err == nil {
+		t.Error("Expected an error for an empty log")
+	}
+}
@@ -0,0 +1,52 @@
+// Package stats holds the small amount of statistics the simulations need to tell
+// real differences between strategies from noise.
+package stats
+
+import "math"
+
+// Z95 is the standard normal quantile for a two-sided 95% confidence level.
+const Z95 = 1.959963984540054
+
+// WinRateMargin returns the half-width of the normal-approximation confidence interval
+// for a win rate of wins out of games: z * sqrt(p(1-p)/games).
+// Wins may be fractional (shared wins). It returns 0 when games is 0.
+func WinRateMargin(wins float64, games int, z float64) float64 {
+	if games <= 0 {
+		return 0
+	}
+	p := wins / float64(games)
+	return z * math.Sqrt(p*(1-p)/float64(games))
+}
+
+// TwoSidedPValue returns the probability of a result at least as far from an even
+// (50/50) split as wins out of games, under the normal approximation to the binomial.
+// Wins may be fractional (shared wins). It returns 1 when games is 0.
+func TwoSidedPValue(wins float64, games int) float64 {
+	if games <= 0 {
+		return 1
+	}
+	n := float64(games)
+	z := (wins - n/2) / math.Sqrt(n/4)
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+// MeanMargin returns the half-width of the normal-approximation confidence interval for the
+// mean of values: z * s / sqrt(n), with s the sample standard deviation. Applied to the
+// per-game differences of paired games it bounds the difference of two win rates.
+// It returns 0 for fewer than 2 values.
+func MeanMargin(values []float64, z float64) float64 {
+	n := len(values)
+	if n < 2 {
+		return 0
+	}
+	mean := 0.0
+	for _, v := range values {
+		mean += v
+	}
+	mean /= float64(n)
+	sumSquares := 0.0
+	for _, v := range values {
+		sumSquares += (v - mean) * (v - mean)
+	}
+	return z * math.Sqrt(sumSquares/float64(n-1)/float64(n))
+}
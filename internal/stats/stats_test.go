@@ -0,0 +1,75 @@
+package stats_test
+
+import (
+	"math"
+	"testing"
+
+	"flip7_strategy/internal/stats"
+)
+
+func TestWinRateMargin(t *testing.T) {
+	tests := []struct {
+		name     string
+		wins     float64
+		games    int
+		expected float64
+	}{
+		{"Even split", 50, 100, 0.0980},   // 1.96 * sqrt(0.25/100)
+		{"Skewed", 243, 1000, 0.0266},     // 1.96 * sqrt(0.243*0.757/1000)
+		{"Shared wins", 12.5, 50, 0.1200}, // p = 0.25
+		{"No wins", 0, 100, 0},
+		{"No games", 0, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := stats.WinRateMargin(tt.wins, tt.games, stats.Z95)
+			if math.Abs(got-tt.expected) > 0.0001 {
+				t.Errorf("Expected %.4f, got %.4f", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestTwoSidedPValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		wins     float64
+		games    int
+		expected float64
+	}{
+		{"Exactly even", 50, 100, 1},
+		{"Two standard errors above", 60, 100, 0.0455},
+		{"Two standard errors below", 40, 100, 0.0455},
+		{"1.96 standard errors", 530.99, 1000, 0.0500},
+		{"No games", 0, 0, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := stats.TwoSidedPValue(tt.wins, tt.games)
+			if math.Abs(got-tt.expected) > 0.0001 {
+				t.Errorf("Expected %.4f, got %.4f", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMeanMargin(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{"Paired differences", []float64{1, 0, -1, 0}, 0.8002}, // 1.96 * sqrt((2/3)/4)
+		{"No spread", []float64{1, 1, 1}, 0},
+		{"Single value", []float64{1}, 0},
+		{"No values", nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := stats.MeanMargin(tt.values, stats.Z95)
+			if math.Abs(got-tt.expected) > 0.0001 {
+				t.Errorf("Expected %.4f, got %.4f", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+// Package testutil generates random game states for tests, in particular property-based tests
+// with testing/quick.
+package testutil
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+
+	"flip7_strategy/internal/domain"
+)
+
+// MaxPlayers is the most players RandomGame seats.
+const MaxPlayers = 6
+
+// RandomGame returns a random legal game in the middle of a round, drawn from rng. It seats 1
+// to MaxPlayers players with random total scores, puts a random share of the shuffled deck on
+// the discard pile as if earlier rounds had used it, then plays random draws: each goes to a
+// random player still in play and may bust them (a Second Chance absorbing the duplicate), and
+// now and then a player who may stay stays or is frozen, banking their hand. A draw that would
+// complete Flip 7, or leave nobody in play, is discarded instead, so the round is never over.
+//
+// Every card is accounted for (see domain.Game.Invariants) and the deck's RemainingCounts
+// match its cards. Stayed and frozen players leave the round's active players, while busted
+// ones stay among them, as in Manual Mode. Players have no strategy (user-controlled); tests
+// that play the game on give them one.
+func RandomGame(rng *rand.Rand) *domain.Game {
+	n := 1 + rng.Intn(MaxPlayers)
+	players := make([]*domain.Player, n)
+	for i := range players {
+		players[i] = domain.NewPlayer(fmt.Sprintf("P%d", i+1), nil)
+		players[i].TotalScore = rng.Intn(domain.WinningThreshold)
+	}
+	g := domain.NewGame(players)
+	g.RoundCount = 1 + rng.Intn(10)
+	g.DealerIndex = rng.Intn(n)
+
+	cards := domain.StandardDeckCards()
+	rng.Shuffle(len(cards), func(i, j int) { cards[i], cards[j] = cards[j], cards[i] })
+	g.Deck = domain.NewDeckInOrder(cards)
+	g.CurrentRound = domain.NewRound(players, players[g.DealerIndex], g.Deck)
+	round := g.CurrentRound
+
+	for i := rng.Intn(len(cards) / 2); i > 0; i-- {
+		card, _ := g.Deck.Draw()
+		g.DiscardPile = append(g.DiscardPile, card)
+	}
+
+	for steps := rng.Intn(8 * n); steps > 0 && g.Deck.Remaining() > 0; steps-- {
+		p := round.ActivePlayers[rng.Intn(len(round.ActivePlayers))]
+		hand := p.CurrentHand
+		if hand.Status != domain.HandStatusActive {
+			continue
+		}
+		inPlay := activeHands(round)
+
+		if hand.CanStay() && inPlay > 1 && rng.Intn(6) == 0 {
+			hand.Status = domain.HandStatusStayed
+			if rng.Intn(2) == 0 {
+				hand.Status = domain.HandStatusFrozen
+			}
+			p.BankCurrentHand()
+			round.RemoveActivePlayer(p)
+			continue
+		}
+
+		card, _ := g.Deck.Draw()
+		busted, flip7, _ := hand.Clone().AddCard(card)
+		if flip7 || (busted && inPlay == 1) {
+			g.DiscardPile = append(g.DiscardPile, card)
+			continue
+		}
+		_, _, discarded := hand.AddCard(card)
+		g.DiscardPile = append(g.DiscardPile, discarded...)
+	}
+
+	round.CurrentTurnIndex = rng.Intn(len(round.ActivePlayers))
+	return g
+}
+
+// NewRound builds a round with domain.NewRound, then gives back the hands the test dealt
+// before building it; players without one keep the fresh hand NewRound gave them.
+func NewRound(players []*domain.Player, dealer *domain.Player, deck *domain.Deck) *domain.Round {
+	hands := make([]*domain.PlayerHand, len(players))
+	for i, p := range players {
+		hands[i] = p.CurrentHand
+	}
+	round := domain.NewRound(players, dealer, deck)
+	for i, p := range players {
+		if hands[i] != nil {
+			p.CurrentHand = hands[i]
+		}
+	}
+	return round
+}
+
+// activeHands counts the round's active players whose hand is still in play.
+func activeHands(round *domain.Round) int {
+	n := 0
+	for _, p := range round.ActivePlayers {
+		if p.CurrentHand.Status == domain.HandStatusActive {
+			n++
+		}
+	}
+	return n
+}
+
+// Game is a RandomGame as a testing/quick argument:
+//
+//	quick.Check(func(g testutil.Game) bool { ... }, nil)
+type Game struct {
+	*domain.Game
+}
+
+// Generate implements quick.Generator.
+func (Game) Generate(rng *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Game{RandomGame(rng)})
+}
+
+// String describes the game in one line, so a failing property shows the state it failed on.
+func (g Game) String() string {
+	var b strings.Builder
+	round := g.CurrentRound
+	fmt.Fprintf(&b, "round %d, deck %d, discards %d, turn %d:", g.RoundCount, round.Deck.Remaining(), len(g.DiscardPile), round.CurrentTurnIndex)
+	for _, p := range g.Players {
+		h := p.CurrentHand
+		fmt.Fprintf(&b, " %s %d %s %v %v %v;", p.Name, p.TotalScore, h.Status, h.RawNumberCards, h.ModifierCards, h.ActionCards)
+	}
+	return b.String()
+}
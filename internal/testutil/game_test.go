@@ -0,0 +1,108 @@
+package testutil_test
+
+import (
+	"testing"
+	"testing/quick"
+
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/testutil"
+)
+
+func TestRandomGame_IsLegalMidRound(t *testing.T) {
+	legal := func(g testutil.Game) bool {
+		if err := g.Invariants(); err != nil {
+			t.Logf("%v: %v", g, err)
+			return false
+		}
+		round := g.CurrentRound
+		if round.IsEnded || round.Deck != g.Deck {
+			t.Logf("%v: expected a round in progress on the game's deck", g)
+			return false
+		}
+		if n := len(round.ActivePlayers); round.CurrentTurnIndex < 0 || round.CurrentTurnIndex >= n {
+			t.Logf("%v: turn index outside the %d active players", g, n)
+			return false
+		}
+		if n := len(g.Players); n < 1 || n > testutil.MaxPlayers {
+			t.Logf("%v: %d players", g, n)
+			return false
+		}
+
+		counts := make(map[domain.NumberValue]int)
+		for _, c := range g.Deck.Cards {
+			if c.Type == domain.CardTypeNumber {
+				counts[c.Value]++
+			}
+		}
+		for v := domain.NumberValue(0); v <= 12; v++ {
+			if g.Deck.RemainingCounts[v] != counts[v] {
+				t.Logf("%v: %d copies of %d counted, %d in the deck", g, g.Deck.RemainingCounts[v], v, counts[v])
+				return false
+			}
+		}
+
+		inPlay := 0
+		for _, p := range g.Players {
+			h := p.CurrentHand
+			if h.Status == domain.HandStatusActive {
+				inPlay++
+			}
+			if len(h.NumberCards) >= 7 && h.Status != domain.HandStatusBusted {
+				t.Logf("%v: %s holds Flip 7", g, p.Name)
+				return false
+			}
+			if dup := len(h.RawNumberCards) != len(h.NumberCards); dup != (h.Status == domain.HandStatusBusted) {
+				t.Logf("%v: %s has a duplicate only if busted", g, p.Name)
+				return false
+			}
+		}
+		if inPlay == 0 {
+			t.Logf("%v: nobody in play", g)
+			return false
+		}
+		return true
+	}
+	if err := quick.Check(legal, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNewRound_KeepsDealtHands(t *testing.T) {
+	p1 := domain.NewPlayer("P1", nil)
+	p2 := domain.NewPlayer("P2", nil)
+	p3 := domain.NewPlayer("P3", nil)
+	players := []*domain.Player{p1, p2, p3}
+
+	// Configure hands before building the round
+	p1.StartNewRound()
+	p1.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 7})
+	p2.StartNewRound()
+	p2.CurrentHand.AddCard(domain.Card{Type: domain.CardTypeNumber, Value: 9})
+	// p3 has no hand yet
+
+	round := testutil.NewRound(players, p2, domain.NewDeck())
+
+	if len(p1.CurrentHand.RawNumberCards) != 1 || p1.CurrentHand.RawNumberCards[0] != 7 {
+		t.Errorf("Expected P1 hand to be preserved, got %v", p1.CurrentHand.RawNumberCards)
+	}
+	if len(p2.CurrentHand.RawNumberCards) != 1 || p2.CurrentHand.RawNumberCards[0] != 9 {
+		t.Errorf("Expected P2 hand to be preserved, got %v", p2.CurrentHand.RawNumberCards)
+	}
+	if p3.CurrentHand == nil {
+		t.Fatalf("Expected P3 to be given a fresh hand")
+	}
+
+	// Turn order starts from the dealer, as with domain.NewRound
+	expectedOrder := []*domain.Player{p2, p3, p1}
+	for i, p := range expectedOrder {
+		if round.ActivePlayers[i].ID != p.ID {
+			t.Errorf("ActivePlayers[%d] = %s, want %s", i, round.ActivePlayers[i].Name, p.Name)
+		}
+	}
+
+	// domain.NewRound still resets hands
+	domain.NewRound(players, p1, domain.NewDeck())
+	if len(p1.CurrentHand.RawNumberCards) != 0 {
+		t.Errorf("Expected domain.NewRound to reset P1 hand, got %v", p1.CurrentHand.RawNumberCards)
+	}
+}
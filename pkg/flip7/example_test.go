@@ -0,0 +1,69 @@
+package flip7_test
+
+import (
+	"fmt"
+
+	"flip7_strategy/pkg/flip7"
+)
+
+// stayAt is a custom strategy: hit until the hand is worth at least Points, then stay.
+type stayAt struct {
+	Points int
+}
+
+func (s stayAt) Name() string { return fmt.Sprintf("StayAt-%d", s.Points) }
+
+func (s stayAt) Decide(deck flip7.DeckView, hand *flip7.Hand, playerScore int, otherPlayers []*flip7.Player) flip7.Choice {
+	if flip7.ScoreHand(hand) >= s.Points {
+		return flip7.Stay
+	}
+	return flip7.Hit
+}
+
+// ChooseTarget freezes or flips on the first opponent, and gives itself anything else.
+func (s stayAt) ChooseTarget(action flip7.Action, candidates []*flip7.Player, self *flip7.Player) *flip7.Player {
+	for _, c := range candidates {
+		if c.ID != self.ID {
+			return c
+		}
+	}
+	return self
+}
+
+func Example() {
+	seats := []flip7.Seat{
+		{Name: "Custom", Strategy: stayAt{Points: 25}},
+		{Name: "Adaptive", Strategy: flip7.NewAdaptiveStrategy()},
+	}
+
+	results, err := flip7.NewSimulator().RunGames(50, seats)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	total := 0.0
+	for _, seat := range seats {
+		total += results.Wins[seat.Name]
+	}
+	fmt.Printf("%d games, %.0f wins shared out\n", len(results.Games), total)
+	// Output: 50 games, 50 wins shared out
+}
+
+func ExampleSimulator_RunGame() {
+	sim := flip7.NewSimulator()
+	sim.WinningScore = 50
+
+	result, err := sim.RunGame([]flip7.Seat{
+		{Name: "Alice", Strategy: flip7.NewCautiousStrategy()},
+		{Name: "Bob", Strategy: flip7.NewAggressiveStrategy()},
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	winner := result.Winners[0]
+	fmt.Println(result.Scores[winner] >= 50)
+	// Output: true
+}
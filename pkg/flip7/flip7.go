@@ -0,0 +1,69 @@
+// Package flip7 is the public API of the Flip 7 simulator: the types a strategy works with,
+// the built-in strategies, and a Simulator that plays games between them.
+//
+// The game engine lives in internal/; the types below are aliases of the engine's own types,
+// so a strategy written against this package plugs straight into it. Players are identified
+// by name in results. Player.ID (a uuid.UUID) and Hand.Status are visible through the aliases
+// but are engine details and may change.
+package flip7
+
+import (
+	"flip7_strategy/internal/domain"
+	"flip7_strategy/internal/domain/strategy"
+)
+
+// DefaultWinningScore is the score needed to win under the standard rules.
+const DefaultWinningScore = domain.WinningThreshold
+
+type (
+	// Strategy decides whether a player hits or stays, and whom their action cards target.
+	Strategy = domain.Strategy
+	// DeckView is what a card-counting player knows about the deck: how many cards of each
+	// kind remain and the resulting bust risk, but not their order.
+	DeckView = domain.DeckView
+	// Hand is a player's hand in the current round.
+	Hand = domain.PlayerHand
+	// Player is a seat at the table with its banked score and current hand.
+	Player = domain.Player
+	// Card is a number, modifier or action card.
+	Card = domain.Card
+	// Choice is a Strategy's decision for its turn: Hit or Stay.
+	Choice = domain.TurnChoice
+	// Action is the kind of action card a target is chosen for.
+	Action = domain.ActionType
+)
+
+const (
+	Hit  Choice = domain.TurnChoiceHit
+	Stay Choice = domain.TurnChoiceStay
+)
+
+const (
+	ActionFreeze           Action = domain.ActionFreeze
+	ActionFlipThree        Action = domain.ActionFlipThree
+	ActionGiveSecondChance Action = domain.ActionGiveSecondChance
+)
+
+// ScoreHand returns the points the hand would bank now (0 if busted).
+func ScoreHand(h *Hand) int {
+	return domain.NewScoreCalculator().Compute(h).Total
+}
+
+// NewCautiousStrategy stays above 30 points or once the bust risk exceeds 10%.
+func NewCautiousStrategy() Strategy { return strategy.NewCautiousStrategy() }
+
+// NewAggressiveStrategy keeps hitting until the bust risk exceeds 30%, chasing Flip 7.
+func NewAggressiveStrategy() Strategy { return strategy.NewAggressiveStrategy() }
+
+// NewProbabilisticStrategy stays once the bust risk exceeds 20%, accepting more risk when far behind
+// and less when close to winning.
+func NewProbabilisticStrategy() Strategy { return strategy.NewProbabilisticStrategy() }
+
+// NewHeuristicStrategy hits until its number cards add up to at least threshold.
+func NewHeuristicStrategy(threshold int) Strategy { return strategy.NewHeuristicStrategy(threshold) }
+
+// NewExpectedValueStrategy hits while the expected score after one more card beats staying.
+func NewExpectedValueStrategy() Strategy { return strategy.NewExpectedValueStrategy() }
+
+// NewAdaptiveStrategy plays ExpectedValue and turns Aggressive once an opponent reaches the winning score.
+func NewAdaptiveStrategy() Strategy { return strategy.NewAdaptiveStrategy() }
@@ -0,0 +1,120 @@
+package flip7
+
+import (
+	"errors"
+	"fmt"
+
+	"flip7_strategy/internal/application"
+	"flip7_strategy/internal/domain"
+)
+
+// Seat is a player taking part in simulated games. A fresh Player is created from it for every game.
+type Seat struct {
+	Name     string // Must be unique at the table; results are keyed by it
+	Strategy Strategy
+}
+
+// GameResult is the outcome of one game.
+type GameResult struct {
+	Winners []string       // Seat names; empty if the game hit the round limit
+	Scores  map[string]int // Final score per seat name
+	Rounds  int
+}
+
+// Results aggregates a series of games.
+type Results struct {
+	Games []GameResult
+	// Wins per seat name. A game won by several players on equal scores counts 1/k for each of the k winners.
+	Wins map[string]float64
+}
+
+// WinRate returns the share of games won by the named seat.
+func (r Results) WinRate(name string) float64 {
+	if len(r.Games) == 0 {
+		return 0
+	}
+	return r.Wins[name] / float64(len(r.Games))
+}
+
+// Simulator plays games between seats with the standard rules.
+// The zero value plays silent games to DefaultWinningScore without a round limit.
+type Simulator struct {
+	WinningScore int  // Score needed to win; 0 means DefaultWinningScore
+	MaxRounds    int  // End a game without a winner after this many rounds; 0 means no limit
+	Verbose      bool // Print every deal and decision to stdout
+}
+
+// NewSimulator creates a Simulator with the default settings.
+func NewSimulator() *Simulator {
+	return &Simulator{}
+}
+
+// RunGame plays one game. The first seat deals first.
+func (s *Simulator) RunGame(seats []Seat) (GameResult, error) {
+	if err := validateSeats(seats); err != nil {
+		return GameResult{}, err
+	}
+
+	players := make([]*domain.Player, len(seats))
+	for i, seat := range seats {
+		players[i] = domain.NewPlayer(seat.Name, seat.Strategy)
+	}
+	game := domain.NewGame(players)
+	if s.WinningScore > 0 {
+		game.WinningScore = s.WinningScore
+	}
+
+	svc := application.NewGameService(game)
+	svc.Silent = !s.Verbose
+	svc.MaxRounds = s.MaxRounds
+	svc.RunGame()
+
+	result := GameResult{Scores: make(map[string]int, len(players)), Rounds: game.RoundCount}
+	for _, p := range players {
+		result.Scores[p.Name] = p.TotalScore
+	}
+	for _, w := range game.Winners {
+		result.Winners = append(result.Winners, w.Name)
+	}
+	return result, nil
+}
+
+// RunGames plays n games with the same seats and aggregates the results.
+func (s *Simulator) RunGames(n int, seats []Seat) (Results, error) {
+	if err := validateSeats(seats); err != nil {
+		return Results{}, err
+	}
+
+	results := Results{Wins: make(map[string]float64, len(seats))}
+	for _, seat := range seats {
+		results.Wins[seat.Name] = 0
+	}
+	for i := 0; i < n; i++ {
+		game, err := s.RunGame(seats)
+		if err != nil {
+			return Results{}, err
+		}
+		results.Games = append(results.Games, game)
+		for _, w := range game.Winners {
+			results.Wins[w] += 1.0 / float64(len(game.Winners))
+		}
+	}
+	return results, nil
+}
+
+func validateSeats(seats []Seat) error {
+	if len(seats) == 0 {
+		return errors.New("at least one seat is required")
+	}
+	names := make(map[string]bool, len(seats))
+	for _, seat := range seats {
+		if seat.Strategy == nil {
+			return fmt.Errorf("seat %q has no strategy", seat.Name)
+		}
+		if names[seat.Name] {
+			return fmt.Errorf("seat name %q is used twice", seat.Name)
+		}
+		names[seat.Name] = true
+	}
+	return nil
+}
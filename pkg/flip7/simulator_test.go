@@ -0,0 +1,70 @@
+package flip7_test
+
+import (
+	"testing"
+
+	"flip7_strategy/pkg/flip7"
+)
+
+func TestSimulator_RejectsInvalidSeats(t *testing.T) {
+	tests := []struct {
+		name  string
+		seats []flip7.Seat
+	}{
+		{"No seats", nil},
+		{"Missing strategy", []flip7.Seat{{Name: "Alice"}}},
+		{"Duplicate names", []flip7.Seat{
+			{Name: "Alice", Strategy: flip7.NewCautiousStrategy()},
+			{Name: "Alice", Strategy: flip7.NewAggressiveStrategy()},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := flip7.NewSimulator().RunGames(1, tt.seats); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestSimulator_MaxRoundsEndsGameWithoutWinner(t *testing.T) {
+	sim := flip7.NewSimulator()
+	sim.MaxRounds = 3
+
+	// Staying on the initial card cannot reach 200 points in 3 rounds.
+	results, err := sim.RunGames(5, []flip7.Seat{{Name: "Solo", Strategy: stayAt{Points: 0}}})
+	if err != nil {
+		t.Fatalf("RunGames failed: %v", err)
+	}
+	for i, game := range results.Games {
+		if game.Rounds != 3 {
+			t.Errorf("Game %d: expected 3 rounds, got %d", i, game.Rounds)
+		}
+		if len(game.Winners) != 0 {
+			t.Errorf("Game %d: expected no winner, got %v", i, game.Winners)
+		}
+	}
+	if rate := results.WinRate("Solo"); rate != 0 {
+		t.Errorf("Expected a win rate of 0, got %.2f", rate)
+	}
+}
+
+func TestSimulator_WinRatesAddUpToOne(t *testing.T) {
+	seats := []flip7.Seat{
+		{Name: "Cautious", Strategy: flip7.NewCautiousStrategy()},
+		{Name: "Heuristic", Strategy: flip7.NewHeuristicStrategy(27)},
+		{Name: "EV", Strategy: flip7.NewExpectedValueStrategy()},
+	}
+	results, err := flip7.NewSimulator().RunGames(30, seats)
+	if err != nil {
+		t.Fatalf("RunGames failed: %v", err)
+	}
+
+	total := 0.0
+	for _, seat := range seats {
+		total += results.WinRate(seat.Name)
+	}
+	if total < 0.999 || total > 1.001 {
+		t.Errorf("Expected win rates to add up to 1, got %.4f", total)
+	}
+}